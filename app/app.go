@@ -366,7 +366,11 @@ func NewOsmosisApp(
 		blockProcessStrategyManager := commondomain.NewBlockProcessStrategyManager()
 
 		// Create sqs grpc client
-		sqsGRPCClient := sqsservice.NewGRPCCLient(sqsConfig.GRPCIngestAddress, sqsConfig.GRPCIngestMaxCallSizeBytes, appCodec)
+		sqsGRPCClientOpts, err := sqsConfig.GRPCClientOptions()
+		if err != nil {
+			panic(fmt.Sprintf("invalid sqs grpc config: %v", err))
+		}
+		sqsGRPCClient := sqsservice.NewGRPCCLient(sqsConfig.GRPCIngestAddress, sqsConfig.GRPCIngestMaxCallSizeBytes, appCodec, sqsGRPCClientOpts...)
 
 		// Create write listeners for the SQS service.
 		writeListeners, storeKeyMap := getSQSServiceWriteListeners(app, appCodec, poolTracker, app.WasmKeeper)
@@ -394,7 +398,10 @@ func NewOsmosisApp(
 
 	// initialize indexer if enabled
 	if indexerConfig.IsEnabled {
-		indexerPublisher := indexerConfig.Initialize()
+		indexerPublisher, err := indexerConfig.Initialize(homePath, logger)
+		if err != nil {
+			panic(err)
+		}
 
 		// TODO: handle graceful shutdown
 		pubSubCtx := context.Background()
@@ -431,7 +438,7 @@ func NewOsmosisApp(
 			StoreKeyMap:    storeKeyMap,
 		}
 		poolExtractor := poolextractor.New(poolKeepers, poolTracker)
-		indexerStreamingService := indexerservice.New(blockUpdatesProcessUtils, blockProcessStrategyManager, indexerPublisher, storeKeyMap, poolExtractor, poolTracker, keepers, app.GetTxConfig().TxDecoder(), logger)
+		indexerStreamingService := indexerservice.New(blockUpdatesProcessUtils, blockProcessStrategyManager, indexerPublisher, storeKeyMap, poolExtractor, poolTracker, keepers, app.GetTxConfig().TxDecoder(), indexerdomain.NewEventAllowlist(indexerConfig.EventTypes()), logger)
 
 		// Register the SQS streaming service with the app.
 		streamingServices = append(streamingServices, indexerStreamingService)
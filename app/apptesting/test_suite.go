@@ -563,7 +563,8 @@ func (s *KeeperTestHelper) SwapAndSetSpotPrice(poolId uint64, fromAsset sdk.Coin
 		route,
 		fromAsset.Amount,
 		sdk.NewCoin(toAsset.Denom,
-			toAsset.Amount.Quo(osmomath.NewInt(4))))
+			toAsset.Amount.Quo(osmomath.NewInt(4))),
+		poolmanagertypes.SwapLimits{})
 	s.Require().NoError(err)
 
 	spotPrice, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, poolId, fromAsset.Denom, toAsset.Denom)
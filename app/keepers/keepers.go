@@ -236,9 +236,12 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.AuthenticatorManager = authenticator.NewAuthenticatorManager()
 	appKeepers.AuthenticatorManager.InitializeAuthenticators([]authenticator.Authenticator{
 		authenticator.NewSignatureVerification(appKeepers.AccountKeeper),
+		authenticator.NewSecp256r1SignatureVerification(appKeepers.AccountKeeper),
 		authenticator.NewMessageFilter(encodingConfig),
+		authenticator.NewSession(appKeepers.AuthenticatorManager, appKeepers.keys[smartaccounttypes.StoreKey]),
 		authenticator.NewAllOf(appKeepers.AuthenticatorManager),
 		authenticator.NewAnyOf(appKeepers.AuthenticatorManager),
+		authenticator.NewThresholdOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOf(appKeepers.AuthenticatorManager),
 	})
@@ -613,6 +616,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.AuthenticatorManager.RegisterAuthenticator(
 		authenticator.NewCosmwasmAuthenticator(appKeepers.ContractKeeper, appKeepers.AccountKeeper, appCodec))
 
+	// register native spend limit authenticator
+	appKeepers.AuthenticatorManager.RegisterAuthenticator(
+		authenticator.NewSpendLimit(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.BankKeeper, appKeepers.TwapKeeper))
+
 	// set token factory contract keeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 
@@ -69,6 +69,9 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 		LastErrorTime:               time.Time{}, // no previous error
 	}
 	twapRecord2 := twapRecord1
@@ -10,6 +10,9 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v25/app/keepers"
 	"github.com/osmosis-labs/osmosis/v25/app/upgrades"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v25/x/protorev/types"
 )
 
 const (
@@ -55,11 +58,33 @@ func CreateUpgradeHandler(
 			keepers.PoolManagerKeeper.SetDenomPairTakerFee(ctx, tradingPairTakerFee.TokenOutDenom, tradingPairTakerFee.TokenInDenom, tradingPairTakerFee.TakerFee)
 		}
 
+		// Set the volume taker fee params, which are disabled until governance sets tiers.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyVolumeTakerFeeTiers, []poolmanagertypes.VolumeTakerFeeTier{})
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyVolumeTakerFeeWindowDays, poolmanagertypes.DefaultParams().TakerFeeParams.VolumeTakerFeeWindowDays)
+
 		// Set the authenticator params in the store
 		authenticatorParams := keepers.SmartAccountKeeper.GetParams(ctx)
 		authenticatorParams.MaximumUnauthenticatedGas = MaximumUnauthenticatedGas
 		keepers.SmartAccountKeeper.SetParams(ctx, authenticatorParams)
 
+		// Track the twap volume and log price squared accumulators from this height.
+		keepers.TwapKeeper.SetAccumulatorsStartHeight(ctx, ctx.BlockHeight())
+
+		// Set the protorev search strategy to the binary search that has been used so far.
+		keepers.ProtoRevKeeper.SetParam(ctx, protorevtypes.ParamStoreKeySearchStrategy, protorevtypes.DefaultSearchStrategy)
+
+		// Set the protorev swapper rebate share, which is disabled until governance sets it.
+		keepers.ProtoRevKeeper.SetParam(ctx, protorevtypes.ParamStoreKeySwapperRebateShare, protorevtypes.DefaultSwapperRebateShare)
+
+		// Set the minimum amount of the token provided to create a concentrated liquidity limit order.
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyLimitOrderMinAmount, concentratedliquiditytypes.DefaultLimitOrderMinAmount)
+
+		// Index the pools of every denom so that protorev can find cyclic routes before the next daily epoch. This reads every
+		// pool once, as the daily epoch does.
+		if err := keepers.ProtoRevKeeper.UpdateAllPoolsForDenoms(ctx); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
@@ -19,7 +19,9 @@ import (
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v25/x/protorev/types"
 )
 
 const (
@@ -49,6 +51,9 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 	s.PrepareTradingPairTakerFeeTest()
 	s.PrepareIncreaseUnauthenticatedGasTest()
+	s.PrepareProtoRevSearchStrategyTest()
+	s.PrepareProtoRevSwapperRebateShareTest()
+	s.PrepareLimitOrderMinAmountTest()
 
 	// Run the upgrade
 	dummyUpgrade(s)
@@ -59,6 +64,10 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 	s.ExecuteTradingPairTakerFeeTest()
 	s.ExecuteIncreaseUnauthenticatedGasTest()
+	s.ExecuteTwapAccumulatorsTest()
+	s.ExecuteProtoRevSearchStrategyTest()
+	s.ExecuteProtoRevSwapperRebateShareTest()
+	s.ExecuteLimitOrderMinAmountTest()
 }
 
 func dummyUpgrade(s *UpgradeTestSuite) {
@@ -115,3 +124,34 @@ func (s *UpgradeTestSuite) ExecuteIncreaseUnauthenticatedGasTest() {
 	authenticatorParams := s.App.SmartAccountKeeper.GetParams(s.Ctx)
 	s.Require().Equal(authenticatorParams.MaximumUnauthenticatedGas, v26.MaximumUnauthenticatedGas)
 }
+
+func (s *UpgradeTestSuite) ExecuteTwapAccumulatorsTest() {
+	s.Require().Equal(v26UpgradeHeight, s.App.TwapKeeper.GetAccumulatorsStartHeight(s.Ctx))
+}
+
+func (s *UpgradeTestSuite) PrepareProtoRevSearchStrategyTest() {
+	// Set the search strategy to a strategy other than the default one
+	s.App.ProtoRevKeeper.SetParam(s.Ctx, protorevtypes.ParamStoreKeySearchStrategy, protorevtypes.SearchStrategyClosedForm)
+}
+
+func (s *UpgradeTestSuite) ExecuteProtoRevSearchStrategyTest() {
+	s.Require().Equal(protorevtypes.DefaultSearchStrategy, s.App.ProtoRevKeeper.GetSearchStrategy(s.Ctx))
+}
+
+func (s *UpgradeTestSuite) PrepareProtoRevSwapperRebateShareTest() {
+	// Set the swapper rebate share to a share other than the default one
+	s.App.ProtoRevKeeper.SetParam(s.Ctx, protorevtypes.ParamStoreKeySwapperRebateShare, osmomath.NewDecWithPrec(5, 1))
+}
+
+func (s *UpgradeTestSuite) ExecuteProtoRevSwapperRebateShareTest() {
+	s.Require().Equal(protorevtypes.DefaultSwapperRebateShare, s.App.ProtoRevKeeper.GetSwapperRebateShare(s.Ctx))
+}
+
+func (s *UpgradeTestSuite) PrepareLimitOrderMinAmountTest() {
+	// Set the limit order min amount to an amount other than the default one
+	s.App.ConcentratedLiquidityKeeper.SetParam(s.Ctx, concentratedliquiditytypes.KeyLimitOrderMinAmount, osmomath.NewInt(1))
+}
+
+func (s *UpgradeTestSuite) ExecuteLimitOrderMinAmountTest() {
+	s.Require().Equal(concentratedliquiditytypes.DefaultLimitOrderMinAmount, s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx).LimitOrderMinAmount)
+}
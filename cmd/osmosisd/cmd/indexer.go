@@ -0,0 +1,123 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/outbox"
+)
+
+// IndexerCmd returns the commands operating the indexer outbox.
+func IndexerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Commands operating the indexer outbox",
+		Long: `Commands operating the indexer outbox. The outbox is stored in the node data directory and can only be
+opened by one process at a time, so the node must be stopped before running these commands.`,
+	}
+
+	cmd.AddCommand(
+		indexerStatusCmd(),
+		indexerRepublishCmd(),
+	)
+
+	return cmd
+}
+
+func indexerStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Get the delivery status of the indexer outbox",
+		Long: `Get the delivery status of the indexer outbox, including the last height whose data has been acknowledged by the backend.
+Example:
+	osmosisd indexer status
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			db, err := indexer.OpenOutboxDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			status, err := outbox.ReadStatus(db)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+func indexerRepublishCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "republish [from-height] [to-height]",
+		Short: "Republish the indexer data of a height range",
+		Long: `Republish to the backend configured in app.toml the indexer data of the heights in [from-height, to-height].
+Only the heights within the outbox retention can be republished, see "osmosisd indexer status".
+Example:
+	osmosisd indexer republish 16841115 16841200
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			fromHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			indexerConfig := indexer.NewConfigFromOptions(serverCtx.Viper)
+			if !indexerConfig.IsEnabled {
+				return errors.New("the indexer is not enabled in app.toml")
+			}
+			// The republished data is read from the outbox, so it is delivered the same way as by the outbox.
+			indexerConfig.OutboxEnabled = true
+			client, err := indexerConfig.NewBackendClient(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			db, err := indexer.OpenOutboxDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			status, err := outbox.ReadStatus(db)
+			if err != nil {
+				return err
+			}
+			if status.ArchivedFromHeight == 0 {
+				return errors.New("no data is retained in the outbox")
+			}
+			if fromHeight < status.ArchivedFromHeight {
+				cmd.PrintErrf("warning: heights below %d are not retained in the outbox\n", status.ArchivedFromHeight)
+			}
+
+			count, err := outbox.Republish(cmd.Context(), db, client, fromHeight, toHeight)
+			cmd.Printf("republished %d payloads\n", count)
+			return err
+		},
+	}
+}
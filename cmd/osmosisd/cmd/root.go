@@ -701,6 +701,25 @@ grpc-ingest-address = "{{ .SidecarQueryServerConfig.GRPCIngestAddress }}"
 # The maximum size of the GRPC message that can be received by the sqs service in bytes.
 grpc-ingest-max-call-size-bytes = "{{ .SidecarQueryServerConfig.GRPCIngestMaxCallSizeBytes }}"
 
+# If enabled, the connection to the sqs service uses TLS.
+grpc-tls-enabled = "{{ .SidecarQueryServerConfig.GRPCTLSEnabled }}"
+# The CA certificate file used to verify the sqs service certificate. The system root CAs are used if empty.
+grpc-tls-ca-file = "{{ .SidecarQueryServerConfig.GRPCTLSCAFile }}"
+# The client certificate and key files, to authenticate to the sqs service with mTLS.
+grpc-tls-cert-file = "{{ .SidecarQueryServerConfig.GRPCTLSCertFile }}"
+grpc-tls-key-file = "{{ .SidecarQueryServerConfig.GRPCTLSKeyFile }}"
+# Overrides the server name used to verify the sqs service certificate.
+grpc-tls-server-name = "{{ .SidecarQueryServerConfig.GRPCTLSServerName }}"
+# The bearer token sent to the sqs service with each call. Requires TLS.
+grpc-auth-token = "{{ .SidecarQueryServerConfig.GRPCAuthToken }}"
+
+# The number of times pushing the data of a block is retried on transient errors,
+# and the wait before the first retry in milliseconds, doubled on each retry.
+# Retries delay the commit of the block, so they should be kept short. If the push still fails,
+# the data of all the pools is pushed in the next block.
+grpc-max-retries = "{{ .SidecarQueryServerConfig.GRPCMaxRetries }}"
+grpc-retry-initial-backoff-ms = "{{ .SidecarQueryServerConfig.GRPCRetryInitialBackoffMs }}"
+
 ###############################################################################
 ###              Osmosis Indexer Configuration                              ###
 ###############################################################################
@@ -709,6 +728,13 @@ grpc-ingest-max-call-size-bytes = "{{ .SidecarQueryServerConfig.GRPCIngestMaxCal
 # The indexer service is disabled by default.
 is-enabled = "{{ .IndexerConfig.IsEnabled }}"
 
+# The backend the indexer data is published to. One of:
+# - "pubsub": GCP Pub/Sub, configured with the gcp-project-id and topic id options.
+# - "file": append-only newline-delimited JSON files, one per data type, written to file-dir.
+# - "webhook": HTTP POST of each payload as JSON to webhook-url, with the data type in the
+#   X-Osmosis-Indexer-Topic header.
+backend = "{{ .IndexerConfig.Backend }}"
+
 # The GCP project id to use for the indexer service.
 gcp-project-id = "{{ .IndexerConfig.GCPProjectId }}"
 
@@ -730,6 +756,33 @@ token-supply-offset-topic-id = "{{ .IndexerConfig.TokenSupplyOffsetTopicId }}"
 # The topic id to use for publishing pair metadata
 pair-topic-id = "{{ .IndexerConfig.PairTopicId }}"
 
+# The comma-separated list of the event types published with the transactions.
+# The events of each message are also published along with the message type and sender.
+event-allowlist = "{{ .IndexerConfig.EventAllowlist }}"
+
+# The directory of the files written by the file backend.
+# Relative paths are resolved against the node home directory.
+file-dir = "{{ .IndexerConfig.FileDir }}"
+
+# The URL the webhook backend posts the data to.
+webhook-url = "{{ .IndexerConfig.WebhookURL }}"
+
+# The value of the Authorization header sent by the webhook backend. Not sent if empty.
+webhook-auth-header = "{{ .IndexerConfig.WebhookAuthHeader }}"
+
+# The timeout of each webhook request in seconds.
+webhook-timeout-seconds = "{{ .IndexerConfig.WebhookTimeoutSeconds }}"
+
+# If enabled, the data is stored in a durable outbox under the node data directory before being
+# published, and retried with backoff until the backend acknowledges it.
+# Disabled by default. Enabling it creates data/indexer_outbox.db under the node home.
+# Use "osmosisd indexer status" to get the last acknowledged height.
+outbox-enabled = "{{ .IndexerConfig.OutboxEnabled }}"
+
+# The number of blocks whose published data is kept in the outbox, so that it can be
+# republished with "osmosisd indexer republish".
+outbox-retention-blocks = "{{ .IndexerConfig.OutboxRetentionBlocks }}"
+
 ###############################################################################
 ###              OpenTelemetry (OTEL) Configuration                         ###
 ###############################################################################
@@ -767,6 +820,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, t
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome, genutiltypes.DefaultMessageValidator, valOperAddressCodec),
 		ExportDeriveBalancesCmd(),
 		StakedToCSVCmd(),
+		ExportTwapRecordsCmd(),
 		AddGenesisAccountCmd(osmosis.DefaultNodeHome),
 		genutilcli.GenTxCmd(tempApp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome, valOperAddressCodec),
 		genutilcli.ValidateGenesisCmd(tempApp.ModuleBasics),
@@ -781,6 +835,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, t
 		UpdateAssetListCmd(osmosis.DefaultNodeHome, tempApp.ModuleBasics),
 		snapshot.Cmd(newApp),
 		pruning.Cmd(newApp, osmosis.DefaultNodeHome),
+		IndexerCmd(),
 	)
 
 	server.AddCommands(rootCmd, osmosis.DefaultNodeHome, newApp, createOsmosisAppAndExport, addModuleInitFlags)
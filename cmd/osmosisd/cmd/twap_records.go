@@ -0,0 +1,206 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	osmosis "github.com/osmosis-labs/osmosis/v25/app"
+	"github.com/osmosis-labs/osmosis/v25/x/twap/types"
+)
+
+const (
+	flagTwapExportFormat    = "format"
+	flagTwapExportHeight    = "height"
+	flagTwapExportStartTime = "start-time"
+	flagTwapExportEndTime   = "end-time"
+
+	twapExportFormatCSV    = "csv"
+	twapExportFormatNDJSON = "ndjson"
+
+	// twapExportPageLimit is the number of records read from the store at a time.
+	twapExportPageLimit = 1000
+)
+
+// ExportTwapRecordsCmd exports the historical twap records of a denom pair of a pool from the node DB.
+func ExportTwapRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-twap-records [pool-id] [denom0] [denom1] [output-file]",
+		Short: "Export the historical twap records of a denom pair of a pool to a CSV or NDJSON file",
+		Long: `Export the historical twap records of a denom pair of a pool from the node DB to a CSV or NDJSON file,
+with the exact accumulators used by the chain. The node must not be running.
+Start and end time are unix times, and default to exporting every record in state.
+Example:
+	osmosisd export-twap-records 1 uosmo uatom ../twap_records.csv
+	osmosisd export-twap-records 1 uosmo uatom ../twap_records.ndjson --format ndjson --start-time 1667088000 --height 16841115
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			serverCtx.Config.SetRoot(clientCtx.HomeDir)
+
+			poolId, err := osmocli.ParseUint(args[0], "pool-id")
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagTwapExportFormat)
+			if err != nil {
+				return err
+			}
+			if format != twapExportFormatCSV && format != twapExportFormatNDJSON {
+				return fmt.Errorf("unknown format %s, must be %s or %s", format, twapExportFormatCSV, twapExportFormatNDJSON)
+			}
+			height, err := cmd.Flags().GetInt64(flagTwapExportHeight)
+			if err != nil {
+				return err
+			}
+			startTime, err := parseTwapExportTimeFlag(cmd, flagTwapExportStartTime, time.Unix(0, 0))
+			if err != nil {
+				return err
+			}
+			// the default end time is after any record in state.
+			endTime, err := parseTwapExportTimeFlag(cmd, flagTwapExportEndTime, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return fmt.Errorf("error opening DB, make sure osmosisd is not running when calling this command: %w", err)
+			}
+			defer db.Close()
+
+			loadLatest := height == 0
+			app := osmosis.NewOsmosisApp(serverCtx.Logger, db, nil, loadLatest, map[int64]bool{}, serverCtx.Config.RootDir, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+			if !loadLatest {
+				if err := app.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+			ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+
+			outputFile, err := os.Create(args[3])
+			if err != nil {
+				return err
+			}
+			if err := exportTwapRecords(ctx, app, outputFile, format, poolId, args[1], args[2], startTime, endTime); err != nil {
+				// the export error is more relevant than a close error of the partially written file.
+				_ = outputFile.Close()
+				return err
+			}
+			return outputFile.Close()
+		},
+	}
+
+	cmd.Flags().String(flagTwapExportFormat, twapExportFormatCSV, "Output format, csv or ndjson")
+	cmd.Flags().Int64(flagTwapExportHeight, 0, "Height of the state to export the records from (default: latest height)")
+	cmd.Flags().String(flagTwapExportStartTime, "", "Unix time of the first records to export (default: the oldest record)")
+	cmd.Flags().String(flagTwapExportEndTime, "", "Unix time of the last records to export (default: the newest record)")
+
+	return cmd
+}
+
+// exportTwapRecords writes the historical twap records of the denom pair of the pool in the time range to w,
+// one page of records at a time.
+func exportTwapRecords(ctx sdk.Context, app *osmosis.OsmosisApp, w io.Writer, format string, poolId uint64, denom0, denom1 string, startTime, endTime time.Time) error {
+	writeRecords := writeTwapRecordsNDJSON(w, app.AppCodec())
+	flush := func() error { return nil }
+	if format == twapExportFormatCSV {
+		writer := csv.NewWriter(w)
+		if err := writer.Write(twapRecordCSVHeader); err != nil {
+			return err
+		}
+		writeRecords = writeTwapRecordsCSV(writer)
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	}
+
+	pageReq := &query.PageRequest{Limit: twapExportPageLimit}
+	for {
+		records, pageRes, err := app.TwapKeeper.GetHistoricalRecords(ctx, poolId, denom0, denom1, startTime, endTime, pageReq)
+		if err != nil {
+			return err
+		}
+		if err := writeRecords(records); err != nil {
+			return err
+		}
+		if len(pageRes.NextKey) == 0 {
+			return flush()
+		}
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: twapExportPageLimit}
+	}
+}
+
+func parseTwapExportTimeFlag(cmd *cobra.Command, flagName string, defaultTime time.Time) (time.Time, error) {
+	arg, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if arg == "" {
+		return defaultTime, nil
+	}
+	return osmocli.ParseUnixTime(arg, flagName)
+}
+
+var twapRecordCSVHeader = []string{
+	"pool_id", "asset0_denom", "asset1_denom", "height", "time",
+	"p0_last_spot_price", "p1_last_spot_price",
+	"p0_arithmetic_twap_accumulator", "p1_arithmetic_twap_accumulator", "geometric_twap_accumulator",
+	"last_error_time",
+	"asset0_volume_accumulator", "asset1_volume_accumulator",
+	"log_price_squared_accumulator",
+}
+
+func writeTwapRecordsCSV(writer *csv.Writer) func([]types.TwapRecord) error {
+	return func(records []types.TwapRecord) error {
+		for _, record := range records {
+			row := []string{
+				strconv.FormatUint(record.PoolId, 10), record.Asset0Denom, record.Asset1Denom,
+				strconv.FormatInt(record.Height, 10), record.Time.UTC().Format(time.RFC3339Nano),
+				record.P0LastSpotPrice.String(), record.P1LastSpotPrice.String(),
+				record.P0ArithmeticTwapAccumulator.String(), record.P1ArithmeticTwapAccumulator.String(), record.GeometricTwapAccumulator.String(),
+				record.LastErrorTime.UTC().Format(time.RFC3339Nano),
+				record.Asset0VolumeAccumulator.String(), record.Asset1VolumeAccumulator.String(),
+				record.LogPriceSquaredAccumulator.String(),
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func writeTwapRecordsNDJSON(w io.Writer, cdc codec.JSONCodec) func([]types.TwapRecord) error {
+	return func(records []types.TwapRecord) error {
+		for i := range records {
+			bz, err := cdc.MarshalJSON(&records[i])
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%s\n", bz); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
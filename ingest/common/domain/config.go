@@ -0,0 +1,16 @@
+package commondomain
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// ParseStringWithDefault parses a string value from the options of the given group, returning the default value
+// if the option is not set.
+func ParseStringWithDefault(opts servertypes.AppOptions, groupOptName, optName, defaultValue string) string {
+	if opts.Get(groupOptName+"."+optName) == nil {
+		return defaultValue
+	}
+	return osmoutils.ParseString(opts, groupOptName, optName)
+}
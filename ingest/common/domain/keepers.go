@@ -80,6 +80,8 @@ type PoolManagerKeeper interface {
 
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
 
+	GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error)
+
 	MultihopEstimateInGivenExactAmountOut(
 		ctx sdk.Context,
 		route []poolmanagertypes.SwapAmountOutRoute,
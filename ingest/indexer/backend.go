@@ -0,0 +1,64 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
+)
+
+// Names of the built-in publisher backends.
+const (
+	PubSubBackend  = "pubsub"
+	FileBackend    = "file"
+	WebhookBackend = "webhook"
+)
+
+// BackendFactory creates the client of a publisher backend from the indexer config.
+// homePath is the node home directory, against which relative paths are resolved.
+type BackendFactory func(c Config, homePath string) (domain.Publisher, error)
+
+// backends is the registry of publisher backends, selected by the backend option in app.toml.
+var backends = map[string]BackendFactory{
+	PubSubBackend: func(c Config, _ string) (domain.Publisher, error) {
+		client := service.NewPubSubCLient(c.GCPProjectId, c.BlockTopicId, c.TransactionTopicId, c.PoolTopicId, c.TokenSupplyTopicId, c.TokenSupplyOffsetTopicId, c.PairTopicId)
+		// The outbox retries the deliveries that are not acknowledged. Without it, waiting for
+		// the acknowledgement would only add the round trip to the block processing.
+		if c.OutboxEnabled {
+			client.WithAcknowledgement()
+		}
+		return client, nil
+	},
+	FileBackend: func(c Config, homePath string) (domain.Publisher, error) {
+		dir := c.FileDir
+		if dir != "" && !filepath.IsAbs(dir) {
+			dir = filepath.Join(homePath, dir)
+		}
+		return service.NewFileClient(dir)
+	},
+	WebhookBackend: func(c Config, _ string) (domain.Publisher, error) {
+		return service.NewWebhookClient(c.WebhookURL, c.WebhookAuthHeader, time.Duration(c.WebhookTimeoutSeconds)*time.Second)
+	},
+}
+
+// RegisterBackend registers a publisher backend under the given name.
+// It panics if a backend is already registered under that name.
+func RegisterBackend(name string, factory BackendFactory) {
+	if _, ok := backends[name]; ok {
+		panic(fmt.Sprintf("indexer backend %s is already registered", name))
+	}
+	backends[name] = factory
+}
+
+// RegisteredBackends returns the sorted names of the registered publisher backends.
+func RegisteredBackends() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -3,3 +3,5 @@ package domain
 import "errors"
 
 var ErrDidNotIngestAllData = errors.New("cold start manager has not yet ingested initial data")
+
+var ErrTxResultNotFound = errors.New("transaction result not found in the finalize block response")
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"strings"
+
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v25/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v25/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v25/x/lockup/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v25/x/protorev/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v25/x/superfluid/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v25/x/tokenfactory/types"
+)
+
+// DefaultEventTypes are the event types published with the transactions by default.
+var DefaultEventTypes = []string{
+	// gamm
+	gammtypes.TypeEvtTokenSwapped,
+	gammtypes.TypeEvtPoolJoined,
+	gammtypes.TypeEvtPoolExited,
+
+	// concentrated liquidity
+	concentratedliquiditytypes.TypeEvtCreatePosition,
+	concentratedliquiditytypes.TypeEvtWithdrawPosition,
+
+	// lockup
+	lockuptypes.TypeEvtLockTokens,
+	lockuptypes.TypeEvtAddTokensToLock,
+	lockuptypes.TypeEvtBeginUnlock,
+	lockuptypes.TypeEvtBeginUnlockAll,
+
+	// superfluid
+	superfluidtypes.TypeEvtSuperfluidDelegate,
+	superfluidtypes.TypeEvtSuperfluidIncreaseDelegation,
+	superfluidtypes.TypeEvtSuperfluidUndelegate,
+	superfluidtypes.TypeEvtSuperfluidUnbondLock,
+	superfluidtypes.TypeEvtSuperfluidUndelegateAndUnbondLock,
+	superfluidtypes.TypeEvtAddToConcentratedLiquiditySuperfluidPosition,
+	superfluidtypes.TypeEvtCreateFullRangePositionAndSFDelegate,
+	superfluidtypes.TypeEvtUnlockAndMigrateShares,
+
+	// incentives
+	incentivestypes.TypeEvtCreateGauge,
+	incentivestypes.TypeEvtAddToGauge,
+	incentivestypes.TypeEvtCreateGroup,
+
+	// tokenfactory
+	tokenfactorytypes.TypeMsgMint,
+	tokenfactorytypes.TypeMsgBurn,
+
+	// protorev
+	protorevtypes.TypeEvtBackrun,
+}
+
+// EventAllowlist is the set of event types published with the transactions.
+type EventAllowlist map[string]struct{}
+
+// NewEventAllowlist returns an allowlist of the given event types.
+func NewEventAllowlist(eventTypes []string) EventAllowlist {
+	allowlist := make(EventAllowlist, len(eventTypes))
+	for _, eventType := range eventTypes {
+		allowlist[eventType] = struct{}{}
+	}
+	return allowlist
+}
+
+// ParseEventTypes parses a comma-separated list of event types, ignoring whitespace and empty entries.
+func ParseEventTypes(eventTypes string) []string {
+	var parsed []string
+	for _, eventType := range strings.Split(eventTypes, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			parsed = append(parsed, eventType)
+		}
+	}
+	return parsed
+}
+
+// Contains returns true if the event type is allowed.
+func (a EventAllowlist) Contains(eventType string) bool {
+	_, ok := a[eventType]
+	return ok
+}
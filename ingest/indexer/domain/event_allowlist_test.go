@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+)
+
+// Validates that the event types are parsed from a comma-separated list, ignoring whitespace and empty entries.
+func TestParseEventTypes(t *testing.T) {
+	tests := map[string]struct {
+		eventTypes string
+		expected   []string
+	}{
+		"empty": {
+			eventTypes: "",
+		},
+		"single event type": {
+			eventTypes: "token_swapped",
+			expected:   []string{"token_swapped"},
+		},
+		"multiple event types": {
+			eventTypes: "token_swapped,pool_joined,pool_exited",
+			expected:   []string{"token_swapped", "pool_joined", "pool_exited"},
+		},
+		"whitespace and empty entries": {
+			eventTypes: " token_swapped , ,pool_joined,, ",
+			expected:   []string{"token_swapped", "pool_joined"},
+		},
+		"only separators": {
+			eventTypes: " , ,",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, domain.ParseEventTypes(tc.eventTypes))
+		})
+	}
+}
+
+// Validates that only the event types of the allowlist are contained in it, matched exactly.
+func TestEventAllowlistContains(t *testing.T) {
+	tests := map[string]struct {
+		eventTypes []string
+		eventType  string
+		expected   bool
+	}{
+		"allowed": {
+			eventTypes: []string{"token_swapped", "pool_joined"},
+			eventType:  "pool_joined",
+			expected:   true,
+		},
+		"not allowed": {
+			eventTypes: []string{"token_swapped", "pool_joined"},
+			eventType:  "pool_exited",
+		},
+		"prefix of an allowed event type": {
+			eventTypes: []string{"token_swapped"},
+			eventType:  "token",
+		},
+		"different case": {
+			eventTypes: []string{"token_swapped"},
+			eventType:  "Token_Swapped",
+		},
+		"empty allowlist": {
+			eventType: "token_swapped",
+		},
+		"default event types": {
+			eventTypes: domain.DefaultEventTypes,
+			eventType:  "token_swapped",
+			expected:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowlist := domain.NewEventAllowlist(tc.eventTypes)
+			require.Equal(t, tc.expected, allowlist.Contains(tc.eventType))
+		})
+	}
+}
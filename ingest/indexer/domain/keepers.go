@@ -10,6 +10,7 @@ import (
 
 type PoolManagerKeeperI interface {
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
+	GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error)
 	GetPool(ctx sdk.Context, poolId uint64) (types.PoolI, error)
 	GetPoolType(ctx sdk.Context, poolId uint64) (types.PoolType, error)
 }
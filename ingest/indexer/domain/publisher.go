@@ -24,6 +24,14 @@ type Publisher interface {
 	PublishPair(ctx context.Context, pair Pair) error
 }
 
+// BlockTracker is implemented by publishers that group the published data by block.
+type BlockTracker interface {
+	// StartBlock is called before any data of the block at the given height is published.
+	StartBlock(height uint64) error
+	// EndBlock is called once all the data of the block at the given height has been published.
+	EndBlock(height uint64) error
+}
+
 // PairPublisher is an interface for publishing pair data.
 type PairPublisher interface {
 	// PublishPoolPairs publishes the given pools as pairs.
@@ -14,6 +14,14 @@ type EventWrapper struct {
 	Event types.Event `json:"event"`
 }
 
+// Message is a message of a transaction along with the events it emitted.
+type Message struct {
+	Index  int            `json:"msg_index"`
+	Type   string         `json:"msg_type"`
+	Sender string         `json:"sender"`
+	Events []EventWrapper `json:"events"`
+}
+
 type Transaction struct {
 	Height             uint64         `json:"height"`
 	BlockTime          time.Time      `json:"timestamp"`
@@ -24,5 +32,6 @@ type Transaction struct {
 	TransactionHash    string         `json:"tx_hash"`
 	TransactionIndexId int            `json:"tx_index_id"`
 	Events             []EventWrapper `json:"events"`
+	Messages           []Message      `json:"messages"`
 	IngestedAt         time.Time      `json:"ingested_at"`
 }
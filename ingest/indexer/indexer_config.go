@@ -1,16 +1,26 @@
 package indexer
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	commondomain "github.com/osmosis-labs/osmosis/v25/ingest/common/domain"
 	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
-	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/outbox"
 )
 
 // Config defines the config for the indexer.
 type Config struct {
 	IsEnabled                bool   `mapstructure:"enabled"`
+	Backend                  string `mapstructure:"backend"`
 	GCPProjectId             string `mapstructure:"gcp-project-id"`
 	BlockTopicId             string `mapstructure:"block-topic-id"`
 	TransactionTopicId       string `mapstructure:"transaction-topic-id"`
@@ -18,6 +28,27 @@ type Config struct {
 	TokenSupplyTopicId       string `mapstructure:"token-supply-topic-id"`
 	TokenSupplyOffsetTopicId string `mapstructure:"token-supply-offset-topic-id"`
 	PairTopicId              string `mapstructure:"pair-offset-topic-id"`
+
+	// EventAllowlist is the comma-separated list of the event types published with the transactions.
+	EventAllowlist string `mapstructure:"event-allowlist"`
+
+	// FileDir is the directory of the NDJSON files written by the file backend.
+	// Relative paths are resolved against the node home directory.
+	FileDir string `mapstructure:"file-dir"`
+
+	// WebhookURL is the endpoint the webhook backend posts the payloads to.
+	WebhookURL string `mapstructure:"webhook-url"`
+	// WebhookAuthHeader is sent as the Authorization header by the webhook backend, if set.
+	WebhookAuthHeader string `mapstructure:"webhook-auth-header"`
+	// WebhookTimeoutSeconds is the timeout of each webhook request.
+	WebhookTimeoutSeconds int `mapstructure:"webhook-timeout-seconds"`
+
+	// OutboxEnabled defines if the data is stored in a durable outbox under the node data directory
+	// before being published, so that it is retried until the backend acknowledges it.
+	// It is disabled by default, since enabling it creates a new database in the data directory.
+	OutboxEnabled bool `mapstructure:"outbox-enabled"`
+	// OutboxRetentionBlocks is the number of blocks whose published data is kept in the outbox for republishing.
+	OutboxRetentionBlocks uint64 `mapstructure:"outbox-retention-blocks"`
 }
 
 // groupOptName is the name of the indexer options group.
@@ -28,12 +59,21 @@ const (
 // DefaultConfig defines the default config for the indexer client.
 var DefaultConfig = Config{
 	IsEnabled:                false,
+	Backend:                  PubSubBackend,
 	GCPProjectId:             "",
 	BlockTopicId:             "",
 	TransactionTopicId:       "",
 	PoolTopicId:              "",
 	TokenSupplyTopicId:       "",
 	TokenSupplyOffsetTopicId: "",
+	PairTopicId:              "",
+	EventAllowlist:           strings.Join(domain.DefaultEventTypes, ","),
+	FileDir:                  "indexer",
+	WebhookURL:               "",
+	WebhookAuthHeader:        "",
+	WebhookTimeoutSeconds:    10,
+	OutboxEnabled:            false,
+	OutboxRetentionBlocks:    10000,
 }
 
 // NewConfigFromOptions returns a new indexer config from the given options.
@@ -46,28 +86,81 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 		}
 	}
 
-	gcpProjectId := osmoutils.ParseString(opts, groupOptName, "gcp-project-id")
-	blockTopicId := osmoutils.ParseString(opts, groupOptName, "block-topic-id")
-	transactionTopicId := osmoutils.ParseString(opts, groupOptName, "transaction-topic-id")
-	poolTopicId := osmoutils.ParseString(opts, groupOptName, "pool-topic-id")
-	tokenSupplyTopicId := osmoutils.ParseString(opts, groupOptName, "token-supply-topic-id")
-	tokenSupplyOffsetTopicId := osmoutils.ParseString(opts, groupOptName, "token-supply-offset-topic-id")
-	pairTopicID := osmoutils.ParseString(opts, groupOptName, "pair-topic-id")
-
-	return Config{
-		IsEnabled:                isEnabled,
-		GCPProjectId:             gcpProjectId,
-		BlockTopicId:             blockTopicId,
-		TransactionTopicId:       transactionTopicId,
-		PoolTopicId:              poolTopicId,
-		TokenSupplyTopicId:       tokenSupplyTopicId,
-		TokenSupplyOffsetTopicId: tokenSupplyOffsetTopicId,
-		PairTopicId:              pairTopicID,
+	// The backend option was introduced after the PubSub options, so configs without it keep using PubSub.
+	backend := commondomain.ParseStringWithDefault(opts, groupOptName, "backend", DefaultConfig.Backend)
+
+	config := Config{
+		IsEnabled:             isEnabled,
+		Backend:               backend,
+		EventAllowlist:        commondomain.ParseStringWithDefault(opts, groupOptName, "event-allowlist", DefaultConfig.EventAllowlist),
+		OutboxEnabled:         osmoutils.ParseBool(opts, groupOptName, "outbox-enabled", DefaultConfig.OutboxEnabled),
+		OutboxRetentionBlocks: cast.ToUint64(commondomain.ParseStringWithDefault(opts, groupOptName, "outbox-retention-blocks", fmt.Sprint(DefaultConfig.OutboxRetentionBlocks))),
+	}
+
+	switch backend {
+	case PubSubBackend:
+		config.GCPProjectId = osmoutils.ParseString(opts, groupOptName, "gcp-project-id")
+		config.BlockTopicId = osmoutils.ParseString(opts, groupOptName, "block-topic-id")
+		config.TransactionTopicId = osmoutils.ParseString(opts, groupOptName, "transaction-topic-id")
+		config.PoolTopicId = osmoutils.ParseString(opts, groupOptName, "pool-topic-id")
+		config.TokenSupplyTopicId = osmoutils.ParseString(opts, groupOptName, "token-supply-topic-id")
+		config.TokenSupplyOffsetTopicId = osmoutils.ParseString(opts, groupOptName, "token-supply-offset-topic-id")
+		config.PairTopicId = osmoutils.ParseString(opts, groupOptName, "pair-topic-id")
+	case FileBackend:
+		config.FileDir = commondomain.ParseStringWithDefault(opts, groupOptName, "file-dir", DefaultConfig.FileDir)
+	case WebhookBackend:
+		config.WebhookURL = osmoutils.ParseString(opts, groupOptName, "webhook-url")
+		config.WebhookAuthHeader = commondomain.ParseStringWithDefault(opts, groupOptName, "webhook-auth-header", DefaultConfig.WebhookAuthHeader)
+		config.WebhookTimeoutSeconds = cast.ToInt(commondomain.ParseStringWithDefault(opts, groupOptName, "webhook-timeout-seconds", fmt.Sprint(DefaultConfig.WebhookTimeoutSeconds)))
+	}
+
+	return config
+}
+
+// EventTypes returns the event types published with the transactions.
+func (c Config) EventTypes() []string {
+	return domain.ParseEventTypes(c.EventAllowlist)
+}
+
+// Initialize initializes the indexer by creating the client of the configured backend and returning a new IndexerPublisher.
+// If the outbox is enabled, the data is published through the outbox, which starts delivering it to the backend.
+// homePath is the node home directory, against which the relative paths of the config are resolved.
+func (c Config) Initialize(homePath string, logger log.Logger) (domain.Publisher, error) {
+	client, err := c.NewBackendClient(homePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.OutboxEnabled {
+		return NewIndexerPublisher(client), nil
+	}
+
+	db, err := OpenOutboxDB(homePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open indexer outbox: %w", err)
+	}
+	indexerOutbox, err := outbox.New(db, client, logger, c.OutboxRetentionBlocks)
+	if err != nil {
+		return nil, err
+	}
+	indexerOutbox.Start()
+	return NewIndexerPublisher(indexerOutbox), nil
+}
+
+// NewBackendClient creates the client of the configured backend.
+func (c Config) NewBackendClient(homePath string) (domain.Publisher, error) {
+	factory, ok := backends[c.Backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown indexer backend %q, registered backends: %v", c.Backend, RegisteredBackends())
+	}
+	client, err := factory(c, homePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize indexer backend %s: %w", c.Backend, err)
 	}
+	return client, nil
 }
 
-// Initialize initializes the indexer by creating a new PubSubClient and returning a new IndexerIngester.
-func (c Config) Initialize() domain.Publisher {
-	pubSubClient := service.NewPubSubCLient(c.GCPProjectId, c.BlockTopicId, c.TransactionTopicId, c.PoolTopicId, c.TokenSupplyTopicId, c.TokenSupplyOffsetTopicId, c.PairTopicId)
-	return NewIndexerPublisher(*pubSubClient)
+// OpenOutboxDB opens the database of the indexer outbox in the node data directory.
+func OpenOutboxDB(homePath string) (dbm.DB, error) {
+	return dbm.NewDB(outbox.DBName, dbm.GoLevelDBBackend, filepath.Join(homePath, "data"))
 }
@@ -2,26 +2,52 @@ package indexer
 
 import (
 	"context"
+	"io"
 
 	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
-	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
 )
 
 // indexerIngester is an implementation of domain.Publisher.
 type indexerPublisher struct {
-	pubsubClient service.PubSubClient
+	client domain.Publisher
 }
 
-// NewIndexerPublisher creates a new IndexerPublisher with the given PubSubClient
-func NewIndexerPublisher(pubsubClient service.PubSubClient) domain.Publisher {
+// NewIndexerPublisher creates a new IndexerPublisher with the given backend client
+func NewIndexerPublisher(client domain.Publisher) domain.Publisher {
 	return &indexerPublisher{
-		pubsubClient: pubsubClient,
+		client: client,
 	}
 }
 
+var _ domain.BlockTracker = &indexerPublisher{}
+
+// StartBlock implements domain.BlockTracker.
+func (i *indexerPublisher) StartBlock(height uint64) error {
+	if tracker, ok := i.client.(domain.BlockTracker); ok {
+		return tracker.StartBlock(height)
+	}
+	return nil
+}
+
+// EndBlock implements domain.BlockTracker.
+func (i *indexerPublisher) EndBlock(height uint64) error {
+	if tracker, ok := i.client.(domain.BlockTracker); ok {
+		return tracker.EndBlock(height)
+	}
+	return nil
+}
+
+// Close closes the backend client if it holds resources.
+func (i *indexerPublisher) Close() error {
+	if closer, ok := i.client.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // PublishBlock implements domain.Publisher.
 func (i *indexerPublisher) PublishBlock(ctx context.Context, block domain.Block) error {
-	err := i.pubsubClient.PublishBlock(ctx, block)
+	err := i.client.PublishBlock(ctx, block)
 	if err != nil {
 		return err
 	}
@@ -30,7 +56,7 @@ func (i *indexerPublisher) PublishBlock(ctx context.Context, block domain.Block)
 
 // PublishTransaction implements domain.Publisher.
 func (i *indexerPublisher) PublishTransaction(ctx context.Context, txn domain.Transaction) error {
-	err := i.pubsubClient.PublishTransaction(ctx, txn)
+	err := i.client.PublishTransaction(ctx, txn)
 	if err != nil {
 		return err
 	}
@@ -39,7 +65,7 @@ func (i *indexerPublisher) PublishTransaction(ctx context.Context, txn domain.Tr
 
 // PublishPool implements domain.Publisher.
 func (i *indexerPublisher) PublishPool(ctx context.Context, pool domain.Pool) error {
-	err := i.pubsubClient.PublishPool(ctx, pool)
+	err := i.client.PublishPool(ctx, pool)
 	if err != nil {
 		return err
 	}
@@ -48,7 +74,7 @@ func (i *indexerPublisher) PublishPool(ctx context.Context, pool domain.Pool) er
 
 // PublishTokenSupply implements domain.Publisher.
 func (i *indexerPublisher) PublishTokenSupply(ctx context.Context, tokenSupply domain.TokenSupply) error {
-	err := i.pubsubClient.PublishTokenSupply(ctx, tokenSupply)
+	err := i.client.PublishTokenSupply(ctx, tokenSupply)
 	if err != nil {
 		return err
 	}
@@ -57,7 +83,7 @@ func (i *indexerPublisher) PublishTokenSupply(ctx context.Context, tokenSupply d
 
 // PublishTokenSupplyOffset implements domain.Publisher.
 func (i *indexerPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSupplyOffset domain.TokenSupplyOffset) error {
-	err := i.pubsubClient.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
+	err := i.client.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
 	if err != nil {
 		return err
 	}
@@ -66,7 +92,7 @@ func (i *indexerPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSu
 
 // PublishPair implements domain.Publisher.
 func (i *indexerPublisher) PublishPair(ctx context.Context, pair domain.Pair) error {
-	err := i.pubsubClient.PublishPair(ctx, pair)
+	err := i.client.PublishPair(ctx, pair)
 	if err != nil {
 		return err
 	}
@@ -55,6 +55,13 @@ func (p PairPublisher) PublishPoolPairs(ctx sdk.Context, pools []poolmanagertype
 			spreadFactor := pool.GetSpreadFactor(ctx)
 			poolID := pool.GetId()
 
+			// The taker fee override of the pool, if any, is charged instead of the taker fee of its denom pairs.
+			poolTakerFee, hasPoolTakerFee, err := p.poolManagerKeeper.GetPoolTakerFee(ctx, poolID)
+			if err != nil {
+				result <- err
+				return
+			}
+
 			// Wait for all the pairs to be published
 			publishPairWg := sync.WaitGroup{}
 			// Initial empty error string
@@ -81,7 +88,9 @@ func (p PairPublisher) PublishPoolPairs(ctx sdk.Context, pools []poolmanagertype
 					mu.RLock()
 					takerFee, ok := denomPairToTakerFeeMap[takerFeeKey]
 					mu.RUnlock()
-					if !ok {
+					if hasPoolTakerFee {
+						takerFee = poolTakerFee
+					} else if !ok {
 						var err error
 						takerFee, err = p.poolManagerKeeper.GetTradingPairTakerFee(ctx, denomI, denomJ)
 						if err != nil {
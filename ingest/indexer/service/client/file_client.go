@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+)
+
+// fileSink appends messages to newline-delimited JSON (NDJSON) files, one file per topic.
+// The files are opened for every write so that they can be rotated by external tools.
+type fileSink struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileClient creates a new client that appends the payloads to <dir>/<topic>.ndjson.
+// The directory is created if it doesn't exist.
+func NewFileClient(dir string) (indexerdomain.Publisher, error) {
+	if dir == "" {
+		return nil, errors.New("file sink directory must be set")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &sinkClient{sink: &fileSink{dir: dir}}, nil
+}
+
+// write implements sink.
+func (f *fileSink) write(_ context.Context, topic string, data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(filepath.Join(f.dir, topic+".ndjson"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	// Write the message and the newline at once so that lines are never interleaved.
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
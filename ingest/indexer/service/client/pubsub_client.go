@@ -21,6 +21,9 @@ type PubSubClient struct {
 	tokenSupplyOffsetTopicId string
 	pairTopicId              string
 	pubsubClient             *pubsub.Client
+
+	// waitForAck defines if publishing waits for the server to acknowledge the message.
+	waitForAck bool
 }
 
 // NewPubSubCLient creates a new PubSubClient.
@@ -36,6 +39,14 @@ func NewPubSubCLient(projectId, blockTopicId, transactionTopicId, poolTopicId, t
 	}
 }
 
+// WithAcknowledgement makes the client wait for the server to acknowledge each published message,
+// so that delivery failures are returned to the caller. It is used when publishing through the outbox,
+// which delivers the data outside of block processing.
+func (p *PubSubClient) WithAcknowledgement() *PubSubClient {
+	p.waitForAck = true
+	return p
+}
+
 // publish publishes a message to the PubSub topic.
 func (p *PubSubClient) publish(ctx context.Context, message any, topicId string) error {
 	// Create PubSub client if it doesn't exist
@@ -55,11 +66,15 @@ func (p *PubSubClient) publish(ctx context.Context, message any, topicId string)
 
 	// Publish message to topic
 	topic := p.pubsubClient.Topic(topicId)
-	topic.Publish(ctx, &pubsub.Message{
+	result := topic.Publish(ctx, &pubsub.Message{
 		Data: msgBytes,
 	})
 
-	return nil
+	if !p.waitForAck {
+		return nil
+	}
+	_, err = result.Get(ctx)
+	return err
 }
 
 // Publish implements PubSubClient.PublishBlock
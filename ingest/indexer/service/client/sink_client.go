@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+)
+
+// Topics under which the payloads are written by the sink clients.
+const (
+	BlockTopic             = "block"
+	TransactionTopic       = "transaction"
+	PoolTopic              = "pool"
+	TokenSupplyTopic       = "token_supply"
+	TokenSupplyOffsetTopic = "token_supply_offset"
+	PairTopic              = "pair"
+)
+
+// sink writes a message, marshalled as JSON, to the destination of the given topic.
+type sink interface {
+	write(ctx context.Context, topic string, data []byte) error
+}
+
+// sinkClient publishes the indexer payloads to a sink.
+// The payloads are the same as the ones published to PubSub.
+type sinkClient struct {
+	sink sink
+}
+
+var _ indexerdomain.Publisher = &sinkClient{}
+
+// publish marshals the message and writes it to the sink.
+func (s *sinkClient) publish(ctx context.Context, message any, topic string) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return s.sink.write(ctx, topic, data)
+}
+
+// PublishBlock implements domain.Publisher.
+func (s *sinkClient) PublishBlock(ctx context.Context, block indexerdomain.Block) error {
+	block.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, block, BlockTopic)
+}
+
+// PublishTransaction implements domain.Publisher.
+func (s *sinkClient) PublishTransaction(ctx context.Context, txn indexerdomain.Transaction) error {
+	txn.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, txn, TransactionTopic)
+}
+
+// PublishPool implements domain.Publisher.
+func (s *sinkClient) PublishPool(ctx context.Context, pool indexerdomain.Pool) error {
+	pool.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, pool, PoolTopic)
+}
+
+// PublishTokenSupply implements domain.Publisher.
+func (s *sinkClient) PublishTokenSupply(ctx context.Context, tokenSupply indexerdomain.TokenSupply) error {
+	tokenSupply.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, tokenSupply, TokenSupplyTopic)
+}
+
+// PublishTokenSupplyOffset implements domain.Publisher.
+func (s *sinkClient) PublishTokenSupplyOffset(ctx context.Context, tokenSupplyOffset indexerdomain.TokenSupplyOffset) error {
+	tokenSupplyOffset.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, tokenSupplyOffset, TokenSupplyOffsetTopic)
+}
+
+// PublishPair implements domain.Publisher.
+func (s *sinkClient) PublishPair(ctx context.Context, pair indexerdomain.Pair) error {
+	pair.IngestedAt = time.Now().UTC()
+	return s.publish(ctx, pair, PairTopic)
+}
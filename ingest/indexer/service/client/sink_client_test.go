@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
+)
+
+// Validates that the file client appends one JSON line per payload to the file of the payload topic.
+func TestFileClient(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "indexer")
+	client, err := service.NewFileClient(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, client.PublishBlock(ctx, indexerdomain.Block{ChainId: "osmosis-1", Height: 1}))
+	require.NoError(t, client.PublishBlock(ctx, indexerdomain.Block{ChainId: "osmosis-1", Height: 2}))
+	require.NoError(t, client.PublishPair(ctx, indexerdomain.Pair{PoolID: 1, Denom0: "uosmo", Denom1: "uion"}))
+
+	blockLines := readLines(t, filepath.Join(dir, service.BlockTopic+".ndjson"))
+	require.Len(t, blockLines, 2)
+	for i, line := range blockLines {
+		var block indexerdomain.Block
+		require.NoError(t, json.Unmarshal([]byte(line), &block))
+		require.Equal(t, uint64(i+1), block.Height)
+		require.False(t, block.IngestedAt.IsZero())
+	}
+
+	pairLines := readLines(t, filepath.Join(dir, service.PairTopic+".ndjson"))
+	require.Len(t, pairLines, 1)
+	var pair indexerdomain.Pair
+	require.NoError(t, json.Unmarshal([]byte(pairLines[0]), &pair))
+	require.Equal(t, "uion", pair.Denom1)
+
+	_, err = service.NewFileClient("")
+	require.Error(t, err)
+}
+
+// Validates that the webhook client posts the payload with its topic and authorization headers,
+// and returns an error on non-2xx responses.
+func TestWebhookClient(t *testing.T) {
+	var (
+		gotTopic string
+		gotAuth  string
+		gotBody  []byte
+		status   = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTopic = r.Header.Get(service.TopicHeader)
+		gotAuth = r.Header.Get("Authorization")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client, err := service.NewWebhookClient(server.URL, "Bearer secret", time.Second)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, client.PublishTransaction(ctx, indexerdomain.Transaction{Height: 10, TransactionHash: "hash"}))
+	require.Equal(t, service.TransactionTopic, gotTopic)
+	require.Equal(t, "Bearer secret", gotAuth)
+
+	var txn indexerdomain.Transaction
+	require.NoError(t, json.Unmarshal(gotBody, &txn))
+	require.Equal(t, "hash", txn.TransactionHash)
+
+	status = http.StatusInternalServerError
+	require.Error(t, client.PublishPool(ctx, indexerdomain.Pool{}))
+
+	_, err = service.NewWebhookClient("", "", time.Second)
+	require.Error(t, err)
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(bz), "\n"), "\n")
+}
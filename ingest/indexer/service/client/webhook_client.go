@@ -0,0 +1,62 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+)
+
+// TopicHeader is the HTTP header holding the topic of the payload posted by the webhook client.
+const TopicHeader = "X-Osmosis-Indexer-Topic"
+
+// webhookSink posts messages to an HTTP endpoint.
+type webhookSink struct {
+	url        string
+	authHeader string
+	httpClient *http.Client
+}
+
+// NewWebhookClient creates a new client that posts each payload as a JSON body to the given URL.
+// The topic of the payload is set in the TopicHeader header. If authHeader is non-empty, it is sent as
+// the Authorization header. Any non-2xx response is returned as an error.
+func NewWebhookClient(url, authHeader string, timeout time.Duration) (indexerdomain.Publisher, error) {
+	if url == "" {
+		return nil, errors.New("webhook url must be set")
+	}
+	return &sinkClient{sink: &webhookSink{
+		url:        url,
+		authHeader: authHeader,
+		httpClient: &http.Client{Timeout: timeout},
+	}}, nil
+}
+
+// write implements sink.
+func (w *webhookSink) write(ctx context.Context, topic string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TopicHeader, topic)
+	if w.authHeader != "" {
+		req.Header.Set("Authorization", w.authHeader)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook returned status %d for topic %s: %s", resp.StatusCode, topic, string(body))
+	}
+	return nil
+}
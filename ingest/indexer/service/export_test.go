@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+type IndexerStreamingService = indexerStreamingService
+
+func (s *indexerStreamingService) PublishTxn(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return s.publishTxn(ctx, req, res)
+}
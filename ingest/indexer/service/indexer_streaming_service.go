@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
@@ -31,6 +33,9 @@ var (
 	oneDec                         = osmomath.OneDec()
 )
 
+// msgIndexAttributeKey is the attribute the baseapp adds to the events emitted by a message, holding the message index.
+const msgIndexAttributeKey = "msg_index"
+
 // ind is a streaming service that processes block data and ingests it into the indexer
 type indexerStreamingService struct {
 	// manages tracking of whether all the data should be processed or only the changed in the block
@@ -49,6 +54,9 @@ type indexerStreamingService struct {
 
 	txDecoder sdk.TxDecoder
 
+	// the event types published with the transactions
+	eventAllowlist domain.EventAllowlist
+
 	logger log.Logger
 }
 
@@ -57,7 +65,8 @@ type indexerStreamingService struct {
 // sqsIngester is an ingester that ingests the block data into SQS.
 // poolTracker is a tracker that tracks the pools that were changed in the block.
 // nodeStatusChecker is a checker that checks if the node is syncing.
-func New(blockUpdatesProcessUtils commondomain.BlockUpdateProcessUtilsI, blockProcessStrategyManager commondomain.BlockProcessStrategyManager, client domain.Publisher, storeKeyMap map[string]storetypes.StoreKey, poolExtractor commondomain.PoolExtractor, poolTracker sqsdomain.BlockPoolUpdateTracker, keepers domain.Keepers, txDecoder sdk.TxDecoder, logger log.Logger) storetypes.ABCIListener {
+// eventAllowlist is the set of event types published with the transactions.
+func New(blockUpdatesProcessUtils commondomain.BlockUpdateProcessUtilsI, blockProcessStrategyManager commondomain.BlockProcessStrategyManager, client domain.Publisher, storeKeyMap map[string]storetypes.StoreKey, poolExtractor commondomain.PoolExtractor, poolTracker sqsdomain.BlockPoolUpdateTracker, keepers domain.Keepers, txDecoder sdk.TxDecoder, eventAllowlist domain.EventAllowlist, logger log.Logger) storetypes.ABCIListener {
 	return &indexerStreamingService{
 		blockProcessStrategyManager: blockProcessStrategyManager,
 
@@ -73,12 +82,17 @@ func New(blockUpdatesProcessUtils commondomain.BlockUpdateProcessUtilsI, blockPr
 
 		txDecoder: txDecoder,
 
+		eventAllowlist: eventAllowlist,
+
 		logger: logger,
 	}
 }
 
 // Close implements baseapp.StreamingService.
 func (s *indexerStreamingService) Close() error {
+	if closer, ok := s.client.(io.Closer); ok {
+		return closer.Close()
+	}
 	return nil
 }
 
@@ -111,6 +125,11 @@ func (s *indexerStreamingService) publishTxn(ctx context.Context, req abci.Reque
 		// Calculate the transaction hash
 		txHash := strings.ToUpper(hex.EncodeToString(tmhash.Sum(txByteArr)))
 
+		// The gas and the events of the transaction are in its result
+		if txnIndex >= len(res.TxResults) {
+			return fmt.Errorf("%w: transaction %s at index %d", domain.ErrTxResultNotFound, txHash, txnIndex)
+		}
+
 		// Gas data
 		gasWanted := res.TxResults[txnIndex].GasWanted
 		gasUsed := res.TxResults[txnIndex].GasUsed
@@ -124,23 +143,41 @@ func (s *indexerStreamingService) publishTxn(ctx context.Context, req abci.Reque
 		txMessages := tx.GetMsgs()
 		msgType := txMessages[0].String()
 
-		// Include these events only:
-		// - token_swapped
-		// - pool_joined
-		// - pool_exited
-		// - create_position
-		// - withdraw_position
-		events := res.GetEvents()
+		// Each message is published with its type, its sender and the events it emitted.
+		messages := make([]domain.Message, len(txMessages))
+		for i, msg := range txMessages {
+			messages[i] = domain.Message{
+				Index: i,
+				Type:  sdk.MsgTypeURL(msg),
+			}
+		}
+
+		// Include the events of the allowlist only.
+		// Events emitted by the messages are attributed to them by their msg_index attribute.
+		events := res.TxResults[txnIndex].GetEvents()
 		var includedEvents []domain.EventWrapper
 		for i, event := range events {
+			msgIndex, ok := eventMsgIndex(event)
+			isMsgEvent := ok && msgIndex < len(messages)
+
+			// The first message event of each message is emitted by the baseapp and holds the signer of the message.
+			if isMsgEvent && event.Type == sdk.EventTypeMessage && messages[msgIndex].Sender == "" {
+				messages[msgIndex].Sender = eventAttribute(event, sdk.AttributeKeySender)
+			}
+
+			if !s.eventAllowlist.Contains(event.Type) {
+				continue
+			}
 			err := s.adjustTokenInAmountBySpreadFactor(ctx, &event)
 			if err != nil {
 				s.logger.Error("Error adjusting amount by spread factor", "error", err)
 				continue
 			}
-			eventType := event.Type
-			if eventType == gammtypes.TypeEvtTokenSwapped || eventType == gammtypes.TypeEvtPoolJoined || eventType == gammtypes.TypeEvtPoolExited || eventType == concentratedliquiditytypes.TypeEvtCreatePosition || eventType == concentratedliquiditytypes.TypeEvtWithdrawPosition {
-				includedEvents = append(includedEvents, domain.EventWrapper{Index: i, Event: event})
+
+			eventWrapper := domain.EventWrapper{Index: i, Event: event}
+			includedEvents = append(includedEvents, eventWrapper)
+			if isMsgEvent {
+				messages[msgIndex].Events = append(messages[msgIndex].Events, eventWrapper)
 			}
 		}
 
@@ -155,6 +192,7 @@ func (s *indexerStreamingService) publishTxn(ctx context.Context, req abci.Reque
 			TransactionHash:    txHash,
 			TransactionIndexId: txnIndex,
 			Events:             includedEvents,
+			Messages:           messages,
 		}
 		err = s.client.PublishTransaction(sdkCtx, txn)
 		if err != nil {
@@ -165,6 +203,25 @@ func (s *indexerStreamingService) publishTxn(ctx context.Context, req abci.Reque
 	return nil
 }
 
+// eventMsgIndex returns the index of the message that emitted the event, if it was emitted by a message.
+func eventMsgIndex(event abci.Event) (int, bool) {
+	msgIndex, err := strconv.Atoi(eventAttribute(event, msgIndexAttributeKey))
+	if err != nil || msgIndex < 0 {
+		return 0, false
+	}
+	return msgIndex, true
+}
+
+// eventAttribute returns the value of the first attribute of the event with the given key, or an empty string.
+func eventAttribute(event abci.Event, key string) string {
+	for _, attribute := range event.Attributes {
+		if attribute.Key == key {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
 // adjustAmountBySpreadFactor adjusts the amount by the spread factor.
 // This is done to adjust the amount of tokens in the token_swapped event by the spread factor,
 // as the amount in the event is the amount AFTER the spread factor is applied.
@@ -234,6 +291,14 @@ func (s *indexerStreamingService) ListenFinalizeBlock(ctx context.Context, req a
 		}()
 	}
 
+	// Let the publisher know which block the data belongs to, e.g. to store it in the outbox under this height.
+	if tracker, ok := s.client.(domain.BlockTracker); ok {
+		if err := tracker.StartBlock(uint64(req.GetHeight())); err != nil {
+			s.logger.Error("Error starting block in indexer publisher", "error", err)
+			return err
+		}
+	}
+
 	// Publish the block data
 	var err error
 	err = s.publishBlock(ctx, req)
@@ -270,6 +335,16 @@ func (s *indexerStreamingService) ListenCommit(ctx context.Context, res abci.Res
 		s.poolTracker.Reset()
 	}()
 
+	// All the data of the block has been published once the block is processed,
+	// whether or not processing it succeeded.
+	if tracker, ok := s.client.(domain.BlockTracker); ok {
+		defer func() {
+			if err := tracker.EndBlock(uint64(sdkCtx.BlockHeight())); err != nil {
+				s.logger.Error("Error ending block in indexer publisher", "error", err)
+			}
+		}()
+	}
+
 	// Create block processor
 	blockProcessor := blockprocessor.NewBlockProcessor(s.blockProcessStrategyManager, s.client, s.poolExtractor, s.keepers)
 
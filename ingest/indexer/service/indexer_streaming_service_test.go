@@ -0,0 +1,226 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/service"
+)
+
+type IndexerServiceTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestIndexerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(IndexerServiceTestSuite))
+}
+
+// transactionPublisherMock records the published transactions.
+type transactionPublisherMock struct {
+	mocks.PublisherMock
+	transactions []domain.Transaction
+}
+
+// PublishTransaction implements domain.Publisher.
+func (p *transactionPublisherMock) PublishTransaction(ctx context.Context, txn domain.Transaction) error {
+	p.transactions = append(p.transactions, txn)
+	return nil
+}
+
+// newEvent returns an event of the given type with the given key-value attributes.
+func newEvent(eventType string, keyValues ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(keyValues); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: keyValues[i], Value: keyValues[i+1]})
+	}
+	return event
+}
+
+// Validates that the transactions are published with their allowlisted events only,
+// and that the events and the senders are attributed to the messages by the msg_index attribute.
+func (s *IndexerServiceTestSuite) TestPublishTxn() {
+	s.Setup()
+
+	var (
+		sender0 = s.TestAccs[0].String()
+		sender1 = s.TestAccs[1].String()
+
+		// Emitted by the ante handler, without msg_index.
+		feeEvent = newEvent("tx", "fee", "1000uosmo")
+
+		msg0Event     = newEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, sender0, "msg_index", "0")
+		msg0Transfer  = newEvent(banktypes.EventTypeTransfer, "amount", "10uosmo", "msg_index", "0")
+		msg0Extra     = newEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, sender1, "msg_index", "0")
+		msg1Event     = newEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, sender1, "msg_index", "1")
+		msg1Transfer  = newEvent(banktypes.EventTypeTransfer, "amount", "20uosmo", "msg_index", "1")
+		msg1CoinMint  = newEvent(banktypes.EventTypeCoinMint, "amount", "20uosmo", "msg_index", "1")
+		outOfRange    = newEvent(banktypes.EventTypeTransfer, "amount", "30uosmo", "msg_index", "2")
+		invalidIndex  = newEvent(banktypes.EventTypeTransfer, "amount", "40uosmo", "msg_index", "first")
+		negativeIndex = newEvent(banktypes.EventTypeTransfer, "amount", "50uosmo", "msg_index", "-1")
+	)
+
+	bankSend := func(from sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, s.TestAccs[2], sdk.NewCoins(sdk.NewCoin("uosmo", osmomath.NewInt(10))))
+	}
+
+	tests := []struct {
+		name      string
+		msgs      [][]sdk.Msg
+		txResults []*abci.ExecTxResult
+		allowlist []string
+
+		expectedEvents   [][]domain.EventWrapper
+		expectedMessages [][]domain.Message
+		expectedError    error
+	}{
+		{
+			name:      "single message, allowlisted events only",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{feeEvent, msg0Event, msg0Transfer}}},
+			allowlist: []string{banktypes.EventTypeTransfer},
+
+			expectedEvents: [][]domain.EventWrapper{{{Index: 2, Event: msg0Transfer}}},
+			expectedMessages: [][]domain.Message{{
+				{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0, Events: []domain.EventWrapper{{Index: 2, Event: msg0Transfer}}},
+			}},
+		},
+		{
+			name: "multiple messages, events attributed by msg_index",
+			msgs: [][]sdk.Msg{{bankSend(s.TestAccs[0]), bankSend(s.TestAccs[1])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{
+				feeEvent, msg0Event, msg0Transfer, msg0Extra, msg1Event, msg1Transfer, msg1CoinMint,
+			}}},
+			allowlist: []string{"tx", banktypes.EventTypeTransfer, banktypes.EventTypeCoinMint},
+
+			expectedEvents: [][]domain.EventWrapper{{
+				{Index: 0, Event: feeEvent},
+				{Index: 2, Event: msg0Transfer},
+				{Index: 5, Event: msg1Transfer},
+				{Index: 6, Event: msg1CoinMint},
+			}},
+			expectedMessages: [][]domain.Message{{
+				// The sender is the one of the first message event of the message.
+				{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0, Events: []domain.EventWrapper{{Index: 2, Event: msg0Transfer}}},
+				{Index: 1, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender1, Events: []domain.EventWrapper{{Index: 5, Event: msg1Transfer}, {Index: 6, Event: msg1CoinMint}}},
+			}},
+		},
+		{
+			name:      "message events are published when allowlisted",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{msg0Event, msg0Transfer}}},
+			allowlist: []string{sdk.EventTypeMessage},
+
+			expectedEvents: [][]domain.EventWrapper{{{Index: 0, Event: msg0Event}}},
+			expectedMessages: [][]domain.Message{{
+				{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0, Events: []domain.EventWrapper{{Index: 0, Event: msg0Event}}},
+			}},
+		},
+		{
+			name:      "events with an invalid or out of range msg_index are not attributed to a message",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0]), bankSend(s.TestAccs[1])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{outOfRange, invalidIndex, negativeIndex}}},
+			allowlist: []string{banktypes.EventTypeTransfer},
+
+			expectedEvents: [][]domain.EventWrapper{{
+				{Index: 0, Event: outOfRange},
+				{Index: 1, Event: invalidIndex},
+				{Index: 2, Event: negativeIndex},
+			}},
+			expectedMessages: [][]domain.Message{{
+				{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend"},
+				{Index: 1, Type: "/cosmos.bank.v1beta1.MsgSend"},
+			}},
+		},
+		{
+			name:      "empty allowlist, senders are still attributed",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{feeEvent, msg0Event, msg0Transfer}}},
+
+			expectedEvents: [][]domain.EventWrapper{nil},
+			expectedMessages: [][]domain.Message{{
+				{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0},
+			}},
+		},
+		{
+			name: "multiple transactions, events of each transaction result",
+			msgs: [][]sdk.Msg{{bankSend(s.TestAccs[0])}, {bankSend(s.TestAccs[1])}},
+			txResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{msg0Event, msg0Transfer}},
+				{Events: []abci.Event{msg1Transfer}},
+			},
+			allowlist: []string{banktypes.EventTypeTransfer},
+
+			expectedEvents: [][]domain.EventWrapper{
+				{{Index: 1, Event: msg0Transfer}},
+				{{Index: 0, Event: msg1Transfer}},
+			},
+			expectedMessages: [][]domain.Message{
+				{{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0, Events: []domain.EventWrapper{{Index: 1, Event: msg0Transfer}}}},
+				// The transfer event has msg_index 1, which is out of range for this single message transaction.
+				{{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+		},
+		{
+			name:      "missing transaction result",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0])}, {bankSend(s.TestAccs[1])}},
+			txResults: []*abci.ExecTxResult{{Events: []abci.Event{msg0Event, msg0Transfer}}},
+			allowlist: []string{banktypes.EventTypeTransfer},
+
+			// The first transaction is published before the missing result is found.
+			expectedEvents: [][]domain.EventWrapper{{{Index: 1, Event: msg0Transfer}}},
+			expectedMessages: [][]domain.Message{
+				{{Index: 0, Type: "/cosmos.bank.v1beta1.MsgSend", Sender: sender0, Events: []domain.EventWrapper{{Index: 1, Event: msg0Transfer}}}},
+			},
+			expectedError: domain.ErrTxResultNotFound,
+		},
+		{
+			name:      "no transaction results",
+			msgs:      [][]sdk.Msg{{bankSend(s.TestAccs[0])}},
+			allowlist: []string{banktypes.EventTypeTransfer},
+
+			expectedError: domain.ErrTxResultNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			txConfig := s.App.GetTxConfig()
+			txs := make([][]byte, len(tc.msgs))
+			for i, msgs := range tc.msgs {
+				txBuilder := txConfig.NewTxBuilder()
+				s.Require().NoError(txBuilder.SetMsgs(msgs...))
+				txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+				s.Require().NoError(err)
+				txs[i] = txBytes
+			}
+
+			publisher := &transactionPublisherMock{}
+			keepers := domain.Keepers{PoolManagerKeeper: s.App.PoolManagerKeeper}
+			indexerService := service.New(nil, nil, publisher, nil, nil, nil, keepers, txConfig.TxDecoder(), domain.NewEventAllowlist(tc.allowlist), log.NewNopLogger()).(*service.IndexerStreamingService)
+
+			err := indexerService.PublishTxn(s.Ctx, abci.RequestFinalizeBlock{Txs: txs}, abci.ResponseFinalizeBlock{TxResults: tc.txResults})
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			s.Require().Len(publisher.transactions, len(tc.expectedEvents))
+			for i, txn := range publisher.transactions {
+				s.Require().Equal(i, txn.TransactionIndexId)
+				s.Require().Equal(tc.expectedEvents[i], txn.Events)
+				s.Require().Equal(tc.expectedMessages[i], txn.Messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
+)
+
+// entry is a payload stored in the outbox.
+type entry struct {
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// newEntry marshals the payload of the given topic into an entry.
+func newEntry(topic string, payload any) ([]byte, error) {
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(entry{Topic: topic, Payload: bz})
+}
+
+// deliver publishes the entry with the client.
+func deliver(ctx context.Context, client domain.Publisher, bz []byte) error {
+	var e entry
+	if err := json.Unmarshal(bz, &e); err != nil {
+		return err
+	}
+
+	switch e.Topic {
+	case service.BlockTopic:
+		var block domain.Block
+		if err := json.Unmarshal(e.Payload, &block); err != nil {
+			return err
+		}
+		return client.PublishBlock(ctx, block)
+	case service.TransactionTopic:
+		var txn domain.Transaction
+		if err := json.Unmarshal(e.Payload, &txn); err != nil {
+			return err
+		}
+		return client.PublishTransaction(ctx, txn)
+	case service.PoolTopic:
+		var pool domain.Pool
+		if err := json.Unmarshal(e.Payload, &pool); err != nil {
+			return err
+		}
+		return client.PublishPool(ctx, pool)
+	case service.TokenSupplyTopic:
+		var tokenSupply domain.TokenSupply
+		if err := json.Unmarshal(e.Payload, &tokenSupply); err != nil {
+			return err
+		}
+		return client.PublishTokenSupply(ctx, tokenSupply)
+	case service.TokenSupplyOffsetTopic:
+		var tokenSupplyOffset domain.TokenSupplyOffset
+		if err := json.Unmarshal(e.Payload, &tokenSupplyOffset); err != nil {
+			return err
+		}
+		return client.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
+	case service.PairTopic:
+		var pair domain.Pair
+		if err := json.Unmarshal(e.Payload, &pair); err != nil {
+			return err
+		}
+		return client.PublishPair(ctx, pair)
+	default:
+		return fmt.Errorf("unknown outbox entry topic %s", e.Topic)
+	}
+}
@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	// pendingPrefix is the prefix of the entries that haven't been delivered yet.
+	pendingPrefix = []byte{0x01}
+	// archivePrefix is the prefix of the delivered entries, kept for republishing.
+	archivePrefix = []byte{0x02}
+	// sealedHeightKey stores the last height whose entries have all been stored.
+	sealedHeightKey = []byte{0x03}
+	// ackedHeightKey stores the last height whose entries have all been delivered.
+	ackedHeightKey = []byte{0x04}
+)
+
+// entryKey returns the key of the entry with the given sequence number in the block at the given height.
+// Heights and sequence numbers are big endian encoded so that entries are iterated in publishing order.
+func entryKey(prefix []byte, height, seq uint64) []byte {
+	key := make([]byte, 0, len(prefix)+16)
+	key = append(key, prefix...)
+	key = binary.BigEndian.AppendUint64(key, height)
+	return binary.BigEndian.AppendUint64(key, seq)
+}
+
+// heightPrefix returns the prefix of the entries of the block at the given height.
+func heightPrefix(prefix []byte, height uint64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	key = append(key, prefix...)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
+// parseEntryHeight returns the height of the entry with the given key.
+func parseEntryHeight(key []byte) (uint64, error) {
+	if len(key) != 17 {
+		return 0, errors.New("invalid outbox entry key")
+	}
+	return binary.BigEndian.Uint64(key[1:9]), nil
+}
+
+func encodeHeight(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, height)
+}
+
+func decodeHeight(bz []byte) uint64 {
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
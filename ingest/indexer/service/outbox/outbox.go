@@ -0,0 +1,344 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/client"
+)
+
+const (
+	// DBName is the name of the outbox database in the node data directory.
+	DBName = "indexer_outbox"
+
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+// Outbox is a durable queue between the indexer and its publisher backend.
+//
+// Each payload is stored before being published, grouped by block height. Once all the payloads of a block
+// have been stored (see EndBlock), they are delivered in order by a background goroutine that retries failed
+// deliveries with an exponential backoff. Delivered payloads are archived for retentionBlocks blocks so that
+// they can be republished.
+type Outbox struct {
+	db              dbm.DB
+	client          domain.Publisher
+	logger          log.Logger
+	retentionBlocks uint64
+
+	// mu guards the writes to the database and the fields below.
+	mu           sync.Mutex
+	height       uint64
+	seq          uint64
+	sealedHeight uint64
+	ackedHeight  uint64
+
+	notify  chan struct{}
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+var (
+	_ domain.Publisher    = &Outbox{}
+	_ domain.BlockTracker = &Outbox{}
+)
+
+// New creates a new outbox delivering the payloads stored in db with the client.
+// Start must be called for the payloads to be delivered.
+func New(db dbm.DB, client domain.Publisher, logger log.Logger, retentionBlocks uint64) (*Outbox, error) {
+	status, err := ReadStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Outbox{
+		db:              db,
+		client:          client,
+		logger:          logger,
+		retentionBlocks: retentionBlocks,
+		sealedHeight:    status.SealedHeight,
+		ackedHeight:     status.LastAckedHeight,
+		notify:          make(chan struct{}, 1),
+		stopped:         make(chan struct{}),
+	}, nil
+}
+
+// Start starts delivering the stored payloads in the background.
+func (o *Outbox) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+	go o.run(ctx)
+}
+
+// Close stops the delivery and closes the database.
+func (o *Outbox) Close() error {
+	if o.cancel != nil {
+		o.cancel()
+		<-o.stopped
+	}
+	return o.db.Close()
+}
+
+// LastAckedHeight returns the last height whose payloads have all been delivered.
+func (o *Outbox) LastAckedHeight() uint64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.ackedHeight
+}
+
+// StartBlock implements domain.BlockTracker.
+// Payloads stored for this height or above are leftovers of a block that was interrupted, or of a chain that
+// was rolled back, so they are deleted.
+func (o *Outbox) StartBlock(height uint64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	batch := o.db.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range [][]byte{pendingPrefix, archivePrefix} {
+		if err := deleteRange(o.db, batch, heightPrefix(prefix, height), prefixEnd(prefix)); err != nil {
+			return err
+		}
+	}
+	if height > 0 && o.sealedHeight >= height {
+		o.sealedHeight = height - 1
+		if err := batch.Set(sealedHeightKey, encodeHeight(o.sealedHeight)); err != nil {
+			return err
+		}
+	}
+	if height > 0 && o.ackedHeight >= height {
+		o.ackedHeight = height - 1
+		if err := batch.Set(ackedHeightKey, encodeHeight(o.ackedHeight)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	o.height = height
+	o.seq = 0
+	return nil
+}
+
+// EndBlock implements domain.BlockTracker.
+// It makes the payloads of the block available for delivery.
+func (o *Outbox) EndBlock(height uint64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := o.db.SetSync(sealedHeightKey, encodeHeight(height)); err != nil {
+		return err
+	}
+	o.sealedHeight = height
+	if err := o.updateAckedHeight(); err != nil {
+		return err
+	}
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// store stores the payload under the current block height.
+func (o *Outbox) store(topic string, payload any) error {
+	bz, err := newEntry(topic, payload)
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	key := entryKey(pendingPrefix, o.height, o.seq)
+	o.seq++
+	return o.db.Set(key, bz)
+}
+
+// run delivers the sealed payloads in order until the context is cancelled.
+func (o *Outbox) run(ctx context.Context) {
+	defer close(o.stopped)
+
+	for {
+		key, value, err := o.next()
+		if err != nil {
+			o.logger.Error("failed to read indexer outbox", "error", err)
+		}
+		if key == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-o.notify:
+			case <-time.After(maxBackoff):
+			}
+			continue
+		}
+
+		backoff := minBackoff
+		for {
+			err := deliver(ctx, o.client, value)
+			if err == nil {
+				break
+			}
+			o.logger.Error("failed to deliver indexer outbox entry, retrying", "error", err, "retry_in", backoff)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, maxBackoff)
+		}
+
+		if err := o.acknowledge(key, value); err != nil {
+			o.logger.Error("failed to acknowledge indexer outbox entry", "error", err)
+		}
+	}
+}
+
+// next returns the first pending entry of a sealed block, or a nil key if there is none.
+func (o *Outbox) next() ([]byte, []byte, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	iterator, err := o.db.Iterator(pendingPrefix, prefixEnd(pendingPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil, nil
+	}
+	height, err := parseEntryHeight(iterator.Key())
+	if err != nil || height > o.sealedHeight {
+		return nil, nil, err
+	}
+	return bytes.Clone(iterator.Key()), bytes.Clone(iterator.Value()), nil
+}
+
+// acknowledge archives the delivered entry and updates the last acknowledged height.
+func (o *Outbox) acknowledge(key, value []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	// The entry may have been deleted by StartBlock while it was delivered.
+	has, err := o.db.Has(key)
+	if err != nil || !has {
+		return err
+	}
+
+	batch := o.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Delete(key); err != nil {
+		return err
+	}
+	archiveKey := append(bytes.Clone(archivePrefix), key[len(pendingPrefix):]...)
+	if err := batch.Set(archiveKey, value); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	return o.updateAckedHeight()
+}
+
+// updateAckedHeight sets the last acknowledged height to the height below the first pending entry, if it is
+// sealed, or to the sealed height otherwise. Archived entries older than the retention are pruned whenever
+// the acknowledged height moves.
+func (o *Outbox) updateAckedHeight() error {
+	ackedHeight := o.sealedHeight
+
+	iterator, err := o.db.Iterator(pendingPrefix, prefixEnd(pendingPrefix))
+	if err != nil {
+		return err
+	}
+	if iterator.Valid() {
+		height, err := parseEntryHeight(iterator.Key())
+		if err == nil && height <= o.sealedHeight {
+			ackedHeight = max(height, 1) - 1
+		}
+	}
+	iterator.Close()
+
+	if ackedHeight == o.ackedHeight {
+		return nil
+	}
+
+	batch := o.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(ackedHeightKey, encodeHeight(ackedHeight)); err != nil {
+		return err
+	}
+	if ackedHeight > o.retentionBlocks {
+		if err := deleteRange(o.db, batch, archivePrefix, heightPrefix(archivePrefix, ackedHeight-o.retentionBlocks+1)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	o.ackedHeight = ackedHeight
+	return nil
+}
+
+// PublishBlock implements domain.Publisher.
+func (o *Outbox) PublishBlock(_ context.Context, block domain.Block) error {
+	return o.store(service.BlockTopic, block)
+}
+
+// PublishTransaction implements domain.Publisher.
+func (o *Outbox) PublishTransaction(_ context.Context, txn domain.Transaction) error {
+	return o.store(service.TransactionTopic, txn)
+}
+
+// PublishPool implements domain.Publisher.
+func (o *Outbox) PublishPool(_ context.Context, pool domain.Pool) error {
+	return o.store(service.PoolTopic, pool)
+}
+
+// PublishTokenSupply implements domain.Publisher.
+func (o *Outbox) PublishTokenSupply(_ context.Context, tokenSupply domain.TokenSupply) error {
+	return o.store(service.TokenSupplyTopic, tokenSupply)
+}
+
+// PublishTokenSupplyOffset implements domain.Publisher.
+func (o *Outbox) PublishTokenSupplyOffset(_ context.Context, tokenSupplyOffset domain.TokenSupplyOffset) error {
+	return o.store(service.TokenSupplyOffsetTopic, tokenSupplyOffset)
+}
+
+// PublishPair implements domain.Publisher.
+func (o *Outbox) PublishPair(_ context.Context, pair domain.Pair) error {
+	return o.store(service.PairTopic, pair)
+}
+
+// deleteRange adds the deletion of the keys in [start, end) to the batch.
+func deleteRange(db dbm.DB, batch dbm.Batch, start, end []byte) error {
+	iterator, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Delete(bytes.Clone(iterator.Key())); err != nil {
+			return err
+		}
+	}
+	return iterator.Error()
+}
+
+// prefixEnd returns the end of the range of the keys with the given single byte prefix.
+func prefixEnd(prefix []byte) []byte {
+	return []byte{prefix[0] + 1}
+}
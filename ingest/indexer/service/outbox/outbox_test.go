@@ -0,0 +1,146 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/service/outbox"
+)
+
+// recordingPublisher records the published payloads, failing the first failures calls.
+type recordingPublisher struct {
+	mu        sync.Mutex
+	failures  int
+	published []string
+}
+
+var _ domain.Publisher = &recordingPublisher{}
+
+func (r *recordingPublisher) record(payload string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("backend unavailable")
+	}
+	r.published = append(r.published, payload)
+	return nil
+}
+
+func (r *recordingPublisher) Published() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.published...)
+}
+
+func (r *recordingPublisher) PublishBlock(_ context.Context, block domain.Block) error {
+	return r.record(fmt.Sprintf("block %d", block.Height))
+}
+
+func (r *recordingPublisher) PublishTransaction(_ context.Context, txn domain.Transaction) error {
+	return r.record("transaction " + txn.TransactionHash)
+}
+
+func (r *recordingPublisher) PublishPool(_ context.Context, _ domain.Pool) error {
+	return r.record("pool")
+}
+
+func (r *recordingPublisher) PublishTokenSupply(_ context.Context, tokenSupply domain.TokenSupply) error {
+	return r.record(fmt.Sprintf("supply %s %s", tokenSupply.Denom, tokenSupply.Supply))
+}
+
+func (r *recordingPublisher) PublishTokenSupplyOffset(_ context.Context, tokenSupplyOffset domain.TokenSupplyOffset) error {
+	return r.record("supply offset " + tokenSupplyOffset.Denom)
+}
+
+func (r *recordingPublisher) PublishPair(_ context.Context, pair domain.Pair) error {
+	return r.record(fmt.Sprintf("pair %d", pair.PoolID))
+}
+
+// Validates that the payloads of a block are delivered in order once the block ends, that failed deliveries
+// are retried, and that the last acknowledged height follows the deliveries.
+func TestOutbox_Delivery(t *testing.T) {
+	db := dbm.NewMemDB()
+	client := &recordingPublisher{failures: 1}
+	o, err := outbox.New(db, client, log.NewNopLogger(), 1)
+	require.NoError(t, err)
+	o.Start()
+	defer o.Close()
+
+	ctx := context.Background()
+
+	// Block 1 is delivered after one failed attempt.
+	require.NoError(t, o.StartBlock(1))
+	require.NoError(t, o.PublishBlock(ctx, domain.Block{Height: 1}))
+	require.NoError(t, o.PublishTransaction(ctx, domain.Transaction{TransactionHash: "A"}))
+	require.NoError(t, o.PublishPair(ctx, domain.Pair{PoolID: 1}))
+	require.NoError(t, o.EndBlock(1))
+
+	require.Eventually(t, func() bool { return o.LastAckedHeight() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"block 1", "transaction A", "pair 1"}, client.Published())
+
+	// Block 2 is interrupted before it ends, so it is not delivered and its payloads are
+	// replaced when the block is processed again.
+	require.NoError(t, o.StartBlock(2))
+	require.NoError(t, o.PublishBlock(ctx, domain.Block{Height: 2}))
+	require.NoError(t, o.PublishTransaction(ctx, domain.Transaction{TransactionHash: "B"}))
+
+	status, err := outbox.ReadStatus(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), status.LastAckedHeight)
+	require.Equal(t, uint64(2), status.PendingEntries)
+
+	require.NoError(t, o.StartBlock(2))
+	require.NoError(t, o.PublishBlock(ctx, domain.Block{Height: 2}))
+	require.NoError(t, o.PublishTransaction(ctx, domain.Transaction{TransactionHash: "C"}))
+	require.NoError(t, o.EndBlock(2))
+
+	require.Eventually(t, func() bool { return o.LastAckedHeight() == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"block 1", "transaction A", "pair 1", "block 2", "transaction C"}, client.Published())
+
+	// With a retention of one block, only block 2 is kept for republishing.
+	status, err = outbox.ReadStatus(db)
+	require.NoError(t, err)
+	require.Equal(t, outbox.Status{LastAckedHeight: 2, SealedHeight: 2, PendingEntries: 0, ArchivedFromHeight: 2}, status)
+
+	republishClient := &recordingPublisher{}
+	count, err := outbox.Republish(ctx, db, republishClient, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, []string{"block 2", "transaction C"}, republishClient.Published())
+
+	_, err = outbox.Republish(ctx, db, republishClient, 2, 1)
+	require.Error(t, err)
+}
+
+// Validates that the outbox resumes from its stored state.
+func TestOutbox_Restart(t *testing.T) {
+	db := dbm.NewMemDB()
+	client := &recordingPublisher{}
+
+	// Payloads stored while the backend was down are delivered after a restart.
+	o, err := outbox.New(db, client, log.NewNopLogger(), 100)
+	require.NoError(t, err)
+	require.NoError(t, o.StartBlock(5))
+	require.NoError(t, o.PublishTokenSupply(context.Background(), domain.TokenSupply{Denom: "uosmo"}))
+	require.NoError(t, o.EndBlock(5))
+	require.Equal(t, uint64(4), o.LastAckedHeight())
+
+	o, err = outbox.New(db, client, log.NewNopLogger(), 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), o.LastAckedHeight())
+	o.Start()
+	defer o.Close()
+
+	require.Eventually(t, func() bool { return o.LastAckedHeight() == 5 }, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, client.Published(), 1)
+}
@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/osmosis-labs/osmosis/v25/ingest/indexer/domain"
+)
+
+// Status is the delivery status of an outbox.
+type Status struct {
+	// LastAckedHeight is the last height whose payloads have all been delivered.
+	LastAckedHeight uint64 `json:"last_acked_height"`
+	// SealedHeight is the last height whose payloads have all been stored.
+	SealedHeight uint64 `json:"sealed_height"`
+	// PendingEntries is the number of payloads waiting to be delivered.
+	PendingEntries uint64 `json:"pending_entries"`
+	// ArchivedFromHeight is the lowest height that can be republished, or 0 if nothing is archived.
+	ArchivedFromHeight uint64 `json:"archived_from_height"`
+}
+
+// ReadStatus reads the delivery status of the outbox stored in db.
+func ReadStatus(db dbm.DB) (Status, error) {
+	var status Status
+
+	bz, err := db.Get(sealedHeightKey)
+	if err != nil {
+		return Status{}, err
+	}
+	status.SealedHeight = decodeHeight(bz)
+
+	bz, err = db.Get(ackedHeightKey)
+	if err != nil {
+		return Status{}, err
+	}
+	status.LastAckedHeight = decodeHeight(bz)
+
+	iterator, err := db.Iterator(pendingPrefix, prefixEnd(pendingPrefix))
+	if err != nil {
+		return Status{}, err
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		status.PendingEntries++
+	}
+	iterator.Close()
+
+	iterator, err = db.Iterator(archivePrefix, prefixEnd(archivePrefix))
+	if err != nil {
+		return Status{}, err
+	}
+	defer iterator.Close()
+	if iterator.Valid() {
+		status.ArchivedFromHeight, err = parseEntryHeight(iterator.Key())
+		if err != nil {
+			return Status{}, err
+		}
+	}
+	return status, nil
+}
+
+// Republish publishes again with the client the archived payloads of the heights in [fromHeight, toHeight],
+// in their original order. It stops at the first delivery error. It returns the number of republished payloads.
+func Republish(ctx context.Context, db dbm.DB, client domain.Publisher, fromHeight, toHeight uint64) (int, error) {
+	if fromHeight > toHeight {
+		return 0, fmt.Errorf("from height %d is greater than to height %d", fromHeight, toHeight)
+	}
+
+	end := prefixEnd(archivePrefix)
+	if toHeight < math.MaxUint64 {
+		end = heightPrefix(archivePrefix, toHeight+1)
+	}
+	iterator, err := db.Iterator(heightPrefix(archivePrefix, fromHeight), end)
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if err := deliver(ctx, client, iterator.Value()); err != nil {
+			height, _ := parseEntryHeight(iterator.Key())
+			return count, fmt.Errorf("failed to republish payload at height %d: %w", height, err)
+		}
+		count++
+	}
+	return count, iterator.Error()
+}
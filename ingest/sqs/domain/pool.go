@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// PoolWithTakerFee is a pool with a taker fee override, which is charged on its swaps
+// instead of the taker fee of the denom pair.
+type PoolWithTakerFee struct {
+	sqsdomain.PoolI
+	TakerFee osmomath.Dec
+}
+
+// SQSPoolModelWithTakerFee is the SQS pool model pushed for the pools with a taker fee override.
+// The taker fee map is keyed by denom pair only, so the override of the pool is pushed along with its model.
+type SQSPoolModelWithTakerFee struct {
+	sqsdomain.SQSPool
+	TakerFee osmomath.Dec `json:"taker_fee"`
+}
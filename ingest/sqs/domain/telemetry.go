@@ -44,4 +44,13 @@ var (
 	// * err - the error returned
 	// * height - the height of the block being processed
 	SQSGRPCConnectionErrorMetricName = "sqs_grpc_connection_error"
+
+	// sqs_grpc_push_retry
+	//
+	// counter that is increased when pushing the block data is retried after a transient error
+	//
+	// Has the following labels:
+	// * err - the error returned
+	// * height - the height of the block being processed
+	SQSGRPCPushRetryMetricName = "sqs_grpc_push_retry"
 )
@@ -300,7 +300,7 @@ func (pi *poolTransformer) convertPool(
 		}
 	}
 
-	return &sqsdomain.PoolWrapper{
+	sqsPool = &sqsdomain.PoolWrapper{
 		ChainModel: pool,
 		SQSModel: sqsdomain.SQSPool{
 			PoolLiquidityCap:      poolLiquidityCapUSDC,
@@ -311,7 +311,18 @@ func (pi *poolTransformer) convertPool(
 			CosmWasmPoolModel:     cosmWasmPoolModel,
 		},
 		TickModel: tickModel,
-	}, nil
+	}
+
+	// The taker fee override of the pool, if any, is charged instead of the taker fee of its denom pairs.
+	poolTakerFee, found, err := pi.poolManagerKeeper.GetPoolTakerFee(ctx, pool.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &domain.PoolWithTakerFee{PoolI: sqsPool, TakerFee: poolTakerFee}, nil
+	}
+
+	return sqsPool, nil
 }
 
 // getPoolDenomsMap converts pool denoms to a map for faster lookup.
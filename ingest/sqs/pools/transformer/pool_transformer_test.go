@@ -14,6 +14,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/osmosis/v25/app/apptesting"
 	commondomain "github.com/osmosis-labs/osmosis/v25/ingest/common/domain"
+	"github.com/osmosis-labs/osmosis/v25/ingest/sqs/domain"
 	poolstransformer "github.com/osmosis-labs/osmosis/v25/ingest/sqs/pools/transformer"
 	clqueryproto "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/client/queryproto"
 	cltypes "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
@@ -109,6 +110,50 @@ func (s *PoolTransformerTestSuite) TestConvertPool_EmptyPriceInfoMap_TakerFee()
 	s.Require().Equal(expectedDenomPairToTakerFeeMap, denomPairToTakerFeeMap)
 }
 
+// This test validates that the taker fee override of a pool is pushed along with the pool,
+// while the denom pair to taker fee map keeps the taker fee of the denom pair.
+func (s *PoolTransformerTestSuite) TestConvertPool_PoolTakerFee() {
+	s.Setup()
+
+	s.setDefaultPoolManagerTakerFee()
+
+	poolTakerFee := osmomath.NewDecWithPrec(1, 3)
+
+	usdcOsmoPoolID := s.CreateDefaultQuoteDenomUOSMOPool()
+	s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, USDC, usdcOsmoPoolID)
+
+	// Prepare two stablecoin pools, one of them with a taker fee override
+	stableCoinPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(USDT, defaultAmount), sdk.NewCoin(USDC, defaultAmount))
+	overriddenPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(USDT, defaultAmount), sdk.NewCoin(USDC, defaultAmount))
+	s.Require().NoError(s.App.PoolManagerKeeper.SetPoolTakerFee(s.Ctx, overriddenPoolID, poolTakerFee))
+
+	poolIngester := s.initializePoolIngester(usdcOsmoPoolID)
+	denomPairToTakerFeeMap := sqsdomain.TakerFeeMap{}
+
+	// System under test
+	pool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, stableCoinPoolID)
+	s.Require().NoError(err)
+	actualPool, err := poolIngester.ConvertPool(s.Ctx, pool, map[string]osmomath.BigDec{}, denomPairToTakerFeeMap)
+	s.Require().NoError(err)
+	s.Require().IsType(&sqsdomain.PoolWrapper{}, actualPool)
+
+	overriddenPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, overriddenPoolID)
+	s.Require().NoError(err)
+	actualOverriddenPool, err := poolIngester.ConvertPool(s.Ctx, overriddenPool, map[string]osmomath.BigDec{}, denomPairToTakerFeeMap)
+	s.Require().NoError(err)
+	s.Require().IsType(&domain.PoolWithTakerFee{}, actualOverriddenPool)
+	s.Require().Equal(overriddenPoolID, actualOverriddenPool.GetId())
+	s.Require().Equal(poolTakerFee, actualOverriddenPool.(*domain.PoolWithTakerFee).TakerFee)
+
+	// The denom pair keeps the taker fee that is charged by the pools without override.
+	s.Require().Equal(sqsdomain.TakerFeeMap{
+		{
+			Denom0: USDC,
+			Denom1: USDT,
+		}: defaultPoolManagerTakerFee,
+	}, denomPairToTakerFeeMap)
+}
+
 // This test validates that converting a pool that has to pool liquidity capitalization from another
 // pool with non-empty denom to routing info map works as expected.
 func (s *PoolTransformerTestSuite) TestConvertPool_NonEmptyPriceInfoMap() {
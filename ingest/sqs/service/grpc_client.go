@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
@@ -25,24 +27,106 @@ type GRPCClient struct {
 	grpcMaxCallSizeBytes int
 	grpcConn             *grpc.ClientConn
 	appCodec             codec.Codec
+
+	transportCredentials credentials.TransportCredentials
+	perRPCCredentials    credentials.PerRPCCredentials
+
+	maxRetries          int
+	retryInitialBackoff time.Duration
 }
 
 var (
 	_ domain.SQSGRPClient = &GRPCClient{}
 )
 
-func NewGRPCCLient(grpcAddress string, grpxMaxCallSizeBytes int, appCodec codec.Codec) *GRPCClient {
-	return &GRPCClient{
+// GRPCClientOption configures a GRPCClient.
+type GRPCClientOption func(*GRPCClient)
+
+// WithTransportCredentials sets the transport credentials of the connection, e.g. TLS.
+// By default, the connection is insecure.
+func WithTransportCredentials(transportCredentials credentials.TransportCredentials) GRPCClientOption {
+	return func(g *GRPCClient) {
+		g.transportCredentials = transportCredentials
+	}
+}
+
+// WithPerRPCCredentials sets the credentials sent with each call, e.g. a bearer token.
+func WithPerRPCCredentials(perRPCCredentials credentials.PerRPCCredentials) GRPCClientOption {
+	return func(g *GRPCClient) {
+		g.perRPCCredentials = perRPCCredentials
+	}
+}
+
+// WithRetry retries pushing the data of a block up to maxRetries times on transient errors,
+// waiting initialBackoff before the first retry and doubling the wait on each subsequent retry.
+// By default, the push is not retried.
+func WithRetry(maxRetries int, initialBackoff time.Duration) GRPCClientOption {
+	return func(g *GRPCClient) {
+		g.maxRetries = maxRetries
+		g.retryInitialBackoff = initialBackoff
+	}
+}
+
+func NewGRPCCLient(grpcAddress string, grpxMaxCallSizeBytes int, appCodec codec.Codec, opts ...GRPCClientOption) *GRPCClient {
+	client := &GRPCClient{
 		grpcAddress:          grpcAddress,
 		grpcMaxCallSizeBytes: grpxMaxCallSizeBytes,
 		appCodec:             appCodec,
+		transportCredentials: insecure.NewCredentials(),
+	}
+	for _, opt := range opts {
+		opt(client)
 	}
+	return client
 }
 
 // PushData implements domain.GracefulSQSGRPClient.
-func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []sqsdomain.PoolI, takerFeesMap sqsdomain.TakerFeeMap) (err error) {
+// Transient errors are retried with backoff as configured by WithRetry. Once the retries are exhausted,
+// the error is returned so that the data is pushed in full in the next block.
+func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []sqsdomain.PoolI, takerFeesMap sqsdomain.TakerFeeMap) error {
+	// Marshal pools
+	poolData, err := g.marshalPools(pools)
+	if err != nil {
+		return err
+	}
+
+	// Marshal taker fees
+	takerFeesBz, err := takerFeesMap.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	req := prototypes.ProcessBlockRequest{
+		BlockHeight:  height,
+		TakerFeesMap: takerFeesBz,
+		Pools:        poolData,
+	}
+
+	backoff := g.retryInitialBackoff
+	for attempt := 0; ; attempt++ {
+		err = g.processBlock(ctx, height, &req)
+		if err == nil || attempt >= g.maxRetries || !isRetryable(err) {
+			return err
+		}
+
+		telemetry.IncrCounterWithLabels([]string{domain.SQSGRPCPushRetryMetricName}, 1, []metrics.Label{
+			telemetry.NewLabel("height", fmt.Sprintf("%d", height)),
+			telemetry.NewLabel("err", err.Error()),
+		})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// processBlock sends the block data to the sidecar query server.
+func (g *GRPCClient) processBlock(ctx context.Context, height uint64, req *prototypes.ProcessBlockRequest) (err error) {
 	// If sqs service is unavailable, we should reset the connection
-	// and attempt to reconnect during the next block.
+	// and attempt to reconnect during the next attempt.
 	var shouldResetConnection bool
 
 	defer func() {
@@ -61,43 +145,34 @@ func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []sqsdom
 	}()
 
 	if g.grpcConn == nil {
-		// Note: we disable retries since we have a custom logic to repeat retries in the next block.
+		// Note: we disable the built-in retries since we have a custom, bounded retry logic in PushData
+		// and repeat the push in full in the next block if it still fails.
 		// Using the built-in GRPC retry back-off logic is likely to halt the serial system.
-		// As a result, we opt in for simply continuing to attempting to process the next block
-		// and retrying the connection and ingest
-		g.grpcConn, err = grpc.NewClient(g.grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(g.grpcMaxCallSizeBytes)), grpc.WithDisableRetry(), grpc.WithDisableRetry(), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+		dialOpts := []grpc.DialOption{
+			grpc.WithTransportCredentials(g.transportCredentials),
+			grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(g.grpcMaxCallSizeBytes)),
+			grpc.WithDisableRetry(),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		}
+		if g.perRPCCredentials != nil {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(g.perRPCCredentials))
+		}
+
+		g.grpcConn, err = grpc.NewClient(g.grpcAddress, dialOpts...)
 		if err != nil {
 			shouldResetConnection = true
 			return err
 		}
 	}
 
-	// Marshal pools
-	poolData, err := g.marshalPools(pools)
-	if err != nil {
-		return err
-	}
-
-	// Marshal taker fees
-	takerFeesBz, err := takerFeesMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-
 	ingesterClient := prototypes.NewSQSIngesterClient(g.grpcConn)
 
-	req := prototypes.ProcessBlockRequest{
-		BlockHeight:  height,
-		TakerFeesMap: takerFeesBz,
-		Pools:        poolData,
-	}
-
-	_, err = ingesterClient.ProcessBlock(ctx, &req)
+	_, err = ingesterClient.ProcessBlock(ctx, req)
 	if err != nil {
 		status, ok := status.FromError(err)
 
 		// If the connection is unavailable, we should reset the connection
-		// and attempt to reconnect during the next block.
+		// and attempt to reconnect during the next attempt.
 		// On any other error, we assume that the connection is still valid so we
 		// do no attempt to recreate it. However, we still return the error to the caller.
 		shouldResetConnection = ok && status.Code() == codes.Unavailable
@@ -108,6 +183,20 @@ func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []sqsdom
 	return nil
 }
 
+// isRetryable returns true if the error is transient, so that pushing the data again may succeed.
+func isRetryable(err error) bool {
+	status, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch status.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
 // marshalPools marshals pools into a format that can be sent over gRPC.
 func (g *GRPCClient) marshalPools(pools []sqsdomain.PoolI) ([]*prototypes.PoolData, error) {
 	// Marshal pools
@@ -119,8 +208,15 @@ func (g *GRPCClient) marshalPools(pools []sqsdomain.PoolI) ([]*prototypes.PoolDa
 			return nil, err
 		}
 
-		// Serialize sqs pool model
-		sqsPoolBz, err := json.Marshal(pool.GetSQSPoolModel())
+		// Serialize sqs pool model, along with the taker fee override of the pool if any
+		var sqsPoolModel any = pool.GetSQSPoolModel()
+		if poolWithTakerFee, ok := pool.(*domain.PoolWithTakerFee); ok {
+			sqsPoolModel = domain.SQSPoolModelWithTakerFee{
+				SQSPool:  pool.GetSQSPoolModel(),
+				TakerFee: poolWithTakerFee.TakerFee,
+			}
+		}
+		sqsPoolBz, err := json.Marshal(sqsPoolModel)
 		if err != nil {
 			return nil, err
 		}
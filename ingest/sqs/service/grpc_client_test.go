@@ -0,0 +1,225 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+	prototypes "github.com/osmosis-labs/sqs/sqsdomain/proto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/app"
+	"github.com/osmosis-labs/osmosis/v25/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v25/ingest/sqs/service"
+	"github.com/osmosis-labs/osmosis/v25/x/gamm/pool-models/balancer"
+)
+
+// fakeIngester fails the first failures calls with the given code and records the authorization metadata.
+type fakeIngester struct {
+	prototypes.UnimplementedSQSIngesterServer
+
+	mu            sync.Mutex
+	failures      int
+	failureCode   codes.Code
+	calls         int
+	authorization []string
+	pools         []*prototypes.PoolData
+}
+
+func (f *fakeIngester) ProcessBlock(ctx context.Context, req *prototypes.ProcessBlockRequest) (*prototypes.ProcessBlockReply, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	md, _ := metadata.FromIncomingContext(ctx)
+	f.authorization = md.Get("authorization")
+	f.pools = req.Pools
+
+	if f.failures > 0 {
+		f.failures--
+		return nil, status.Error(f.failureCode, "sqs unavailable")
+	}
+	return &prototypes.ProcessBlockReply{}, nil
+}
+
+// startFakeIngester starts a gRPC server with the fake ingester and returns its address.
+func startFakeIngester(t *testing.T, ingester *fakeIngester, opts ...grpc.ServerOption) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(opts...)
+	prototypes.RegisterSQSIngesterServer(server, ingester)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+// The codec is only used to marshal the pools, which are empty in these tests.
+
+// Validates that pushing the data is retried on transient errors only, up to the configured number of retries.
+func TestGRPCClient_Retry(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		failures      int
+		failureCode   codes.Code
+		maxRetries    int
+		expectError   bool
+		expectedCalls int
+	}{
+		{"no failure", 0, codes.Unavailable, 2, false, 1},
+		{"transient failures within the retries", 2, codes.Unavailable, 2, false, 3},
+		{"transient failures beyond the retries", 3, codes.Unavailable, 2, true, 3},
+		{"retries disabled", 1, codes.Unavailable, 0, true, 1},
+		{"non transient failure", 1, codes.InvalidArgument, 2, true, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ingester := &fakeIngester{failures: tc.failures, failureCode: tc.failureCode}
+			address := startFakeIngester(t, ingester)
+
+			client := service.NewGRPCCLient(address, 1024*1024, nil, service.WithRetry(tc.maxRetries, time.Millisecond))
+			err := client.PushData(context.Background(), 1, []sqsdomain.PoolI{}, sqsdomain.TakerFeeMap{})
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedCalls, ingester.calls)
+		})
+	}
+}
+
+// Validates that the taker fee override of a pool is pushed along with its SQS pool model.
+func TestGRPCClient_PoolTakerFee(t *testing.T) {
+	ingester := &fakeIngester{}
+	address := startFakeIngester(t, ingester)
+
+	newPool := func(poolId uint64) sqsdomain.PoolI {
+		pool, err := balancer.NewBalancerPool(poolId, balancer.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}, []balancer.PoolAsset{
+			{Token: sdk.NewCoin("uosmo", osmomath.NewInt(100)), Weight: osmomath.NewInt(1)},
+			{Token: sdk.NewCoin("uusdc", osmomath.NewInt(100)), Weight: osmomath.NewInt(1)},
+		}, "", time.Time{})
+		require.NoError(t, err)
+		return sqsdomain.NewPool(&pool, osmomath.ZeroDec(), sdk.NewCoins())
+	}
+
+	poolTakerFee := osmomath.NewDecWithPrec(1, 3)
+	pools := []sqsdomain.PoolI{
+		newPool(1),
+		&domain.PoolWithTakerFee{PoolI: newPool(2), TakerFee: poolTakerFee},
+	}
+
+	client := service.NewGRPCCLient(address, 1024*1024, app.GetEncodingConfig().Marshaler)
+	require.NoError(t, client.PushData(context.Background(), 1, pools, sqsdomain.TakerFeeMap{}))
+	require.Len(t, ingester.pools, 2)
+
+	var sqsPoolModel domain.SQSPoolModelWithTakerFee
+	require.NoError(t, json.Unmarshal(ingester.pools[0].SqsModel, &sqsPoolModel))
+	require.True(t, sqsPoolModel.TakerFee.IsNil())
+	require.NotContains(t, string(ingester.pools[0].SqsModel), "taker_fee")
+
+	require.NoError(t, json.Unmarshal(ingester.pools[1].SqsModel, &sqsPoolModel))
+	require.Equal(t, poolTakerFee, sqsPoolModel.TakerFee)
+}
+
+// Validates that the client connects over mTLS and sends the bearer token.
+func TestGRPCClient_TLSAndAuthToken(t *testing.T) {
+	dir := t.TempDir()
+
+	caFile, caCert, caKey := writeCertificate(t, dir, "ca", nil, nil)
+	serverCertFile, _, _ := writeCertificate(t, dir, "server", caCert, caKey)
+	clientCertFile, _, _ := writeCertificate(t, dir, "client", caCert, caKey)
+
+	serverCert, err := tls.LoadX509KeyPair(serverCertFile, filepath.Join(dir, "server.key"))
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+	serverCredentials := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+
+	ingester := &fakeIngester{}
+	address := startFakeIngester(t, ingester, grpc.Creds(serverCredentials))
+
+	transportCredentials, err := service.NewTLSCredentials(caFile, clientCertFile, filepath.Join(dir, "client.key"), "localhost")
+	require.NoError(t, err)
+
+	client := service.NewGRPCCLient(address, 1024*1024, nil,
+		service.WithTransportCredentials(transportCredentials),
+		service.WithPerRPCCredentials(service.NewBearerTokenCredentials("secret")),
+	)
+	require.NoError(t, client.PushData(context.Background(), 1, []sqsdomain.PoolI{}, sqsdomain.TakerFeeMap{}))
+	require.Equal(t, []string{"Bearer secret"}, ingester.authorization)
+
+	// Without the client certificate, the server rejects the connection.
+	transportCredentials, err = service.NewTLSCredentials(caFile, "", "", "localhost")
+	require.NoError(t, err)
+	client = service.NewGRPCCLient(address, 1024*1024, nil, service.WithTransportCredentials(transportCredentials))
+	require.Error(t, client.PushData(context.Background(), 1, []sqsdomain.PoolI{}, sqsdomain.TakerFeeMap{}))
+
+	// The client certificate and key must be set together.
+	_, err = service.NewTLSCredentials(caFile, clientCertFile, "", "localhost")
+	require.Error(t, err)
+}
+
+// writeCertificate writes a certificate for localhost and its key to dir/name.crt and dir/name.key.
+// The certificate is self-signed and can sign other certificates if parent is nil.
+func writeCertificate(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (string, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, cert, key
+}
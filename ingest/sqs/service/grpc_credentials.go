@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// NewTLSCredentials returns the transport credentials to connect to the sidecar query server over TLS.
+// If caFile is empty, the server certificate is verified with the system root CAs.
+// If certFile and keyFile are set, the client authenticates with this certificate (mTLS).
+// If serverName is set, it overrides the name used to verify the server certificate.
+func NewTLSCredentials(caFile, certFile, keyFile, serverName string) (credentials.TransportCredentials, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificate found in CA file %s", caFile)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("both the client certificate and key files must be set for mTLS")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// bearerTokenCredentials sends a bearer token in the authorization metadata of each call.
+type bearerTokenCredentials struct {
+	token string
+}
+
+var _ credentials.PerRPCCredentials = bearerTokenCredentials{}
+
+// NewBearerTokenCredentials returns the per-call credentials sending the given bearer token.
+// The token is only sent over TLS connections.
+func NewBearerTokenCredentials(token string) credentials.PerRPCCredentials {
+	return bearerTokenCredentials{token: token}
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (b bearerTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + b.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (b bearerTokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -1,9 +1,17 @@
 package sqs
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	commondomain "github.com/osmosis-labs/osmosis/v25/ingest/common/domain"
+	sqsservice "github.com/osmosis-labs/osmosis/v25/ingest/sqs/service"
 )
 
 // Config defines the config for the sidecar query server.
@@ -14,6 +22,23 @@ type Config struct {
 	GRPCIngestAddress string `mapstructure:"grpc-ingest-address"`
 	// GRPCIngestMaxCallSizeBytes defines the maximum size of a gRPC ingest call in bytes.
 	GRPCIngestMaxCallSizeBytes int `mapstructure:"grpc-ingest-max-call-size-bytes"`
+
+	// GRPCTLSEnabled defines if the connection to the sidecar query server uses TLS.
+	GRPCTLSEnabled bool `mapstructure:"grpc-tls-enabled"`
+	// GRPCTLSCAFile is the CA certificate file used to verify the server certificate.
+	// If empty, the system root CAs are used.
+	GRPCTLSCAFile string `mapstructure:"grpc-tls-ca-file"`
+	// GRPCTLSCertFile and GRPCTLSKeyFile are the client certificate and key files used for mTLS.
+	GRPCTLSCertFile string `mapstructure:"grpc-tls-cert-file"`
+	GRPCTLSKeyFile  string `mapstructure:"grpc-tls-key-file"`
+	// GRPCTLSServerName overrides the server name used to verify the server certificate.
+	GRPCTLSServerName string `mapstructure:"grpc-tls-server-name"`
+	// GRPCAuthToken is the bearer token sent with each call. Requires TLS.
+	GRPCAuthToken string `mapstructure:"grpc-auth-token"`
+	// GRPCMaxRetries defines how many times pushing the data of a block is retried on transient errors.
+	GRPCMaxRetries int `mapstructure:"grpc-max-retries"`
+	// GRPCRetryInitialBackoffMs defines the wait before the first retry in milliseconds, doubled on each retry.
+	GRPCRetryInitialBackoffMs int `mapstructure:"grpc-retry-initial-backoff-ms"`
 }
 
 const (
@@ -34,6 +59,11 @@ var DefaultConfig = Config{
 	// During normal operation, we should not approach even 1 MB since we are to stream only
 	// modified pools.
 	GRPCIngestMaxCallSizeBytes: 50 * 1024 * 1024,
+	GRPCTLSEnabled:             false,
+	// Retries happen while the block is committed, so they are kept short.
+	// Data that still fails to be pushed is pushed in full in the next block.
+	GRPCMaxRetries:            2,
+	GRPCRetryInitialBackoffMs: 50,
 }
 
 // NewConfigFromOptions returns a new sidecar query server config from the given options.
@@ -50,9 +80,44 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 
 	grpcIngestMaxCallSizeBytes := osmoutils.ParseInt(opts, groupOptName, "grpc-ingest-max-call-size-bytes")
 
+	// The following options were added after the ones above, so they are optional
+	// to keep the configs generated by previous versions valid.
 	return Config{
 		IsEnabled:                  isEnabled,
 		GRPCIngestAddress:          grpcIngestAddress,
 		GRPCIngestMaxCallSizeBytes: grpcIngestMaxCallSizeBytes,
+		GRPCTLSEnabled:             osmoutils.ParseBool(opts, groupOptName, "grpc-tls-enabled", DefaultConfig.GRPCTLSEnabled),
+		GRPCTLSCAFile:              commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-tls-ca-file", ""),
+		GRPCTLSCertFile:            commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-tls-cert-file", ""),
+		GRPCTLSKeyFile:             commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-tls-key-file", ""),
+		GRPCTLSServerName:          commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-tls-server-name", ""),
+		GRPCAuthToken:              commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-auth-token", ""),
+		GRPCMaxRetries:             cast.ToInt(commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-max-retries", fmt.Sprint(DefaultConfig.GRPCMaxRetries))),
+		GRPCRetryInitialBackoffMs:  cast.ToInt(commondomain.ParseStringWithDefault(opts, groupOptName, "grpc-retry-initial-backoff-ms", fmt.Sprint(DefaultConfig.GRPCRetryInitialBackoffMs))),
 	}
 }
+
+// GRPCClientOptions returns the options of the gRPC client pushing the data to the sidecar query server.
+// It returns an error if the TLS files can't be loaded or if an auth token is set without TLS.
+func (c Config) GRPCClientOptions() ([]sqsservice.GRPCClientOption, error) {
+	opts := []sqsservice.GRPCClientOption{
+		sqsservice.WithRetry(c.GRPCMaxRetries, time.Duration(c.GRPCRetryInitialBackoffMs)*time.Millisecond),
+	}
+
+	if c.GRPCTLSEnabled {
+		transportCredentials, err := sqsservice.NewTLSCredentials(c.GRPCTLSCAFile, c.GRPCTLSCertFile, c.GRPCTLSKeyFile, c.GRPCTLSServerName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sqsservice.WithTransportCredentials(transportCredentials))
+	}
+
+	if c.GRPCAuthToken != "" {
+		if !c.GRPCTLSEnabled {
+			return nil, errors.New("the sqs grpc auth token requires grpc-tls-enabled")
+		}
+		opts = append(opts, sqsservice.WithPerRPCCredentials(sqsservice.NewBearerTokenCredentials(c.GRPCAuthToken)))
+	}
+
+	return opts, nil
+}
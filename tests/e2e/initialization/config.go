@@ -497,6 +497,9 @@ func updateTWAPGenesis(appGenState map[string]json.RawMessage) func(twapGenState
 					P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 					P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 					GeometricTwapAccumulator:    osmomath.ZeroDec(),
+					Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+					Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+					LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 					LastErrorTime:               time.Time{}, // no previous error
 				}
 				twapGenState.Twaps = append(twapGenState.Twaps, twapRecord)
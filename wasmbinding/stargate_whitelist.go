@@ -157,6 +157,7 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Params", &poolmanagerqueryproto.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", &poolmanagerqueryproto.TradingPairTakerFeeResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", &poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/FindBestRoute", &poolmanagerqueryproto.FindBestRouteResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -174,6 +175,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Volatility", &twapquerytypes.VolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/SpotPriceRange", &twapquerytypes.SpotPriceRangeResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapOverRoute", &twapquerytypes.ArithmeticTwapOverRouteResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapOverRoute", &twapquerytypes.GeometricTwapOverRouteResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwap", &twapquerytypes.VolumeWeightedTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwapToNow", &twapquerytypes.VolumeWeightedTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -8,6 +8,7 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagLimitOrder                 = "limit-order"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +16,9 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetLimitOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagLimitOrder, "false", "Whether the position is a limit order, withdrawn once a swap fully crosses it")
+	return fs
+}
@@ -15,6 +15,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserPositions)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionById)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserFilledLimitOrders)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableSpreadRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableIncentives)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecords)
@@ -42,6 +44,28 @@ func GetUserPositions() (*osmocli.QueryDescriptor, *queryproto.UserPositionsRequ
 		&queryproto.UserPositionsRequest{}
 }
 
+func GetUserLimitOrders() (*osmocli.QueryDescriptor, *queryproto.UserLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "user-limit-orders",
+			Short: "Query user's open limit orders",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-limit-orders osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+			CustomFlagOverrides: poolIdFlagOverride,
+		},
+		&queryproto.UserLimitOrdersRequest{}
+}
+
+func GetUserFilledLimitOrders() (*osmocli.QueryDescriptor, *queryproto.UserFilledLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "user-filled-limit-orders",
+			Short: "Query user's filled limit orders",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-filled-limit-orders osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+		},
+		&queryproto.UserFilledLimitOrdersRequest{}
+}
+
 func GetPositionById() (*osmocli.QueryDescriptor, *queryproto.PositionByIdRequest) {
 	return &osmocli.QueryDescriptor{
 			Use:   "position-by-id",
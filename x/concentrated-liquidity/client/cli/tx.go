@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -52,6 +53,10 @@ func NewCreatePositionCmd() (*osmocli.TxCliDesc, *types.MsgCreatePosition) {
 		Use:     "create-position",
 		Short:   "create or add to existing concentrated liquidity position",
 		Example: "osmosisd tx concentratedliquidity create-position 1 \"[-69082]\" 69082 10000uosmo,10000uion 0 0 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		CustomFlagOverrides: map[string]string{
+			"limitorder": FlagLimitOrder,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetLimitOrder()}},
 	}, &types.MsgCreatePosition{}
 }
 
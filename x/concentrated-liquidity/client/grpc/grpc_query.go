@@ -41,6 +41,26 @@ func (q Querier) UserPositions(grpcCtx context.Context,
 	return q.Q.UserPositions(ctx, *req)
 }
 
+func (q Querier) UserLimitOrders(grpcCtx context.Context,
+	req *queryproto.UserLimitOrdersRequest,
+) (*queryproto.UserLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserLimitOrders(ctx, *req)
+}
+
+func (q Querier) UserFilledLimitOrders(grpcCtx context.Context,
+	req *queryproto.UserFilledLimitOrdersRequest,
+) (*queryproto.UserFilledLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserFilledLimitOrders(ctx, *req)
+}
+
 func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	req *queryproto.TickAccumulatorTrackersRequest,
 ) (*queryproto.TickAccumulatorTrackersResponse, error) {
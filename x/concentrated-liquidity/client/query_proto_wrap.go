@@ -45,6 +45,41 @@ func (q Querier) UserPositions(ctx sdk.Context, req clquery.UserPositionsRequest
 	}, nil
 }
 
+// UserLimitOrders returns the open limit orders of the specified user, with the option to filter by a specific pool.
+func (q Querier) UserLimitOrders(ctx sdk.Context, req clquery.UserLimitOrdersRequest) (*clquery.UserLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	limitOrders, err := q.Keeper.GetUserLimitOrders(ctx, sdkAddr, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserLimitOrdersResponse{
+		LimitOrders: limitOrders,
+	}, nil
+}
+
+// UserFilledLimitOrders returns the limit orders of the specified user that have been filled and withdrawn.
+func (q Querier) UserFilledLimitOrders(ctx sdk.Context, req clquery.UserFilledLimitOrdersRequest) (*clquery.UserFilledLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	filledLimitOrders, pageRes, err := q.Keeper.GetUserFilledLimitOrdersSerialized(ctx, sdkAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserFilledLimitOrdersResponse{
+		FilledLimitOrders: filledLimitOrders,
+		Pagination:        pageRes,
+	}, nil
+}
+
 // PositionById returns a position with the specified id. The position is broken down by:
 // - the position itself
 // - the underlying assets
@@ -151,6 +151,208 @@ func (m *UserPositionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// =============================== UserLimitOrders
+type UserLimitOrdersRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId  uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *UserLimitOrdersRequest) Reset()         { *m = UserLimitOrdersRequest{} }
+func (m *UserLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersRequest) ProtoMessage()    {}
+func (*UserLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{2}
+}
+func (m *UserLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersRequest.Merge(m, src)
+}
+func (m *UserLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UserLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type UserLimitOrdersResponse struct {
+	LimitOrders []model.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+}
+
+func (m *UserLimitOrdersResponse) Reset()         { *m = UserLimitOrdersResponse{} }
+func (m *UserLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersResponse) ProtoMessage()    {}
+func (*UserLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{3}
+}
+func (m *UserLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserLimitOrdersResponse) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+// =============================== UserFilledLimitOrders
+type UserFilledLimitOrdersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserFilledLimitOrdersRequest) Reset()         { *m = UserFilledLimitOrdersRequest{} }
+func (m *UserFilledLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserFilledLimitOrdersRequest) ProtoMessage()    {}
+func (*UserFilledLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{4}
+}
+func (m *UserFilledLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserFilledLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserFilledLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserFilledLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFilledLimitOrdersRequest.Merge(m, src)
+}
+func (m *UserFilledLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserFilledLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFilledLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserFilledLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserFilledLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UserFilledLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type UserFilledLimitOrdersResponse struct {
+	FilledLimitOrders []model.FilledLimitOrder `protobuf:"bytes,1,rep,name=filled_limit_orders,json=filledLimitOrders,proto3" json:"filled_limit_orders"`
+	Pagination        *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserFilledLimitOrdersResponse) Reset()         { *m = UserFilledLimitOrdersResponse{} }
+func (m *UserFilledLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserFilledLimitOrdersResponse) ProtoMessage()    {}
+func (*UserFilledLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{5}
+}
+func (m *UserFilledLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserFilledLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserFilledLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserFilledLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFilledLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserFilledLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserFilledLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFilledLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserFilledLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserFilledLimitOrdersResponse) GetFilledLimitOrders() []model.FilledLimitOrder {
+	if m != nil {
+		return m.FilledLimitOrders
+	}
+	return nil
+}
+
+func (m *UserFilledLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // =============================== PositionById
 type PositionByIdRequest struct {
 	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
@@ -160,7 +362,7 @@ func (m *PositionByIdRequest) Reset()         { *m = PositionByIdRequest{} }
 func (m *PositionByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*PositionByIdRequest) ProtoMessage()    {}
 func (*PositionByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{2}
+	return fileDescriptor_5da291368ba4d8e3, []int{6}
 }
 func (m *PositionByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +406,7 @@ func (m *PositionByIdResponse) Reset()         { *m = PositionByIdResponse{} }
 func (m *PositionByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*PositionByIdResponse) ProtoMessage()    {}
 func (*PositionByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{3}
+	return fileDescriptor_5da291368ba4d8e3, []int{7}
 }
 func (m *PositionByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +450,7 @@ func (m *NumPoolPositionsRequest) Reset()         { *m = NumPoolPositionsRequest
 func (m *NumPoolPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*NumPoolPositionsRequest) ProtoMessage()    {}
 func (*NumPoolPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{4}
+	return fileDescriptor_5da291368ba4d8e3, []int{8}
 }
 func (m *NumPoolPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +494,7 @@ func (m *NumPoolPositionsResponse) Reset()         { *m = NumPoolPositionsRespon
 func (m *NumPoolPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*NumPoolPositionsResponse) ProtoMessage()    {}
 func (*NumPoolPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{5}
+	return fileDescriptor_5da291368ba4d8e3, []int{9}
 }
 func (m *NumPoolPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +540,7 @@ func (m *PoolsRequest) Reset()         { *m = PoolsRequest{} }
 func (m *PoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*PoolsRequest) ProtoMessage()    {}
 func (*PoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{6}
+	return fileDescriptor_5da291368ba4d8e3, []int{10}
 }
 func (m *PoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +586,7 @@ func (m *PoolsResponse) Reset()         { *m = PoolsResponse{} }
 func (m *PoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolsResponse) ProtoMessage()    {}
 func (*PoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{7}
+	return fileDescriptor_5da291368ba4d8e3, []int{11}
 }
 func (m *PoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +637,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{8}
+	return fileDescriptor_5da291368ba4d8e3, []int{12}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +674,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{9}
+	return fileDescriptor_5da291368ba4d8e3, []int{13}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +719,7 @@ func (m *TickLiquidityNet) Reset()         { *m = TickLiquidityNet{} }
 func (m *TickLiquidityNet) String() string { return proto.CompactTextString(m) }
 func (*TickLiquidityNet) ProtoMessage()    {}
 func (*TickLiquidityNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{10}
+	return fileDescriptor_5da291368ba4d8e3, []int{14}
 }
 func (m *TickLiquidityNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +765,7 @@ func (m *LiquidityDepthWithRange) Reset()         { *m = LiquidityDepthWithRange
 func (m *LiquidityDepthWithRange) String() string { return proto.CompactTextString(m) }
 func (*LiquidityDepthWithRange) ProtoMessage()    {}
 func (*LiquidityDepthWithRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{11}
+	return fileDescriptor_5da291368ba4d8e3, []int{15}
 }
 func (m *LiquidityDepthWithRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +822,7 @@ func (m *LiquidityNetInDirectionRequest) Reset()         { *m = LiquidityNetInDi
 func (m *LiquidityNetInDirectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LiquidityNetInDirectionRequest) ProtoMessage()    {}
 func (*LiquidityNetInDirectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{12}
+	return fileDescriptor_5da291368ba4d8e3, []int{16}
 }
 func (m *LiquidityNetInDirectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +904,7 @@ func (m *LiquidityNetInDirectionResponse) Reset()         { *m = LiquidityNetInD
 func (m *LiquidityNetInDirectionResponse) String() string { return proto.CompactTextString(m) }
 func (*LiquidityNetInDirectionResponse) ProtoMessage()    {}
 func (*LiquidityNetInDirectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{13}
+	return fileDescriptor_5da291368ba4d8e3, []int{17}
 }
 func (m *LiquidityNetInDirectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +956,7 @@ func (m *LiquidityPerTickRangeRequest) Reset()         { *m = LiquidityPerTickRa
 func (m *LiquidityPerTickRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*LiquidityPerTickRangeRequest) ProtoMessage()    {}
 func (*LiquidityPerTickRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{14}
+	return fileDescriptor_5da291368ba4d8e3, []int{18}
 }
 func (m *LiquidityPerTickRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1001,7 @@ func (m *LiquidityPerTickRangeResponse) Reset()         { *m = LiquidityPerTickR
 func (m *LiquidityPerTickRangeResponse) String() string { return proto.CompactTextString(m) }
 func (*LiquidityPerTickRangeResponse) ProtoMessage()    {}
 func (*LiquidityPerTickRangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{15}
+	return fileDescriptor_5da291368ba4d8e3, []int{19}
 }
 func (m *LiquidityPerTickRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1053,7 @@ func (m *ClaimableSpreadRewardsRequest) Reset()         { *m = ClaimableSpreadRe
 func (m *ClaimableSpreadRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClaimableSpreadRewardsRequest) ProtoMessage()    {}
 func (*ClaimableSpreadRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{16}
+	return fileDescriptor_5da291368ba4d8e3, []int{20}
 }
 func (m *ClaimableSpreadRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,7 +1097,7 @@ func (m *ClaimableSpreadRewardsResponse) Reset()         { *m = ClaimableSpreadR
 func (m *ClaimableSpreadRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClaimableSpreadRewardsResponse) ProtoMessage()    {}
 func (*ClaimableSpreadRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{17}
+	return fileDescriptor_5da291368ba4d8e3, []int{21}
 }
 func (m *ClaimableSpreadRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1142,7 @@ func (m *ClaimableIncentivesRequest) Reset()         { *m = ClaimableIncentivesR
 func (m *ClaimableIncentivesRequest) String() string { return proto.CompactTextString(m) }
 func (*ClaimableIncentivesRequest) ProtoMessage()    {}
 func (*ClaimableIncentivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{18}
+	return fileDescriptor_5da291368ba4d8e3, []int{22}
 }
 func (m *ClaimableIncentivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1187,7 @@ func (m *ClaimableIncentivesResponse) Reset()         { *m = ClaimableIncentives
 func (m *ClaimableIncentivesResponse) String() string { return proto.CompactTextString(m) }
 func (*ClaimableIncentivesResponse) ProtoMessage()    {}
 func (*ClaimableIncentivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{19}
+	return fileDescriptor_5da291368ba4d8e3, []int{23}
 }
 func (m *ClaimableIncentivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1239,7 @@ func (m *PoolAccumulatorRewardsRequest) Reset()         { *m = PoolAccumulatorRe
 func (m *PoolAccumulatorRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*PoolAccumulatorRewardsRequest) ProtoMessage()    {}
 func (*PoolAccumulatorRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{20}
+	return fileDescriptor_5da291368ba4d8e3, []int{24}
 }
 func (m *PoolAccumulatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1284,7 @@ func (m *PoolAccumulatorRewardsResponse) Reset()         { *m = PoolAccumulatorR
 func (m *PoolAccumulatorRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolAccumulatorRewardsResponse) ProtoMessage()    {}
 func (*PoolAccumulatorRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{21}
+	return fileDescriptor_5da291368ba4d8e3, []int{25}
 }
 func (m *PoolAccumulatorRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,7 +1337,7 @@ func (m *TickAccumulatorTrackersRequest) Reset()         { *m = TickAccumulatorT
 func (m *TickAccumulatorTrackersRequest) String() string { return proto.CompactTextString(m) }
 func (*TickAccumulatorTrackersRequest) ProtoMessage()    {}
 func (*TickAccumulatorTrackersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{22}
+	return fileDescriptor_5da291368ba4d8e3, []int{26}
 }
 func (m *TickAccumulatorTrackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1389,7 @@ func (m *TickAccumulatorTrackersResponse) Reset()         { *m = TickAccumulator
 func (m *TickAccumulatorTrackersResponse) String() string { return proto.CompactTextString(m) }
 func (*TickAccumulatorTrackersResponse) ProtoMessage()    {}
 func (*TickAccumulatorTrackersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{23}
+	return fileDescriptor_5da291368ba4d8e3, []int{27}
 }
 func (m *TickAccumulatorTrackersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,7 +1442,7 @@ func (m *IncentiveRecordsRequest) Reset()         { *m = IncentiveRecordsRequest
 func (m *IncentiveRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*IncentiveRecordsRequest) ProtoMessage()    {}
 func (*IncentiveRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{24}
+	return fileDescriptor_5da291368ba4d8e3, []int{28}
 }
 func (m *IncentiveRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1495,7 @@ func (m *IncentiveRecordsResponse) Reset()         { *m = IncentiveRecordsRespon
 func (m *IncentiveRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*IncentiveRecordsResponse) ProtoMessage()    {}
 func (*IncentiveRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{25}
+	return fileDescriptor_5da291368ba4d8e3, []int{29}
 }
 func (m *IncentiveRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,7 +1551,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) String() string {
 }
 func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) ProtoMessage() {}
 func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{26}
+	return fileDescriptor_5da291368ba4d8e3, []int{30}
 }
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1599,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) String() string {
 }
 func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) ProtoMessage() {}
 func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{27}
+	return fileDescriptor_5da291368ba4d8e3, []int{31}
 }
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1644,7 @@ func (m *UserUnbondingPositionsRequest) Reset()         { *m = UserUnbondingPosi
 func (m *UserUnbondingPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsRequest) ProtoMessage()    {}
 func (*UserUnbondingPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{28}
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
 }
 func (m *UserUnbondingPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,7 +1688,7 @@ func (m *UserUnbondingPositionsResponse) Reset()         { *m = UserUnbondingPos
 func (m *UserUnbondingPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsResponse) ProtoMessage()    {}
 func (*UserUnbondingPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{29}
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
 }
 func (m *UserUnbondingPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1732,7 @@ func (m *GetTotalLiquidityRequest) Reset()         { *m = GetTotalLiquidityReque
 func (m *GetTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityRequest) ProtoMessage()    {}
 func (*GetTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{30}
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
 }
 func (m *GetTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1769,7 @@ func (m *GetTotalLiquidityResponse) Reset()         { *m = GetTotalLiquidityResp
 func (m *GetTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityResponse) ProtoMessage()    {}
 func (*GetTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{31}
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
 }
 func (m *GetTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1816,7 @@ func (m *NumNextInitializedTicksRequest) Reset()         { *m = NumNextInitializ
 func (m *NumNextInitializedTicksRequest) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksRequest) ProtoMessage()    {}
 func (*NumNextInitializedTicksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{32}
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
 }
 func (m *NumNextInitializedTicksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +1876,7 @@ func (m *NumNextInitializedTicksResponse) Reset()         { *m = NumNextInitiali
 func (m *NumNextInitializedTicksResponse) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksResponse) ProtoMessage()    {}
 func (*NumNextInitializedTicksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{33}
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
 }
 func (m *NumNextInitializedTicksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1720,6 +1922,10 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*UserLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersRequest")
+	proto.RegisterType((*UserLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersResponse")
+	proto.RegisterType((*UserFilledLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserFilledLimitOrdersRequest")
+	proto.RegisterType((*UserFilledLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserFilledLimitOrdersResponse")
 	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
 	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
 	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
@@ -1759,155 +1965,164 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
+	// 2505 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x71, 0x9c, 0xa4, 0xec, 0xd8, 0xce, 0x24, 0x99, 0xc9, 0x16,
-	0x84, 0xb5, 0x48, 0x32, 0x43, 0x7e, 0x4c, 0xc8, 0xdf, 0x26, 0x1e, 0x7b, 0x1d, 0x8d, 0xd6, 0xf1,
-	0x3a, 0x9d, 0x18, 0x10, 0x42, 0xf4, 0xf6, 0x74, 0x97, 0xc7, 0xad, 0xe9, 0xe9, 0x1a, 0x77, 0x57,
-	0x27, 0x31, 0x4b, 0xa4, 0xd5, 0xee, 0x11, 0x09, 0x16, 0x71, 0x45, 0x48, 0x88, 0x0b, 0x5a, 0x71,
-	0xe4, 0x02, 0x17, 0x04, 0x07, 0x14, 0x71, 0x58, 0xad, 0x84, 0x90, 0xd0, 0x1e, 0x66, 0x21, 0xe1,
-	0x80, 0xb4, 0x80, 0x84, 0xb9, 0x70, 0x44, 0x5d, 0x5d, 0xfd, 0x33, 0x33, 0x3d, 0x76, 0xcf, 0x38,
-	0x9c, 0x38, 0x79, 0xaa, 0x5f, 0xbd, 0xf7, 0xbe, 0xf7, 0x53, 0xaf, 0xeb, 0xbd, 0x36, 0x5c, 0x64,
-	0x6e, 0x93, 0xb9, 0xa6, 0x5b, 0xd6, 0x99, 0xad, 0x53, 0x9b, 0x3b, 0x1a, 0xa7, 0x86, 0x65, 0x6e,
-	0x78, 0xa6, 0x61, 0xf2, 0xcd, 0xf2, 0xa3, 0x8b, 0x35, 0xca, 0xb5, 0x8b, 0xe5, 0x0d, 0x8f, 0x3a,
-	0x9b, 0xa5, 0x96, 0xc3, 0x38, 0xc3, 0x67, 0x25, 0x4b, 0x29, 0x95, 0xa5, 0x24, 0x59, 0xf2, 0x13,
-	0x75, 0x56, 0x67, 0x82, 0xa3, 0xec, 0xff, 0x0a, 0x98, 0xf3, 0x5f, 0xdc, 0x5e, 0x5f, 0x4b, 0x73,
-	0xb4, 0xa6, 0x2b, 0xf7, 0xce, 0x66, 0xc3, 0xc6, 0x4d, 0xbd, 0xa1, 0x9a, 0xf6, 0x5a, 0xa8, 0xa2,
-	0xa0, 0x0b, 0xbe, 0x72, 0x4d, 0x73, 0x69, 0xb4, 0x49, 0x67, 0xa6, 0x1d, 0x42, 0x48, 0xd2, 0x85,
-	0x61, 0xd1, 0xae, 0x96, 0x56, 0x37, 0x6d, 0x8d, 0x9b, 0x2c, 0xdc, 0x7b, 0xaa, 0xce, 0x58, 0xdd,
-	0xa2, 0x65, 0xad, 0x65, 0x96, 0x35, 0xdb, 0x66, 0x5c, 0x10, 0x43, 0x80, 0x27, 0x24, 0x55, 0xac,
-	0x6a, 0xde, 0x5a, 0x59, 0xb3, 0x37, 0x43, 0x52, 0xa0, 0x44, 0x0d, 0x1c, 0x10, 0x2c, 0x24, 0xe9,
-	0x4a, 0x36, 0xb3, 0x5a, 0xcc, 0x35, 0x13, 0x48, 0x6e, 0x66, 0xe3, 0x32, 0x05, 0xd1, 0x7c, 0x44,
-	0x55, 0x87, 0xea, 0xcc, 0x31, 0x02, 0x6e, 0xf2, 0x4b, 0x04, 0x13, 0xab, 0x2e, 0x75, 0x56, 0xa4,
-	0x50, 0x57, 0xa1, 0x1b, 0x1e, 0x75, 0x39, 0x3e, 0x0f, 0xaf, 0x68, 0x86, 0xe1, 0x50, 0xd7, 0x9d,
-	0x46, 0x67, 0xd0, 0x4c, 0xae, 0x82, 0xb7, 0xda, 0xc5, 0xb1, 0x4d, 0xad, 0x69, 0x5d, 0x27, 0x92,
-	0x40, 0x94, 0x70, 0x0b, 0x3e, 0x07, 0xaf, 0xb4, 0x18, 0xb3, 0x54, 0xd3, 0x98, 0x1e, 0x39, 0x83,
-	0x66, 0xf6, 0x25, 0x77, 0x4b, 0x02, 0x51, 0x0e, 0xf8, 0xbf, 0xaa, 0x06, 0x5e, 0x04, 0x88, 0xfd,
-	0x39, 0xbd, 0xf7, 0x0c, 0x9a, 0x39, 0x74, 0xe9, 0x0b, 0x25, 0xe9, 0x0a, 0xdf, 0xf9, 0xa5, 0x20,
-	0xab, 0x24, 0xf4, 0xd2, 0x8a, 0x56, 0xa7, 0x12, 0x96, 0x92, 0xe0, 0x24, 0xbf, 0x45, 0x70, 0xbc,
-	0x0b, 0xbb, 0xdb, 0x62, 0xb6, 0x4b, 0xf1, 0xdb, 0x90, 0x0b, 0xbd, 0xe4, 0xc3, 0xdf, 0x3b, 0x73,
-	0xe8, 0xd2, 0xcd, 0x52, 0xa6, 0xec, 0x2c, 0x2d, 0x7a, 0x96, 0x15, 0x0a, 0xac, 0x38, 0x54, 0x6b,
-	0x18, 0xec, 0xb1, 0x5d, 0xd9, 0xf7, 0xac, 0x5d, 0xdc, 0xa3, 0xc4, 0x42, 0xf1, 0xdd, 0x0e, 0x1b,
-	0x46, 0x84, 0x0d, 0xaf, 0xed, 0x68, 0x43, 0x00, 0xaf, 0xc3, 0x88, 0x65, 0x18, 0x8f, 0xd4, 0x6d,
-	0x56, 0x8d, 0xd0, 0xfd, 0x57, 0xe1, 0x50, 0xa8, 0xcc, 0x77, 0x2a, 0x12, 0x4e, 0x9d, 0xdc, 0x6a,
-	0x17, 0x71, 0xe8, 0xd4, 0x88, 0x48, 0x14, 0x08, 0x57, 0x55, 0x83, 0x3c, 0x82, 0x89, 0x4e, 0x79,
-	0xd2, 0x25, 0xdf, 0x82, 0x83, 0xe1, 0x2e, 0x21, 0xed, 0xe5, 0x78, 0x24, 0x92, 0x49, 0x16, 0x61,
-	0x6a, 0xd9, 0x6b, 0xae, 0x30, 0x66, 0xf5, 0xa4, 0x52, 0x22, 0x39, 0xd0, 0x4e, 0xc9, 0x41, 0xbe,
-	0x09, 0xd3, 0xbd, 0x72, 0xa4, 0x0d, 0x77, 0x60, 0x2c, 0xb2, 0x5b, 0x67, 0x9e, 0xcd, 0xa5, 0xbc,
-	0x13, 0x5b, 0xed, 0xe2, 0xf1, 0x2e, 0xbf, 0x08, 0x3a, 0x51, 0x0e, 0x87, 0x0f, 0xe6, 0xc5, 0xfa,
-	0xab, 0x30, 0xea, 0x8b, 0x8e, 0xa0, 0x2d, 0xa6, 0x84, 0x71, 0x98, 0x54, 0xfc, 0x3e, 0x82, 0xc3,
-	0x52, 0xb0, 0xc4, 0x3a, 0x0b, 0xfb, 0x7d, 0x8b, 0xc2, 0xf4, 0x9b, 0x28, 0x05, 0x25, 0xa1, 0x14,
-	0x96, 0x84, 0xd2, 0x9c, 0xbd, 0x59, 0xc9, 0xfd, 0xfe, 0x17, 0x17, 0xf6, 0xfb, 0x7c, 0x55, 0x25,
-	0xd8, 0xfd, 0xf2, 0xf2, 0xea, 0x08, 0x1c, 0x5e, 0x11, 0x35, 0x53, 0xc2, 0x25, 0xab, 0x30, 0x16,
-	0x3e, 0x90, 0x10, 0xe7, 0xe1, 0x40, 0x50, 0x56, 0x65, 0x42, 0x9c, 0xdd, 0x21, 0x21, 0x02, 0x76,
-	0x19, 0x79, 0xc9, 0x4a, 0x3e, 0x44, 0x70, 0xf4, 0xa1, 0xa9, 0x37, 0x96, 0xc2, 0x6d, 0xcb, 0x94,
-	0xe3, 0xb7, 0xe1, 0x70, 0xc4, 0xa6, 0xda, 0x94, 0xcb, 0x12, 0x72, 0xc3, 0xe7, 0xfc, 0xa4, 0x5d,
-	0x3c, 0x19, 0xd8, 0xe3, 0x1a, 0x8d, 0x92, 0xc9, 0xca, 0x4d, 0x8d, 0xaf, 0x97, 0x96, 0x68, 0x5d,
-	0xd3, 0x37, 0x17, 0xa8, 0xbe, 0xd5, 0x2e, 0x4e, 0x04, 0xa1, 0xec, 0x90, 0x40, 0x94, 0x51, 0x2b,
-	0xa9, 0xe1, 0x0a, 0x80, 0x2c, 0xef, 0x06, 0x7d, 0x22, 0xfc, 0xb4, 0xb7, 0x72, 0x7c, 0xab, 0x5d,
-	0x3c, 0x16, 0xf0, 0xc6, 0x34, 0xa2, 0xe4, 0xfc, 0x45, 0x55, 0xfc, 0xfe, 0x07, 0x82, 0xa9, 0x08,
-	0xe8, 0x02, 0x6d, 0xf1, 0xf5, 0xaf, 0x99, 0x7c, 0x5d, 0xd1, 0xec, 0x3a, 0xc5, 0x6b, 0x70, 0x34,
-	0xd6, 0xa8, 0x35, 0xa3, 0xf4, 0xda, 0x25, 0xec, 0x23, 0xd1, 0x7a, 0x4e, 0xc8, 0xf4, 0x91, 0x5b,
-	0xec, 0x31, 0x75, 0x54, 0x1f, 0x56, 0x2f, 0xf2, 0x98, 0x46, 0x94, 0x9c, 0x58, 0xf8, 0xde, 0xf5,
-	0xb9, 0xbc, 0x56, 0x2b, 0xe4, 0xda, 0xdb, 0xcd, 0x15, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0x9f, 0x8b,
-	0x7c, 0x3a, 0x02, 0x85, 0x64, 0x60, 0xaa, 0xf6, 0x82, 0xe9, 0x50, 0xdd, 0x4f, 0x90, 0x61, 0x0e,
-	0x27, 0x2e, 0xc1, 0x41, 0xce, 0x1a, 0xd4, 0x56, 0xcd, 0x20, 0x37, 0x73, 0x95, 0xf1, 0xad, 0x76,
-	0xf1, 0x88, 0xf4, 0xb9, 0xa4, 0x10, 0xe5, 0x15, 0xf1, 0xb3, 0x6a, 0xfb, 0xa8, 0x5d, 0xae, 0x39,
-	0xbc, 0x0f, 0xea, 0x98, 0x46, 0x94, 0x9c, 0x58, 0x08, 0x5b, 0xaf, 0xc1, 0xa8, 0xe7, 0x52, 0x55,
-	0xf7, 0xa4, 0xb5, 0xfb, 0xce, 0xa0, 0x99, 0x83, 0x95, 0xa9, 0xad, 0x76, 0x71, 0x5c, 0x5a, 0x9b,
-	0xa0, 0x12, 0x05, 0x3c, 0x97, 0xce, 0x7b, 0x91, 0x9b, 0x6a, 0xcc, 0xb3, 0x8d, 0x80, 0x71, 0x7f,
-	0xb7, 0xc2, 0x98, 0x46, 0x94, 0x9c, 0x58, 0x24, 0x15, 0xda, 0x4c, 0x15, 0xcf, 0xa6, 0x0f, 0xa4,
-	0x29, 0x0c, 0xa9, 0x81, 0xc2, 0x65, 0x56, 0x11, 0x8b, 0x9f, 0xec, 0x85, 0x62, 0x5f, 0x0f, 0xcb,
-	0x73, 0xb6, 0x9e, 0xcc, 0x2c, 0xc3, 0xcf, 0xba, 0xb0, 0x2a, 0x5c, 0xcd, 0x58, 0x82, 0xbb, 0x0f,
-	0x98, 0x3c, 0x83, 0x71, 0x6e, 0x89, 0x5c, 0x76, 0xf1, 0xab, 0x30, 0xaa, 0x7b, 0x8e, 0x43, 0x6d,
-	0x9e, 0xc8, 0x2e, 0xe5, 0x90, 0x7c, 0x26, 0x6c, 0xb5, 0xe0, 0x58, 0xb8, 0x25, 0xe2, 0x16, 0x91,
-	0xc9, 0x55, 0x6e, 0x67, 0xcb, 0xf3, 0xe9, 0xc0, 0x27, 0x3d, 0x52, 0x88, 0x72, 0x54, 0x3e, 0x8b,
-	0xa0, 0xe2, 0xf7, 0x10, 0xe0, 0x70, 0xa3, 0xbb, 0xe1, 0x70, 0xb5, 0xe5, 0x98, 0x3a, 0x15, 0x11,
-	0xcd, 0x55, 0x1e, 0x4a, 0x7d, 0xe5, 0xba, 0xc9, 0xd7, 0xbd, 0x5a, 0x49, 0x67, 0xcd, 0xb2, 0xf4,
-	0xc7, 0x05, 0x4b, 0xab, 0xb9, 0xe1, 0x42, 0xfc, 0x15, 0x30, 0x2a, 0x66, 0x3d, 0xc0, 0x70, 0xa2,
-	0x13, 0x43, 0x2c, 0x3a, 0x06, 0xf1, 0x60, 0xc3, 0xe1, 0x2b, 0xe2, 0xd1, 0x9b, 0x70, 0x2a, 0x42,
-	0xb4, 0x12, 0x9c, 0x0c, 0x71, 0xe4, 0x87, 0x7a, 0x3f, 0xfd, 0x1a, 0xc1, 0xe9, 0x3e, 0xd2, 0x64,
-	0xb8, 0x6b, 0x90, 0x8b, 0x3d, 0x1b, 0xc4, 0xf9, 0xf5, 0x8c, 0x71, 0xee, 0x53, 0x9b, 0xc2, 0xeb,
-	0x47, 0xc4, 0x80, 0xaf, 0xc3, 0x68, 0xcd, 0xd3, 0x1b, 0x94, 0x77, 0x14, 0xc0, 0x44, 0xc6, 0x26,
-	0xa9, 0x44, 0x39, 0x14, 0x2c, 0x83, 0x22, 0xf8, 0x75, 0x38, 0x3d, 0x6f, 0x69, 0x66, 0x53, 0xab,
-	0x59, 0xf4, 0x41, 0xcb, 0xa1, 0x9a, 0xa1, 0xd0, 0xc7, 0x9a, 0x63, 0xb8, 0xbb, 0xbe, 0x7b, 0xfc,
-	0x18, 0x41, 0xa1, 0x9f, 0x68, 0xe9, 0x9c, 0xef, 0xc0, 0xb4, 0x1e, 0xee, 0x50, 0x5d, 0xb1, 0x45,
-	0x75, 0x82, 0x3d, 0xd2, 0x57, 0x27, 0x3a, 0xde, 0x76, 0xa1, 0x67, 0xe6, 0x99, 0x69, 0x57, 0x5e,
-	0xf3, 0xdd, 0xb0, 0xd5, 0x2e, 0x16, 0x65, 0xf4, 0xfb, 0x08, 0x22, 0xca, 0xa4, 0x9e, 0x8a, 0x82,
-	0xac, 0x42, 0x3e, 0xc2, 0x57, 0x0d, 0x2f, 0xc4, 0xbb, 0xb7, 0xfb, 0xfd, 0x11, 0x38, 0x99, 0x2a,
-	0x57, 0x1a, 0xbd, 0x01, 0x13, 0x31, 0xd6, 0xe8, 0x22, 0x9e, 0xc1, 0xe0, 0xcf, 0x49, 0x83, 0x4f,
-	0x76, 0x1b, 0x1c, 0x0b, 0x21, 0xca, 0xb8, 0xde, 0xab, 0xda, 0x57, 0xb9, 0xc6, 0x9c, 0x35, 0x6a,
-	0x72, 0x6a, 0x24, 0x55, 0x8e, 0x0c, 0xa8, 0x32, 0x4d, 0x08, 0x51, 0xc6, 0xa3, 0xc7, 0xb1, 0x4a,
-	0xb2, 0x04, 0xa7, 0xfd, 0xab, 0xcc, 0x9c, 0xae, 0x7b, 0x4d, 0xcf, 0xd2, 0x38, 0x73, 0xba, 0xf2,
-	0x6a, 0xa0, 0x73, 0xf6, 0x9b, 0x11, 0x28, 0xf4, 0x13, 0x27, 0xdd, 0xfa, 0x01, 0x82, 0x93, 0x1d,
-	0x91, 0x57, 0xeb, 0x0e, 0x7b, 0xcc, 0xd7, 0xd5, 0xba, 0xc5, 0x6a, 0x9a, 0x25, 0xdd, 0x7b, 0x2a,
-	0xd5, 0xd6, 0x05, 0xaa, 0x0b, 0x73, 0x2f, 0xfb, 0xe6, 0x7e, 0xf8, 0x69, 0xf1, 0x5c, 0xa2, 0x06,
-	0xc9, 0x36, 0x30, 0xf8, 0x73, 0xc1, 0x35, 0x1a, 0x65, 0xbe, 0xd9, 0xa2, 0x6e, 0xc8, 0xe3, 0x2a,
-	0xd3, 0x6e, 0x22, 0xab, 0xee, 0x0a, 0x9d, 0x77, 0x85, 0x4a, 0xfc, 0x5d, 0x04, 0x13, 0x5e, 0x8b,
-	0x9b, 0x4d, 0xda, 0x85, 0x25, 0xf0, 0xfb, 0x95, 0x8c, 0x75, 0x60, 0x55, 0x88, 0x78, 0xe8, 0x68,
-	0x7a, 0x83, 0x3a, 0xdd, 0x21, 0x49, 0x93, 0x4f, 0x14, 0x1c, 0x3c, 0x4e, 0xa2, 0x21, 0xef, 0x23,
-	0x28, 0xf8, 0xf5, 0x29, 0xe1, 0x43, 0x29, 0x73, 0xa8, 0x98, 0x0c, 0x79, 0xe9, 0xfa, 0x6c, 0x04,
-	0x8a, 0x7d, 0x51, 0xc8, 0x50, 0x3e, 0x43, 0x70, 0x2d, 0x35, 0x94, 0xac, 0x25, 0xce, 0x19, 0x55,
-	0x8d, 0xf0, 0xb5, 0xaa, 0xb2, 0x35, 0xd5, 0xd2, 0x5c, 0xae, 0x72, 0x47, 0x7b, 0x44, 0x1d, 0xf7,
-	0x7f, 0x19, 0xe8, 0x4b, 0xbd, 0x81, 0x7e, 0x4b, 0x02, 0x8a, 0x5e, 0xf3, 0x6f, 0xad, 0x2d, 0x69,
-	0x2e, 0x7f, 0x18, 0x82, 0xc1, 0x4f, 0xe1, 0x88, 0x8c, 0x10, 0x97, 0x56, 0xee, 0x2a, 0xf8, 0x05,
-	0x19, 0xfc, 0xc9, 0x8e, 0xe0, 0x87, 0xa2, 0x89, 0x32, 0xe6, 0x25, 0xb7, 0xbb, 0xe4, 0x7b, 0x08,
-	0xa6, 0xa2, 0x43, 0xa9, 0x88, 0x56, 0x7f, 0xb8, 0x60, 0xbf, 0xac, 0xd6, 0xe8, 0x23, 0x04, 0xd3,
-	0xbd, 0x80, 0x64, 0xdc, 0x4d, 0x38, 0xd6, 0x3d, 0x98, 0x08, 0xcb, 0xe2, 0x97, 0x33, 0xba, 0xab,
-	0x4b, 0xb6, 0x7c, 0x57, 0x1e, 0x35, 0xbb, 0x54, 0xbe, 0xbc, 0xce, 0xea, 0x5d, 0x04, 0xe7, 0xe6,
-	0x17, 0xef, 0xdd, 0x13, 0x7d, 0x9b, 0xb1, 0x64, 0xda, 0x8d, 0x45, 0x87, 0x35, 0xe7, 0x13, 0x20,
-	0x03, 0x4a, 0xe8, 0xf5, 0xfb, 0x30, 0x91, 0xb4, 0x40, 0xed, 0x0c, 0x41, 0x31, 0x51, 0xde, 0x53,
-	0x76, 0x11, 0x05, 0xeb, 0x3d, 0x92, 0x89, 0x09, 0xe7, 0xb3, 0x21, 0x90, 0x6e, 0xbe, 0x06, 0xa3,
-	0xfa, 0x5a, 0xb3, 0xd9, 0xa5, 0x3a, 0x71, 0x5d, 0x48, 0x52, 0x89, 0x02, 0xfe, 0x52, 0xaa, 0xba,
-	0x07, 0xa7, 0x57, 0x5d, 0xea, 0xac, 0xda, 0x35, 0x66, 0x1b, 0xa6, 0x5d, 0xdf, 0xdd, 0xa0, 0x88,
-	0xfc, 0x14, 0x41, 0xa1, 0x9f, 0x3c, 0x09, 0xf6, 0x5d, 0x04, 0xf9, 0x68, 0xd0, 0xa2, 0x3e, 0x36,
-	0xf9, 0xba, 0xda, 0xa2, 0x8e, 0xc9, 0x0c, 0xd5, 0x62, 0x7a, 0x43, 0x66, 0xc7, 0xad, 0x8c, 0xd9,
-	0x11, 0x8a, 0xf7, 0xef, 0x52, 0x2b, 0x42, 0xca, 0x12, 0xd3, 0x1b, 0x32, 0x49, 0xa6, 0x22, 0x35,
-	0x9d, 0x64, 0x92, 0x87, 0xe9, 0xbb, 0x94, 0x3f, 0x64, 0x5c, 0xb3, 0xa2, 0x2b, 0x59, 0xd8, 0x47,
-	0xff, 0x00, 0xc1, 0x89, 0x14, 0xa2, 0x04, 0xcf, 0xe1, 0x08, 0xf7, 0x29, 0x6a, 0xf7, 0x15, 0x70,
-	0x9b, 0x57, 0xee, 0x97, 0x64, 0x69, 0x9a, 0xc9, 0x50, 0x9a, 0x82, 0xba, 0x34, 0xc6, 0x3b, 0xb4,
-	0x93, 0x2d, 0x04, 0x85, 0x65, 0xaf, 0xb9, 0x4c, 0x9f, 0xf0, 0xaa, 0x6d, 0x72, 0x53, 0xb3, 0xcc,
-	0x6f, 0x53, 0xd1, 0xdb, 0x0c, 0x77, 0xf6, 0x6f, 0xc3, 0x58, 0xd8, 0xcd, 0xa9, 0x06, 0xb5, 0x59,
-	0x53, 0x76, 0x7b, 0x89, 0x41, 0x4b, 0x27, 0x9d, 0x28, 0xa3, 0xb2, 0xe7, 0x5b, 0xf0, 0x97, 0xb8,
-	0x06, 0x79, 0xdb, 0x6b, 0xaa, 0x36, 0x7d, 0xe2, 0xdf, 0x41, 0x23, 0x44, 0xa2, 0x2b, 0x71, 0x45,
-	0xbb, 0xb1, 0xaf, 0x72, 0x76, 0xab, 0x5d, 0x7c, 0x35, 0x10, 0xd6, 0x7f, 0x2f, 0x51, 0xa6, 0xec,
-	0x74, 0xc3, 0xc8, 0x8f, 0x46, 0xa0, 0xd8, 0xd7, 0xe8, 0xff, 0xfb, 0xd6, 0xeb, 0xd2, 0xbf, 0xf2,
-	0xb0, 0xff, 0xbe, 0x5f, 0xd1, 0xf0, 0xcf, 0x10, 0x88, 0x21, 0x93, 0x8b, 0x2f, 0x67, 0x3e, 0x35,
-	0xf1, 0x8c, 0x2c, 0x7f, 0x65, 0x30, 0xa6, 0xc0, 0xf3, 0xe4, 0xca, 0x7b, 0x7f, 0xf8, 0xeb, 0x0f,
-	0x47, 0x4a, 0xf8, 0x7c, 0x39, 0xeb, 0x54, 0xdb, 0x07, 0xf8, 0x73, 0x04, 0x07, 0x82, 0x31, 0x13,
-	0xce, 0xac, 0x36, 0x39, 0xe5, 0xca, 0xcf, 0x0e, 0xc8, 0x25, 0xd1, 0xce, 0x0a, 0xb4, 0x65, 0x7c,
-	0x21, 0x2b, 0xda, 0x00, 0xe3, 0x47, 0x08, 0x0e, 0x77, 0x4c, 0xa0, 0xf1, 0x8d, 0xac, 0x2f, 0xf9,
-	0x94, 0x99, 0x7b, 0xfe, 0xe6, 0x70, 0xcc, 0xd2, 0x86, 0x8a, 0xb0, 0xe1, 0x26, 0xbe, 0x5e, 0x1e,
-	0xec, 0x3b, 0x82, 0x5b, 0x7e, 0x47, 0x56, 0xe7, 0xa7, 0xf8, 0x33, 0x04, 0xc7, 0x53, 0xbb, 0x5b,
-	0x3c, 0x3f, 0x68, 0x0b, 0x9b, 0xd2, 0x69, 0xe7, 0x17, 0x76, 0x27, 0x44, 0x1a, 0x7a, 0x57, 0x18,
-	0x3a, 0x87, 0x6f, 0x67, 0x34, 0x34, 0xae, 0x00, 0xe1, 0x90, 0x4c, 0x75, 0x84, 0x4d, 0xff, 0x4e,
-	0x8e, 0x03, 0x3b, 0x87, 0x37, 0xf8, 0x8d, 0x41, 0xa1, 0xa6, 0x8e, 0xd7, 0xf2, 0x8b, 0xbb, 0x15,
-	0x23, 0x6d, 0xae, 0x0a, 0x9b, 0xe7, 0xf1, 0xdc, 0xc0, 0x36, 0xdb, 0x62, 0x0c, 0x10, 0xdf, 0x9f,
-	0xf1, 0x3f, 0x11, 0x4c, 0xa6, 0x77, 0xe9, 0x38, 0x6b, 0x7c, 0xb6, 0x9d, 0x1f, 0xe4, 0xdf, 0xd8,
-	0xa5, 0x94, 0x21, 0xc3, 0xdc, 0x6f, 0x1c, 0x80, 0xff, 0x82, 0x60, 0x3c, 0xa5, 0x3d, 0xc7, 0x73,
-	0x83, 0xe2, 0xec, 0x19, 0x19, 0xe4, 0x2b, 0xbb, 0x11, 0x21, 0xed, 0x9c, 0x17, 0x76, 0xde, 0xc2,
-	0x37, 0x06, 0xb6, 0x33, 0x6e, 0xc9, 0xf1, 0xef, 0x10, 0x8c, 0x26, 0xbf, 0xfb, 0xe0, 0xeb, 0x03,
-	0x5e, 0x90, 0x12, 0x1f, 0x9f, 0xf2, 0x37, 0x86, 0xe2, 0x95, 0xe6, 0xdc, 0x12, 0xe6, 0x5c, 0xc5,
-	0xb3, 0x03, 0x96, 0x21, 0xb5, 0xb6, 0xa9, 0x9a, 0x06, 0xfe, 0x1b, 0x82, 0xc9, 0xf4, 0xbe, 0x3f,
-	0x73, 0x76, 0x6e, 0x3b, 0x85, 0xc8, 0x9c, 0x9d, 0xdb, 0x0f, 0x1f, 0xc8, 0x9c, 0x30, 0xf3, 0x06,
-	0xbe, 0x36, 0xc0, 0xfb, 0x4d, 0xd5, 0x7c, 0x79, 0x51, 0x5e, 0xfe, 0x11, 0xc1, 0xd1, 0xee, 0xce,
-	0x08, 0xbf, 0x3e, 0x5c, 0xdb, 0x13, 0x99, 0x77, 0x7b, 0x68, 0x7e, 0x69, 0xd8, 0x1d, 0x61, 0xd8,
-	0x75, 0xfc, 0x95, 0xf2, 0x70, 0x1f, 0x96, 0x5d, 0xfc, 0x77, 0x04, 0x53, 0x7d, 0x1a, 0xfe, 0xcc,
-	0x65, 0x75, 0xfb, 0xb1, 0x45, 0xe6, 0xb2, 0xba, 0xc3, 0xdc, 0x61, 0xe0, 0x77, 0xa6, 0x78, 0x79,
-	0x04, 0x51, 0x0c, 0x5b, 0x70, 0xfc, 0xab, 0x11, 0xf8, 0x7c, 0x96, 0x6e, 0x0c, 0x2b, 0x59, 0x8b,
-	0x45, 0xf6, 0xe6, 0x32, 0xff, 0xe0, 0xa5, 0xca, 0x94, 0x5e, 0x31, 0x85, 0x57, 0x74, 0xac, 0x65,
-	0xad, 0x48, 0x89, 0xee, 0x51, 0xb5, 0x4c, 0xbb, 0xa1, 0xae, 0x39, 0xac, 0xa9, 0x26, 0x99, 0xca,
-	0xef, 0xa4, 0x75, 0xb7, 0x4f, 0xf1, 0x7f, 0x10, 0x4c, 0xa6, 0xf7, 0x83, 0x99, 0x8f, 0xfb, 0xb6,
-	0xed, 0x69, 0xe6, 0xe3, 0xbe, 0x7d, 0x53, 0x4a, 0xee, 0x0b, 0x97, 0xbc, 0x89, 0xab, 0x19, 0x5d,
-	0xe2, 0xb9, 0xd4, 0x51, 0xbd, 0x50, 0x9e, 0x9a, 0x76, 0xd7, 0xfa, 0x04, 0xc1, 0xb1, 0x9e, 0x46,
-	0x12, 0x67, 0x3d, 0xbf, 0xfd, 0xfa, 0xd3, 0xfc, 0x9d, 0xe1, 0x05, 0x0c, 0x79, 0x28, 0xea, 0x94,
-	0xab, 0x5d, 0x4d, 0xaf, 0xb8, 0x5a, 0xf5, 0x69, 0xce, 0x32, 0xd7, 0x80, 0xed, 0x3b, 0xda, 0xcc,
-	0x35, 0x60, 0x87, 0x1e, 0x71, 0xe0, 0xab, 0x55, 0xff, 0x66, 0xb5, 0xb2, 0xfe, 0xec, 0x79, 0x01,
-	0x7d, 0xfc, 0xbc, 0x80, 0xfe, 0xfc, 0xbc, 0x80, 0x3e, 0x78, 0x51, 0xd8, 0xf3, 0xf1, 0x8b, 0xc2,
-	0x9e, 0x3f, 0xbd, 0x28, 0xec, 0xf9, 0xc6, 0xf2, 0x4e, 0xdf, 0xb8, 0x1e, 0x5d, 0x9a, 0x2d, 0x3f,
-	0xe9, 0xd0, 0x7c, 0x21, 0x56, 0xad, 0x5b, 0x26, 0xb5, 0x79, 0xf0, 0x3f, 0x49, 0xc1, 0x3f, 0x10,
-	0x1c, 0x10, 0x7f, 0x2e, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x8e, 0x2a, 0x8b, 0xa7, 0x25,
-	0x00, 0x00,
+	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x76, 0xec, 0xa4, 0xec, 0xd8, 0x4e, 0x27, 0x99, 0xc9, 0x36,
+	0x84, 0xb5, 0x48, 0x32, 0x43, 0x7e, 0x4c, 0xc8, 0x7f, 0x3c, 0x76, 0x1c, 0x8d, 0xd6, 0x71, 0x9c,
+	0x4e, 0x02, 0x68, 0x85, 0xe8, 0xed, 0xe9, 0xae, 0x19, 0xb7, 0xa6, 0xa7, 0x6b, 0xdc, 0x5d, 0x9d,
+	0xc4, 0x2c, 0x91, 0x56, 0xbb, 0x47, 0x24, 0x58, 0x04, 0x47, 0x84, 0x84, 0xb8, 0xa0, 0x15, 0x47,
+	0x2e, 0x70, 0x00, 0xc1, 0x01, 0x45, 0x1c, 0x56, 0x2b, 0x21, 0xb4, 0x68, 0x0f, 0xb3, 0x90, 0x70,
+	0x40, 0x5a, 0x40, 0xc8, 0x5c, 0x38, 0xa2, 0xae, 0xae, 0xee, 0xe9, 0x99, 0xe9, 0x71, 0x7a, 0x66,
+	0x0c, 0x17, 0x4e, 0x9e, 0xea, 0xaa, 0xf7, 0xde, 0xf7, 0xbd, 0xf7, 0xea, 0xef, 0x95, 0xe1, 0x2c,
+	0x75, 0xeb, 0xd4, 0x35, 0xdd, 0x82, 0x4e, 0x6d, 0x9d, 0xd8, 0xcc, 0xd1, 0x18, 0x31, 0x2c, 0x73,
+	0xc3, 0x33, 0x0d, 0x93, 0x6d, 0x16, 0x1e, 0x9d, 0x2d, 0x13, 0xa6, 0x9d, 0x2d, 0x6c, 0x78, 0xc4,
+	0xd9, 0xcc, 0x37, 0x1c, 0xca, 0x28, 0x3e, 0x29, 0x44, 0xf2, 0x89, 0x22, 0x79, 0x21, 0x22, 0x4d,
+	0x55, 0x69, 0x95, 0x72, 0x89, 0x82, 0xff, 0x2b, 0x10, 0x96, 0x3e, 0xbf, 0xbd, 0xbd, 0x86, 0xe6,
+	0x68, 0x75, 0x57, 0x8c, 0x9d, 0x4f, 0x87, 0x8d, 0x99, 0x7a, 0x4d, 0x35, 0xed, 0x4a, 0x68, 0x22,
+	0xab, 0x73, 0xb9, 0x42, 0x59, 0x73, 0x49, 0x34, 0x48, 0xa7, 0xa6, 0x1d, 0x42, 0x88, 0xf7, 0x73,
+	0x62, 0xd1, 0xa8, 0x86, 0x56, 0x35, 0x6d, 0x8d, 0x99, 0x34, 0x1c, 0x7b, 0xac, 0x4a, 0x69, 0xd5,
+	0x22, 0x05, 0xad, 0x61, 0x16, 0x34, 0xdb, 0xa6, 0x8c, 0x77, 0x86, 0x00, 0x8f, 0x88, 0x5e, 0xde,
+	0x2a, 0x7b, 0x95, 0x82, 0x66, 0x6f, 0x86, 0x5d, 0x81, 0x11, 0x35, 0x70, 0x40, 0xd0, 0x10, 0x5d,
+	0x17, 0xd2, 0xd1, 0x6a, 0x50, 0xd7, 0x8c, 0x21, 0xb9, 0x9a, 0x4e, 0xca, 0xe4, 0x9d, 0xe6, 0x23,
+	0xa2, 0x3a, 0x44, 0xa7, 0x8e, 0x11, 0x48, 0xcb, 0x3f, 0x47, 0x30, 0xf5, 0xd0, 0x25, 0xce, 0x9a,
+	0x50, 0xea, 0x2a, 0x64, 0xc3, 0x23, 0x2e, 0xc3, 0xa7, 0xe1, 0x15, 0xcd, 0x30, 0x1c, 0xe2, 0xba,
+	0xb3, 0xe8, 0x04, 0x9a, 0xcb, 0x14, 0xf1, 0x56, 0x33, 0x37, 0xbe, 0xa9, 0xd5, 0xad, 0xcb, 0xb2,
+	0xe8, 0x90, 0x95, 0x70, 0x08, 0x3e, 0x05, 0xaf, 0x34, 0x28, 0xb5, 0x54, 0xd3, 0x98, 0x1d, 0x39,
+	0x81, 0xe6, 0xf6, 0xc4, 0x47, 0x8b, 0x0e, 0x59, 0xd9, 0xe7, 0xff, 0x2a, 0x19, 0x78, 0x19, 0xa0,
+	0xe5, 0xcf, 0xd9, 0xdd, 0x27, 0xd0, 0xdc, 0xe8, 0xb9, 0xcf, 0xe5, 0x85, 0x2b, 0x7c, 0xe7, 0xe7,
+	0x83, 0xac, 0x12, 0xd0, 0xf3, 0x6b, 0x5a, 0x95, 0x08, 0x58, 0x4a, 0x4c, 0x52, 0xfe, 0x0d, 0x82,
+	0xc3, 0x1d, 0xd8, 0xdd, 0x06, 0xb5, 0x5d, 0x82, 0xdf, 0x84, 0x4c, 0xe8, 0x25, 0x1f, 0xfe, 0xee,
+	0xb9, 0xd1, 0x73, 0x57, 0xf3, 0xa9, 0xb2, 0x33, 0xbf, 0xec, 0x59, 0x56, 0xa8, 0xb0, 0xe8, 0x10,
+	0xad, 0x66, 0xd0, 0xc7, 0x76, 0x71, 0xcf, 0xb3, 0x66, 0x6e, 0x97, 0xd2, 0x52, 0x8a, 0x6f, 0xb7,
+	0x71, 0x18, 0xe1, 0x1c, 0x5e, 0x7b, 0x29, 0x87, 0x00, 0x5e, 0x1b, 0x09, 0x17, 0xa6, 0x7d, 0x0e,
+	0x2b, 0x66, 0xdd, 0x64, 0x77, 0x1d, 0x83, 0x38, 0xff, 0x83, 0x08, 0xc8, 0x1e, 0xcc, 0x74, 0x19,
+	0x15, 0xae, 0x7b, 0x03, 0xc6, 0x2c, 0xff, 0xb3, 0x4a, 0xf9, 0x77, 0xe1, 0xbd, 0xb3, 0x29, 0xbd,
+	0xd7, 0xd2, 0x28, 0x5c, 0x36, 0x6a, 0xb5, 0x6c, 0xc8, 0xdf, 0x47, 0x70, 0xcc, 0xb7, 0xbb, 0x6c,
+	0x5a, 0x16, 0x31, 0x86, 0xa6, 0xbc, 0x9c, 0x10, 0x83, 0x41, 0xf2, 0xe8, 0x23, 0x04, 0xc7, 0x7b,
+	0xc0, 0x12, 0x4e, 0xa9, 0xc3, 0x64, 0x85, 0x77, 0xaa, 0x09, 0xbe, 0xb9, 0x98, 0x36, 0xb3, 0x3a,
+	0xd4, 0x0b, 0x0f, 0x1d, 0xaa, 0x74, 0x9a, 0xdd, 0xb9, 0xe4, 0x5a, 0x85, 0xc9, 0x28, 0x97, 0x37,
+	0x4b, 0x46, 0xe8, 0xe6, 0x8b, 0x30, 0x1a, 0x66, 0xb2, 0x9f, 0x2f, 0x88, 0xe7, 0xcb, 0xf4, 0x56,
+	0x33, 0x87, 0xc3, 0x7c, 0x89, 0x3a, 0x65, 0x05, 0xc2, 0x56, 0xc9, 0x90, 0x1f, 0xc1, 0x54, 0xbb,
+	0x3e, 0xe1, 0x9f, 0xaf, 0xc3, 0xfe, 0x70, 0x14, 0xd7, 0xb6, 0x33, 0xd3, 0x2d, 0xd2, 0x29, 0x2f,
+	0xc3, 0xcc, 0xaa, 0x57, 0x5f, 0xa3, 0xd4, 0xea, 0x5a, 0xa7, 0x62, 0x79, 0x8f, 0x5e, 0x9a, 0xf7,
+	0x5f, 0x83, 0xd9, 0x6e, 0x3d, 0x82, 0xc3, 0x4d, 0x18, 0x8f, 0x78, 0xeb, 0xd4, 0xb3, 0x99, 0xd0,
+	0x77, 0x64, 0xab, 0x99, 0x3b, 0xdc, 0xe1, 0x17, 0xde, 0x2f, 0x2b, 0x07, 0xc2, 0x0f, 0x8b, 0xbc,
+	0xfd, 0x65, 0x18, 0xf3, 0x55, 0x47, 0xd0, 0x76, 0x2a, 0x3f, 0xbf, 0x83, 0xe0, 0x80, 0x50, 0x2c,
+	0xb0, 0xce, 0xc3, 0x5e, 0x9f, 0x51, 0x98, 0x81, 0x53, 0xf9, 0x60, 0xbf, 0xc9, 0x87, 0xfb, 0x4d,
+	0x7e, 0xc1, 0xde, 0x2c, 0x66, 0x7e, 0xf7, 0xb3, 0x33, 0x7b, 0x7d, 0xb9, 0x92, 0x12, 0x8c, 0xde,
+	0xb9, 0xbc, 0x9a, 0x80, 0x03, 0x6b, 0x7c, 0x43, 0x16, 0x70, 0xe5, 0x87, 0x30, 0x1e, 0x7e, 0x10,
+	0x10, 0x17, 0x61, 0x5f, 0xb0, 0x67, 0x8b, 0x84, 0x38, 0xf9, 0x92, 0x84, 0x08, 0xc4, 0x45, 0xe4,
+	0x85, 0xa8, 0xfc, 0x3e, 0x82, 0x83, 0x0f, 0x4c, 0xbd, 0xb6, 0x12, 0x0e, 0x5b, 0x25, 0x0c, 0xbf,
+	0x09, 0x07, 0x22, 0x31, 0xd5, 0x26, 0x4c, 0x2c, 0x15, 0x57, 0x7c, 0xc9, 0x8f, 0x9b, 0xb9, 0xa3,
+	0x01, 0x1f, 0xd7, 0xa8, 0xe5, 0x4d, 0x5a, 0xa8, 0x6b, 0x6c, 0x3d, 0xbf, 0x42, 0xaa, 0x9a, 0xbe,
+	0xb9, 0x44, 0xf4, 0xad, 0x66, 0x6e, 0x2a, 0x08, 0x65, 0x9b, 0x06, 0x59, 0x19, 0xb3, 0xe2, 0x16,
+	0x2e, 0x00, 0x88, 0xb3, 0x83, 0x41, 0x9e, 0x70, 0x3f, 0xed, 0x2e, 0x1e, 0xde, 0x6a, 0xe6, 0x0e,
+	0x05, 0xb2, 0xad, 0x3e, 0x59, 0xc9, 0xf8, 0x8d, 0x12, 0xff, 0xfd, 0x77, 0x04, 0x33, 0x11, 0xd0,
+	0x25, 0xd2, 0x60, 0xeb, 0x5f, 0x31, 0xd9, 0xba, 0xa2, 0xd9, 0x55, 0x82, 0x2b, 0x70, 0xb0, 0x65,
+	0x51, 0xab, 0x47, 0xe9, 0x35, 0x24, 0xec, 0x89, 0xa8, 0xbd, 0xc0, 0x75, 0xfa, 0xc8, 0x2d, 0xfa,
+	0x98, 0x38, 0xaa, 0x0f, 0xab, 0x1b, 0x79, 0xab, 0x4f, 0x56, 0x32, 0xbc, 0xe1, 0x7b, 0xd7, 0x97,
+	0xf2, 0x1a, 0x8d, 0x50, 0x6a, 0x77, 0xa7, 0x54, 0xab, 0x4f, 0x56, 0x32, 0xbc, 0xe1, 0x4b, 0xc9,
+	0x9f, 0x8c, 0x40, 0x36, 0x1e, 0x98, 0x92, 0xbd, 0x64, 0x3a, 0x44, 0xf7, 0x13, 0x64, 0x90, 0xc9,
+	0x89, 0xf3, 0xb0, 0x9f, 0xd1, 0x1a, 0xb1, 0x55, 0x33, 0xc8, 0xcd, 0x4c, 0x71, 0x72, 0xab, 0x99,
+	0x9b, 0x10, 0x3e, 0x17, 0x3d, 0xb2, 0xf2, 0x0a, 0xff, 0x59, 0xb2, 0x7d, 0xd4, 0x2e, 0xd3, 0x1c,
+	0xd6, 0x03, 0x75, 0xab, 0x4f, 0x56, 0x32, 0xbc, 0xc1, 0xb9, 0x5e, 0x82, 0x31, 0xcf, 0x25, 0xaa,
+	0xee, 0x09, 0xb6, 0x7b, 0x4e, 0xa0, 0xb9, 0xfd, 0xc5, 0x99, 0xad, 0x66, 0x6e, 0x52, 0xb0, 0x8d,
+	0xf5, 0xca, 0x0a, 0x78, 0x2e, 0x59, 0xf4, 0x22, 0x37, 0x95, 0xa9, 0x67, 0x1b, 0x81, 0xe0, 0xde,
+	0x4e, 0x83, 0xad, 0x3e, 0x59, 0xc9, 0xf0, 0x46, 0xdc, 0xa0, 0x4d, 0x55, 0xfe, 0x6d, 0x76, 0x5f,
+	0x92, 0xc1, 0xb0, 0x37, 0x30, 0xb8, 0x4a, 0x8b, 0xbc, 0xf1, 0xa3, 0xdd, 0x90, 0xeb, 0xe9, 0x61,
+	0x31, 0xcf, 0xd6, 0xe3, 0x99, 0x65, 0xf8, 0x59, 0xd7, 0xef, 0xbe, 0xd4, 0x39, 0xc1, 0xc4, 0x1c,
+	0x9c, 0xb0, 0xda, 0x72, 0xd9, 0xc5, 0xaf, 0xc2, 0x98, 0xee, 0x39, 0x0e, 0xb1, 0x59, 0x2c, 0xbb,
+	0x94, 0x51, 0xf1, 0x8d, 0x73, 0xb5, 0xe0, 0x50, 0x38, 0x24, 0x92, 0xe6, 0x91, 0xc9, 0x14, 0x6f,
+	0xa4, 0xcb, 0xf3, 0xd9, 0xc0, 0x27, 0x5d, 0x5a, 0x64, 0xe5, 0xa0, 0xf8, 0x16, 0x41, 0xc5, 0xef,
+	0x20, 0xc0, 0xe1, 0x40, 0x77, 0xc3, 0x61, 0x6a, 0xc3, 0x31, 0x75, 0xc2, 0x23, 0x9a, 0x29, 0x3e,
+	0x10, 0xf6, 0x0a, 0x55, 0x93, 0xad, 0x7b, 0xe5, 0xbc, 0x4e, 0xeb, 0x05, 0xe1, 0x8f, 0x33, 0x96,
+	0x56, 0x76, 0xc3, 0x06, 0xff, 0xcb, 0x61, 0x14, 0xcd, 0x6a, 0x80, 0xe1, 0x48, 0x3b, 0x86, 0x96,
+	0xea, 0x16, 0x88, 0xfb, 0x1b, 0x0e, 0x5b, 0xe3, 0x9f, 0x5e, 0x87, 0x63, 0x11, 0xa2, 0xb5, 0x60,
+	0x66, 0xf0, 0x29, 0x3f, 0xd0, 0xfe, 0xf4, 0x2b, 0x04, 0xc7, 0x7b, 0x68, 0x13, 0xe1, 0x2e, 0x43,
+	0xa6, 0xe5, 0xd9, 0x20, 0xce, 0xd7, 0x53, 0x9f, 0xcd, 0x12, 0xd7, 0xa6, 0xf0, 0x6c, 0x1b, 0x09,
+	0xe0, 0xcb, 0x30, 0x56, 0xf6, 0xf4, 0x1a, 0x61, 0x6d, 0x0b, 0x60, 0x2c, 0x63, 0xe3, 0xbd, 0xb2,
+	0x32, 0x1a, 0x34, 0x83, 0x45, 0xf0, 0xab, 0x70, 0x7c, 0xd1, 0xd2, 0xcc, 0xba, 0x56, 0xb6, 0xc8,
+	0xfd, 0x86, 0x43, 0x34, 0x43, 0x21, 0x8f, 0x35, 0xc7, 0x70, 0x87, 0x3e, 0x7b, 0xfc, 0x10, 0x41,
+	0xb6, 0x97, 0x6a, 0xe1, 0x9c, 0x6f, 0xc2, 0xac, 0x1e, 0x8e, 0x50, 0x5d, 0x3e, 0x44, 0x75, 0x82,
+	0x31, 0xc2, 0x57, 0x47, 0xda, 0x76, 0xbb, 0xd0, 0x33, 0x8b, 0xd4, 0xb4, 0x8b, 0xaf, 0xf9, 0x6e,
+	0xd8, 0x6a, 0xe6, 0x72, 0x22, 0xfa, 0x3d, 0x14, 0xc9, 0xca, 0xb4, 0x9e, 0x88, 0x42, 0x7e, 0x08,
+	0x52, 0x84, 0xaf, 0x14, 0xde, 0xb6, 0x86, 0xe7, 0xfd, 0xee, 0x08, 0x1c, 0x4d, 0xd4, 0x2b, 0x48,
+	0x6f, 0xc0, 0x54, 0x0b, 0x6b, 0x74, 0xcb, 0x4b, 0x41, 0xf8, 0x33, 0x82, 0xf0, 0xd1, 0x4e, 0xc2,
+	0x2d, 0x25, 0xb2, 0x32, 0xa9, 0x77, 0x9b, 0xf6, 0x4d, 0x56, 0xa8, 0x53, 0x21, 0x26, 0x23, 0x46,
+	0xdc, 0xe4, 0x48, 0x9f, 0x26, 0x93, 0x94, 0xc8, 0xca, 0x64, 0xf4, 0xb9, 0x65, 0x52, 0x5e, 0x81,
+	0xe3, 0xfe, 0x51, 0x66, 0x41, 0xd7, 0xbd, 0xba, 0x67, 0x69, 0x8c, 0x3a, 0x1d, 0x79, 0xd5, 0xd7,
+	0x3c, 0xfb, 0xf5, 0x08, 0x64, 0x7b, 0xa9, 0x13, 0x6e, 0x7d, 0x0f, 0xc1, 0xd1, 0xb6, 0xc8, 0xab,
+	0x55, 0x87, 0x3e, 0x66, 0xeb, 0x6a, 0xd5, 0xa2, 0x65, 0xcd, 0x12, 0xee, 0x3d, 0x96, 0xc8, 0x75,
+	0x89, 0xe8, 0x9c, 0xee, 0x79, 0x9f, 0xee, 0xfb, 0x9f, 0xe4, 0x4e, 0xc5, 0xd6, 0xa0, 0x60, 0xbc,
+	0xf8, 0x73, 0xc6, 0x35, 0x6a, 0x05, 0xb6, 0xd9, 0x20, 0x6e, 0x28, 0xe3, 0x2a, 0xb3, 0x6e, 0x2c,
+	0xab, 0x6e, 0x73, 0x9b, 0xb7, 0xb9, 0x49, 0xfc, 0x2d, 0x04, 0x53, 0x5e, 0x83, 0x99, 0x75, 0xd2,
+	0x81, 0x25, 0xf0, 0xfb, 0x85, 0x94, 0xeb, 0xc0, 0x43, 0xae, 0xe2, 0x81, 0xa3, 0xe9, 0x35, 0xe2,
+	0x74, 0x86, 0x24, 0x49, 0xbf, 0xac, 0xe0, 0xe0, 0x73, 0x1c, 0x8d, 0xfc, 0x2e, 0x82, 0xac, 0xbf,
+	0x3e, 0xc5, 0x7c, 0x28, 0x74, 0x0e, 0x14, 0x93, 0x01, 0x0f, 0x5d, 0x9f, 0x8e, 0x40, 0xae, 0x27,
+	0x0a, 0x11, 0xca, 0x67, 0x08, 0x2e, 0x25, 0x86, 0x92, 0x36, 0xf8, 0x3c, 0x23, 0xaa, 0x11, 0x6e,
+	0xab, 0x2a, 0xad, 0xa8, 0x96, 0xe6, 0x32, 0x95, 0x39, 0xda, 0x23, 0xe2, 0xb8, 0xff, 0xcd, 0x40,
+	0x9f, 0xeb, 0x0e, 0xf4, 0x5d, 0x01, 0x28, 0xda, 0xe6, 0xef, 0x56, 0x56, 0x34, 0x97, 0x3d, 0x08,
+	0xc1, 0xe0, 0xa7, 0x30, 0x21, 0x22, 0xc4, 0x04, 0xcb, 0xa1, 0x82, 0x9f, 0x15, 0xc1, 0x9f, 0x6e,
+	0x0b, 0x7e, 0xa8, 0x5a, 0x56, 0xc6, 0xbd, 0xf8, 0x70, 0x57, 0xfe, 0x36, 0x82, 0x99, 0x68, 0x52,
+	0x2a, 0xbc, 0x8e, 0x34, 0x58, 0xb0, 0x77, 0xea, 0x6a, 0xf4, 0x01, 0x82, 0xd9, 0x6e, 0x40, 0x22,
+	0xee, 0x26, 0x1c, 0xea, 0xac, 0x7a, 0x85, 0xcb, 0xe2, 0x17, 0x53, 0xba, 0xab, 0x43, 0xb7, 0xd8,
+	0x2b, 0x0f, 0x9a, 0x1d, 0x26, 0x77, 0xee, 0x66, 0xf5, 0x36, 0x82, 0x53, 0x8b, 0xcb, 0x77, 0xee,
+	0xf0, 0x7b, 0x9b, 0xb1, 0x62, 0xda, 0xb5, 0x65, 0x87, 0xd6, 0x17, 0x63, 0x20, 0x83, 0x9e, 0xd0,
+	0xeb, 0xf7, 0x60, 0x2a, 0xce, 0x40, 0x6d, 0x0f, 0x41, 0x2e, 0xb6, 0xbc, 0x27, 0x8c, 0x92, 0x15,
+	0xac, 0x77, 0x69, 0x96, 0x4d, 0x38, 0x9d, 0x0e, 0x81, 0x70, 0xf3, 0x25, 0x18, 0xd3, 0x2b, 0xf5,
+	0x7a, 0x87, 0xe9, 0xd8, 0x71, 0x21, 0xde, 0x2b, 0x2b, 0xe0, 0x37, 0x85, 0xa9, 0x3b, 0x41, 0xe1,
+	0xe5, 0xa1, 0x5d, 0xa6, 0xb6, 0x61, 0xda, 0xd5, 0xe1, 0xaa, 0x90, 0xf2, 0x8f, 0x11, 0x64, 0x7b,
+	0xe9, 0x13, 0x60, 0xdf, 0x46, 0x20, 0x45, 0x55, 0x3c, 0xf5, 0xb1, 0xc9, 0xd6, 0xd5, 0x06, 0x71,
+	0x4c, 0x6a, 0xa8, 0x16, 0xd5, 0x6b, 0x22, 0x3b, 0xae, 0xa5, 0xcc, 0x8e, 0x50, 0xbd, 0x7f, 0x96,
+	0x5a, 0xe3, 0x5a, 0x56, 0xa8, 0x5e, 0x13, 0x49, 0x32, 0x13, 0x99, 0x69, 0xef, 0x96, 0x25, 0x98,
+	0xbd, 0x4d, 0xd8, 0x03, 0xca, 0x34, 0x2b, 0x3a, 0x92, 0x85, 0xf7, 0xe8, 0xef, 0x22, 0x38, 0x92,
+	0xd0, 0x29, 0xc0, 0x33, 0x98, 0x60, 0x7e, 0x8f, 0xda, 0x79, 0x04, 0xdc, 0x66, 0xcb, 0xfd, 0x82,
+	0x58, 0x9a, 0xe6, 0x52, 0x2c, 0x4d, 0xc1, 0xba, 0x34, 0xce, 0xda, 0xac, 0xcb, 0x5b, 0x08, 0xb2,
+	0xab, 0x5e, 0x7d, 0x95, 0x3c, 0x61, 0x25, 0xdb, 0x64, 0xa6, 0x66, 0x99, 0xdf, 0x20, 0xfc, 0x6e,
+	0x33, 0xd8, 0xdc, 0xbf, 0x01, 0xe3, 0xe1, 0x6d, 0x4e, 0x35, 0x88, 0x4d, 0xeb, 0xe2, 0xb6, 0x17,
+	0x2b, 0xb4, 0xb4, 0xf7, 0xcb, 0xca, 0x98, 0xb8, 0xf3, 0x2d, 0xf9, 0x4d, 0x5c, 0x06, 0xc9, 0xf6,
+	0xea, 0xaa, 0x4d, 0x9e, 0xf8, 0x67, 0xd0, 0x08, 0x11, 0xbf, 0x95, 0xb8, 0xfc, 0xba, 0xb1, 0xa7,
+	0x78, 0x72, 0xab, 0x99, 0x7b, 0x35, 0x50, 0xd6, 0x7b, 0xac, 0xac, 0xcc, 0xd8, 0xc9, 0xc4, 0xe4,
+	0x1f, 0x8c, 0x40, 0xae, 0x27, 0xe9, 0xff, 0xfb, 0xab, 0xd7, 0xb9, 0x5f, 0x66, 0x61, 0xef, 0x3d,
+	0x7f, 0x45, 0xc3, 0x3f, 0x41, 0xc0, 0x8b, 0x4c, 0x2e, 0x3e, 0x9f, 0x7a, 0xd6, 0xb4, 0x6a, 0x64,
+	0xd2, 0x85, 0xfe, 0x84, 0x02, 0xcf, 0xcb, 0x17, 0xde, 0xf9, 0xfd, 0x5f, 0xbe, 0x37, 0x92, 0xc7,
+	0xa7, 0x0b, 0x69, 0x9f, 0x4c, 0x7c, 0x80, 0x3f, 0x45, 0xb0, 0x2f, 0x28, 0x33, 0xe1, 0xd4, 0x66,
+	0xe3, 0x55, 0x2e, 0x69, 0xbe, 0x4f, 0x29, 0x81, 0x76, 0x9e, 0xa3, 0x2d, 0xe0, 0x33, 0x69, 0xd1,
+	0x06, 0x18, 0x3f, 0x40, 0x70, 0xa0, 0xed, 0x79, 0x03, 0x5f, 0x49, 0xbb, 0xc9, 0x27, 0x3c, 0xe8,
+	0x48, 0x57, 0x07, 0x13, 0x16, 0x1c, 0x8a, 0x9c, 0xc3, 0x55, 0x7c, 0xb9, 0xd0, 0xdf, 0x23, 0x95,
+	0x5b, 0x78, 0x4b, 0xac, 0xce, 0x4f, 0xf1, 0x47, 0x08, 0x26, 0x3a, 0x9e, 0x1d, 0xf0, 0xb5, 0x3e,
+	0x50, 0x75, 0x3f, 0x18, 0x48, 0xd7, 0x07, 0x15, 0x17, 0xb4, 0x6e, 0x71, 0x5a, 0x37, 0xf0, 0xb5,
+	0x94, 0xb4, 0xe2, 0xe5, 0xff, 0x18, 0xb3, 0x7f, 0x8a, 0x97, 0xa8, 0xae, 0x17, 0x04, 0xbc, 0xd8,
+	0x07, 0xc0, 0x5e, 0xcf, 0x22, 0xd2, 0xd2, 0x70, 0x4a, 0x04, 0xd7, 0x15, 0xce, 0x75, 0x19, 0x2f,
+	0xa5, 0xe4, 0x9a, 0xf0, 0xe2, 0x11, 0xa3, 0xfc, 0x29, 0x82, 0xc3, 0x89, 0xa5, 0x8a, 0xd4, 0x94,
+	0xb7, 0x2b, 0x9b, 0x48, 0x4b, 0xc3, 0x29, 0x11, 0x94, 0x6f, 0x73, 0xca, 0x0b, 0xf8, 0x46, 0xea,
+	0xf0, 0x8a, 0x2f, 0x6a, 0x58, 0xf1, 0x54, 0x1d, 0xce, 0xe9, 0x5f, 0xf1, 0xda, 0x6e, 0x7b, 0x25,
+	0x0e, 0xdf, 0xea, 0x17, 0x6a, 0x62, 0xad, 0x54, 0x5a, 0x1e, 0x56, 0x8d, 0xe0, 0x5c, 0xe2, 0x9c,
+	0x17, 0xf1, 0x42, 0xdf, 0x9c, 0x6d, 0x5e, 0xd3, 0x69, 0x5d, 0x86, 0xf0, 0x3f, 0x10, 0x4c, 0x27,
+	0x97, 0x5c, 0x70, 0xda, 0xf8, 0x6c, 0x5b, 0x0c, 0x92, 0x6e, 0x0d, 0xa9, 0x65, 0xc0, 0x30, 0xf7,
+	0xaa, 0xed, 0xe0, 0x3f, 0x23, 0x98, 0x4c, 0xa8, 0xb5, 0xe0, 0x85, 0x7e, 0x71, 0x76, 0xd5, 0x7f,
+	0xa4, 0xe2, 0x30, 0x2a, 0x04, 0xcf, 0x45, 0xce, 0xf3, 0x1a, 0xbe, 0xd2, 0x37, 0xcf, 0x56, 0x7d,
+	0x05, 0xff, 0x16, 0xf9, 0xcf, 0x54, 0xad, 0x47, 0x3c, 0x7c, 0xb9, 0xcf, 0xd3, 0x6e, 0xec, 0x25,
+	0x51, 0xba, 0x32, 0x90, 0xac, 0xa0, 0x73, 0x8d, 0xd3, 0xb9, 0x88, 0xe7, 0xfb, 0xdc, 0x53, 0xd4,
+	0xf2, 0xa6, 0x6a, 0x1a, 0xf8, 0xaf, 0x08, 0xa6, 0x93, 0x8b, 0x38, 0xa9, 0xb3, 0x73, 0xdb, 0x92,
+	0x92, 0x74, 0x6b, 0x48, 0x2d, 0x82, 0xe6, 0x02, 0xa7, 0x79, 0x05, 0x5f, 0xea, 0xe3, 0xb0, 0xa2,
+	0x6a, 0xbe, 0xbe, 0x28, 0x2f, 0xff, 0x80, 0xe0, 0x60, 0xe7, 0x35, 0x17, 0x5f, 0x1f, 0xec, 0x0e,
+	0x1b, 0xd1, 0xbb, 0x31, 0xb0, 0xbc, 0x20, 0x76, 0x93, 0x13, 0xbb, 0x8c, 0xbf, 0x54, 0x18, 0xec,
+	0x5f, 0x50, 0x5c, 0xfc, 0x37, 0x04, 0x33, 0x3d, 0xaa, 0x37, 0xa9, 0x97, 0xd5, 0xed, 0x6b, 0x50,
+	0xd2, 0xf2, 0xb0, 0x6a, 0x06, 0x3c, 0x00, 0xf1, 0xcd, 0x23, 0x88, 0x62, 0x58, 0x4f, 0xc1, 0xbf,
+	0x18, 0x81, 0xcf, 0xa6, 0xb9, 0x5a, 0x63, 0x25, 0xed, 0x62, 0x91, 0xbe, 0x52, 0x20, 0xdd, 0xdf,
+	0x51, 0x9d, 0xc2, 0x2b, 0x26, 0xf7, 0x8a, 0x8e, 0xb5, 0xb4, 0x2b, 0x52, 0xac, 0x14, 0xa0, 0x5a,
+	0xa6, 0x5d, 0x53, 0x2b, 0x0e, 0xad, 0xab, 0x71, 0xa1, 0xc2, 0x5b, 0x49, 0xa5, 0x8a, 0xa7, 0xf8,
+	0xdf, 0x08, 0xa6, 0x93, 0x2f, 0xf7, 0xb8, 0x9f, 0xf3, 0x51, 0xcf, 0x5a, 0x83, 0x74, 0x6b, 0x48,
+	0x2d, 0xc2, 0x25, 0xf7, 0xb8, 0x4b, 0x5e, 0xc7, 0xa5, 0x94, 0x2e, 0xf1, 0x5c, 0xe2, 0xa8, 0x5e,
+	0xa8, 0x4f, 0x4d, 0x3a, 0x38, 0x7f, 0x8c, 0xe0, 0x50, 0x57, 0x55, 0x00, 0xa7, 0x9d, 0xbf, 0xbd,
+	0x8a, 0x0d, 0xd2, 0xcd, 0xc1, 0x15, 0x0c, 0x38, 0x29, 0xaa, 0x84, 0xa9, 0x1d, 0x15, 0x0c, 0x7e,
+	0xb4, 0xea, 0x71, 0xd3, 0x4e, 0xbd, 0x06, 0x6c, 0x5f, 0x9e, 0x90, 0x96, 0x87, 0x55, 0x33, 0xe0,
+	0xd1, 0xaa, 0x77, 0xe5, 0xa1, 0xb8, 0xfe, 0xec, 0x79, 0x16, 0x7d, 0xf8, 0x3c, 0x8b, 0xfe, 0xf4,
+	0x3c, 0x8b, 0xde, 0x7b, 0x91, 0xdd, 0xf5, 0xe1, 0x8b, 0xec, 0xae, 0x3f, 0xbe, 0xc8, 0xee, 0x7a,
+	0x63, 0xf5, 0x65, 0x0f, 0x96, 0x8f, 0xce, 0xcd, 0x17, 0x9e, 0xb4, 0x59, 0x3e, 0xd3, 0x32, 0xad,
+	0x5b, 0x26, 0xb1, 0x59, 0xf0, 0xdf, 0x8b, 0xc1, 0x7f, 0x83, 0xec, 0xe3, 0x7f, 0xce, 0xff, 0x67,
+	0x00, 0x4b, 0x7e, 0x49, 0x58, 0xd1, 0x29, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1928,6 +2143,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 	// UserPositions returns all concentrated positions of some address.
 	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
+	// UserLimitOrders returns the open limit orders of some address.
+	UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error)
+	// UserFilledLimitOrders returns the limit orders of some address that have
+	// been filled and withdrawn.
+	UserFilledLimitOrders(ctx context.Context, in *UserFilledLimitOrdersRequest, opts ...grpc.CallOption) (*UserFilledLimitOrdersResponse, error)
 	// LiquidityPerTickRange returns the amount of liquidity per every tick range
 	// existing within the given pool
 	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
@@ -1999,6 +2219,24 @@ func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsReques
 	return out, nil
 }
 
+func (c *queryClient) UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error) {
+	out := new(UserLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserFilledLimitOrders(ctx context.Context, in *UserFilledLimitOrdersRequest, opts ...grpc.CallOption) (*UserFilledLimitOrdersResponse, error) {
+	out := new(UserFilledLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserFilledLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
 	out := new(LiquidityPerTickRangeResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
@@ -2115,6 +2353,11 @@ type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	// UserPositions returns all concentrated positions of some address.
 	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
+	// UserLimitOrders returns the open limit orders of some address.
+	UserLimitOrders(context.Context, *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error)
+	// UserFilledLimitOrders returns the limit orders of some address that have
+	// been filled and withdrawn.
+	UserFilledLimitOrders(context.Context, *UserFilledLimitOrdersRequest) (*UserFilledLimitOrdersResponse, error)
 	// LiquidityPerTickRange returns the amount of liquidity per every tick range
 	// existing within the given pool
 	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
@@ -2164,6 +2407,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest)
 func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
 }
+func (*UnimplementedQueryServer) UserLimitOrders(ctx context.Context, req *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) UserFilledLimitOrders(ctx context.Context, req *UserFilledLimitOrdersRequest) (*UserFilledLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFilledLimitOrders not implemented")
+}
 func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
 }
@@ -2259,6 +2508,42 @@ func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UserLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserLimitOrders(ctx, req.(*UserLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserFilledLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFilledLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserFilledLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserFilledLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserFilledLimitOrders(ctx, req.(*UserFilledLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LiquidityPerTickRangeRequest)
 	if err := dec(in); err != nil {
@@ -2491,6 +2776,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserPositions",
 			Handler:    _Query_UserPositions_Handler,
 		},
+		{
+			MethodName: "UserLimitOrders",
+			Handler:    _Query_UserLimitOrders_Handler,
+		},
+		{
+			MethodName: "UserFilledLimitOrders",
+			Handler:    _Query_UserFilledLimitOrders_Handler,
+		},
 		{
 			MethodName: "LiquidityPerTickRange",
 			Handler:    _Query_LiquidityPerTickRange_Handler,
@@ -2640,7 +2933,7 @@ func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *UserLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2650,25 +2943,32 @@ func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2678,18 +2978,174 @@ func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserFilledLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserFilledLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserFilledLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserFilledLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserFilledLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserFilledLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FilledLimitOrders) > 0 {
+		for iNdEx := len(m.FilledLimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilledLimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3896,6 +4352,73 @@ func (m *UserPositionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *UserLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *UserLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserFilledLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserFilledLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FilledLimitOrders) > 0 {
+		for _, e := range m.FilledLimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *PositionByIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4648,6 +5171,429 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UserLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserFilledLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserFilledLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserFilledLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserFilledLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserFilledLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserFilledLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledLimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledLimitOrders = append(m.FilledLimitOrders, model.FilledLimitOrder{})
+			if err := m.FilledLimitOrders[len(m.FilledLimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
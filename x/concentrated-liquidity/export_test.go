@@ -160,6 +160,10 @@ func (k Keeper) SetPositionIdToLock(ctx sdk.Context, positionId, underlyingLockI
 	k.setPositionIdToLock(ctx, positionId, underlyingLockId)
 }
 
+func (k Keeper) SetFilledLimitOrder(ctx sdk.Context, owner sdk.AccAddress, filledLimitOrder model.FilledLimitOrder) {
+	k.setFilledLimitOrder(ctx, owner, filledLimitOrder)
+}
+
 func RoundTickToCanonicalPriceTick(lowerTick, upperTick int64, priceTickLower, priceTickUpper osmomath.BigDec, tickSpacing uint64) (int64, int64, error) {
 	return roundTickToCanonicalPriceTick(lowerTick, upperTick, priceTickLower, priceTickUpper, tickSpacing)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types/genesis"
 )
@@ -99,6 +100,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		for uptimeIndex, uptimeRecord := range positionWrapper.UptimeAccumRecords {
 			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
 		}
+
+		// set limit order for position
+		if positionWrapper.LimitOrder != nil {
+			if err := k.setLimitOrder(ctx, *positionWrapper.LimitOrder); err != nil {
+				panic(err)
+			}
+
+			// the order may have been left crossed by a swap that crossed more orders than it fills
+			pool, err := k.getPoolById(ctx, positionWrapper.LimitOrder.PoolId)
+			if err != nil {
+				panic(err)
+			}
+			k.trackCrossedLimitOrders(ctx, pool.GetId(), pool.GetCurrentTick())
+		}
+	}
+
+	// set filled limit orders
+	for _, filledLimitOrder := range genState.FilledLimitOrders {
+		k.setFilledLimitOrder(ctx, sdk.MustAccAddressFromBech32(filledLimitOrder.Address), filledLimitOrder)
 	}
 
 	// set total liquidity
@@ -225,14 +245,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			uptimeAccumObject[uptimeIndex] = accumRecord
 		}
 
+		// Retrieve limit order for position, if it is one
+		var limitOrder *model.LimitOrder
+		if k.isLimitOrder(ctx, position.PositionId) {
+			positionLimitOrder, err := k.GetLimitOrder(ctx, position.PositionId)
+			if err != nil {
+				panic(err)
+			}
+			limitOrder = &positionLimitOrder
+		}
+
 		positionData = append(positionData, genesis.PositionData{
 			LockId:                  lockId,
 			Position:                &position,
 			SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
 			UptimeAccumRecords:      uptimeAccumObject,
+			LimitOrder:              limitOrder,
 		})
 	}
 
+	filledLimitOrders, err := k.getAllFilledLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Get the incentive pool ID migration threshold
 	incentivesAccumulatorPoolIDMigrationThreshold, err := k.GetIncentivePoolIDMigrationThreshold(ctx)
 	if err != nil {
@@ -253,6 +289,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
 		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+		FilledLimitOrders:                             filledLimitOrders,
 	}
 }
 
@@ -37,6 +37,7 @@ var (
 			AuthorizedSpreadFactors:      []osmomath.Dec{osmomath.MustNewDecFromStr("0.0001"), osmomath.MustNewDecFromStr("0.0003"), osmomath.MustNewDecFromStr("0.0005")},
 			BalancerSharesRewardDiscount: types.DefaultBalancerSharesDiscount,
 			AuthorizedUptimes:            types.DefaultAuthorizedUptimes,
+			LimitOrderMinAmount:          types.DefaultLimitOrderMinAmount,
 		},
 		PoolData:              []genesis.PoolData{},
 		NextIncentiveRecordId: 2,
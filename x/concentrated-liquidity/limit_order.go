@@ -0,0 +1,427 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkprefix "cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
+)
+
+// CreateLimitOrder creates a position that is marked as a limit order. A limit order is a position that:
+// - spans a single tick spacing
+// - is provided with a single token
+// - sits entirely on the side of the current tick where the position only holds the provided token
+// - is provided with at least the limit order min amount of the token
+// Once a swap moves the current tick fully across the position, it holds only the other token and is
+// withdrawn to its owner by the swap. See fillCrossedLimitOrders for details.
+//
+// Returns error if any of the above conditions are not met, or if the position cannot be created.
+func (k Keeper) CreateLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tokensProvided sdk.Coins, amount0Min, amount1Min osmomath.Int, lowerTick, upperTick int64) (CreatePositionData, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if len(tokensProvided) != 1 {
+		return CreatePositionData{}, types.LimitOrderTokensProvidedError{TokensProvided: tokensProvided}
+	}
+
+	minAmount := k.GetParams(ctx).LimitOrderMinAmount
+	if tokensProvided[0].Amount.LT(minAmount) {
+		return CreatePositionData{}, types.LimitOrderBelowMinAmountError{TokenProvided: tokensProvided[0], MinAmount: minAmount}
+	}
+
+	tickSpacing := pool.GetTickSpacing()
+	if upperTick-lowerTick != int64(tickSpacing) {
+		return CreatePositionData{}, types.LimitOrderInvalidTickRangeError{LowerTick: lowerTick, UpperTick: upperTick, TickSpacing: tickSpacing}
+	}
+
+	tokenInDenom := tokensProvided[0].Denom
+	var tokenOutDenom string
+	switch tokenInDenom {
+	case pool.GetToken0():
+		tokenOutDenom = pool.GetToken1()
+	case pool.GetToken1():
+		tokenOutDenom = pool.GetToken0()
+	default:
+		return CreatePositionData{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenInDenom}
+	}
+
+	// An order selling token0 must be above the current tick, and an order selling token1 must be below it.
+	currentTick := pool.GetCurrentTick()
+	sellsToken0 := tokenInDenom == pool.GetToken0()
+	if (sellsToken0 && currentTick >= lowerTick) || (!sellsToken0 && currentTick < upperTick) {
+		return CreatePositionData{}, types.LimitOrderInRangeError{LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick, TokenInDenom: tokenInDenom}
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The ticks may have been rounded to their canonical price ticks, so we check the range again.
+	if positionData.UpperTick-positionData.LowerTick != int64(tickSpacing) {
+		return CreatePositionData{}, types.LimitOrderInvalidTickRangeError{LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick, TickSpacing: tickSpacing}
+	}
+
+	err = k.setLimitOrder(ctx, model.LimitOrder{
+		PositionId:    positionData.ID,
+		PoolId:        poolId,
+		LowerTick:     positionData.LowerTick,
+		UpperTick:     positionData.UpperTick,
+		TokenInDenom:  tokenInDenom,
+		TokenOutDenom: tokenOutDenom,
+	})
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	return positionData, nil
+}
+
+// GetLimitOrder returns the limit order for the given position id.
+// Returns error if the position is not a limit order.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, positionId uint64) (model.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	limitOrder := model.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrder(positionId), &limitOrder)
+	if err != nil {
+		return model.LimitOrder{}, err
+	}
+	if !found {
+		return model.LimitOrder{}, types.LimitOrderNotFoundError{PositionId: positionId}
+	}
+
+	return limitOrder, nil
+}
+
+// isLimitOrder returns true if the position with the given id is a limit order.
+func (k Keeper) isLimitOrder(ctx sdk.Context, positionId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyLimitOrder(positionId))
+}
+
+// setLimitOrder stores the limit order and indexes it by the tick at which it is filled.
+func (k Keeper) setLimitOrder(ctx sdk.Context, limitOrder model.LimitOrder) error {
+	tickKey, err := k.limitOrderTickKey(ctx, limitOrder)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLimitOrder(limitOrder.PositionId), &limitOrder)
+	store.Set(tickKey, []byte{1})
+	return nil
+}
+
+// removeLimitOrder removes the limit order marker and its tick index for the given position id.
+// The position itself is left untouched. No-op if the position is not a limit order.
+func (k Keeper) removeLimitOrder(ctx sdk.Context, positionId uint64) error {
+	if !k.isLimitOrder(ctx, positionId) {
+		return nil
+	}
+
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	tickKey, err := k.limitOrderTickKey(ctx, limitOrder)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLimitOrder(positionId))
+	store.Delete(tickKey)
+	return nil
+}
+
+// limitOrderTickKey returns the key indexing the limit order by the tick at which it is filled.
+// Orders selling token0 are filled once the current tick reaches their upper tick, while orders
+// selling token1 are filled once the current tick is below their lower tick.
+func (k Keeper) limitOrderTickKey(ctx sdk.Context, limitOrder model.LimitOrder) ([]byte, error) {
+	pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if limitOrder.TokenInDenom == pool.GetToken0() {
+		return types.KeyLimitOrderTick(limitOrder.PoolId, true, limitOrder.UpperTick, limitOrder.PositionId), nil
+	}
+	return types.KeyLimitOrderTick(limitOrder.PoolId, false, limitOrder.LowerTick, limitOrder.PositionId), nil
+}
+
+// getFilledLimitOrderIds returns the ids of at most limit positions of the given pool that have been fully
+// crossed by the current tick, and thus only hold the token they buy.
+func (k Keeper) getFilledLimitOrderIds(ctx sdk.Context, poolId uint64, currentTick int64, limit int) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextTickBz := types.TickIndexToBytes(currentTick + 1)
+
+	positionIds := []uint64{}
+	gather := func(sellsToken0 bool, start, end []byte) {
+		prefixStore := sdkprefix.NewStore(store, types.KeyLimitOrderTickSidePrefix(poolId, sellsToken0))
+		iterator := prefixStore.Iterator(start, end)
+		defer iterator.Close()
+
+		for ; iterator.Valid() && len(positionIds) < limit; iterator.Next() {
+			key := iterator.Key()
+			positionIds = append(positionIds, sdk.BigEndianToUint64(key[len(key)-types.Uint64ByteSize:]))
+		}
+	}
+
+	// Orders selling token0 with an upper tick less than or equal to the current tick.
+	gather(true, nil, nextTickBz)
+	// Orders selling token1 with a lower tick greater than the current tick.
+	gather(false, nextTickBz, nil)
+
+	return positionIds
+}
+
+// fillCrossedLimitOrders withdraws the limit orders of the pool that have been fully crossed by the current tick
+// to their owners, so that they cannot be swapped back. It is called by every swap, which pays the gas of the fills.
+// At most types.MaxLimitOrdersFilledPerSwap orders are filled, so that the gas cost of a swap is bounded. If more
+// orders have been crossed, the pool is marked to have the rest filled at the end of the block by FillLimitOrders.
+func (k Keeper) fillCrossedLimitOrders(ctx sdk.Context, poolId uint64, currentTick int64) {
+	positionIds := k.getFilledLimitOrderIds(ctx, poolId, currentTick, types.MaxLimitOrdersFilledPerSwap+1)
+	if len(positionIds) > types.MaxLimitOrdersFilledPerSwap {
+		ctx.KVStore(k.storeKey).Set(types.KeyPoolLimitOrdersToFill(poolId), []byte{1})
+		positionIds = positionIds[:types.MaxLimitOrdersFilledPerSwap]
+	}
+
+	for _, positionId := range positionIds {
+		k.tryFillLimitOrder(ctx, poolId, positionId)
+	}
+}
+
+// trackCrossedLimitOrders marks the pool as having limit orders to fill at the end of the block if any of its
+// limit orders have been fully crossed by the current tick.
+func (k Keeper) trackCrossedLimitOrders(ctx sdk.Context, poolId uint64, currentTick int64) {
+	if len(k.getFilledLimitOrderIds(ctx, poolId, currentTick, 1)) == 0 {
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyPoolLimitOrdersToFill(poolId), []byte{1})
+}
+
+// FillLimitOrders withdraws the limit orders of the marked pools that are still fully crossed by the current tick
+// to their owners. Pools are marked by swaps that cross more orders than they fill. At most
+// types.MaxLimitOrdersFilledPerBlock orders are filled per block. Pools with orders left over stay marked and are
+// filled at the end of the next block.
+func (k Keeper) FillLimitOrders(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	poolIds := k.getPoolIdsWithLimitOrdersToFill(ctx, types.MaxLimitOrdersFilledPerBlock)
+
+	remainingFills := types.MaxLimitOrdersFilledPerBlock
+	for _, poolId := range poolIds {
+		if remainingFills == 0 {
+			return
+		}
+
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to get pool (%d) to fill limit orders: %s", poolId, err))
+			store.Delete(types.KeyPoolLimitOrdersToFill(poolId))
+			continue
+		}
+
+		positionIds := k.getFilledLimitOrderIds(ctx, poolId, pool.GetCurrentTick(), remainingFills)
+		for _, positionId := range positionIds {
+			k.tryFillLimitOrder(ctx, poolId, positionId)
+		}
+
+		// All of the crossed orders of the pool have been gathered if fewer than the remaining fills were found.
+		if len(positionIds) < remainingFills {
+			store.Delete(types.KeyPoolLimitOrdersToFill(poolId))
+		}
+		remainingFills -= len(positionIds)
+	}
+}
+
+// getPoolIdsWithLimitOrdersToFill returns the ids of at most limit pools that have been marked as having limit orders to fill.
+func (k Keeper) getPoolIdsWithLimitOrdersToFill(ctx sdk.Context, limit int) []uint64 {
+	prefixStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.PoolLimitOrdersToFillPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid() && len(poolIds) < limit; iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return poolIds
+}
+
+// tryFillLimitOrder fills the limit order with the given position id in a cache context. If the order fails to be
+// withdrawn, the position is no longer treated as a limit order, so that it is not retried on every swap, and a
+// limit_order_fill_failed event is emitted so that its owner is notified that it is kept as a regular position.
+func (k Keeper) tryFillLimitOrder(ctx sdk.Context, poolId, positionId uint64) {
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.fillLimitOrder(cacheCtx, positionId)
+	})
+	if err == nil {
+		return
+	}
+
+	ctx.Logger().Error(fmt.Sprintf("failed to fill limit order (%d), removing limit order: %s", positionId, err))
+	if err := k.removeLimitOrder(ctx, positionId); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to remove limit order (%d): %s", positionId, err))
+	}
+
+	owner := ""
+	if position, err := k.GetPosition(ctx, positionId); err == nil {
+		owner = position.Address
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtLimitOrderFillFailed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner),
+		sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+	))
+}
+
+// fillLimitOrder withdraws the full liquidity of the limit order with the given position id to its owner,
+// and records it as filled.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, positionId uint64) error {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	// Withdrawing the full liquidity deletes the position along with its limit order.
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return err
+	}
+
+	filledLimitOrder := model.FilledLimitOrder{
+		LimitOrder:   limitOrder,
+		Address:      position.Address,
+		TokensOut:    sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1)),
+		FilledHeight: ctx.BlockHeight(),
+		FilledTime:   ctx.BlockTime(),
+	}
+	k.setFilledLimitOrder(ctx, owner, filledLimitOrder)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFillLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOwner, position.Address),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, filledLimitOrder.TokensOut.String()),
+	))
+
+	return nil
+}
+
+// setFilledLimitOrder stores the filled limit order under its owner. At most types.MaxFilledLimitOrdersPerOwner
+// orders are kept per owner, and the order filled the earliest is removed once the owner goes over it.
+func (k Keeper) setFilledLimitOrder(ctx sdk.Context, owner sdk.AccAddress, filledLimitOrder model.FilledLimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyFilledLimitOrder(owner, filledLimitOrder.FilledHeight, filledLimitOrder.LimitOrder.PositionId)
+	isNew := !store.Has(key)
+	osmoutils.MustSet(store, key, &filledLimitOrder)
+	if !isNew {
+		return
+	}
+
+	count := k.getFilledLimitOrderCount(ctx, owner) + 1
+	if count > types.MaxFilledLimitOrdersPerOwner {
+		iterator := sdkprefix.NewStore(store, types.KeyUserFilledLimitOrders(owner)).Iterator(nil, nil)
+		oldestKey := append(types.KeyUserFilledLimitOrders(owner), iterator.Key()...)
+		iterator.Close()
+
+		store.Delete(oldestKey)
+		count--
+	}
+	store.Set(types.KeyFilledLimitOrderCount(owner), sdk.Uint64ToBigEndian(count))
+}
+
+// getFilledLimitOrderCount returns the number of filled limit orders recorded for the given owner.
+func (k Keeper) getFilledLimitOrderCount(ctx sdk.Context, owner sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyFilledLimitOrderCount(owner))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetUserLimitOrders returns the open limit orders of the given address, with the option to filter by a specific pool.
+func (k Keeper) GetUserLimitOrders(ctx sdk.Context, addr sdk.AccAddress, poolId uint64) ([]model.LimitOrder, error) {
+	positions, err := k.GetUserPositions(ctx, addr, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	limitOrders := []model.LimitOrder{}
+	for _, position := range positions {
+		if !k.isLimitOrder(ctx, position.PositionId) {
+			continue
+		}
+
+		limitOrder, err := k.GetLimitOrder(ctx, position.PositionId)
+		if err != nil {
+			return nil, err
+		}
+		limitOrders = append(limitOrders, limitOrder)
+	}
+
+	return limitOrders, nil
+}
+
+// GetUserFilledLimitOrdersSerialized returns the filled limit orders of the given address, ordered by the height at
+// which they were filled, in a way that can be paginated. Only the latest types.MaxFilledLimitOrdersPerOwner are kept.
+func (k Keeper) GetUserFilledLimitOrdersSerialized(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) ([]model.FilledLimitOrder, *query.PageResponse, error) {
+	filledLimitOrdersStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.KeyUserFilledLimitOrders(addr))
+
+	filledLimitOrders := []model.FilledLimitOrder{}
+	pageRes, err := query.Paginate(filledLimitOrdersStore, pagination, func(key, value []byte) error {
+		filledLimitOrder := model.FilledLimitOrder{}
+		if err := k.cdc.Unmarshal(value, &filledLimitOrder); err != nil {
+			return err
+		}
+		filledLimitOrders = append(filledLimitOrders, filledLimitOrder)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return filledLimitOrders, pageRes, nil
+}
+
+// getAllFilledLimitOrders returns the filled limit orders of all addresses.
+func (k Keeper) getAllFilledLimitOrders(ctx sdk.Context) ([]model.FilledLimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.FilledLimitOrderPrefix, func(value []byte) (model.FilledLimitOrder, error) {
+		filledLimitOrder := model.FilledLimitOrder{}
+		err := k.cdc.Unmarshal(value, &filledLimitOrder)
+		return filledLimitOrder, err
+	})
+}
@@ -0,0 +1,383 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
+)
+
+// limitOrderTicks returns the ticks of the limit order one tick spacing away from the current tick,
+// on the side where the order only holds the token it sells.
+func limitOrderTicks(currentTick int64, sellsToken0 bool) (int64, int64) {
+	tickSpacing := int64(DefaultTickSpacing)
+	if sellsToken0 {
+		lowerTick := (currentTick/tickSpacing + 1) * tickSpacing
+		return lowerTick, lowerTick + tickSpacing
+	}
+	upperTick := currentTick / tickSpacing * tickSpacing
+	return upperTick - tickSpacing, upperTick
+}
+
+// setupLimitOrderPool creates a pool with the default position, with a limit order min amount below the amounts
+// used by the limit order tests.
+func (s *KeeperTestSuite) setupLimitOrderPool() types.ConcentratedPoolExtension {
+	s.SetupTest()
+	s.App.ConcentratedLiquidityKeeper.SetParam(s.Ctx, types.KeyLimitOrderMinAmount, osmomath.NewInt(1000))
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) TestCreateLimitOrder() {
+	tickSpacing := int64(DefaultTickSpacing)
+
+	tests := map[string]struct {
+		tokensProvided sdk.Coins
+		// ticks relative to the default limit order ticks of the side selling the first provided token
+		lowerTickDelta int64
+		upperTickDelta int64
+		sellsToken0    bool
+		expectedError  error
+	}{
+		"sell token0 above current tick": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1000))),
+			sellsToken0:    true,
+		},
+		"sell token1 below current tick": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(5000000))),
+		},
+		"error: two tokens provided": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1000)), sdk.NewCoin(USDC, osmomath.NewInt(5000000))),
+			sellsToken0:    true,
+			expectedError:  types.LimitOrderTokensProvidedError{},
+		},
+		"error: range wider than tick spacing": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1000))),
+			upperTickDelta: tickSpacing,
+			sellsToken0:    true,
+			expectedError:  types.LimitOrderInvalidTickRangeError{},
+		},
+		"error: sell token0 in range": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1000))),
+			lowerTickDelta: -tickSpacing,
+			upperTickDelta: -tickSpacing,
+			sellsToken0:    true,
+			expectedError:  types.LimitOrderInRangeError{},
+		},
+		"error: sell token1 above current tick": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(5000000))),
+			lowerTickDelta: 3 * tickSpacing,
+			upperTickDelta: 3 * tickSpacing,
+			expectedError:  types.LimitOrderInRangeError{},
+		},
+		"error: below min amount": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(999))),
+			sellsToken0:    true,
+			expectedError:  types.LimitOrderBelowMinAmountError{},
+		},
+		"error: token not in pool": {
+			tokensProvided: sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1000))),
+			sellsToken0:    true,
+			expectedError:  types.TokenInDenomNotInPoolError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			pool := s.setupLimitOrderPool()
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, tc.tokensProvided)
+
+			lowerTick, upperTick := limitOrderTicks(pool.GetCurrentTick(), tc.sellsToken0)
+			lowerTick += tc.lowerTickDelta
+			upperTick += tc.upperTickDelta
+
+			positionData, err := s.App.ConcentratedLiquidityKeeper.CreateLimitOrder(s.Ctx, pool.GetId(), owner, tc.tokensProvided, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			tokenIn, tokenOut := USDC, ETH
+			if tc.sellsToken0 {
+				tokenIn, tokenOut = ETH, USDC
+			}
+
+			limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(positionData.ID, limitOrder.PositionId)
+			s.Require().Equal(pool.GetId(), limitOrder.PoolId)
+			s.Require().Equal(lowerTick, limitOrder.LowerTick)
+			s.Require().Equal(upperTick, limitOrder.UpperTick)
+			s.Require().Equal(tokenIn, limitOrder.TokenInDenom)
+			s.Require().Equal(tokenOut, limitOrder.TokenOutDenom)
+
+			limitOrders, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, 0)
+			s.Require().NoError(err)
+			s.Require().Len(limitOrders, 1)
+			s.Require().Equal(limitOrder, limitOrders[0])
+
+			// Limit orders cannot be added to.
+			s.FundAcc(owner, tc.tokensProvided)
+			_, _, _, err = s.App.ConcentratedLiquidityKeeper.AddToPosition(s.Ctx, owner, positionData.ID, tc.tokensProvided.AmountOf(ETH), tc.tokensProvided.AmountOf(USDC), osmomath.ZeroInt(), osmomath.ZeroInt())
+			s.Require().ErrorIs(err, types.LimitOrderAddToPositionError{PositionId: positionData.ID})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFillLimitOrders() {
+	tests := map[string]struct {
+		tokenProvided sdk.Coin
+		tokenIn       sdk.Coin
+		sellsToken0   bool
+		expectFilled  bool
+	}{
+		"sell token0, swap fully crosses the order": {
+			tokenProvided: sdk.NewCoin(ETH, osmomath.NewInt(1000)),
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(3000000000)),
+			sellsToken0:   true,
+			expectFilled:  true,
+		},
+		"sell token0, swap does not reach the order": {
+			tokenProvided: sdk.NewCoin(ETH, osmomath.NewInt(1000)),
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(1000000)),
+			sellsToken0:   true,
+		},
+		"sell token0, swap moves away from the order": {
+			tokenProvided: sdk.NewCoin(ETH, osmomath.NewInt(1000)),
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(600000)),
+			sellsToken0:   true,
+		},
+		"sell token1, swap fully crosses the order": {
+			tokenProvided: sdk.NewCoin(USDC, osmomath.NewInt(5000000)),
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(600000)),
+			expectFilled:  true,
+		},
+		"sell token1, swap does not reach the order": {
+			tokenProvided: sdk.NewCoin(USDC, osmomath.NewInt(5000000)),
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(100)),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			pool := s.setupLimitOrderPool()
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenProvided))
+
+			lowerTick, upperTick := limitOrderTicks(pool.GetCurrentTick(), tc.sellsToken0)
+			positionData, err := s.App.ConcentratedLiquidityKeeper.CreateLimitOrder(s.Ctx, pool.GetId(), owner, sdk.NewCoins(tc.tokenProvided), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+			s.Require().NoError(err)
+
+			// The open limit order is exported along with its position.
+			genesis := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+			for _, data := range genesis.PositionData {
+				if data.Position.PositionId == positionData.ID {
+					s.Require().NotNil(data.LimitOrder)
+				} else {
+					s.Require().Nil(data.LimitOrder)
+				}
+			}
+
+			tokenOutDenom := USDC
+			if tc.tokenIn.Denom == USDC {
+				tokenOutDenom = ETH
+			}
+
+			swapper := s.TestAccs[2]
+			s.FundAcc(swapper, sdk.NewCoins(tc.tokenIn))
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tc.tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+			s.Require().NoError(err)
+
+			// The swap fills the order itself, so the pool is not left to be filled at the end of the block.
+			s.Require().False(s.isPoolMarkedForLimitOrderFills(pool.GetId()))
+
+			limitOrders, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			filledLimitOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserFilledLimitOrdersSerialized(s.Ctx, owner, nil)
+			s.Require().NoError(err)
+
+			if !tc.expectFilled {
+				s.Require().Len(limitOrders, 1)
+				s.Require().Empty(filledLimitOrders)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtFillLimitOrder, 0)
+
+				_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+				s.Require().NoError(err)
+				return
+			}
+
+			s.Require().Empty(limitOrders)
+			s.Require().Len(filledLimitOrders, 1)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtFillLimitOrder, 1)
+
+			// The position has been withdrawn to the owner, entirely in the token it buys.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionData.ID})
+
+			filledLimitOrder := filledLimitOrders[0]
+			s.Require().Equal(positionData.ID, filledLimitOrder.LimitOrder.PositionId)
+			s.Require().Equal(owner.String(), filledLimitOrder.Address)
+			s.Require().Equal(s.Ctx.BlockHeight(), filledLimitOrder.FilledHeight)
+			s.Require().Len(filledLimitOrder.TokensOut, 1)
+			s.Require().Equal(filledLimitOrder.LimitOrder.TokenOutDenom, filledLimitOrder.TokensOut[0].Denom)
+			s.Require().True(filledLimitOrder.TokensOut[0].Amount.IsPositive())
+
+			ownerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().True(ownerBalance.IsAllGTE(filledLimitOrder.TokensOut))
+
+			// The filled limit order is exported.
+			genesis = s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+			s.Require().Equal(filledLimitOrders, genesis.FilledLimitOrders)
+		})
+	}
+}
+
+// isPoolMarkedForLimitOrderFills returns true if the pool is marked as having limit orders to fill at the end of the block.
+func (s *KeeperTestSuite) isPoolMarkedForLimitOrderFills(poolId uint64) bool {
+	return s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Has(types.KeyPoolLimitOrdersToFill(poolId))
+}
+
+func (s *KeeperTestSuite) TestFillLimitOrdersGas() {
+	tokenProvided := sdk.NewCoin(ETH, osmomath.NewInt(1000))
+	tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(3000000000))
+
+	// crossingSwapGas creates the given number of limit orders selling token0 right above the current tick, and
+	// returns the gas consumed by a swap that fully crosses them, along with the pool and the owner of the orders.
+	crossingSwapGas := func(numOrders int) (uint64, types.ConcentratedPoolExtension, sdk.AccAddress) {
+		pool := s.setupLimitOrderPool()
+		owner := s.TestAccs[1]
+		lowerTick, upperTick := limitOrderTicks(pool.GetCurrentTick(), true)
+		for i := 0; i < numOrders; i++ {
+			s.FundAcc(owner, sdk.NewCoins(tokenProvided))
+			_, err := s.App.ConcentratedLiquidityKeeper.CreateLimitOrder(s.Ctx, pool.GetId(), owner, sdk.NewCoins(tokenProvided), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+			s.Require().NoError(err)
+		}
+
+		swapper := s.TestAccs[2]
+		s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+		pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		gasBefore := s.Ctx.GasMeter().GasConsumed()
+		_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, ETH, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+		return s.Ctx.GasMeter().GasConsumed() - gasBefore, pool, owner
+	}
+
+	// The gas cost of a swap is bounded by the number of limit orders it fills, and does not grow with
+	// the number of orders it crosses beyond those.
+	numOrders := types.MaxLimitOrdersFilledPerSwap + types.MaxLimitOrdersFilledPerBlock + 10
+	fewerOrdersGas, _, _ := crossingSwapGas(types.MaxLimitOrdersFilledPerSwap + 10)
+	manyOrdersGas, pool, owner := crossingSwapGas(numOrders)
+	s.Require().InEpsilon(fewerOrdersGas, manyOrdersGas, 0.001)
+
+	// The swap fills MaxLimitOrdersFilledPerSwap orders and marks the pool to have the rest filled at the end of the block.
+	limitOrders, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(limitOrders, numOrders-types.MaxLimitOrdersFilledPerSwap)
+	s.Require().True(s.isPoolMarkedForLimitOrderFills(pool.GetId()))
+
+	// At most MaxLimitOrdersFilledPerBlock orders are filled at the end of a block, and the pool stays marked
+	// until the orders left over are filled at the end of the next block.
+	s.App.ConcentratedLiquidityKeeper.FillLimitOrders(s.Ctx)
+	limitOrders, err = s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(limitOrders, numOrders-types.MaxLimitOrdersFilledPerSwap-types.MaxLimitOrdersFilledPerBlock)
+	s.Require().True(s.isPoolMarkedForLimitOrderFills(pool.GetId()))
+
+	s.App.ConcentratedLiquidityKeeper.FillLimitOrders(s.Ctx)
+	limitOrders, err = s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(limitOrders)
+	s.Require().False(s.isPoolMarkedForLimitOrderFills(pool.GetId()))
+
+	// Only the latest MaxFilledLimitOrdersPerOwner filled orders are kept.
+	filledLimitOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserFilledLimitOrdersSerialized(s.Ctx, owner, &query.PageRequest{Limit: uint64(numOrders + 1)})
+	s.Require().NoError(err)
+	s.Require().Len(filledLimitOrders, types.MaxFilledLimitOrdersPerOwner)
+}
+
+func (s *KeeperTestSuite) TestFillLimitOrderFailure() {
+	pool := s.setupLimitOrderPool()
+
+	owner := s.TestAccs[1]
+	tokenProvided := sdk.NewCoin(ETH, osmomath.NewInt(1000))
+	s.FundAcc(owner, sdk.NewCoins(tokenProvided))
+	lowerTick, upperTick := limitOrderTicks(pool.GetCurrentTick(), true)
+	positionData, err := s.App.ConcentratedLiquidityKeeper.CreateLimitOrder(s.Ctx, pool.GetId(), owner, sdk.NewCoins(tokenProvided), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	s.Require().NoError(err)
+
+	// Tie the position to an active lock, so that it cannot be withdrawn.
+	lockId := s.LockTokens(owner, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(10))), time.Hour)
+	s.App.ConcentratedLiquidityKeeper.SetPositionIdToLock(s.Ctx, positionData.ID, lockId)
+
+	swapper := s.TestAccs[2]
+	tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(3000000000))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, ETH, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+
+	// The owner is notified that the order is kept as a regular position.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtFillLimitOrder, 0)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtLimitOrderFillFailed, 1)
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtLimitOrderFillFailed {
+			continue
+		}
+		ownerAttr, found := event.GetAttribute(types.AttributeKeyOwner)
+		s.Require().True(found)
+		s.Require().Equal(owner.String(), ownerAttr.Value)
+		_, found = event.GetAttribute(types.AttributeKeyError)
+		s.Require().True(found)
+	}
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+	s.Require().NoError(err)
+	limitOrders, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(limitOrders)
+	filledLimitOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserFilledLimitOrdersSerialized(s.Ctx, owner, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(filledLimitOrders)
+}
+
+func (s *KeeperTestSuite) TestSetFilledLimitOrderRetention() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+
+	// Record one more order than is kept, filled at increasing heights and in decreasing position id order.
+	numOrders := types.MaxFilledLimitOrdersPerOwner + 1
+	for i := 0; i < numOrders; i++ {
+		filledLimitOrder := model.FilledLimitOrder{
+			LimitOrder:   model.LimitOrder{PositionId: uint64(numOrders - i)},
+			Address:      owner.String(),
+			FilledHeight: int64(i + 1),
+		}
+		s.App.ConcentratedLiquidityKeeper.SetFilledLimitOrder(s.Ctx, owner, filledLimitOrder)
+
+		// Setting the same record again does not count it twice.
+		s.App.ConcentratedLiquidityKeeper.SetFilledLimitOrder(s.Ctx, owner, filledLimitOrder)
+	}
+
+	// The order filled the earliest is removed, and the rest are ordered by fill height.
+	filledLimitOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserFilledLimitOrdersSerialized(s.Ctx, owner, &query.PageRequest{Limit: uint64(numOrders + 1)})
+	s.Require().NoError(err)
+	s.Require().Len(filledLimitOrders, types.MaxFilledLimitOrdersPerOwner)
+	for i, filledLimitOrder := range filledLimitOrders {
+		s.Require().Equal(int64(i+2), filledLimitOrder.FilledHeight)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/math"
 	types "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v25/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
 )
 
 const noUnderlyingLockId = uint64(0)
@@ -56,6 +57,11 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return CreatePositionData{}, err
 	}
 
+	// Positions can't be created on a paused pool, but they can still be withdrawn.
+	if k.poolmanagerKeeper.IsPoolPaused(ctx, poolId) {
+		return CreatePositionData{}, poolmanagertypes.PoolPausedError{PoolId: poolId}
+	}
+
 	for _, token := range tokensProvided {
 		if token.Denom != pool.GetToken0() && token.Denom != pool.GetToken1() {
 			return CreatePositionData{}, errors.New("token provided is not one of the pool tokens")
@@ -308,6 +314,10 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
 
+		if err := k.removeLimitOrder(ctx, positionId); err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+
 		// Note that here we currently use the iterator based definition to search
 		// for a remaining position in the pool. Since we have removed a position we need to
 		// search if there are more.
@@ -417,6 +427,11 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// Adding to a limit order would re-create it as a regular position, so it is not allowed.
+	if k.isLimitOrder(ctx, positionId) {
+		return 0, osmomath.Int{}, osmomath.Int{}, types.LimitOrderAddToPositionError{PositionId: position.PositionId}
+	}
+
 	// Withdraw full position.
 	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
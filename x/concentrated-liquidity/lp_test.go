@@ -14,6 +14,7 @@ import (
 	clmodel "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
 	types "github.com/osmosis-labs/osmosis/v25/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
 )
 
 type lpTest struct {
@@ -417,6 +418,24 @@ func (s *KeeperTestSuite) createPositionWithLockState(ls lockState, poolId uint6
 	return fullRangePositionData.ID, fullRangePositionData.Liquidity
 }
 
+// validates that positions can't be created on a paused pool, but can still be withdrawn.
+func (s *KeeperTestSuite) TestPausedPoolPositions() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+
+	s.Require().NoError(s.App.PoolManagerKeeper.PausePool(s.Ctx, pool.GetId()))
+
+	s.FundAcc(s.TestAccs[1], DefaultCoins)
+	_, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, poolmanagertypes.PoolPausedError{PoolId: pool.GetId()})
+
+	liquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, liquidity)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestWithdrawPosition() {
 	defaultTimeElapsed := time.Hour * 24
 	uptimeHelper := getExpectedUptimes()
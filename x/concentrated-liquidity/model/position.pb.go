@@ -11,6 +11,7 @@ package model
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -265,10 +266,183 @@ func (m *PositionWithPeriodLock) GetLocks() types1.PeriodLock {
 	return types1.PeriodLock{}
 }
 
+// LimitOrder marks a position that spans a single tick spacing as a limit
+// order. The position is created entirely in token_in_denom, and once a swap
+// fully crosses it, it is entirely in token_out_denom and is withdrawn to its
+// owner.
+type LimitOrder struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	PoolId     uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick  int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// token_in_denom is the denom the order sells into the pool.
+	TokenInDenom string `protobuf:"bytes,5,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	// token_out_denom is the denom the order buys from the pool.
+	TokenOutDenom string `protobuf:"bytes,6,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1363e25aa5179fb1, []int{3}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// FilledLimitOrder records a limit order that was filled by a swap and
+// withdrawn to its owner.
+type FilledLimitOrder struct {
+	LimitOrder LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order" yaml:"limit_order"`
+	// address is the owner the order was withdrawn to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// tokens_out is the liquidity withdrawn from the order. Spread rewards and
+	// incentives are claimed alongside it and are not included.
+	TokensOut    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	FilledHeight int64                                    `protobuf:"varint,4,opt,name=filled_height,json=filledHeight,proto3" json:"filled_height,omitempty" yaml:"filled_height"`
+	FilledTime   time.Time                                `protobuf:"bytes,5,opt,name=filled_time,json=filledTime,proto3,stdtime" json:"filled_time" yaml:"filled_time"`
+}
+
+func (m *FilledLimitOrder) Reset()         { *m = FilledLimitOrder{} }
+func (m *FilledLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*FilledLimitOrder) ProtoMessage()    {}
+func (*FilledLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1363e25aa5179fb1, []int{4}
+}
+func (m *FilledLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilledLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilledLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilledLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilledLimitOrder.Merge(m, src)
+}
+func (m *FilledLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilledLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilledLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilledLimitOrder proto.InternalMessageInfo
+
+func (m *FilledLimitOrder) GetLimitOrder() LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return LimitOrder{}
+}
+
+func (m *FilledLimitOrder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FilledLimitOrder) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
+func (m *FilledLimitOrder) GetFilledHeight() int64 {
+	if m != nil {
+		return m.FilledHeight
+	}
+	return 0
+}
+
+func (m *FilledLimitOrder) GetFilledTime() time.Time {
+	if m != nil {
+		return m.FilledTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
 	proto.RegisterType((*FullPositionBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullPositionBreakdown")
 	proto.RegisterType((*PositionWithPeriodLock)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithPeriodLock")
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
+	proto.RegisterType((*FilledLimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.FilledLimitOrder")
 }
 
 func init() {
@@ -276,51 +450,67 @@ func init() {
 }
 
 var fileDescriptor_1363e25aa5179fb1 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xfc, 0xb4, 0x9d, 0x48, 0x9f, 0x90, 0x5b, 0x2a, 0x37, 0x85, 0x38, 0x32, 0x42,
-	0x8d, 0x04, 0xf5, 0x90, 0xf0, 0x53, 0x89, 0x65, 0x40, 0x48, 0x95, 0xba, 0x28, 0xa6, 0x08, 0x09,
-	0x21, 0x45, 0x63, 0xcf, 0x34, 0x1d, 0x62, 0x67, 0x5c, 0xcf, 0xa4, 0x25, 0x12, 0x4f, 0xc0, 0xaa,
-	0x2b, 0x5e, 0x80, 0x1d, 0x4f, 0xd2, 0x65, 0x97, 0x88, 0x45, 0x8a, 0xda, 0x37, 0xe8, 0x13, 0xa0,
-	0x19, 0x8f, 0xed, 0x80, 0xca, 0xdf, 0xa2, 0x2b, 0xcf, 0xdc, 0x33, 0xe7, 0x9c, 0xeb, 0x7b, 0xaf,
-	0x2e, 0x78, 0xc0, 0x78, 0xc4, 0x38, 0xe5, 0x30, 0x60, 0xa3, 0x80, 0x8c, 0x44, 0x82, 0x04, 0xc1,
-	0x21, 0xdd, 0x1f, 0x53, 0x4c, 0xc5, 0x04, 0x1e, 0x74, 0x7c, 0x22, 0x50, 0x07, 0xc6, 0x8c, 0x53,
-	0x41, 0xd9, 0xc8, 0x8d, 0x13, 0x26, 0x98, 0x79, 0x5b, 0xb3, 0xdc, 0x4b, 0x59, 0xae, 0x66, 0x35,
-	0xec, 0x01, 0x63, 0x83, 0x90, 0x40, 0x45, 0xf2, 0xc7, 0xbb, 0x50, 0xd0, 0x88, 0x70, 0x81, 0xa2,
-	0x38, 0xd5, 0x69, 0x2c, 0x0d, 0xd8, 0x80, 0xa9, 0x23, 0x94, 0x27, 0x1d, 0x6d, 0x06, 0x4a, 0x1e,
-	0xfa, 0x88, 0x93, 0x3c, 0x83, 0x80, 0x51, 0xed, 0xde, 0x58, 0xc9, 0x72, 0x0e, 0x59, 0x30, 0x1c,
-	0xc7, 0xea, 0x93, 0x42, 0xce, 0x87, 0x32, 0x98, 0xdf, 0xd6, 0xb9, 0x9a, 0x1b, 0xa0, 0x9e, 0xe5,
-	0xdd, 0xa7, 0xd8, 0x32, 0x5a, 0x46, 0xbb, 0xd2, 0x5b, 0xbe, 0x98, 0xda, 0xe6, 0x04, 0x45, 0xe1,
-	0x63, 0x67, 0x06, 0x74, 0x3c, 0x90, 0xdd, 0x36, 0xb1, 0x79, 0x17, 0xcc, 0x21, 0x8c, 0x13, 0xc2,
-	0xb9, 0xf5, 0x5f, 0xcb, 0x68, 0x2f, 0xf4, 0xcc, 0x8b, 0xa9, 0xfd, 0x7f, 0x4a, 0xd2, 0x80, 0xe3,
-	0x65, 0x4f, 0xcc, 0x3b, 0x60, 0x2e, 0x66, 0x2c, 0x94, 0x16, 0x65, 0x65, 0x31, 0xf3, 0x5a, 0x03,
-	0x8e, 0x57, 0x93, 0xa7, 0x4d, 0x6c, 0xde, 0x04, 0x20, 0x64, 0x87, 0x24, 0xe9, 0x0b, 0x1a, 0x0c,
-	0xad, 0x4a, 0xcb, 0x68, 0x97, 0xbd, 0x05, 0x15, 0xd9, 0xa1, 0xc1, 0x50, 0xc2, 0xe3, 0x38, 0xce,
-	0xe0, 0x6a, 0x0a, 0xab, 0x88, 0x82, 0x5f, 0x82, 0x85, 0xb7, 0x8c, 0x8e, 0xfa, 0xb2, 0x8e, 0x56,
-	0xad, 0x65, 0xb4, 0xeb, 0xdd, 0x86, 0x9b, 0x16, 0xd9, 0xcd, 0x8a, 0xec, 0xee, 0x64, 0x45, 0xee,
-	0xdd, 0x38, 0x9e, 0xda, 0xa5, 0x8b, 0xa9, 0x7d, 0x2d, 0x4d, 0x26, 0xa7, 0x3a, 0x47, 0xa7, 0xb6,
-	0xe1, 0xcd, 0xcb, 0xbb, 0x7c, 0x2c, 0x65, 0xf3, 0xe6, 0x59, 0x73, 0xea, 0x8f, 0x37, 0x24, 0xf5,
-	0xeb, 0xd4, 0x5e, 0x4d, 0x7b, 0xc1, 0xf1, 0xd0, 0xa5, 0x0c, 0x46, 0x48, 0xec, 0xb9, 0x5b, 0x64,
-	0x80, 0x82, 0xc9, 0x53, 0x12, 0x14, 0xca, 0x39, 0xdb, 0xf1, 0x0a, 0x25, 0xe7, 0x63, 0x15, 0x5c,
-	0x7f, 0x36, 0x0e, 0xc3, 0xac, 0x21, 0xbd, 0x84, 0xa0, 0x21, 0x66, 0x87, 0x23, 0xf3, 0x39, 0x98,
-	0xcf, 0xca, 0xad, 0xda, 0x52, 0xef, 0x42, 0xf7, 0xaf, 0x46, 0xca, 0xcd, 0xb5, 0x2a, 0x32, 0x41,
-	0x2f, 0x97, 0x31, 0x7d, 0x50, 0x43, 0x9c, 0x13, 0x71, 0x4f, 0xb5, 0xac, 0xde, 0x5d, 0x71, 0xd3,
-	0xcc, 0x5d, 0x39, 0x45, 0x39, 0xfd, 0x09, 0xa3, 0xa3, 0x1e, 0x94, 0xd4, 0xcf, 0xa7, 0xf6, 0xda,
-	0x80, 0x8a, 0xbd, 0xb1, 0xef, 0x06, 0x2c, 0x82, 0x7a, 0xe4, 0xd2, 0xcf, 0x3a, 0xc7, 0x43, 0x28,
-	0x26, 0x31, 0xe1, 0x8a, 0xe0, 0x69, 0xe5, 0xdc, 0xa3, 0xa3, 0x1a, 0x7d, 0x15, 0x1e, 0x1d, 0xf3,
-	0x3d, 0xb0, 0x82, 0x10, 0xd1, 0x08, 0xf9, 0x21, 0xe9, 0xf3, 0x38, 0x21, 0x08, 0xf7, 0x13, 0x72,
-	0x88, 0x12, 0xcc, 0xad, 0x4a, 0xab, 0xfc, 0x7b, 0xd7, 0x35, 0xdd, 0x70, 0x3b, 0x6d, 0xcb, 0xaf,
-	0x84, 0x1c, 0x6f, 0x39, 0x87, 0x5e, 0x28, 0xc4, 0x4b, 0x01, 0x73, 0x1f, 0x2c, 0x15, 0x24, 0xaa,
-	0x1a, 0x41, 0x0f, 0x08, 0xb7, 0xaa, 0x7f, 0x72, 0xbe, 0xa5, 0x9d, 0x57, 0x7f, 0x76, 0x2e, 0x44,
-	0x1c, 0x6f, 0x31, 0x0f, 0x6f, 0xe6, 0x51, 0x69, 0xb9, 0xcb, 0x92, 0x5d, 0x42, 0x05, 0xc1, 0xb3,
-	0x96, 0xb5, 0x7f, 0xb4, 0xbc, 0x4c, 0xc4, 0xf1, 0x16, 0xf3, 0x70, 0x61, 0xe9, 0x7c, 0x32, 0xc0,
-	0x72, 0x36, 0x48, 0xaf, 0xa8, 0xd8, 0xdb, 0x26, 0x09, 0x65, 0x78, 0x8b, 0x05, 0xc3, 0xab, 0x98,
-	0xcc, 0x47, 0xa0, 0x2a, 0x37, 0x14, 0xd7, 0x83, 0xd9, 0xc8, 0xf5, 0xd2, 0xf5, 0xe5, 0x16, 0xee,
-	0x9a, 0x9a, 0x3e, 0xef, 0xbd, 0x39, 0x3e, 0x6b, 0x1a, 0x27, 0x67, 0x4d, 0xe3, 0xdb, 0x59, 0xd3,
-	0x38, 0x3a, 0x6f, 0x96, 0x4e, 0xce, 0x9b, 0xa5, 0x2f, 0xe7, 0xcd, 0xd2, 0xeb, 0xde, 0xcc, 0x50,
-	0x69, 0xb1, 0xf5, 0x10, 0xf9, 0x3c, 0xbb, 0xc0, 0x83, 0xee, 0x43, 0xf8, 0xee, 0x87, 0x95, 0xbe,
-	0x5e, 0xec, 0xf4, 0x88, 0x61, 0x12, 0xfa, 0x35, 0xb5, 0x2f, 0xee, 0x7f, 0x0f, 0x00, 0x00, 0xff,
-	0xff, 0xcd, 0x55, 0xc1, 0x26, 0x01, 0x06, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x3f, 0x89, 0xc7, 0x6d, 0x69, 0xb7, 0x49, 0xb4, 0x71, 0xc1, 0x6b, 0x0d, 0x42,
+	0xb5, 0x04, 0xd9, 0xc5, 0x21, 0x50, 0x09, 0x09, 0x21, 0x2d, 0xa5, 0x22, 0x52, 0xa4, 0x94, 0xa5,
+	0x08, 0x09, 0x90, 0xac, 0xf5, 0xce, 0xc4, 0x1e, 0xbc, 0xbb, 0xe3, 0xee, 0x8c, 0x13, 0x22, 0x21,
+	0x71, 0xcf, 0x55, 0xaf, 0x78, 0x01, 0xee, 0xfa, 0x24, 0xbd, 0x41, 0xea, 0x25, 0xe2, 0x62, 0x83,
+	0x92, 0x37, 0xf0, 0x13, 0xa0, 0xf9, 0xd9, 0x1f, 0x57, 0x85, 0xa4, 0x95, 0x7a, 0xe5, 0x3d, 0xe7,
+	0x9b, 0xef, 0x3b, 0x67, 0xcf, 0x39, 0x7b, 0xc6, 0x60, 0x8f, 0xb2, 0x98, 0x32, 0xc2, 0xdc, 0x90,
+	0x26, 0x21, 0x4e, 0x78, 0x1a, 0x70, 0x8c, 0x22, 0xf2, 0x78, 0x4e, 0x10, 0xe1, 0xa7, 0xee, 0xf1,
+	0x60, 0x84, 0x79, 0x30, 0x70, 0x67, 0x94, 0x11, 0x4e, 0x68, 0xe2, 0xcc, 0x52, 0xca, 0xa9, 0xf9,
+	0x9e, 0x66, 0x39, 0x2f, 0x65, 0x39, 0x9a, 0xd5, 0xb1, 0xc7, 0x94, 0x8e, 0x23, 0xec, 0x4a, 0xd2,
+	0x68, 0x7e, 0xe4, 0x72, 0x12, 0x63, 0xc6, 0x83, 0x78, 0xa6, 0x74, 0x3a, 0x1b, 0x63, 0x3a, 0xa6,
+	0xf2, 0xd1, 0x15, 0x4f, 0xda, 0xdb, 0x0d, 0xa5, 0xbc, 0x3b, 0x0a, 0x18, 0x2e, 0x32, 0x08, 0x29,
+	0xd1, 0xd1, 0x3b, 0xdb, 0x79, 0xce, 0x11, 0x0d, 0xa7, 0xf3, 0x99, 0xfc, 0x51, 0x10, 0xfc, 0xad,
+	0x06, 0xd6, 0x1f, 0xea, 0x5c, 0xcd, 0x7b, 0xa0, 0x9d, 0xe7, 0x3d, 0x24, 0xc8, 0x32, 0x7a, 0x46,
+	0xbf, 0xee, 0x6d, 0x2d, 0x32, 0xdb, 0x3c, 0x0d, 0xe2, 0xe8, 0x53, 0x58, 0x01, 0xa1, 0x0f, 0x72,
+	0x6b, 0x1f, 0x99, 0x1f, 0x80, 0xb5, 0x00, 0xa1, 0x14, 0x33, 0x66, 0xad, 0xf6, 0x8c, 0x7e, 0xcb,
+	0x33, 0x17, 0x99, 0x7d, 0x43, 0x91, 0x34, 0x00, 0xfd, 0xfc, 0x88, 0xf9, 0x3e, 0x58, 0x9b, 0x51,
+	0x1a, 0x89, 0x10, 0x35, 0x19, 0xa2, 0x72, 0x5a, 0x03, 0xd0, 0x6f, 0x8a, 0xa7, 0x7d, 0x64, 0xbe,
+	0x03, 0x40, 0x44, 0x4f, 0x70, 0x3a, 0xe4, 0x24, 0x9c, 0x5a, 0xf5, 0x9e, 0xd1, 0xaf, 0xf9, 0x2d,
+	0xe9, 0x79, 0x44, 0xc2, 0xa9, 0x80, 0xe7, 0xb3, 0x59, 0x0e, 0x37, 0x14, 0x2c, 0x3d, 0x12, 0xfe,
+	0x16, 0xb4, 0x7e, 0xa2, 0x24, 0x19, 0x8a, 0x3a, 0x5a, 0xcd, 0x9e, 0xd1, 0x6f, 0xef, 0x76, 0x1c,
+	0x55, 0x64, 0x27, 0x2f, 0xb2, 0xf3, 0x28, 0x2f, 0xb2, 0xf7, 0xf6, 0xb3, 0xcc, 0x5e, 0x59, 0x64,
+	0xf6, 0x4d, 0x95, 0x4c, 0x41, 0x85, 0x4f, 0xce, 0x6c, 0xc3, 0x5f, 0x17, 0xb6, 0x38, 0x2c, 0x64,
+	0x8b, 0xe6, 0x59, 0x6b, 0xf2, 0x8d, 0xef, 0x09, 0xea, 0xdf, 0x99, 0x7d, 0x47, 0xf5, 0x82, 0xa1,
+	0xa9, 0x43, 0xa8, 0x1b, 0x07, 0x7c, 0xe2, 0x1c, 0xe0, 0x71, 0x10, 0x9e, 0xde, 0xc7, 0x61, 0xa9,
+	0x5c, 0xb0, 0xa1, 0x5f, 0x2a, 0xc1, 0xdf, 0x1b, 0x60, 0xf3, 0xc1, 0x3c, 0x8a, 0xf2, 0x86, 0x78,
+	0x29, 0x0e, 0xa6, 0x88, 0x9e, 0x24, 0xe6, 0xd7, 0x60, 0x3d, 0x2f, 0xb7, 0x6c, 0x4b, 0x7b, 0xd7,
+	0x75, 0xae, 0x34, 0x52, 0x4e, 0xa1, 0x55, 0x17, 0x09, 0xfa, 0x85, 0x8c, 0x39, 0x02, 0xcd, 0x80,
+	0x31, 0xcc, 0x3f, 0x94, 0x2d, 0x6b, 0xef, 0x6e, 0x3b, 0x2a, 0x73, 0x47, 0x4c, 0x51, 0x41, 0xff,
+	0x82, 0x92, 0xc4, 0x73, 0x05, 0xf5, 0xe9, 0x99, 0x7d, 0x77, 0x4c, 0xf8, 0x64, 0x3e, 0x72, 0x42,
+	0x1a, 0xbb, 0x7a, 0xe4, 0xd4, 0xcf, 0x0e, 0x43, 0x53, 0x97, 0x9f, 0xce, 0x30, 0x93, 0x04, 0x5f,
+	0x2b, 0x17, 0x31, 0x06, 0x56, 0xed, 0x0d, 0xc5, 0x18, 0x98, 0xbf, 0x00, 0x2b, 0x8c, 0x02, 0x12,
+	0x07, 0xa3, 0x08, 0x0f, 0xd9, 0x2c, 0xc5, 0x01, 0x1a, 0xa6, 0xf8, 0x24, 0x48, 0x11, 0xb3, 0xea,
+	0xbd, 0xda, 0xff, 0x47, 0xbd, 0xab, 0x1b, 0x6e, 0xab, 0xb6, 0xfc, 0x97, 0x10, 0xf4, 0xb7, 0x0a,
+	0xe8, 0x1b, 0x89, 0xf8, 0x0a, 0x30, 0x1f, 0x83, 0x8d, 0x92, 0x44, 0x64, 0x23, 0xc8, 0x31, 0x66,
+	0x56, 0xe3, 0xb2, 0xc8, 0xef, 0xea, 0xc8, 0x77, 0x5e, 0x8c, 0x5c, 0x8a, 0x40, 0xff, 0x76, 0xe1,
+	0xde, 0x2f, 0xbc, 0x22, 0xe4, 0x11, 0x4d, 0x8f, 0x30, 0xe1, 0x18, 0x55, 0x43, 0x36, 0x5f, 0x31,
+	0xe4, 0xcb, 0x44, 0xa0, 0x7f, 0xbb, 0x70, 0x97, 0x21, 0xe1, 0x1f, 0x06, 0xd8, 0xca, 0x07, 0xe9,
+	0x3b, 0xc2, 0x27, 0x0f, 0x71, 0x4a, 0x28, 0x3a, 0xa0, 0xe1, 0xf4, 0x4d, 0x4c, 0xe6, 0x27, 0xa0,
+	0x21, 0x36, 0x14, 0xd3, 0x83, 0xd9, 0x29, 0xf4, 0xd4, 0xfa, 0x72, 0xca, 0xe8, 0x9a, 0xaa, 0x8e,
+	0xc3, 0x8b, 0x55, 0x00, 0x0e, 0x48, 0x4c, 0xf8, 0x61, 0x8a, 0x70, 0xfa, 0xfa, 0xdb, 0xac, 0xb2,
+	0x9f, 0x56, 0x2f, 0xdd, 0x4f, 0x7b, 0x4b, 0xfb, 0x49, 0x8c, 0x79, 0xcd, 0xdb, 0x5c, 0x64, 0xf6,
+	0x2d, 0xfd, 0xa1, 0x17, 0x18, 0xac, 0xae, 0xad, 0xbd, 0xa5, 0xb5, 0x55, 0x7f, 0x91, 0x55, 0x62,
+	0xb0, 0xba, 0xcd, 0x3e, 0x07, 0x37, 0x38, 0x9d, 0xe2, 0x64, 0x48, 0x92, 0x21, 0xc2, 0x09, 0x8d,
+	0xe5, 0xc2, 0x6b, 0x79, 0xdb, 0x8b, 0xcc, 0xde, 0x54, 0xcc, 0x65, 0x1c, 0xfa, 0xd7, 0xa4, 0x63,
+	0x3f, 0xb9, 0x2f, 0x4c, 0xd3, 0x03, 0x6f, 0xa9, 0x03, 0x74, 0xce, 0xb5, 0x42, 0x53, 0x2a, 0x74,
+	0x16, 0x99, 0xbd, 0x55, 0x55, 0x28, 0x0e, 0x40, 0xff, 0xba, 0xf4, 0x1c, 0xce, 0xb9, 0xd4, 0x80,
+	0x7f, 0xd6, 0xc0, 0xcd, 0x07, 0x24, 0x8a, 0x30, 0xaa, 0xd4, 0x3a, 0x01, 0xed, 0x48, 0x58, 0x43,
+	0x2a, 0x4c, 0x3d, 0x08, 0x83, 0x2b, 0x0e, 0x42, 0xa9, 0xe3, 0x75, 0xf4, 0x88, 0x9a, 0xf9, 0x9a,
+	0x2c, 0x34, 0xa1, 0x0f, 0xa2, 0x32, 0xde, 0xab, 0x5d, 0x38, 0xbf, 0x02, 0x20, 0xdf, 0x81, 0x89,
+	0xd7, 0xb2, 0x6a, 0x97, 0x7d, 0x27, 0x5f, 0xea, 0x24, 0x6e, 0x55, 0x0a, 0x22, 0xa9, 0xf0, 0xe9,
+	0x99, 0xdd, 0xbf, 0xe2, 0x7e, 0x62, 0x7e, 0x4b, 0x11, 0x0f, 0xe7, 0xdc, 0xfc, 0x0c, 0x5c, 0x3f,
+	0x92, 0x25, 0x1b, 0x4e, 0x30, 0x19, 0x4f, 0xb8, 0xee, 0xb8, 0xb5, 0xc8, 0xec, 0x0d, 0xfd, 0x31,
+	0x56, 0x61, 0xe8, 0x5f, 0x53, 0xf6, 0x57, 0xd2, 0x34, 0x7f, 0x00, 0x6d, 0x8d, 0xcb, 0x7b, 0xac,
+	0x71, 0xe9, 0x3d, 0xd6, 0x5d, 0x2e, 0x63, 0x85, 0xac, 0x6e, 0x32, 0xa0, 0x3c, 0x82, 0xe0, 0xfd,
+	0xf8, 0xec, 0xbc, 0x6b, 0x3c, 0x3f, 0xef, 0x1a, 0xff, 0x9c, 0x77, 0x8d, 0x27, 0x17, 0xdd, 0x95,
+	0xe7, 0x17, 0xdd, 0x95, 0xbf, 0x2e, 0xba, 0x2b, 0xdf, 0x7b, 0x95, 0x57, 0xd5, 0x9d, 0xdc, 0x89,
+	0x82, 0x11, 0xcb, 0x0d, 0xf7, 0x78, 0xf7, 0x63, 0xf7, 0xe7, 0xa5, 0x3f, 0x42, 0x3b, 0xe5, 0x3f,
+	0xa1, 0x98, 0x22, 0x1c, 0x8d, 0x9a, 0x32, 0xbb, 0x8f, 0xfe, 0x1d, 0x00, 0x7d, 0xcc, 0xa1, 0x1e,
+	0x37, 0x09, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -529,6 +719,130 @@ func (m *PositionWithPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilledLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FilledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FilledTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintPosition(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.FilledHeight != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.FilledHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPosition(v)
 	base := offset
@@ -615,6 +929,61 @@ func (m *PositionWithPeriodLock) Size() (n int) {
 	return n
 }
 
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	return n
+}
+
+func (m *FilledLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
+	if m.FilledHeight != 0 {
+		n += 1 + sovPosition(uint64(m.FilledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FilledTime)
+	n += 1 + l + sovPosition(uint64(l))
+	return n
+}
+
 func sovPosition(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1213,6 +1582,397 @@ func (m *PositionWithPeriodLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilledLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilledLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledHeight", wireType)
+			}
+			m.FilledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FilledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPosition(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -831,6 +831,9 @@ func (k Keeper) updatePoolForSwap(
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
 
+	// Fill the limit orders fully crossed by the swap, so that they cannot be swapped back.
+	k.fillCrossedLimitOrders(ctx, poolId, pool.GetCurrentTick())
+
 	return err
 }
 
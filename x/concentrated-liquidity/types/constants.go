@@ -28,6 +28,15 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// The maximum number of crossed limit orders that are filled and withdrawn by a swap.
+	// Orders left over are filled at the end of the block.
+	MaxLimitOrdersFilledPerSwap = 10
+	// The maximum number of limit orders that are filled and withdrawn at the end of a block.
+	// Orders left over are filled at the end of the next block.
+	MaxLimitOrdersFilledPerBlock = 100
+	// The maximum number of filled limit orders recorded per address. The oldest record is
+	// removed once an address goes over it.
+	MaxFilledLimitOrdersPerOwner = 100
 )
 
 var (
@@ -69,4 +78,7 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+	// The minimum amount of the token provided to create a limit order, which is a
+	// single unit of tokens with 6 decimals.
+	DefaultLimitOrderMinAmount = osmomath.NewInt(1_000_000)
 )
@@ -956,3 +956,57 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type LimitOrderInvalidTickRangeError struct {
+	LowerTick   int64
+	UpperTick   int64
+	TickSpacing uint64
+}
+
+func (e LimitOrderInvalidTickRangeError) Error() string {
+	return fmt.Sprintf("limit order must span a single tick spacing (%d), got lower tick (%d) and upper tick (%d)", e.TickSpacing, e.LowerTick, e.UpperTick)
+}
+
+type LimitOrderTokensProvidedError struct {
+	TokensProvided sdk.Coins
+}
+
+func (e LimitOrderTokensProvidedError) Error() string {
+	return fmt.Sprintf("limit order must be provided with exactly one token, got (%s)", e.TokensProvided)
+}
+
+type LimitOrderInRangeError struct {
+	LowerTick    int64
+	UpperTick    int64
+	CurrentTick  int64
+	TokenInDenom string
+}
+
+func (e LimitOrderInRangeError) Error() string {
+	return fmt.Sprintf("limit order selling (%s) with lower tick (%d) and upper tick (%d) must be entirely on the other side of the current tick (%d)", e.TokenInDenom, e.LowerTick, e.UpperTick, e.CurrentTick)
+}
+
+type LimitOrderNotFoundError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("position (%d) is not a limit order", e.PositionId)
+}
+
+type LimitOrderAddToPositionError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderAddToPositionError) Error() string {
+	return fmt.Sprintf("cannot add to position (%d) since it is a limit order, create a new limit order instead", e.PositionId)
+}
+
+type LimitOrderBelowMinAmountError struct {
+	TokenProvided sdk.Coin
+	MinAmount     osmomath.Int
+}
+
+func (e LimitOrderBelowMinAmountError) Error() string {
+	return fmt.Sprintf("limit order must be provided with at least (%s) of the token, got (%s)", e.MinAmount, e.TokenProvided)
+}
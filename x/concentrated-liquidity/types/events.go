@@ -15,6 +15,8 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtFillLimitOrder            = "fill_limit_order"
+	TypeEvtLimitOrderFillFailed      = "limit_order_fill_failed"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -50,4 +52,6 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyOwner                                              = "owner"
+	AttributeKeyError                                              = "error"
 )
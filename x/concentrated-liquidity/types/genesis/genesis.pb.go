@@ -179,6 +179,8 @@ type PositionData struct {
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
 	SpreadRewardAccumRecord accum.Record    `protobuf:"bytes,3,opt,name=spread_reward_accum_record,json=spreadRewardAccumRecord,proto3" json:"spread_reward_accum_record"`
 	UptimeAccumRecords      []accum.Record  `protobuf:"bytes,4,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records"`
+	// limit_order is set if the position is an open limit order.
+	LimitOrder *model.LimitOrder `protobuf:"bytes,5,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty" yaml:"limit_order"`
 }
 
 func (m *PositionData) Reset()         { *m = PositionData{} }
@@ -242,17 +244,25 @@ func (m *PositionData) GetUptimeAccumRecords() []accum.Record {
 	return nil
 }
 
+func (m *PositionData) GetLimitOrder() *model.LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return nil
+}
+
 // GenesisState defines the concentrated liquidity module's genesis state.
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containing serialized pool struct and ticks.
-	PoolData                                      []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
-	PositionData                                  []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
-	NextPositionId                                uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
-	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
-	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
-	SpreadFactorPoolIdMigrationThreshold          uint64         `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	PoolData                                      []PoolData               `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	PositionData                                  []PositionData           `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	NextPositionId                                uint64                   `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	NextIncentiveRecordId                         uint64                   `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	IncentivesAccumulatorPoolIdMigrationThreshold uint64                   `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
+	SpreadFactorPoolIdMigrationThreshold          uint64                   `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	FilledLimitOrders                             []model.FilledLimitOrder `protobuf:"bytes,8,rep,name=filled_limit_orders,json=filledLimitOrders,proto3" json:"filled_limit_orders" yaml:"filled_limit_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -337,6 +347,13 @@ func (m *GenesisState) GetSpreadFactorPoolIdMigrationThreshold() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFilledLimitOrders() []model.FilledLimitOrder {
+	if m != nil {
+		return m.FilledLimitOrders
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -403,67 +420,71 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
+	// 1022 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0xa5, 0x4e, 0x8a, 0x04, 0x82, 0x43, 0x5c, 0x28, 0x32, 0x08, 0x88, 0x86, 0x70,
-	0xe1, 0xcb, 0x8c, 0x77, 0xc7, 0xce, 0xd0, 0xf5, 0x8e, 0xd9, 0x19, 0x87, 0xf8, 0xca, 0x1d, 0x09,
-	0x71, 0xe2, 0x27, 0xf0, 0x03, 0x90, 0x38, 0x73, 0xab, 0x10, 0x87, 0x1c, 0x39, 0xad, 0x50, 0x72,
-	0xe0, 0xee, 0x5f, 0x80, 0x76, 0x3e, 0xfc, 0x11, 0x9c, 0xc4, 0xe9, 0x6d, 0xc6, 0xef, 0xfb, 0x3c,
-	0xef, 0x33, 0xef, 0x97, 0x17, 0xec, 0x70, 0xd1, 0xe5, 0x82, 0x09, 0x3f, 0xe0, 0x71, 0x40, 0x63,
-	0x99, 0x10, 0x49, 0xc3, 0x88, 0x7d, 0xd7, 0x67, 0x21, 0x93, 0x03, 0xff, 0xe8, 0x61, 0x8b, 0x4a,
-	0xf2, 0xd0, 0xef, 0xd0, 0x98, 0x0a, 0x26, 0xaa, 0xbd, 0x84, 0x4b, 0x0e, 0x37, 0x0d, 0xa8, 0x3a,
-	0x13, 0x54, 0x35, 0xa0, 0x8d, 0xb5, 0x0e, 0xef, 0x70, 0x85, 0xf0, 0xb3, 0x93, 0x06, 0x6f, 0xdc,
-	0x0d, 0x14, 0xba, 0xa9, 0x0d, 0xfa, 0x62, 0x4d, 0x1d, 0xce, 0x3b, 0x11, 0xf5, 0xd5, 0xad, 0xd5,
-	0x6f, 0xfb, 0x24, 0x1e, 0x18, 0xd3, 0xcb, 0x56, 0x27, 0x09, 0x82, 0x7e, 0x77, 0xa4, 0x4b, 0xdd,
-	0x8c, 0xcb, 0xab, 0x97, 0x3f, 0xa5, 0x47, 0x12, 0xd2, 0xb5, 0x91, 0x76, 0xe7, 0x7b, 0x76, 0x8f,
-	0x0b, 0x26, 0x19, 0x8f, 0x0d, 0xea, 0xd1, 0x7c, 0x28, 0xc9, 0x82, 0xa7, 0x4d, 0x16, 0xb7, 0xed,
-	0x8b, 0xdf, 0x9e, 0x0f, 0xc6, 0x94, 0x91, 0x1d, 0xd1, 0x66, 0x42, 0x03, 0x9e, 0x84, 0x1a, 0x8d,
-	0xfe, 0x72, 0xc0, 0xf2, 0x93, 0x7e, 0x14, 0x1d, 0xb0, 0xe0, 0x29, 0x7c, 0x0d, 0xdc, 0xe8, 0x71,
-	0x1e, 0x35, 0x59, 0xe8, 0x3a, 0x15, 0x67, 0x2b, 0x57, 0x87, 0xc3, 0xd4, 0xbb, 0x35, 0x20, 0xdd,
-	0xe8, 0x2d, 0x64, 0x0c, 0x08, 0x17, 0xb2, 0x53, 0x23, 0x84, 0xbb, 0x00, 0x18, 0x29, 0x21, 0x3d,
-	0x76, 0x17, 0x2b, 0xce, 0xd6, 0x52, 0xfd, 0xce, 0x30, 0xf5, 0x6e, 0x6b, 0xff, 0xb1, 0x0d, 0xe1,
-	0x95, 0xec, 0xd2, 0xc8, 0xce, 0xf0, 0x2b, 0x90, 0xcb, 0xb4, 0xbb, 0x4b, 0x15, 0x67, 0xab, 0x58,
-	0xf3, 0xab, 0x73, 0xd5, 0xba, 0x7a, 0xa0, 0xf0, 0x6d, 0x5e, 0x77, 0x9f, 0xa5, 0xde, 0xc2, 0x30,
-	0xf5, 0x56, 0xa7, 0x82, 0xb4, 0x39, 0xc2, 0x8a, 0x16, 0xfd, 0x9e, 0x03, 0xcb, 0xfb, 0x9c, 0x47,
-	0xef, 0x12, 0x49, 0xe0, 0x0e, 0xc8, 0x65, 0x5a, 0xd5, 0x5b, 0x8a, 0xb5, 0xb5, 0xaa, 0xae, 0x7f,
-	0xd5, 0xd6, 0xbf, 0xba, 0x17, 0x0f, 0xea, 0x2b, 0x7f, 0xfe, 0xb6, 0x9d, 0xcf, 0x10, 0x0d, 0xac,
-	0x9c, 0xe1, 0x17, 0x20, 0x9f, 0xb1, 0x0a, 0x77, 0xb1, 0xb2, 0x74, 0x0d, 0x85, 0x36, 0x87, 0xf5,
-	0x35, 0xa3, 0xb0, 0x34, 0x56, 0x28, 0x10, 0xd6, 0x9c, 0xf0, 0x17, 0x07, 0xdc, 0x15, 0xbd, 0x84,
-	0x92, 0xb0, 0x99, 0xd0, 0xef, 0x49, 0x12, 0x36, 0x55, 0x8b, 0xf5, 0x23, 0x22, 0x79, 0x62, 0x72,
-	0x52, 0x9b, 0x33, 0xe2, 0x5e, 0x86, 0xfc, 0xa4, 0xf5, 0x2d, 0x0d, 0x64, 0x7d, 0xcb, 0x04, 0xad,
-	0xe8, 0xa0, 0x17, 0x86, 0x40, 0x78, 0x5d, 0xdb, 0xb0, 0x32, 0xed, 0x8d, 0x2d, 0xf0, 0x67, 0x07,
-	0xac, 0x8f, 0x7a, 0x44, 0x4c, 0x82, 0x84, 0x9b, 0x53, 0xa9, 0x78, 0x1e, 0x61, 0x9b, 0x46, 0xd8,
-	0x3d, 0x2d, 0x6c, 0x76, 0x00, 0x84, 0x5f, 0x1c, 0x1b, 0x26, 0x34, 0x09, 0xc8, 0xc0, 0xed, 0xf3,
-	0x7d, 0x2b, 0xdc, 0xbc, 0x52, 0xf3, 0xc6, 0x9c, 0x6a, 0x1a, 0x16, 0x8f, 0x15, 0xbc, 0x9e, 0xcb,
-	0x14, 0xe1, 0x55, 0x36, 0xfd, 0xb3, 0x40, 0x7f, 0x2c, 0x82, 0xd2, 0xbe, 0x19, 0x48, 0xd5, 0x3d,
-	0x1f, 0x82, 0x65, 0x3b, 0xa0, 0xa6, 0x83, 0xe6, 0xed, 0x05, 0x4b, 0x83, 0x47, 0x04, 0xd9, 0x64,
-	0x45, 0x3c, 0xeb, 0xd5, 0x50, 0x4d, 0xca, 0xd4, 0x64, 0x19, 0x03, 0xc2, 0x85, 0xec, 0xd4, 0x08,
-	0xe1, 0x37, 0x60, 0x63, 0x46, 0x05, 0xcd, 0xfb, 0x4d, 0x97, 0xdc, 0x1b, 0x69, 0xd1, 0x4b, 0xca,
-	0xc6, 0x9e, 0x7a, 0xe5, 0xff, 0x8b, 0xad, 0xcd, 0xf0, 0x33, 0xb0, 0xd6, 0xef, 0x49, 0xd6, 0xa5,
-	0x53, 0xd4, 0xb6, 0xd0, 0x73, 0x71, 0x43, 0x4d, 0x30, 0xc1, 0x2a, 0xd0, 0xbf, 0x79, 0x50, 0x7a,
-	0x5f, 0xef, 0xf2, 0x4f, 0x25, 0x91, 0x14, 0x3e, 0x06, 0x05, 0xbd, 0x18, 0x4d, 0x06, 0x37, 0xaf,
-	0xc8, 0xe0, 0xbe, 0x72, 0x36, 0x11, 0x0c, 0x14, 0x62, 0xb0, 0xa2, 0x96, 0x4f, 0x48, 0x24, 0xb9,
-	0xe6, 0x54, 0xda, 0x55, 0x60, 0x18, 0x97, 0x7b, 0x76, 0x35, 0x7c, 0x0d, 0x6e, 0xda, 0xda, 0x68,
-	0xde, 0x25, 0xc5, 0xbb, 0x73, 0xcd, 0x0a, 0x4f, 0x70, 0x97, 0x7a, 0x93, 0xcd, 0xf3, 0x1e, 0x58,
-	0x8d, 0xe9, 0xb1, 0x6c, 0x8e, 0x82, 0xb0, 0xd0, 0xcd, 0xa9, 0xc2, 0xbf, 0x34, 0x4c, 0xbd, 0x75,
-	0x5d, 0xf8, 0xf3, 0x1e, 0x08, 0xdf, 0xca, 0x7e, 0xb2, 0xe4, 0x8d, 0x10, 0x7e, 0x09, 0x5c, 0xe5,
-	0x74, 0x7e, 0x08, 0x32, 0xba, 0xbc, 0xa2, 0xbb, 0x3f, 0x4c, 0x3d, 0x6f, 0x82, 0x6e, 0x86, 0x27,
-	0xc2, 0x77, 0x32, 0xd3, 0xb9, 0x41, 0x68, 0x84, 0xf0, 0x57, 0x07, 0xd4, 0x66, 0x4f, 0x64, 0xd3,
-	0x6c, 0xfb, 0x66, 0x97, 0x75, 0x12, 0xa2, 0xe4, 0xc9, 0xc3, 0x84, 0x8a, 0x43, 0x1e, 0x85, 0x6e,
-	0x41, 0x05, 0x7e, 0x67, 0x98, 0x7a, 0x6f, 0x5e, 0x36, 0xd5, 0x97, 0x71, 0x20, 0xbc, 0x3d, 0x73,
-	0xe2, 0xd5, 0x22, 0x0e, 0x3f, 0xb2, 0x80, 0x03, 0xeb, 0x0f, 0x7f, 0x74, 0xc0, 0x03, 0x33, 0x13,
-	0x6d, 0x12, 0x5c, 0xa5, 0xf0, 0x86, 0x52, 0xb8, 0x3b, 0x4c, 0xbd, 0xd7, 0xa7, 0x16, 0xe2, 0xd5,
-	0x50, 0x84, 0x5f, 0xd1, 0xbe, 0x4f, 0x94, 0xeb, 0x45, 0x7a, 0xd0, 0x0f, 0x0e, 0x28, 0x4e, 0xec,
-	0x39, 0x78, 0x1f, 0xe4, 0x62, 0xd2, 0xa5, 0xaa, 0xcd, 0x57, 0xea, 0x2f, 0x0c, 0x53, 0xaf, 0x68,
-	0x8a, 0x42, 0xba, 0x14, 0x61, 0x65, 0x84, 0x1f, 0x83, 0x9b, 0x7a, 0xdc, 0x02, 0x1e, 0x4b, 0x1a,
-	0x4b, 0xb5, 0x0a, 0x8a, 0xb5, 0x07, 0x17, 0x8c, 0xdb, 0x44, 0x5e, 0x1e, 0x6b, 0x00, 0x2e, 0x29,
-	0x0f, 0x73, 0xab, 0x87, 0xcf, 0x4e, 0xcb, 0xce, 0xc9, 0x69, 0xd9, 0xf9, 0xe7, 0xb4, 0xec, 0xfc,
-	0x74, 0x56, 0x5e, 0x38, 0x39, 0x2b, 0x2f, 0xfc, 0x7d, 0x56, 0x5e, 0xf8, 0xfc, 0x83, 0x0e, 0x93,
-	0x87, 0xfd, 0x56, 0x35, 0xe0, 0x5d, 0xdf, 0x90, 0x6f, 0x47, 0xa4, 0x25, 0xec, 0xc5, 0x3f, 0xaa,
-	0x3d, 0xf2, 0x8f, 0xa7, 0xbe, 0x18, 0xb6, 0xc7, 0x9f, 0x0c, 0x72, 0xd0, 0xa3, 0xc2, 0x7e, 0x94,
-	0xb5, 0x0a, 0xea, 0xff, 0x72, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x33, 0x57, 0x0c,
-	0xcc, 0x09, 0x00, 0x00,
+	0x18, 0xce, 0xd6, 0x8e, 0x9b, 0x8c, 0xd3, 0x92, 0x0c, 0x69, 0xb3, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
+	0x94, 0x82, 0x62, 0x13, 0x27, 0x05, 0x81, 0xe0, 0x10, 0x17, 0x8a, 0xcc, 0x57, 0xa3, 0x21, 0x5c,
+	0xf8, 0x5a, 0xc6, 0xbb, 0x63, 0x67, 0xda, 0xf5, 0x8e, 0xd9, 0x19, 0x87, 0xe4, 0xca, 0x15, 0x21,
+	0x21, 0x4e, 0xfc, 0x04, 0x2e, 0xdc, 0x90, 0xf8, 0x0b, 0x15, 0xe2, 0xd0, 0x23, 0xa7, 0x15, 0x4a,
+	0xfe, 0x81, 0x25, 0xee, 0x68, 0x67, 0x66, 0xed, 0xb5, 0x71, 0x93, 0x0d, 0xb7, 0x99, 0x7d, 0xdf,
+	0xe7, 0x79, 0x9f, 0x99, 0xf7, 0x63, 0x07, 0xec, 0x70, 0xd1, 0xe3, 0x82, 0x89, 0xba, 0xc7, 0x43,
+	0x8f, 0x86, 0x32, 0x22, 0x92, 0xfa, 0x01, 0xfb, 0x66, 0xc0, 0x7c, 0x26, 0x4f, 0xea, 0x47, 0xdb,
+	0x6d, 0x2a, 0xc9, 0x76, 0xbd, 0x4b, 0x43, 0x2a, 0x98, 0xa8, 0xf5, 0x23, 0x2e, 0x39, 0xdc, 0x30,
+	0xa0, 0xda, 0x4c, 0x50, 0xcd, 0x80, 0xd6, 0x57, 0xbb, 0xbc, 0xcb, 0x15, 0xa2, 0x9e, 0xac, 0x34,
+	0x78, 0xfd, 0x96, 0xa7, 0xd0, 0xae, 0x36, 0xe8, 0x4d, 0x6a, 0xea, 0x72, 0xde, 0x0d, 0x68, 0x5d,
+	0xed, 0xda, 0x83, 0x4e, 0x9d, 0x84, 0x27, 0xc6, 0xf4, 0x62, 0xaa, 0x93, 0x78, 0xde, 0xa0, 0x37,
+	0xd2, 0xa5, 0x76, 0xc6, 0xe5, 0xe5, 0xf3, 0x8f, 0xd2, 0x27, 0x11, 0xe9, 0xa5, 0x91, 0x76, 0xf3,
+	0x1d, 0xbb, 0xcf, 0x05, 0x93, 0x8c, 0x87, 0x06, 0x75, 0x2f, 0x1f, 0x4a, 0x32, 0xef, 0xb1, 0xcb,
+	0xc2, 0x4e, 0x7a, 0xe2, 0xb7, 0xf2, 0xc1, 0x98, 0x32, 0xb2, 0x23, 0xea, 0x46, 0xd4, 0xe3, 0x91,
+	0xaf, 0xd1, 0xe8, 0x4f, 0x0b, 0x2c, 0x3c, 0x18, 0x04, 0xc1, 0x01, 0xf3, 0x1e, 0xc3, 0x57, 0xc0,
+	0xd5, 0x3e, 0xe7, 0x81, 0xcb, 0x7c, 0xdb, 0xaa, 0x5a, 0x9b, 0xc5, 0x26, 0x1c, 0xc6, 0xce, 0xf5,
+	0x13, 0xd2, 0x0b, 0xde, 0x44, 0xc6, 0x80, 0x70, 0x29, 0x59, 0xb5, 0x7c, 0xb8, 0x0b, 0x80, 0x91,
+	0xe2, 0xd3, 0x63, 0xfb, 0x4a, 0xd5, 0xda, 0x2c, 0x34, 0x6f, 0x0c, 0x63, 0x67, 0x45, 0xfb, 0x8f,
+	0x6d, 0x08, 0x2f, 0x26, 0x9b, 0x56, 0xb2, 0x86, 0x5f, 0x82, 0x62, 0xa2, 0xdd, 0x2e, 0x54, 0xad,
+	0xcd, 0x72, 0xa3, 0x5e, 0xcb, 0x95, 0xeb, 0xda, 0x81, 0xc2, 0x77, 0x78, 0xd3, 0x7e, 0x12, 0x3b,
+	0x73, 0xc3, 0xd8, 0x59, 0x9e, 0x08, 0xd2, 0xe1, 0x08, 0x2b, 0x5a, 0xf4, 0x7b, 0x11, 0x2c, 0xec,
+	0x73, 0x1e, 0xbc, 0x43, 0x24, 0x81, 0x3b, 0xa0, 0x98, 0x68, 0x55, 0x67, 0x29, 0x37, 0x56, 0x6b,
+	0x3a, 0xff, 0xb5, 0x34, 0xff, 0xb5, 0xbd, 0xf0, 0xa4, 0xb9, 0xf8, 0xc7, 0x6f, 0x5b, 0xf3, 0x09,
+	0xa2, 0x85, 0x95, 0x33, 0xfc, 0x1c, 0xcc, 0x27, 0xac, 0xc2, 0xbe, 0x52, 0x2d, 0x5c, 0x42, 0x61,
+	0x7a, 0x87, 0xcd, 0x55, 0xa3, 0x70, 0x69, 0xac, 0x50, 0x20, 0xac, 0x39, 0xe1, 0xcf, 0x16, 0xb8,
+	0x25, 0xfa, 0x11, 0x25, 0xbe, 0x1b, 0xd1, 0x6f, 0x49, 0xe4, 0xbb, 0xaa, 0xc4, 0x06, 0x01, 0x91,
+	0x3c, 0x32, 0x77, 0xd2, 0xc8, 0x19, 0x71, 0x2f, 0x41, 0x3e, 0x6c, 0x3f, 0xa2, 0x9e, 0x6c, 0x6e,
+	0x9a, 0xa0, 0x55, 0x1d, 0xf4, 0x99, 0x21, 0x10, 0x5e, 0xd3, 0x36, 0xac, 0x4c, 0x7b, 0x63, 0x0b,
+	0xfc, 0xc9, 0x02, 0x6b, 0xa3, 0x1a, 0x11, 0x59, 0x90, 0xb0, 0x8b, 0xd5, 0xc2, 0xff, 0x14, 0xb6,
+	0x61, 0x84, 0xdd, 0xd6, 0xc2, 0x66, 0x07, 0x40, 0xf8, 0xe6, 0xd8, 0x90, 0xd1, 0x24, 0x20, 0x03,
+	0x2b, 0xd3, 0x75, 0x2b, 0xec, 0x79, 0xa5, 0xe6, 0xb5, 0x9c, 0x6a, 0x5a, 0x29, 0x1e, 0x2b, 0x78,
+	0xb3, 0x98, 0x28, 0xc2, 0xcb, 0x6c, 0xf2, 0xb3, 0x40, 0xbf, 0x16, 0xc0, 0xd2, 0xbe, 0x69, 0x48,
+	0x55, 0x3d, 0x1f, 0x80, 0x85, 0xb4, 0x41, 0x4d, 0x05, 0xe5, 0xad, 0x85, 0x94, 0x06, 0x8f, 0x08,
+	0x92, 0xce, 0x0a, 0x78, 0x52, 0xab, 0xbe, 0x7d, 0x65, 0xba, 0xb3, 0x8c, 0x01, 0xe1, 0x52, 0xb2,
+	0x6a, 0xf9, 0xf0, 0x6b, 0xb0, 0x3e, 0x23, 0x83, 0xe6, 0xfc, 0xa6, 0x4a, 0x6e, 0x8f, 0xb4, 0x28,
+	0xe3, 0x28, 0xf6, 0xc4, 0x29, 0xff, 0x9b, 0x6c, 0x6d, 0x86, 0x9f, 0x82, 0xd5, 0x41, 0x5f, 0xb2,
+	0x1e, 0x9d, 0xa0, 0x4e, 0x13, 0x9d, 0x8b, 0x1b, 0x6a, 0x82, 0x0c, 0xab, 0x80, 0x8f, 0x40, 0x39,
+	0x60, 0x3d, 0x26, 0x5d, 0x1e, 0xf9, 0x34, 0xb2, 0xe7, 0x95, 0xd2, 0xed, 0x9c, 0xb7, 0xf6, 0x61,
+	0x82, 0x7c, 0x98, 0x00, 0x9b, 0x37, 0x87, 0xb1, 0x03, 0xcd, 0xe5, 0x8c, 0xf9, 0x10, 0x06, 0xc1,
+	0xc8, 0x07, 0xfd, 0x53, 0x02, 0x4b, 0xef, 0xe9, 0xff, 0xc6, 0x27, 0x92, 0x48, 0x0a, 0xef, 0x83,
+	0x92, 0x1e, 0xc2, 0x26, 0x5b, 0x1b, 0x17, 0xc4, 0xdd, 0x57, 0xce, 0xe6, 0x34, 0x06, 0x0a, 0x31,
+	0x58, 0x54, 0x83, 0xce, 0x27, 0x92, 0x5c, 0x72, 0x02, 0xa4, 0x63, 0xc7, 0x30, 0x2e, 0xf4, 0xd3,
+	0x31, 0xf4, 0x15, 0xb8, 0x96, 0xd6, 0x81, 0xe6, 0x2d, 0x28, 0xde, 0x9d, 0x4b, 0x56, 0x53, 0x86,
+	0x7b, 0xa9, 0x9f, 0x2d, 0xd4, 0x77, 0xc1, 0x72, 0x48, 0x8f, 0xa5, 0x3b, 0x0a, 0xc2, 0x7c, 0xbb,
+	0xa8, 0x8a, 0xec, 0x85, 0x61, 0xec, 0xac, 0xe9, 0x7b, 0x9c, 0xf6, 0x40, 0xf8, 0x7a, 0xf2, 0x29,
+	0x25, 0x6f, 0xf9, 0xf0, 0x0b, 0x60, 0x2b, 0xa7, 0xe9, 0x86, 0x4b, 0xe8, 0xe6, 0x15, 0xdd, 0x9d,
+	0x61, 0xec, 0x38, 0x19, 0xba, 0x19, 0x9e, 0x08, 0xdf, 0x48, 0x4c, 0x53, 0x4d, 0xd7, 0xf2, 0xe1,
+	0x2f, 0x16, 0x68, 0xcc, 0xee, 0x7e, 0xd7, 0xfc, 0x59, 0xdc, 0x1e, 0xeb, 0x46, 0x44, 0xc9, 0x93,
+	0x87, 0x11, 0x15, 0x87, 0x3c, 0xf0, 0xed, 0x92, 0x0a, 0xfc, 0xf6, 0x30, 0x76, 0xde, 0x38, 0x6f,
+	0x82, 0x9c, 0xc7, 0x81, 0xf0, 0xd6, 0xcc, 0xe9, 0xa2, 0x86, 0xbe, 0xff, 0x51, 0x0a, 0x38, 0x48,
+	0xfd, 0xe1, 0x0f, 0x16, 0xb8, 0x6b, 0xfa, 0xaf, 0x43, 0xbc, 0x8b, 0x14, 0x5e, 0x55, 0x0a, 0x77,
+	0x87, 0xb1, 0xf3, 0xea, 0xc4, 0xf0, 0xbd, 0x18, 0x8a, 0xf0, 0x4b, 0xda, 0xf7, 0x01, 0xf1, 0xce,
+	0xd3, 0xf3, 0xbd, 0x05, 0x9e, 0xef, 0xb0, 0x20, 0xa0, 0xbe, 0x9b, 0xe9, 0x06, 0x61, 0x2f, 0xa8,
+	0x32, 0x7a, 0x3d, 0xef, 0x0f, 0x4a, 0x31, 0x64, 0x9a, 0x0c, 0x99, 0xd1, 0xbc, 0xae, 0x65, 0xcf,
+	0x88, 0x80, 0xf0, 0x4a, 0x67, 0x0a, 0x25, 0xd0, 0x77, 0x16, 0x28, 0x67, 0x26, 0x3c, 0xbc, 0x03,
+	0x8a, 0x21, 0xe9, 0x51, 0xd5, 0x74, 0x8b, 0xcd, 0xe7, 0x86, 0xb1, 0x53, 0x36, 0x25, 0x42, 0x7a,
+	0x14, 0x61, 0x65, 0x84, 0x1f, 0x83, 0x6b, 0x7a, 0xd0, 0x78, 0x3c, 0x94, 0x34, 0x94, 0x6a, 0x08,
+	0x96, 0x1b, 0x77, 0x9f, 0x31, 0x68, 0x32, 0x59, 0xba, 0xaf, 0x01, 0x78, 0x49, 0x79, 0x98, 0x5d,
+	0xd3, 0x7f, 0x72, 0x5a, 0xb1, 0x9e, 0x9e, 0x56, 0xac, 0xbf, 0x4f, 0x2b, 0xd6, 0x8f, 0x67, 0x95,
+	0xb9, 0xa7, 0x67, 0x95, 0xb9, 0xbf, 0xce, 0x2a, 0x73, 0x9f, 0xbd, 0xdf, 0x65, 0xf2, 0x70, 0xd0,
+	0xae, 0x79, 0xbc, 0x57, 0x37, 0xe4, 0x5b, 0x01, 0x69, 0x8b, 0x74, 0x53, 0x3f, 0x6a, 0xdc, 0xab,
+	0x1f, 0x4f, 0xbc, 0x95, 0xb6, 0xc6, 0x8f, 0x25, 0x79, 0xd2, 0xa7, 0x22, 0x7d, 0x8e, 0xb6, 0x4b,
+	0xea, 0xa5, 0xb0, 0xf3, 0xef, 0x00, 0xe3, 0x47, 0xb9, 0x5d, 0xc6, 0x0a, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -616,6 +637,18 @@ func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LimitOrder != nil {
+		{
+			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.UptimeAccumRecords) > 0 {
 		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -680,6 +713,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FilledLimitOrders) > 0 {
+		for iNdEx := len(m.FilledLimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilledLimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpreadFactorPoolIdMigrationThreshold))
 		i--
@@ -865,6 +912,10 @@ func (m *PositionData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LimitOrder != nil {
+		l = m.LimitOrder.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -900,6 +951,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.SpreadFactorPoolIdMigrationThreshold))
 	}
+	if len(m.FilledLimitOrders) > 0 {
+		for _, e := range m.FilledLimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1419,6 +1476,42 @@ func (m *PositionData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitOrder == nil {
+				m.LimitOrder = &model.LimitOrder{}
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1646,6 +1739,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledLimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledLimitOrders = append(m.FilledLimitOrders, model.FilledLimitOrder{})
+			if err := m.FilledLimitOrders[len(m.FilledLimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -53,6 +54,20 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	LimitOrderPrefix       = []byte{0x17}
+	LimitOrderTickPrefix   = []byte{0x18}
+	FilledLimitOrderPrefix = []byte{0x19}
+	// PoolLimitOrdersToFillPrefix indexes the pools with more crossed limit orders than a swap fills,
+	// which are filled at the end of the block.
+	PoolLimitOrdersToFillPrefix = []byte{0x1A}
+	// FilledLimitOrderCountPrefix stores the number of filled limit orders recorded for an address.
+	FilledLimitOrderCountPrefix = []byte{0x1B}
+
+	// LimitOrderTickPrefix + pool id + side byte
+	KeyLimitOrderTickSidePrefixLengthBytes = len(LimitOrderTickPrefix) + Uint64ByteSize + 1
+	// LimitOrderTickPrefix + pool id + side byte + sign byte + tick index + position id
+	KeyLimitOrderTickLengthBytes = KeyLimitOrderTickSidePrefixLengthBytes + 1 + Uint64ByteSize + Uint64ByteSize
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -183,6 +198,63 @@ func KeyPositionId(positionId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PositionIdPrefix, positionId))
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrder returns the key consisted of (LimitOrderPrefix | position Id) and is used to store limit order info.
+func KeyLimitOrder(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", LimitOrderPrefix, positionId))
+}
+
+// KeyLimitOrderTick returns the key used to index a limit order by the tick at which it is filled.
+// Orders selling token0 are indexed by their upper tick and orders selling token1 by their lower tick,
+// so that the orders filled by a swap can be iterated over from the pool's current tick.
+func KeyLimitOrderTick(poolId uint64, sellsToken0 bool, tickIndex int64, positionId uint64) []byte {
+	key := make([]byte, 0, KeyLimitOrderTickLengthBytes)
+	key = append(key, KeyLimitOrderTickSidePrefix(poolId, sellsToken0)...)
+	key = append(key, TickIndexToBytes(tickIndex)...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyLimitOrderTickSidePrefix returns the prefix key used to iterate over the limit orders of a pool
+// that sell the same token, ordered by tick.
+func KeyLimitOrderTickSidePrefix(poolId uint64, sellsToken0 bool) []byte {
+	side := byte(0)
+	if !sellsToken0 {
+		side = 1
+	}
+	key := make([]byte, 0, KeyLimitOrderTickSidePrefixLengthBytes)
+	key = append(key, LimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, side)
+	return key
+}
+
+// KeyFilledLimitOrder returns the key consisted of (FilledLimitOrderPrefix | length prefixed address | filled height | position Id)
+// and is used to store the limit orders of an address that have been filled, ordered by the height at which they were filled.
+func KeyFilledLimitOrder(addr sdk.AccAddress, filledHeight int64, positionId uint64) []byte {
+	key := KeyUserFilledLimitOrders(addr)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(filledHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyUserFilledLimitOrders returns the prefix key used to iterate over the filled limit orders of an address.
+func KeyUserFilledLimitOrders(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FilledLimitOrderPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// KeyFilledLimitOrderCount returns the key consisted of (FilledLimitOrderCountPrefix | length prefixed address)
+// and is used to store the number of filled limit orders recorded for an address.
+func KeyFilledLimitOrderCount(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, FilledLimitOrderCountPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// KeyPoolLimitOrdersToFill returns the key consisted of (PoolLimitOrdersToFillPrefix | pool Id) and is used
+// to mark a pool as having limit orders left to fill at the end of the block.
+func KeyPoolLimitOrdersToFill(poolId uint64) []byte {
+	return append(append([]byte{}, PoolLimitOrdersToFillPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Position Prefix Keys
 
 // KeyAddressPoolIdPositionId returns the full key needed to store the position id for given addr + pool id + position id combination.
@@ -47,6 +47,10 @@ func (msg MsgCreatePosition) ValidateBasic() error {
 		return CoinLengthError{Length: len(msg.TokensProvided), MaxLength: 2}
 	}
 
+	if msg.LimitOrder && len(msg.TokensProvided) != 1 {
+		return LimitOrderTokensProvidedError{TokensProvided: msg.TokensProvided}
+	}
+
 	for _, coin := range msg.TokensProvided {
 		if coin.Amount.LTE(osmomath.ZeroInt()) {
 			return NotPositiveRequireAmountError{Amount: coin.Amount.String()}
@@ -177,6 +177,34 @@ func TestMsgCreatePosition(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name: "limit order",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokensProvided:  sdk.NewCoins(sdk.NewCoin("stake", osmomath.OneInt())),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+				LimitOrder:      true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "limit order with two tokens",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokensProvided:  sdk.NewCoins(sdk.NewCoin("stake", osmomath.OneInt()), sdk.NewCoin("osmo", osmomath.OneInt())),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+				LimitOrder:      true,
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
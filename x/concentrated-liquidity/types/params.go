@@ -20,6 +20,7 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyLimitOrderMinAmount                = []byte("LimitOrderMinAmount")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -29,7 +30,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, limitOrderMinAmount osmomath.Int) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -38,6 +39,7 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		LimitOrderMinAmount:                 limitOrderMinAmount,
 	}
 }
 
@@ -51,6 +53,7 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		LimitOrderMinAmount:                 DefaultLimitOrderMinAmount,
 	}
 }
 
@@ -77,6 +80,9 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validateLimitOrderMinAmount(p.LimitOrderMinAmount); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,6 +96,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyLimitOrderMinAmount, &p.LimitOrderMinAmount, validateLimitOrderMinAmount),
 	}
 }
 
@@ -223,3 +230,17 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateLimitOrderMinAmount validates that the limit order min amount is a non-negative osmomath.Int.
+func validateLimitOrderMinAmount(i interface{}) error {
+	limitOrderMinAmount, ok := i.(osmomath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for limit order min amount: %T", i)
+	}
+
+	if limitOrderMinAmount.IsNil() || limitOrderMinAmount.IsNegative() {
+		return fmt.Errorf("limit order min amount must be non-negative, got %s", limitOrderMinAmount)
+	}
+
+	return nil
+}
@@ -64,6 +64,11 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// limit_order_min_amount is the minimum amount of the token provided to
+	// create a limit order. Since a bounded number of limit orders is filled
+	// every block, this prevents dust orders from delaying the fills of other
+	// orders.
+	LimitOrderMinAmount cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=limit_order_min_amount,json=limitOrderMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"limit_order_min_amount" yaml:"limit_order_min_amount"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,48 +156,51 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x98, 0x82,
-	0x2e, 0xa5, 0x4d, 0xa4, 0xa2, 0x87, 0x7a, 0x10, 0xe2, 0x6a, 0x2f, 0x15, 0x6a, 0xaa, 0x08, 0x45,
-	0x18, 0x66, 0x27, 0xd3, 0xec, 0xb0, 0x49, 0x9e, 0x74, 0x66, 0x62, 0x5d, 0xc1, 0x93, 0x08, 0x1e,
-	0x3d, 0x78, 0xf0, 0x05, 0x79, 0xe8, 0xb1, 0x47, 0xf1, 0x10, 0xa5, 0xbd, 0x79, 0xdc, 0x57, 0x20,
-	0x9b, 0xec, 0xda, 0x2c, 0x55, 0xec, 0x2d, 0x33, 0xdf, 0xcf, 0xf3, 0x67, 0x9e, 0x7c, 0x79, 0xf4,
-	0x15, 0x90, 0x09, 0x48, 0x2e, 0x3d, 0x0a, 0x29, 0x65, 0xa9, 0x12, 0x44, 0xb1, 0x30, 0xe6, 0xfb,
-	0x39, 0x0f, 0xb9, 0x1a, 0x78, 0x19, 0x11, 0x24, 0x91, 0x6e, 0x26, 0x40, 0x81, 0x71, 0x63, 0xcc,
-	0xba, 0x7f, 0x64, 0x97, 0xae, 0x44, 0x10, 0x41, 0x49, 0x7a, 0xa3, 0xaf, 0x2a, 0x68, 0xc9, 0x8a,
-	0x00, 0xa2, 0x98, 0x79, 0xe5, 0xa9, 0x9b, 0xef, 0x79, 0x61, 0x2e, 0x88, 0xe2, 0x90, 0x56, 0x3a,
-	0xfa, 0x32, 0xa7, 0xcf, 0x6e, 0x97, 0x55, 0x8c, 0x5d, 0xfd, 0x2a, 0xc9, 0x55, 0x0f, 0x04, 0x7f,
-	0xc3, 0x42, 0xac, 0x38, 0xed, 0x63, 0x99, 0x11, 0xca, 0xd3, 0xc8, 0xd4, 0x9c, 0x66, 0x7b, 0xc6,
-	0x47, 0xc3, 0xc2, 0xb6, 0x06, 0x24, 0x89, 0x37, 0xd0, 0x5f, 0x40, 0x14, 0x2c, 0x9e, 0x2a, 0xcf,
-	0x38, 0xed, 0xef, 0x54, 0xf7, 0xc6, 0x3b, 0x4d, 0x6f, 0xd5, 0x62, 0x64, 0x26, 0x18, 0x09, 0xf1,
-	0x1e, 0xa1, 0x0a, 0x84, 0x34, 0xff, 0x73, 0x9a, 0xed, 0x05, 0x7f, 0xf3, 0xb0, 0xb0, 0x1b, 0xdf,
-	0x0a, 0xfb, 0x1a, 0x2d, 0x1f, 0x2a, 0xc3, 0xbe, 0xcb, 0xc1, 0x4b, 0x88, 0xea, 0xb9, 0x5b, 0x2c,
-	0x22, 0x74, 0xd0, 0x61, 0x74, 0x58, 0xd8, 0xce, 0x99, 0x0e, 0xa6, 0xb3, 0xa1, 0xa0, 0xf6, 0x8c,
-	0x9d, 0x52, 0x7a, 0x5c, 0x29, 0xc6, 0x27, 0x4d, 0xb7, 0xbb, 0x24, 0x26, 0x29, 0x65, 0x02, 0xcb,
-	0x1e, 0x11, 0x4c, 0x62, 0xc1, 0x0e, 0x88, 0x08, 0x71, 0xc8, 0x25, 0x85, 0x3c, 0x55, 0x66, 0xd3,
-	0xd1, 0xda, 0x0b, 0xfe, 0x93, 0xf3, 0xf5, 0x72, 0xb3, 0xea, 0xe5, 0x1f, 0x39, 0x51, 0x70, 0x7d,
-	0x42, 0xec, 0x94, 0x40, 0x50, 0xea, 0x9d, 0xb1, 0x6c, 0xa4, 0x53, 0x83, 0xdf, 0xcf, 0x41, 0x31,
-	0x1c, 0xb2, 0x14, 0x12, 0x69, 0xce, 0x94, 0x93, 0xb9, 0x37, 0x2c, 0xec, 0xdb, 0x67, 0x9e, 0x5d,
-	0x07, 0xd1, 0x6a, 0xc8, 0x32, 0xc1, 0xe8, 0xc8, 0x12, 0x1b, 0x48, 0x89, 0x9c, 0x21, 0x53, 0xab,
-	0xff, 0x8c, 0xa7, 0x23, 0xb8, 0x53, 0xb2, 0xc6, 0x7b, 0x4d, 0x37, 0x6a, 0x79, 0xf2, 0x4c, 0xf1,
-	0x84, 0x49, 0xf3, 0x7f, 0xa7, 0xd9, 0xbe, 0xb0, 0xde, 0x72, 0x2b, 0xc7, 0xb8, 0x13, 0xc7, 0xb8,
-	0x9d, 0xb1, 0x63, 0xfc, 0xfb, 0xa3, 0xa1, 0xfc, 0x2c, 0x6c, 0x63, 0xe2, 0xa1, 0x55, 0x48, 0xb8,
-	0x62, 0x49, 0xa6, 0x06, 0xc3, 0xc2, 0x6e, 0x9d, 0x69, 0x70, 0x9c, 0x18, 0x7d, 0xfe, 0x6e, 0x6b,
-	0xc1, 0xe5, 0x53, 0xe1, 0x79, 0x75, 0x6f, 0x7c, 0xd0, 0xf4, 0x5b, 0x5c, 0xe2, 0x8c, 0x89, 0x84,
-	0x4b, 0xc9, 0x21, 0x8d, 0x99, 0x94, 0x38, 0x03, 0x88, 0x31, 0x15, 0xac, 0xac, 0x80, 0x59, 0x4a,
-	0xba, 0x31, 0x0b, 0xcd, 0x59, 0x47, 0x6b, 0xcf, 0xfb, 0xeb, 0xc3, 0xc2, 0x76, 0xab, 0x3a, 0xe7,
-	0x0c, 0x44, 0xc1, 0x32, 0x97, 0xdb, 0x53, 0xe0, 0x36, 0x40, 0xfc, 0x70, 0x8c, 0x3d, 0xaa, 0x28,
-	0xe3, 0xad, 0xbe, 0x9c, 0xa7, 0x82, 0x49, 0x25, 0x38, 0x55, 0x2c, 0xac, 0xe5, 0x02, 0x81, 0x0f,
-	0x7a, 0x5c, 0xb1, 0x98, 0x4b, 0x65, 0xce, 0x95, 0xbf, 0xc3, 0x1d, 0x16, 0xf6, 0x4a, 0xd5, 0xc5,
-	0x39, 0x82, 0x50, 0xe0, 0xd4, 0xa9, 0xdf, 0xd5, 0x41, 0xbc, 0x98, 0x20, 0xc6, 0x03, 0xfd, 0x52,
-	0x0f, 0xa0, 0x8f, 0x23, 0x22, 0x71, 0xcc, 0x13, 0xae, 0xcc, 0x79, 0x47, 0x6b, 0xcf, 0xf8, 0xad,
-	0x61, 0x61, 0x2f, 0x56, 0x95, 0xa6, 0x75, 0x14, 0x5c, 0x1c, 0x5d, 0x6c, 0x12, 0xb9, 0x35, 0x3a,
-	0xfa, 0x2f, 0x0f, 0x8f, 0x2d, 0xed, 0xe8, 0xd8, 0xd2, 0x7e, 0x1c, 0x5b, 0xda, 0xc7, 0x13, 0xab,
-	0x71, 0x74, 0x62, 0x35, 0xbe, 0x9e, 0x58, 0x8d, 0x5d, 0x3f, 0xe2, 0xaa, 0x97, 0x77, 0x5d, 0x0a,
-	0x89, 0x37, 0x5e, 0x20, 0x6b, 0x31, 0xe9, 0xca, 0xc9, 0xc1, 0x7b, 0xb5, 0x7e, 0xd7, 0x7b, 0x3d,
-	0xb5, 0x7f, 0xd6, 0x4e, 0x17, 0x90, 0x1a, 0x64, 0x4c, 0x76, 0x67, 0x4b, 0x2f, 0xdc, 0xf9, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0xc9, 0xb5, 0x78, 0xae, 0x04, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4c,
+	0x1c, 0xc7, 0x37, 0xcf, 0xf6, 0xe9, 0xd3, 0xe6, 0x79, 0x78, 0xc0, 0x68, 0x35, 0x5b, 0x6d, 0x12,
+	0x52, 0xd0, 0xa5, 0xb4, 0x89, 0x54, 0xf4, 0x50, 0x41, 0x31, 0xae, 0x16, 0xa1, 0xc5, 0x9a, 0x2a,
+	0x42, 0x11, 0x86, 0xd9, 0xc9, 0x34, 0x3b, 0x6c, 0x32, 0x93, 0xce, 0x4c, 0xac, 0x2b, 0x78, 0x12,
+	0xc1, 0xa3, 0x07, 0x0f, 0xbe, 0x15, 0xdf, 0x41, 0x8f, 0x3d, 0x8a, 0x87, 0x28, 0xed, 0xcd, 0x63,
+	0x5e, 0x81, 0xec, 0x64, 0xd7, 0xee, 0xb2, 0x15, 0x7b, 0xcb, 0xfc, 0xbe, 0x9f, 0xdf, 0x9f, 0x99,
+	0x7c, 0xf9, 0xe9, 0x4b, 0x4c, 0xa4, 0x4c, 0x10, 0xe1, 0x23, 0x46, 0x11, 0xa6, 0x92, 0x43, 0x89,
+	0xa3, 0x84, 0xec, 0xe5, 0x24, 0x22, 0xb2, 0xe7, 0x67, 0x90, 0xc3, 0x54, 0x78, 0x19, 0x67, 0x92,
+	0x19, 0x0b, 0x03, 0xd6, 0x3b, 0x95, 0x9d, 0xbf, 0x10, 0xb3, 0x98, 0x29, 0xd2, 0xef, 0x7f, 0x55,
+	0x49, 0xf3, 0x56, 0xcc, 0x58, 0x9c, 0x60, 0x5f, 0x9d, 0xda, 0xf9, 0xae, 0x1f, 0xe5, 0x1c, 0x4a,
+	0xc2, 0x68, 0xa5, 0xbb, 0x9f, 0x67, 0xf4, 0xe9, 0x2d, 0xd5, 0xc5, 0xd8, 0xd1, 0x2f, 0xc1, 0x5c,
+	0x76, 0x18, 0x27, 0xaf, 0x71, 0x04, 0x24, 0x41, 0x5d, 0x20, 0x32, 0x88, 0x08, 0x8d, 0x4d, 0xcd,
+	0xa9, 0x37, 0xa7, 0x02, 0xb7, 0x2c, 0x6c, 0xab, 0x07, 0xd3, 0x64, 0xcd, 0xfd, 0x0d, 0xe8, 0x86,
+	0x73, 0x27, 0xca, 0x53, 0x82, 0xba, 0xdb, 0x55, 0xdc, 0x78, 0xab, 0xe9, 0x8d, 0x91, 0x1c, 0x91,
+	0x71, 0x0c, 0x23, 0xb0, 0x0b, 0x91, 0x64, 0x5c, 0x98, 0x7f, 0x39, 0xf5, 0xe6, 0x6c, 0xb0, 0x7e,
+	0x50, 0xd8, 0xb5, 0xaf, 0x85, 0x7d, 0x19, 0xa9, 0x8b, 0x8a, 0xa8, 0xeb, 0x11, 0xe6, 0xa7, 0x50,
+	0x76, 0xbc, 0x0d, 0x1c, 0x43, 0xd4, 0x6b, 0x61, 0x54, 0x16, 0xb6, 0x33, 0x31, 0xc1, 0x78, 0x35,
+	0x37, 0x1c, 0xb9, 0xc6, 0xb6, 0x92, 0x1e, 0x56, 0x8a, 0xf1, 0x51, 0xd3, 0xed, 0x36, 0x4c, 0x20,
+	0x45, 0x98, 0x03, 0xd1, 0x81, 0x1c, 0x0b, 0xc0, 0xf1, 0x3e, 0xe4, 0x11, 0x88, 0x88, 0x40, 0x2c,
+	0xa7, 0xd2, 0xac, 0x3b, 0x5a, 0x73, 0x36, 0xd8, 0x3c, 0xdb, 0x2c, 0x57, 0xab, 0x59, 0xfe, 0x50,
+	0xd3, 0x0d, 0xaf, 0x0c, 0x89, 0x6d, 0x05, 0x84, 0x4a, 0x6f, 0x0d, 0x64, 0x83, 0x8e, 0x3d, 0xfc,
+	0x5e, 0xce, 0x24, 0x06, 0x11, 0xa6, 0x2c, 0x15, 0xe6, 0x94, 0x7a, 0x99, 0x5b, 0x65, 0x61, 0x5f,
+	0x9f, 0xb8, 0xf6, 0x28, 0xe8, 0x2e, 0x47, 0x38, 0xe3, 0x18, 0xf5, 0x2d, 0xb1, 0xe6, 0x4a, 0x9e,
+	0x63, 0xd7, 0xd4, 0x46, 0x7f, 0xc6, 0x93, 0x3e, 0xdc, 0x52, 0xac, 0xf1, 0x4e, 0xd3, 0x8d, 0x91,
+	0x3a, 0x79, 0x26, 0x49, 0x8a, 0x85, 0xf9, 0xb7, 0x53, 0x6f, 0xfe, 0xbb, 0xda, 0xf0, 0x2a, 0xc7,
+	0x78, 0x43, 0xc7, 0x78, 0xad, 0x81, 0x63, 0x82, 0xdb, 0xfd, 0x47, 0xf9, 0x51, 0xd8, 0xc6, 0xd0,
+	0x43, 0xcb, 0x2c, 0x25, 0x12, 0xa7, 0x99, 0xec, 0x95, 0x85, 0xdd, 0x98, 0x18, 0x70, 0x50, 0xd8,
+	0xfd, 0xf4, 0xcd, 0xd6, 0xc2, 0x73, 0x27, 0xc2, 0xb3, 0x2a, 0x6e, 0xbc, 0xd7, 0xf4, 0x6b, 0x44,
+	0x80, 0x0c, 0xf3, 0x94, 0x08, 0x41, 0x18, 0x4d, 0xb0, 0x10, 0x20, 0x63, 0x2c, 0x01, 0x88, 0x63,
+	0xd5, 0x01, 0x60, 0x0a, 0xdb, 0x09, 0x8e, 0xcc, 0x69, 0x47, 0x6b, 0xce, 0x04, 0xab, 0x65, 0x61,
+	0x7b, 0x55, 0x9f, 0x33, 0x26, 0xba, 0xe1, 0x22, 0x11, 0x5b, 0x63, 0xe0, 0x16, 0x63, 0xc9, 0xfd,
+	0x01, 0xf6, 0xa0, 0xa2, 0x8c, 0x37, 0xfa, 0x62, 0x4e, 0x39, 0x16, 0x92, 0x13, 0x24, 0x71, 0x34,
+	0x52, 0x8b, 0x71, 0xb0, 0xdf, 0x21, 0x12, 0x27, 0x44, 0x48, 0xf3, 0x1f, 0xf5, 0x3b, 0xbc, 0xb2,
+	0xb0, 0x97, 0xaa, 0x29, 0xce, 0x90, 0xe4, 0x86, 0xce, 0x28, 0xf5, 0xab, 0x3b, 0xe3, 0xcf, 0x87,
+	0x88, 0x71, 0x57, 0xff, 0xbf, 0xc3, 0x58, 0x17, 0xc4, 0x50, 0x80, 0x84, 0xa4, 0x44, 0x9a, 0x33,
+	0x8e, 0xd6, 0x9c, 0x0a, 0x1a, 0x65, 0x61, 0xcf, 0x55, 0x9d, 0xc6, 0x75, 0x37, 0xfc, 0xaf, 0x1f,
+	0x58, 0x87, 0x62, 0xa3, 0x7f, 0x34, 0x84, 0x7e, 0x51, 0xc5, 0x01, 0xe3, 0x11, 0xe6, 0x20, 0x25,
+	0x14, 0xc0, 0x54, 0xf9, 0x79, 0x56, 0xf9, 0xf9, 0xce, 0xc0, 0xcf, 0x73, 0x93, 0x7e, 0x7e, 0x44,
+	0x65, 0x59, 0xd8, 0x0b, 0x55, 0x97, 0xd3, 0x8b, 0xb8, 0xe1, 0x79, 0x25, 0x3c, 0xee, 0xc7, 0x37,
+	0x09, 0xbd, 0xa7, 0xa2, 0xc1, 0x8b, 0x83, 0x23, 0x4b, 0x3b, 0x3c, 0xb2, 0xb4, 0xef, 0x47, 0x96,
+	0xf6, 0xe1, 0xd8, 0xaa, 0x1d, 0x1e, 0x5b, 0xb5, 0x2f, 0xc7, 0x56, 0x6d, 0x27, 0x88, 0x89, 0xec,
+	0xe4, 0x6d, 0x0f, 0xb1, 0xd4, 0x1f, 0x6c, 0xad, 0x95, 0x04, 0xb6, 0xc5, 0xf0, 0xe0, 0xbf, 0x5c,
+	0xbd, 0xe9, 0xbf, 0x1a, 0x5b, 0x7a, 0x2b, 0x27, 0x5b, 0x4f, 0xf6, 0x32, 0x2c, 0xda, 0xd3, 0xca,
+	0x80, 0x37, 0x7e, 0x0e, 0x00, 0x2a, 0xee, 0xdf, 0xca, 0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -215,6 +223,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LimitOrderMinAmount.Size()
+		i -= size
+		if _, err := m.LimitOrderMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -361,6 +379,8 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	l = m.LimitOrderMinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -682,6 +702,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrderMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
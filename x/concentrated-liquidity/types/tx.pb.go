@@ -46,6 +46,11 @@ type MsgCreatePosition struct {
 	TokensProvided  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tokens_provided,json=tokensProvided,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_provided"`
 	TokenMinAmount0 cosmossdk_io_math.Int                    `protobuf:"bytes,6,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
 	TokenMinAmount1 cosmossdk_io_math.Int                    `protobuf:"bytes,7,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+	// limit_order marks the position as a limit order. The position must span a
+	// single tick spacing, be provided with a single token and sit entirely
+	// outside of the current tick. Once a swap fully crosses it, it is withdrawn
+	// to the sender in the other token.
+	LimitOrder bool `protobuf:"varint,8,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty" yaml:"limit_order"`
 }
 
 func (m *MsgCreatePosition) Reset()         { *m = MsgCreatePosition{} }
@@ -116,6 +121,13 @@ func (m *MsgCreatePosition) GetTokensProvided() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *MsgCreatePosition) GetLimitOrder() bool {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return false
+}
+
 type MsgCreatePositionResponse struct {
 	PositionId       uint64                      `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 	Amount0          cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
@@ -806,85 +818,86 @@ func init() {
 }
 
 var fileDescriptor_b181243e31403684 = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0xdb, 0x54,
-	0x1c, 0xae, 0x9b, 0x2c, 0xa5, 0x6f, 0xac, 0x6d, 0xdc, 0xae, 0x4d, 0xd3, 0x11, 0x57, 0x4f, 0x20,
-	0x65, 0x85, 0xd8, 0x4d, 0x19, 0x02, 0x82, 0xb4, 0xb1, 0x14, 0x4d, 0xca, 0x44, 0xb4, 0xc9, 0xab,
-	0x84, 0x84, 0x90, 0x22, 0xd7, 0x7e, 0x75, 0x9f, 0x9a, 0xf8, 0x05, 0x3f, 0xb7, 0x59, 0xaf, 0x1c,
-	0x10, 0x20, 0x0e, 0x68, 0x12, 0x47, 0x38, 0x23, 0xe0, 0x30, 0x89, 0x13, 0x77, 0x24, 0x76, 0xe0,
-	0xb0, 0x23, 0xe2, 0xe0, 0xa1, 0xf6, 0x30, 0x71, 0xcd, 0x5f, 0x80, 0xec, 0xe7, 0x3c, 0xbb, 0x76,
-	0xba, 0xd6, 0x29, 0xca, 0x81, 0x4b, 0x62, 0xbf, 0xf7, 0x7e, 0xdf, 0xfb, 0xde, 0xf7, 0xfd, 0x7e,
-	0xef, 0xd9, 0x06, 0x32, 0xa1, 0x1d, 0x42, 0x31, 0x55, 0x74, 0x62, 0xe9, 0xc8, 0x72, 0x6c, 0xcd,
-	0x41, 0x46, 0x1b, 0x7f, 0xba, 0x8f, 0x0d, 0xec, 0x1c, 0x2a, 0x07, 0xd5, 0x6d, 0xe4, 0x68, 0x55,
-	0xc5, 0x79, 0x28, 0x77, 0x6d, 0xe2, 0x10, 0xf1, 0xb5, 0x60, 0xbc, 0x3c, 0x74, 0xbc, 0x1c, 0x8c,
-	0x2f, 0x2e, 0xe9, 0xfe, 0x38, 0xa5, 0x43, 0x4d, 0xe5, 0xa0, 0xea, 0xfd, 0xb1, 0xf8, 0xe2, 0x82,
-	0x49, 0x4c, 0xe2, 0x5f, 0x2a, 0xde, 0x55, 0xd0, 0x9a, 0xd7, 0x3a, 0xd8, 0x22, 0x8a, 0xff, 0x1b,
-	0x34, 0x95, 0x02, 0x84, 0x6d, 0x8d, 0x22, 0x4e, 0x43, 0x27, 0xd8, 0x62, 0xfd, 0xf0, 0xf7, 0x2c,
-	0xc8, 0x37, 0xa9, 0xb9, 0x69, 0x23, 0xcd, 0x41, 0xf7, 0x09, 0xc5, 0x0e, 0x26, 0x96, 0xf8, 0x3a,
-	0x98, 0xea, 0x12, 0xd2, 0x6e, 0x61, 0xa3, 0x20, 0xac, 0x0a, 0xe5, 0x6c, 0x5d, 0xec, 0xbb, 0xd2,
-	0xcc, 0xa1, 0xd6, 0x69, 0xd7, 0x60, 0xd0, 0x01, 0xd5, 0x9c, 0x77, 0xd5, 0x30, 0xc4, 0xeb, 0x20,
-	0x47, 0x91, 0x65, 0x20, 0xbb, 0x30, 0xb9, 0x2a, 0x94, 0xa7, 0xeb, 0xf9, 0xbe, 0x2b, 0x5d, 0x61,
-	0x63, 0x59, 0x3b, 0x54, 0x83, 0x01, 0xe2, 0x0d, 0x00, 0xda, 0xa4, 0x87, 0xec, 0x96, 0x83, 0xf5,
-	0xbd, 0x42, 0x66, 0x55, 0x28, 0x67, 0xea, 0x57, 0xfb, 0xae, 0x94, 0x67, 0xc3, 0xc3, 0x3e, 0xa8,
-	0x4e, 0xfb, 0x37, 0x5b, 0x58, 0xdf, 0xf3, 0xa2, 0xf6, 0xbb, 0xdd, 0x41, 0x54, 0x36, 0x1e, 0x15,
-	0xf6, 0x41, 0x75, 0xda, 0xbf, 0xf1, 0xa3, 0x1c, 0x30, 0xeb, 0x90, 0x3d, 0x64, 0xd1, 0x56, 0xd7,
-	0x26, 0x07, 0xd8, 0x40, 0x46, 0xe1, 0xd2, 0x6a, 0xa6, 0x7c, 0x79, 0x63, 0x59, 0x66, 0x9a, 0xc8,
-	0x9e, 0x26, 0x03, 0xa9, 0xe5, 0x4d, 0x82, 0xad, 0xfa, 0xfa, 0x13, 0x57, 0x9a, 0xf8, 0xf1, 0x99,
-	0x54, 0x36, 0xb1, 0xb3, 0xbb, 0xbf, 0x2d, 0xeb, 0xa4, 0xa3, 0x04, 0x02, 0xb2, 0xbf, 0x0a, 0x35,
-	0xf6, 0x14, 0xe7, 0xb0, 0x8b, 0xa8, 0x1f, 0x40, 0xd5, 0x19, 0x36, 0xc7, 0xfd, 0x60, 0x0a, 0x11,
-	0x81, 0xbc, 0xdf, 0xd2, 0xea, 0x60, 0xab, 0xa5, 0x75, 0xc8, 0xbe, 0xe5, 0xac, 0x17, 0x72, 0xbe,
-	0x2e, 0xef, 0x7a, 0xe0, 0x7f, 0xb9, 0xd2, 0x55, 0x06, 0x45, 0x8d, 0x3d, 0x19, 0x13, 0xa5, 0xa3,
-	0x39, 0xbb, 0x72, 0xc3, 0x72, 0xfa, 0xae, 0x54, 0x60, 0xeb, 0x49, 0xc4, 0x43, 0x95, 0xad, 0xa4,
-	0x89, 0xad, 0xdb, 0xac, 0x65, 0xd8, 0x34, 0xd5, 0xc2, 0xd4, 0x85, 0xa6, 0xa9, 0x26, 0xa6, 0xa9,
-	0xd6, 0xd6, 0x3e, 0x7b, 0xfe, 0x78, 0x2d, 0x30, 0xef, 0xab, 0xe7, 0x8f, 0xd7, 0x8a, 0x3c, 0xcd,
-	0xdb, 0x15, 0xdd, 0x4f, 0x99, 0x4a, 0x37, 0xc8, 0x19, 0xf8, 0x5b, 0x06, 0x2c, 0x27, 0x32, 0x49,
-	0x45, 0xb4, 0x4b, 0x2c, 0x8a, 0xc4, 0xb7, 0xc1, 0xe5, 0xc1, 0xc8, 0x30, 0xab, 0x16, 0xfb, 0xae,
-	0x24, 0x0e, 0xb2, 0x8a, 0x77, 0x42, 0x15, 0x0c, 0xee, 0x1a, 0x86, 0xd8, 0x00, 0x53, 0x03, 0x19,
-	0x59, 0x7a, 0x29, 0x67, 0xad, 0x2f, 0xc8, 0x53, 0x2e, 0xde, 0x20, 0x3e, 0x84, 0xaa, 0xfa, 0xa9,
-	0x97, 0x16, 0xaa, 0xca, 0xa1, 0xaa, 0x62, 0x1b, 0xe4, 0x79, 0xb5, 0xb6, 0x98, 0x12, 0x5e, 0x7a,
-	0x79, 0xa0, 0xb7, 0x02, 0xd0, 0x95, 0x24, 0xe8, 0x87, 0xc8, 0xd4, 0xf4, 0xc3, 0x0f, 0x90, 0x1e,
-	0xba, 0x90, 0x40, 0x81, 0xea, 0x1c, 0x6f, 0x63, 0x5a, 0x1a, 0xb1, 0xb2, 0xc9, 0x8d, 0x54, 0x36,
-	0x53, 0xe7, 0x2b, 0x1b, 0xf8, 0x45, 0x16, 0xcc, 0x35, 0xa9, 0x79, 0xdb, 0x30, 0xb6, 0x08, 0xdf,
-	0x0f, 0x46, 0x76, 0x2f, 0xc5, 0xde, 0x70, 0x37, 0x34, 0x9a, 0xb9, 0xb3, 0x7e, 0x96, 0x3b, 0xb3,
-	0x51, 0x77, 0x5a, 0x51, 0xa7, 0xef, 0x86, 0x4e, 0x67, 0x47, 0xc1, 0x8a, 0x5a, 0x3d, 0xb4, 0xa2,
-	0x2f, 0x8d, 0xa7, 0xa2, 0x73, 0x63, 0xad, 0x68, 0xcd, 0x30, 0x2a, 0x0e, 0x09, 0x2b, 0xfa, 0x1f,
-	0x01, 0x14, 0xe2, 0xa9, 0xf0, 0x3f, 0x2d, 0x68, 0xf8, 0x68, 0x12, 0xcc, 0x37, 0xa9, 0xf9, 0x11,
-	0x76, 0x76, 0x0d, 0x5b, 0xeb, 0x8d, 0x35, 0xf3, 0x31, 0x08, 0x4b, 0x3e, 0xb0, 0x2e, 0x58, 0xcf,
-	0xcd, 0xf3, 0xed, 0x25, 0x4b, 0xf1, 0xbd, 0x84, 0x81, 0x40, 0x75, 0x96, 0x37, 0x31, 0xff, 0x6b,
-	0x6f, 0xc4, 0xec, 0xbf, 0x16, 0xb1, 0xbf, 0x17, 0xac, 0x3d, 0x4c, 0x80, 0x5f, 0x04, 0xb0, 0x32,
-	0x44, 0x14, 0x9e, 0x03, 0x11, 0x2b, 0x85, 0xff, 0xce, 0xca, 0xc9, 0x0b, 0x5a, 0xf9, 0x93, 0x00,
-	0x96, 0xbc, 0x83, 0x88, 0xb4, 0xdb, 0x48, 0x77, 0x1e, 0x74, 0x6d, 0xa4, 0x19, 0x2a, 0xea, 0x69,
-	0xb6, 0x41, 0xc5, 0x1a, 0x78, 0x39, 0xe2, 0x18, 0x2d, 0x08, 0xab, 0x99, 0x72, 0xb6, 0xbe, 0xd4,
-	0x77, 0xa5, 0xf9, 0x84, 0x9f, 0x14, 0xaa, 0x97, 0x43, 0x43, 0x69, 0x0a, 0x47, 0x6b, 0xd7, 0x63,
-	0x32, 0x2f, 0x47, 0xcf, 0x4d, 0xd2, 0xae, 0xd0, 0x6e, 0xc5, 0x66, 0x8c, 0xe0, 0x1f, 0x02, 0x90,
-	0x4e, 0x61, 0xcb, 0x75, 0xfe, 0x41, 0x00, 0x05, 0x9d, 0x0d, 0x40, 0x46, 0x8b, 0xfa, 0x63, 0x5a,
-	0x01, 0x80, 0xbf, 0x84, 0x17, 0x3e, 0xd4, 0x3c, 0xf0, 0x94, 0xec, 0xbb, 0x92, 0xc4, 0xb8, 0x9e,
-	0x06, 0x04, 0x53, 0x3d, 0xf7, 0x2c, 0x72, 0x98, 0x13, 0x94, 0xe1, 0xcf, 0x02, 0x58, 0x08, 0x97,
-	0xd3, 0xf0, 0x1f, 0x6e, 0xf1, 0x01, 0x1a, 0x9b, 0xf2, 0x95, 0x98, 0xf2, 0xaf, 0x9c, 0x54, 0xde,
-	0x23, 0x55, 0xc1, 0x9c, 0x15, 0x74, 0x27, 0xc1, 0xb5, 0x61, 0x74, 0xb9, 0xf4, 0xdf, 0x09, 0x60,
-	0x21, 0x54, 0x2c, 0x8c, 0x3c, 0x5b, 0xf6, 0x7b, 0x81, 0xec, 0x2b, 0x71, 0xd9, 0x23, 0xd3, 0xa7,
-	0x92, 0x7c, 0x9e, 0x43, 0x44, 0x64, 0xf5, 0xf8, 0xed, 0x10, 0x7b, 0x07, 0xe1, 0x18, 0xbf, 0xc9,
-	0x94, 0xfc, 0x86, 0x81, 0xa4, 0xe4, 0xc7, 0x21, 0x42, 0x7e, 0xf0, 0x57, 0x01, 0x14, 0x9b, 0xd4,
-	0xbc, 0xb3, 0x6f, 0x99, 0x78, 0xe7, 0x70, 0x73, 0x57, 0xb3, 0x4d, 0x64, 0x0c, 0x36, 0x92, 0xb1,
-	0x65, 0xc5, 0x8d, 0x58, 0x56, 0xbc, 0x1a, 0xc9, 0x8a, 0x1d, 0x46, 0xad, 0xa2, 0x33, 0x6e, 0x7c,
-	0xf7, 0xa3, 0x70, 0x17, 0xc0, 0xd3, 0xa9, 0xf3, 0x0c, 0xa9, 0x83, 0x59, 0x0b, 0xf5, 0x5a, 0xc9,
-	0x53, 0xa2, 0xd8, 0x77, 0xa5, 0x45, 0xc6, 0x27, 0x36, 0x00, 0xaa, 0x57, 0x2c, 0xc4, 0xb7, 0xd3,
-	0x86, 0x01, 0x9f, 0xb1, 0xaa, 0xd9, 0xb2, 0x35, 0x8b, 0xee, 0x20, 0x7b, 0xdc, 0xfa, 0x88, 0x55,
-	0x30, 0xed, 0x51, 0x24, 0x3d, 0x0b, 0xd9, 0xc1, 0xd1, 0xb3, 0xd0, 0x77, 0xa5, 0xb9, 0x90, 0xbd,
-	0xdf, 0x05, 0xd5, 0x97, 0x2c, 0xd4, 0xbb, 0xe7, 0x5d, 0xbe, 0xb0, 0xd0, 0x9c, 0x60, 0x1d, 0x11,
-	0x2d, 0x4b, 0x7e, 0x9d, 0x25, 0x16, 0x38, 0x50, 0x71, 0xe3, 0xf3, 0x29, 0x90, 0x69, 0x52, 0x53,
-	0xfc, 0x5a, 0x00, 0x33, 0xb1, 0x97, 0xd1, 0x77, 0xe4, 0x73, 0xbd, 0x2c, 0xcb, 0x89, 0x97, 0x8f,
-	0xe2, 0xfb, 0xa3, 0x46, 0x72, 0x73, 0x1f, 0x09, 0x60, 0x2e, 0xf1, 0x4c, 0x50, 0x3b, 0x3f, 0x6c,
-	0x3c, 0xb6, 0x58, 0x1f, 0x3d, 0x96, 0x93, 0xfa, 0x52, 0x00, 0x57, 0x62, 0xcf, 0xe7, 0xe7, 0x47,
-	0x3d, 0x11, 0x58, 0xbc, 0x35, 0x62, 0x20, 0xe7, 0xf2, 0xbd, 0x00, 0x16, 0x86, 0x9e, 0xb4, 0x37,
-	0x53, 0x68, 0x3f, 0x24, 0xbe, 0x78, 0xe7, 0x62, 0xf1, 0x9c, 0xe0, 0xb7, 0x02, 0xc8, 0x27, 0x4f,
-	0xa3, 0xf7, 0x52, 0xa3, 0x87, 0xc1, 0xc5, 0xcd, 0x0b, 0x04, 0x9f, 0xe0, 0x95, 0xac, 0xf7, 0x14,
-	0xbc, 0x12, 0xc1, 0x69, 0x78, 0x9d, 0x5a, 0x88, 0xf5, 0x4f, 0x9e, 0x1c, 0x95, 0x84, 0xa7, 0x47,
-	0x25, 0xe1, 0xef, 0xa3, 0x92, 0xf0, 0xcd, 0x71, 0x69, 0xe2, 0xe9, 0x71, 0x69, 0xe2, 0xcf, 0xe3,
-	0xd2, 0xc4, 0xc7, 0xf5, 0xc8, 0x49, 0x10, 0x4c, 0x54, 0x69, 0x6b, 0xdb, 0x74, 0x70, 0xa3, 0x1c,
-	0x6c, 0xbc, 0xa5, 0x3c, 0x3c, 0xf1, 0x05, 0xac, 0x12, 0x7e, 0x02, 0xf3, 0x4f, 0x8a, 0xed, 0x9c,
-	0xff, 0xd5, 0xe9, 0xcd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x74, 0xca, 0xd6, 0x30, 0x13,
-	0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0xe3, 0xc4,
+	0x17, 0xee, 0x34, 0xdd, 0x74, 0x3b, 0xfb, 0xdb, 0xb6, 0x71, 0xbb, 0xad, 0x9b, 0xf6, 0x17, 0x47,
+	0x23, 0x90, 0xb2, 0x85, 0xd8, 0x4d, 0x59, 0x04, 0x04, 0x69, 0x97, 0x4d, 0xd1, 0x4a, 0x59, 0x11,
+	0x75, 0xe5, 0xad, 0x84, 0x84, 0x90, 0x22, 0xd7, 0x9e, 0xba, 0xa3, 0x26, 0x9e, 0xe0, 0x71, 0x9b,
+	0xed, 0x0d, 0x71, 0x40, 0x80, 0x38, 0xa0, 0x95, 0x38, 0xc2, 0x19, 0x01, 0x87, 0x95, 0x38, 0x71,
+	0xe7, 0xb0, 0x07, 0x0e, 0x7b, 0x44, 0x1c, 0xbc, 0xa8, 0x3d, 0xac, 0xb8, 0xe6, 0x2f, 0x40, 0xf6,
+	0x38, 0xb6, 0x6b, 0xa7, 0xb4, 0x49, 0x57, 0x3d, 0x70, 0x49, 0x3c, 0x33, 0xef, 0xbd, 0xf9, 0xe6,
+	0xfb, 0xde, 0x9b, 0x19, 0x1b, 0xca, 0x94, 0xb5, 0x29, 0x23, 0x4c, 0xd1, 0xa9, 0xa5, 0x63, 0xcb,
+	0xb1, 0x35, 0x07, 0x1b, 0x2d, 0xf2, 0xc9, 0x3e, 0x31, 0x88, 0x73, 0xa8, 0x1c, 0x54, 0xb6, 0xb1,
+	0xa3, 0x55, 0x14, 0xe7, 0x91, 0xdc, 0xb1, 0xa9, 0x43, 0x85, 0x57, 0x03, 0x7b, 0x79, 0xa0, 0xbd,
+	0x1c, 0xd8, 0xe7, 0x17, 0x75, 0xdf, 0x4e, 0x69, 0x33, 0x53, 0x39, 0xa8, 0x78, 0x7f, 0xdc, 0x3f,
+	0x3f, 0x6f, 0x52, 0x93, 0xfa, 0x8f, 0x8a, 0xf7, 0x14, 0xf4, 0xe6, 0xb4, 0x36, 0xb1, 0xa8, 0xe2,
+	0xff, 0x06, 0x5d, 0x85, 0x20, 0xc2, 0xb6, 0xc6, 0x70, 0x08, 0x43, 0xa7, 0xc4, 0xe2, 0xe3, 0xe8,
+	0xd3, 0x2b, 0x30, 0xd7, 0x60, 0xe6, 0x86, 0x8d, 0x35, 0x07, 0x3f, 0xa0, 0x8c, 0x38, 0x84, 0x5a,
+	0xc2, 0x6b, 0x70, 0xb2, 0x43, 0x69, 0xab, 0x49, 0x0c, 0x11, 0x14, 0x41, 0x69, 0xa2, 0x26, 0xf4,
+	0x5c, 0x69, 0xfa, 0x50, 0x6b, 0xb7, 0xaa, 0x28, 0x18, 0x40, 0x6a, 0xd6, 0x7b, 0xaa, 0x1b, 0xc2,
+	0x4d, 0x98, 0x65, 0xd8, 0x32, 0xb0, 0x2d, 0x8e, 0x17, 0x41, 0x69, 0xaa, 0x96, 0xeb, 0xb9, 0xd2,
+	0x75, 0x6e, 0xcb, 0xfb, 0x91, 0x1a, 0x18, 0x08, 0xb7, 0x20, 0x6c, 0xd1, 0x2e, 0xb6, 0x9b, 0x0e,
+	0xd1, 0xf7, 0xc4, 0x4c, 0x11, 0x94, 0x32, 0xb5, 0x1b, 0x3d, 0x57, 0xca, 0x71, 0xf3, 0x68, 0x0c,
+	0xa9, 0x53, 0x7e, 0x63, 0x8b, 0xe8, 0x7b, 0x9e, 0xd7, 0x7e, 0xa7, 0xd3, 0xf7, 0x9a, 0x48, 0x7a,
+	0x45, 0x63, 0x48, 0x9d, 0xf2, 0x1b, 0xbe, 0x97, 0x03, 0x67, 0x1c, 0xba, 0x87, 0x2d, 0xd6, 0xec,
+	0xd8, 0xf4, 0x80, 0x18, 0xd8, 0x10, 0xaf, 0x14, 0x33, 0xa5, 0x6b, 0xeb, 0x4b, 0x32, 0xe7, 0x44,
+	0xf6, 0x38, 0xe9, 0x53, 0x2d, 0x6f, 0x50, 0x62, 0xd5, 0xd6, 0x9e, 0xba, 0xd2, 0xd8, 0x8f, 0xcf,
+	0xa5, 0x92, 0x49, 0x9c, 0xdd, 0xfd, 0x6d, 0x59, 0xa7, 0x6d, 0x25, 0x20, 0x90, 0xff, 0x95, 0x99,
+	0xb1, 0xa7, 0x38, 0x87, 0x1d, 0xcc, 0x7c, 0x07, 0xa6, 0x4e, 0xf3, 0x39, 0x1e, 0x04, 0x53, 0x08,
+	0x18, 0xe6, 0xfc, 0x9e, 0x66, 0x9b, 0x58, 0x4d, 0xad, 0x4d, 0xf7, 0x2d, 0x67, 0x4d, 0xcc, 0xfa,
+	0xbc, 0xbc, 0xe3, 0x05, 0xff, 0xd3, 0x95, 0x6e, 0xf0, 0x50, 0xcc, 0xd8, 0x93, 0x09, 0x55, 0xda,
+	0x9a, 0xb3, 0x2b, 0xd7, 0x2d, 0xa7, 0xe7, 0x4a, 0x22, 0x5f, 0x4f, 0xca, 0x1f, 0xa9, 0x7c, 0x25,
+	0x0d, 0x62, 0xdd, 0xe5, 0x3d, 0x83, 0xa6, 0xa9, 0x88, 0x93, 0x17, 0x9a, 0xa6, 0x92, 0x9a, 0xa6,
+	0x22, 0xbc, 0x05, 0xaf, 0xb5, 0x48, 0x9b, 0x38, 0x4d, 0x6a, 0x7b, 0xfa, 0x5e, 0x2d, 0x82, 0xd2,
+	0xd5, 0xda, 0x42, 0xcf, 0x95, 0x84, 0x40, 0xb0, 0x68, 0x10, 0xa9, 0xd0, 0x6f, 0x6d, 0x7a, 0x8d,
+	0xea, 0xea, 0x67, 0x2f, 0x9e, 0xac, 0x06, 0xaa, 0x7f, 0xf5, 0xe2, 0xc9, 0x6a, 0x3e, 0xac, 0x8f,
+	0x56, 0x59, 0xf7, 0x73, 0xad, 0xdc, 0x09, 0x92, 0x0d, 0xfd, 0x96, 0x81, 0x4b, 0xa9, 0x14, 0x54,
+	0x31, 0xeb, 0x50, 0x8b, 0x61, 0x0f, 0x42, 0xdf, 0x32, 0x4a, 0xc7, 0x18, 0x84, 0xd8, 0x20, 0x52,
+	0x61, 0xbf, 0x55, 0x37, 0x84, 0x3a, 0x9c, 0xec, 0xf3, 0xcf, 0xf3, 0x52, 0x39, 0x8b, 0x98, 0x20,
+	0xc1, 0x43, 0xd6, 0xfb, 0xfe, 0x51, 0xa8, 0x8a, 0x98, 0x19, 0x21, 0x54, 0x25, 0x0c, 0x55, 0x11,
+	0x5a, 0x30, 0x17, 0x96, 0x79, 0x93, 0x33, 0xe1, 0xe5, 0xa5, 0x17, 0xf4, 0x4e, 0x10, 0x74, 0x39,
+	0x1d, 0xf4, 0x03, 0x6c, 0x6a, 0xfa, 0xe1, 0xfb, 0x58, 0x8f, 0xe4, 0x4b, 0x45, 0x41, 0xea, 0x6c,
+	0xd8, 0xc7, 0xb9, 0x34, 0x12, 0xf5, 0x96, 0x1d, 0xa9, 0xde, 0x26, 0xcf, 0x57, 0x6f, 0xe8, 0x8b,
+	0x09, 0x38, 0xdb, 0x60, 0xe6, 0x5d, 0xc3, 0xd8, 0xa2, 0xe1, 0x46, 0x32, 0xb2, 0x7a, 0x43, 0x6c,
+	0x2a, 0xf7, 0x23, 0xa1, 0xb9, 0x3a, 0x6b, 0x67, 0xa9, 0x33, 0x13, 0x57, 0xa7, 0x19, 0x57, 0xfa,
+	0x7e, 0xa4, 0xf4, 0xc4, 0x28, 0xb1, 0xe2, 0x52, 0x0f, 0xdc, 0x0a, 0xae, 0x5c, 0xce, 0x56, 0x90,
+	0x7d, 0xd9, 0x5b, 0xc1, 0xbf, 0x56, 0xb4, 0x66, 0x18, 0x65, 0x87, 0x46, 0x15, 0xfd, 0x37, 0x80,
+	0x62, 0x32, 0x15, 0xfe, 0xa3, 0x05, 0x8d, 0x1e, 0x8f, 0xc3, 0xb9, 0x06, 0x33, 0x3f, 0x24, 0xce,
+	0xae, 0x61, 0x6b, 0xdd, 0x4b, 0xcd, 0x7c, 0x02, 0xa3, 0x92, 0x0f, 0xa4, 0x0b, 0xd6, 0x73, 0xfb,
+	0x7c, 0x7b, 0xc9, 0x62, 0x72, 0x2f, 0xe1, 0x41, 0x90, 0x3a, 0x13, 0x76, 0x71, 0xfd, 0xab, 0xaf,
+	0x27, 0xe4, 0x5f, 0x89, 0xc9, 0xdf, 0x0d, 0xd6, 0x1e, 0x25, 0xc0, 0x2f, 0x00, 0x2e, 0x0f, 0x20,
+	0x25, 0xcc, 0x81, 0x98, 0x94, 0xe0, 0xe5, 0x49, 0x39, 0x7e, 0x41, 0x29, 0x7f, 0x02, 0x70, 0xd1,
+	0x3b, 0x88, 0x68, 0xab, 0x85, 0x75, 0xe7, 0x61, 0xc7, 0xc6, 0x9a, 0xa1, 0xe2, 0xae, 0x66, 0x1b,
+	0x4c, 0xa8, 0xc2, 0xff, 0xc5, 0x14, 0x63, 0x22, 0x28, 0x66, 0x4a, 0x13, 0xb5, 0xc5, 0x9e, 0x2b,
+	0xcd, 0xa5, 0xf4, 0x64, 0x48, 0xbd, 0x16, 0x09, 0xca, 0x86, 0x50, 0xb4, 0x7a, 0x33, 0x41, 0xf3,
+	0x52, 0xfc, 0xdc, 0xa4, 0xad, 0x32, 0xeb, 0x94, 0x6d, 0x8e, 0x08, 0xfd, 0x0e, 0xa0, 0x74, 0x0a,
+	0xda, 0x90, 0xe7, 0x1f, 0x00, 0x14, 0x75, 0x6e, 0x80, 0x8d, 0x26, 0xf3, 0x6d, 0x9a, 0x41, 0x00,
+	0x11, 0x9c, 0x75, 0x1b, 0x7a, 0xe8, 0x31, 0xd9, 0x73, 0x25, 0x89, 0x63, 0x3d, 0x2d, 0x10, 0x1a,
+	0xea, 0xc2, 0xb4, 0x10, 0x86, 0x39, 0x01, 0x19, 0xfd, 0x0c, 0xe0, 0x7c, 0xb4, 0x9c, 0xba, 0x7f,
+	0x2b, 0x26, 0x07, 0xf8, 0xd2, 0x98, 0x2f, 0x27, 0x98, 0xff, 0xff, 0x49, 0xe6, 0x3d, 0x50, 0x65,
+	0x12, 0xa2, 0x42, 0xee, 0x38, 0x5c, 0x19, 0x04, 0x37, 0xa4, 0xfe, 0x3b, 0x00, 0xe7, 0x23, 0xc6,
+	0x22, 0xcf, 0xb3, 0x69, 0xdf, 0x0c, 0x68, 0x5f, 0x4e, 0xd2, 0x1e, 0x9b, 0x7e, 0x28, 0xca, 0xe7,
+	0xc2, 0x10, 0x31, 0x5a, 0x3d, 0x7c, 0x3b, 0xd4, 0xde, 0xc1, 0x24, 0x81, 0x6f, 0x7c, 0x48, 0x7c,
+	0x83, 0x82, 0x0c, 0x89, 0x2f, 0x0c, 0x11, 0xe1, 0x43, 0xbf, 0x02, 0x98, 0x6f, 0x30, 0xf3, 0xde,
+	0xbe, 0x65, 0x92, 0x9d, 0xc3, 0x8d, 0x5d, 0xcd, 0x36, 0xb1, 0xd1, 0xdf, 0x48, 0x2e, 0x2d, 0x2b,
+	0x6e, 0x25, 0xb2, 0xe2, 0x95, 0x58, 0x56, 0xec, 0x70, 0x68, 0x65, 0x9d, 0x63, 0x0b, 0x77, 0x3f,
+	0x86, 0x76, 0x21, 0x3a, 0x1d, 0x7a, 0x98, 0x21, 0x35, 0x38, 0x63, 0xe1, 0x6e, 0x33, 0x7d, 0x4a,
+	0xe4, 0x7b, 0xae, 0xb4, 0xc0, 0xf1, 0x24, 0x0c, 0x90, 0x7a, 0xdd, 0xc2, 0xe1, 0x76, 0x5a, 0x37,
+	0xd0, 0x73, 0x5e, 0x35, 0x5b, 0xb6, 0x66, 0xb1, 0x1d, 0x6c, 0x5f, 0x36, 0x3f, 0x42, 0x05, 0x4e,
+	0x79, 0x10, 0x69, 0xd7, 0xc2, 0x76, 0x70, 0xf4, 0xcc, 0xf7, 0x5c, 0x69, 0x36, 0x42, 0xef, 0x0f,
+	0x21, 0xf5, 0xaa, 0x85, 0xbb, 0x9b, 0x5d, 0xeb, 0x8c, 0x42, 0x73, 0x82, 0x75, 0xc4, 0xb8, 0x2c,
+	0xc0, 0x95, 0x41, 0x0b, 0xec, 0xb3, 0xb8, 0xfe, 0xf9, 0x24, 0xcc, 0x34, 0x98, 0x29, 0x7c, 0x0d,
+	0xe0, 0x74, 0xe2, 0x2d, 0xf6, 0x6d, 0xf9, 0x5c, 0x6f, 0xd9, 0x72, 0xea, 0xe5, 0x23, 0xff, 0xde,
+	0xa8, 0x9e, 0xa1, 0xb8, 0x8f, 0x01, 0x9c, 0x4d, 0xdd, 0x09, 0xaa, 0xe7, 0x0f, 0x9b, 0xf4, 0xcd,
+	0xd7, 0x46, 0xf7, 0x0d, 0x41, 0x7d, 0x09, 0xe0, 0xf5, 0xc4, 0xfd, 0xfc, 0xfc, 0x51, 0x4f, 0x38,
+	0xe6, 0xef, 0x8c, 0xe8, 0x18, 0x62, 0xf9, 0x1e, 0xc0, 0xf9, 0x81, 0x27, 0xed, 0xed, 0x21, 0xb8,
+	0x1f, 0xe0, 0x9f, 0xbf, 0x77, 0x31, 0xff, 0x10, 0xe0, 0xb7, 0x00, 0xe6, 0xd2, 0xa7, 0xd1, 0xbb,
+	0x43, 0x47, 0x8f, 0x9c, 0xf3, 0x1b, 0x17, 0x70, 0x3e, 0x81, 0x2b, 0x5d, 0xef, 0x43, 0xe0, 0x4a,
+	0x39, 0xe7, 0x37, 0x2e, 0xe0, 0xdc, 0xc7, 0x55, 0xfb, 0xf8, 0xe9, 0x51, 0x01, 0x3c, 0x3b, 0x2a,
+	0x80, 0xbf, 0x8e, 0x0a, 0xe0, 0x9b, 0xe3, 0xc2, 0xd8, 0xb3, 0xe3, 0xc2, 0xd8, 0x1f, 0xc7, 0x85,
+	0xb1, 0x8f, 0x6a, 0xb1, 0x93, 0x20, 0x98, 0xa8, 0xdc, 0xd2, 0xb6, 0x59, 0xbf, 0xa1, 0x1c, 0xac,
+	0xbf, 0xa9, 0x3c, 0x3a, 0xf1, 0xe9, 0xac, 0x1c, 0x7d, 0x3b, 0xf3, 0x4f, 0x8a, 0xed, 0xac, 0xff,
+	0xb9, 0xea, 0x8d, 0x7f, 0x06, 0x00, 0xa8, 0xb8, 0x2a, 0x43, 0x69, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1181,6 +1194,16 @@ func (m *MsgCreatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LimitOrder {
+		i--
+		if m.LimitOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TokenMinAmount1.Size()
 		i -= size
@@ -1897,6 +1920,9 @@ func (m *MsgCreatePosition) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenMinAmount1.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.LimitOrder {
+		n += 2
+	}
 	return n
 }
 
@@ -2362,6 +2388,26 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LimitOrder = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -151,6 +151,10 @@ func (k Keeper) JoinPoolNoSwap(
 			}
 		}
 	}()
+	if err := k.checkPoolNotPaused(ctx, poolId); err != nil {
+		return nil, osmomath.ZeroInt(), err
+	}
+
 	// all pools handled within this method are pointer references, `JoinPool` directly updates the pools
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -248,6 +252,10 @@ func (k Keeper) JoinSwapExactAmountIn(
 		}
 	}()
 
+	if err := k.checkPoolNotPaused(ctx, poolId); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	pool, err := k.GetCFMMPool(ctx, poolId)
 	if err != nil {
 		return osmomath.Int{}, err
@@ -296,6 +304,10 @@ func (k Keeper) JoinSwapShareAmountOut(
 		}
 	}()
 
+	if err := k.checkPoolNotPaused(ctx, poolId); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	pool, err := k.GetCFMMPool(ctx, poolId)
 	if err != nil {
 		return osmomath.Int{}, err
@@ -374,6 +386,11 @@ func (k Keeper) ExitSwapShareAmountIn(
 	shareInAmount osmomath.Int,
 	tokenOutMinAmount osmomath.Int,
 ) (tokenOutAmount osmomath.Int, err error) {
+	// Unlike ExitPool, this swaps against the pool, so it isn't allowed on a paused pool.
+	if err := k.checkPoolNotPaused(ctx, poolId); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	exitCoins, err := k.ExitPool(ctx, sender, poolId, shareInAmount, sdk.Coins{})
 	if err != nil {
 		return osmomath.Int{}, err
@@ -412,6 +429,11 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount osmomath.Int,
 ) (shareInAmount osmomath.Int, err error) {
+	// Unlike ExitPool, this swaps against the pool, so it isn't allowed on a paused pool.
+	if err := k.checkPoolNotPaused(ctx, poolId); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	pool, err := k.GetCFMMPool(ctx, poolId)
 	if err != nil {
 		return osmomath.Int{}, err
@@ -433,3 +455,11 @@ func (k Keeper) ExitSwapExactAmountOut(
 
 	return shareInAmount, nil
 }
+
+// checkPoolNotPaused returns an error if the pool is paused in the pool manager, in which case it can only be exited.
+func (k Keeper) checkPoolNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.poolManager.IsPoolPaused(ctx, poolId) {
+		return poolmanagertypes.PoolPausedError{PoolId: poolId}
+	}
+	return nil
+}
@@ -690,6 +690,33 @@ func (s *KeeperTestSuite) TestExitPool() {
 
 // TestJoinPoolExitPool_InverseRelationship tests that joining pool and exiting pool
 // guarantees same amount in and out
+// validates that a paused pool can be exited, but not joined nor exited with a swap.
+func (s *KeeperTestSuite) TestPausedPoolJoinExit() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	sender := s.TestAccs[1]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000), sdk.NewInt64Coin("bar", 100_000)))
+	_, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.App.PoolManagerKeeper.PausePool(s.Ctx, poolId))
+	expectedErr := poolmanagertypes.PoolPausedError{PoolId: poolId}
+
+	_, _, err = s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = s.App.GAMMKeeper.JoinSwapExactAmountIn(s.Ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)), osmomath.OneInt())
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = s.App.GAMMKeeper.JoinSwapShareAmountOut(s.Ctx, sender, poolId, "foo", types.OneShare, osmomath.NewInt(100_000))
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = s.App.GAMMKeeper.ExitSwapShareAmountIn(s.Ctx, sender, poolId, "foo", types.OneShare, osmomath.OneInt())
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = s.App.GAMMKeeper.ExitSwapExactAmountOut(s.Ctx, sender, poolId, sdk.NewInt64Coin("foo", 1_000), types.OneShare.MulRaw(10))
+	s.Require().ErrorIs(err, expectedErr)
+
+	_, err = s.App.GAMMKeeper.ExitPool(s.Ctx, sender, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestJoinPoolExitPool_InverseRelationship() {
 	testCases := []struct {
 		name             string
@@ -539,7 +539,7 @@ func (s *KeeperTestSuite) TestOutOfGasError() {
 // 				},
 // 				testCoin,
 // 				osmomath.ZeroInt(),
-// 			)
+// 			, poolmanagertypes.SwapLimits{})
 
 // 			_, swapOutErr := s.App.PoolManagerKeeper.RouteExactAmountOut(
 // 				s.Ctx,
@@ -552,7 +552,7 @@ func (s *KeeperTestSuite) TestOutOfGasError() {
 // 				},
 // 				osmomath.NewInt(1000000000000000000),
 // 				testCoin,
-// 			)
+// 			, poolmanagertypes.SwapLimits{})
 
 // 			if test.expectPass {
 // 				s.Require().NoError(swapInErr)
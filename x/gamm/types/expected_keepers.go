@@ -71,13 +71,15 @@ type PoolManager interface {
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
-		tokenOutMinAmount osmomath.Int) (tokenOutAmount osmomath.Int, err error)
+		tokenOutMinAmount osmomath.Int,
+		limits poolmanagertypes.SwapLimits) (tokenOutAmount osmomath.Int, err error)
 
 	RouteExactAmountOut(ctx sdk.Context,
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountOutRoute,
 		tokenInMaxAmount osmomath.Int,
 		tokenOut sdk.Coin,
+		limits poolmanagertypes.SwapLimits,
 	) (tokenInAmount osmomath.Int, err error)
 
 	MultihopEstimateOutGivenExactAmountIn(
@@ -98,6 +100,8 @@ type PoolManager interface {
 	CreateConcentratedPoolAsPoolManager(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (poolmanagertypes.PoolI, error)
 
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
+
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
 }
 
 type PoolIncentivesKeeper interface {
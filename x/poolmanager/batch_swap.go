@@ -0,0 +1,68 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// BatchSwap executes the legs in order and returns their results, in the same order.
+//
+// With the AllOrNothing policy, the first leg to fail reverts all the legs and its error is returned.
+// With the BestEffort policy, each leg is executed in its own cache context, so that a failing leg only reverts
+// itself, and its error is reported in its result instead.
+//
+// The legs are executed through RouteExactAmountIn and RouteExactAmountOut, so that the optional
+// limits of each leg are enforced, and the swap hooks see the pools of every leg that succeeds, as with the other
+// swap messages.
+func (k Keeper) BatchSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	legs []types.BatchSwapLeg,
+	policy types.BatchSwapPolicy,
+) ([]types.BatchSwapLegResult, error) {
+	results := make([]types.BatchSwapLegResult, len(legs))
+
+	if policy == types.AllOrNothing {
+		cacheCtx, write := ctx.CacheContext()
+		for i, leg := range legs {
+			amount, err := k.batchSwapLeg(cacheCtx, sender, leg)
+			if err != nil {
+				return nil, types.BatchSwapLegError{Index: i, Err: err}
+			}
+			results[i] = types.BatchSwapLegResult{Success: true, Amount: amount}
+		}
+		write()
+		return results, nil
+	}
+
+	for i, leg := range legs {
+		var amount osmomath.Int
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			var err error
+			amount, err = k.batchSwapLeg(cacheCtx, sender, leg)
+			return err
+		})
+		if err != nil {
+			results[i] = types.BatchSwapLegResult{Success: false, Amount: osmomath.ZeroInt(), Error: err.Error()}
+			continue
+		}
+		results[i] = types.BatchSwapLegResult{Success: true, Amount: amount}
+	}
+	return results, nil
+}
+
+// batchSwapLeg executes the leg, and returns its token out amount if it's an exact in swap, or its token in amount
+// if it's an exact out swap.
+func (k Keeper) batchSwapLeg(ctx sdk.Context, sender sdk.AccAddress, leg types.BatchSwapLeg) (osmomath.Int, error) {
+	switch {
+	case leg.ExactIn != nil && leg.ExactOut == nil:
+		return k.RouteExactAmountIn(ctx, sender, leg.ExactIn.Routes, leg.ExactIn.TokenIn, leg.ExactIn.TokenOutMinAmount, leg.ExactIn.SwapLimits())
+	case leg.ExactIn == nil && leg.ExactOut != nil:
+		return k.RouteExactAmountOut(ctx, sender, leg.ExactOut.Routes, leg.ExactOut.TokenInMaxAmount, leg.ExactOut.TokenOut, leg.ExactOut.SwapLimits())
+	default:
+		return osmomath.Int{}, types.ErrInvalidBatchSwapLeg
+	}
+}
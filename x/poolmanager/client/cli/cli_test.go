@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
@@ -73,6 +74,12 @@ func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
 
+var (
+	testDeadline       = time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+	testMaxPriceImpact = osmomath.MustNewDecFromStr("0.01")
+	testLimitSpotPrice = osmomath.MustNewDecFromStr("0.5")
+)
+
 func TestNewSwapExactAmountOutCmd(t *testing.T) {
 	desc, _ := cli.NewSwapExactAmountOutCmd()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountOut]{
@@ -85,6 +92,17 @@ func TestNewSwapExactAmountOutCmd(t *testing.T) {
 				TokenOut:         sdk.NewInt64Coin("stake", 10),
 			},
 		},
+		"swap exact amount out with limits": {
+			Cmd: "10stake 20 --swap-route-pool-ids=1 --swap-route-denoms=node0token --deadline=2024-08-01T12:00:00Z --limit-spot-price=0.5 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountOut{
+				Sender:           testAddresses[0].String(),
+				Routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "node0token"}},
+				TokenInMaxAmount: osmomath.NewIntFromUint64(20),
+				TokenOut:         sdk.NewInt64Coin("stake", 10),
+				Deadline:         &testDeadline,
+				LimitSpotPrice:   &testLimitSpotPrice,
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -183,6 +201,17 @@ func TestNewSwapExactAmountInCmd(t *testing.T) {
 				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
 			},
 		},
+		"swap exact amount in with limits": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=node0token --deadline=2024-08-01T12:00:00Z --max-price-impact=0.01 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
+				Deadline:          &testDeadline,
+				MaxPriceImpact:    &testMaxPriceImpact,
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -258,6 +287,30 @@ func TestGetCmdEstimateSinglePoolSwapExactAmountOut(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdFindBestRoute(t *testing.T) {
+	desc, _ := cli.GetCmdFindBestRoute()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.FindBestRouteRequest]{
+		"basic test": {
+			Cmd: "10stake node0token",
+			ExpectedQuery: &queryproto.FindBestRouteRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "node0token",
+			},
+		},
+		"with flags": {
+			Cmd: "10stake node0token --max-hops=2 --max-routes=5 --split=true",
+			ExpectedQuery: &queryproto.FindBestRouteRequest{
+				TokenIn:       "10stake",
+				TokenOutDenom: "node0token",
+				MaxHops:       2,
+				MaxRoutes:     5,
+				Split:         true,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 	val := s.network.Validators[0]
 
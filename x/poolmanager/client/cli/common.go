@@ -4,9 +4,12 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
 )
 
@@ -71,3 +74,52 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	}
 	return routes, nil
 }
+
+func swapDeadline(fs *flag.FlagSet) (*time.Time, error) {
+	deadlineStr, err := fs.GetString(FlagDeadline)
+	if err != nil || deadlineStr == "" {
+		return nil, err
+	}
+
+	deadline, err := time.Parse(time.RFC3339, deadlineStr)
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
+func swapMaxPriceImpact(fs *flag.FlagSet) (*osmomath.Dec, error) {
+	return optionalDecFlag(fs, FlagMaxPriceImpact)
+}
+
+func swapLimitSpotPrice(fs *flag.FlagSet) (*osmomath.Dec, error) {
+	return optionalDecFlag(fs, FlagLimitSpotPrice)
+}
+
+func optionalDecFlag(fs *flag.FlagSet, flagName string) (*osmomath.Dec, error) {
+	decStr, err := fs.GetString(flagName)
+	if err != nil || decStr == "" {
+		return nil, err
+	}
+
+	dec, err := osmomath.NewDecFromStr(decStr)
+	if err != nil {
+		return nil, err
+	}
+	return &dec, nil
+}
+
+// swapLimitsFieldParsers parses the optional swap limits of the swap messages from their flags.
+var swapLimitsFieldParsers = map[string]osmocli.CustomFieldParserFn{
+	"Deadline":       osmocli.FlagOnlyParser(swapDeadline),
+	"MaxPriceImpact": osmocli.FlagOnlyParser(swapMaxPriceImpact),
+	"LimitSpotPrice": osmocli.FlagOnlyParser(swapLimitSpotPrice),
+}
+
+// withSwapLimitsFieldParsers returns the given field parsers and the parsers of the swap limits.
+func withSwapLimitsFieldParsers(parsers map[string]osmocli.CustomFieldParserFn) map[string]osmocli.CustomFieldParserFn {
+	for field, parser := range swapLimitsFieldParsers {
+		parsers[field] = parser
+	}
+	return parsers
+}
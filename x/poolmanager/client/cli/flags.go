@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"time"
+
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
 )
 
@@ -27,6 +30,22 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to uint32.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint32.
+	FlagMaxRoutes = "max-routes"
+	// Will be parsed to bool.
+	FlagSplit = "split"
+	// Will be parsed to time.Time.
+	FlagDeadline = "deadline"
+	// Will be parsed to osmomath.Dec.
+	FlagMaxPriceImpact = "max-price-impact"
+	// Will be parsed to osmomath.Dec.
+	FlagLimitSpotPrice = "limit-spot-price"
+	// Will be parsed to string.
+	FlagLegsFile = "legs-file"
+	// Will be parsed to types.BatchSwapPolicy.
+	FlagPolicy = "policy"
 )
 
 type createBalancerPoolInputs struct {
@@ -70,6 +89,33 @@ type SwapAmountOutSplitRoute struct {
 	TokenOutAmount int64                      `json:"token_out_amount"`
 }
 
+type batchSwapLegsInputs struct {
+	Legs []batchSwapLegInputs `json:"legs"`
+}
+
+type batchSwapLegInputs struct {
+	ExactIn  *swapExactAmountInLegInputs  `json:"exact_in"`
+	ExactOut *swapExactAmountOutLegInputs `json:"exact_out"`
+}
+
+type swapExactAmountInLegInputs struct {
+	Routes            []types.SwapAmountInRoute `json:"routes"`
+	TokenIn           string                    `json:"token_in"`
+	TokenOutMinAmount int64                     `json:"token_out_min_amount"`
+	Deadline          *time.Time                `json:"deadline,omitempty"`
+	MaxPriceImpact    *osmomath.Dec             `json:"max_price_impact,omitempty"`
+	LimitSpotPrice    *osmomath.Dec             `json:"limit_spot_price,omitempty"`
+}
+
+type swapExactAmountOutLegInputs struct {
+	Routes           []types.SwapAmountOutRoute `json:"routes"`
+	TokenInMaxAmount int64                      `json:"token_in_max_amount"`
+	TokenOut         string                     `json:"token_out"`
+	Deadline         *time.Time                 `json:"deadline,omitempty"`
+	MaxPriceImpact   *osmomath.Dec              `json:"max_price_impact,omitempty"`
+	LimitSpotPrice   *osmomath.Dec              `json:"limit_spot_price,omitempty"`
+}
+
 func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagSwapRoutePoolIds, "", "swap route pool id")
@@ -106,3 +152,33 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetFindBestRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxHops, "0", "Maximum number of pools of a route (0 uses the default)")
+	fs.String(FlagMaxRoutes, "0", "Maximum number of routes returned (0 uses the default)")
+	fs.String(FlagSplit, "false", "Split the token in across the best routes that don't share any pool")
+	return fs
+}
+
+func FlagSetBatchSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagLegsFile, "", "Batch swap legs json file path")
+	return fs
+}
+
+func FlagSetBatchSwapPolicy() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPolicy, "all-or-nothing", "Batch swap policy, either all-or-nothing or best-effort")
+	return fs
+}
+
+func FlagSetSwapLimits() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDeadline, "", "Latest block time, in RFC3339 format, at which the swap can execute")
+	fs.String(FlagMaxPriceImpact, "", "Maximum relative change, between 0 and 1, of the spot price of each route caused by the swap")
+	fs.String(FlagLimitSpotPrice, "", "Minimum spot price of the token in, in terms of the token out, of each route after the swap")
+	return fs
+}
@@ -40,6 +40,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllRegisteredAlloyedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAccountVolumeTakerFeeTier)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPoolTakerFees)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPausedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdFindBestRoute)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -279,3 +283,44 @@ func GetAllRegisteredAlloyedPools() (*osmocli.QueryDescriptor, *queryproto.AllRe
 		Long:  "{{.Short}}",
 	}, &queryproto.AllRegisteredAlloyedPoolsRequest{}
 }
+
+func GetCmdAccountVolumeTakerFeeTier() (*osmocli.QueryDescriptor, *queryproto.AccountVolumeTakerFeeTierRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "account-volume-taker-fee-tier",
+		Short: "Query the trailing swap volume of an account and the volume taker fee tier it reaches",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} account-volume-taker-fee-tier osmo1...`,
+	}, &queryproto.AccountVolumeTakerFeeTierRequest{}
+}
+
+func GetCmdAllPoolTakerFees() (*osmocli.QueryDescriptor, *queryproto.AllPoolTakerFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-pool-taker-fees",
+		Short: "Query the taker fee overrides of all pools",
+		Long:  "{{.Short}}",
+	}, &queryproto.AllPoolTakerFeesRequest{}
+}
+
+func GetCmdPausedPools() (*osmocli.QueryDescriptor, *queryproto.PausedPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "paused-pools",
+		Short: "Query the ids of the paused pools",
+		Long:  "{{.Short}}",
+	}, &queryproto.PausedPoolsRequest{}
+}
+
+// GetCmdFindBestRoute returns the routes with the highest expected output for a swap.
+func GetCmdFindBestRoute() (*osmocli.QueryDescriptor, *queryproto.FindBestRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "find-best-route",
+		Short: "Query the routes with the highest expected output for a swap",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} find-best-route 1000000uosmo uion --max-hops=3 --max-routes=5 --split=true`,
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetFindBestRoute()}},
+		CustomFlagOverrides: map[string]string{
+			"maxhops":   FlagMaxHops,
+			"maxroutes": FlagMaxRoutes,
+			"split":     FlagSplit,
+		},
+	}, &queryproto.FindBestRouteRequest{}
+}
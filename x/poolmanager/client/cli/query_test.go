@@ -24,6 +24,9 @@ func (s *QueryTestSuite) SetupSuite() {
 	s.queryClient = poolmanagerqueryproto.NewQueryClient(s.QueryHelper)
 	// create a new pool
 	s.PrepareBalancerPool()
+	// create a two denom pool with uosmo for the route finder, which only routes through pools with two denoms and
+	// liquidity in uosmo
+	s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("baz", 1_000_000))
 	s.Commit()
 }
 
@@ -72,6 +75,22 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			},
 			&poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{},
 		},
+		{
+			"Query find best route",
+			"/osmosis.poolmanager.v1beta1.Query/FindBestRoute",
+			&poolmanagerqueryproto.FindBestRouteRequest{
+				TokenIn:       "10uosmo",
+				TokenOutDenom: "baz",
+				Split:         true,
+			},
+			&poolmanagerqueryproto.FindBestRouteResponse{},
+		},
+		{
+			"Query paused pools",
+			"/osmosis.poolmanager.v1beta1.Query/PausedPools",
+			&poolmanagerqueryproto.PausedPoolsRequest{},
+			&poolmanagerqueryproto.PausedPoolsResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -24,6 +24,7 @@ import (
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v25/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v25/x/gamm/pool-models/stableswap"
@@ -37,7 +38,12 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewBatchSwapCmd)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
+	txCmd.AddCommand(NewSetPoolTakerFeeCmd())
+	txCmd.AddCommand(NewRemovePoolTakerFeeCmd())
+	txCmd.AddCommand(NewPausePoolsCmd())
+	txCmd.AddCommand(NewUnpausePoolsCmd())
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
@@ -50,11 +56,14 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 	return &osmocli.TxCliDesc{
 		Use:     "swap-exact-amount-in",
 		Short:   "swap exact amount in",
-		Example: "osmosisd tx poolmanager swap-exact-amount-in 2000000uosmo 1 --swap-route-pool-ids 5 --swap-route-denoms uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
-		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+		Example: "osmosisd tx poolmanager swap-exact-amount-in 2000000uosmo 1 --swap-route-pool-ids 5 --swap-route-denoms uion --deadline 2024-08-01T12:00:00Z --max-price-impact 0.01 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		CustomFieldParsers: withSwapLimitsFieldParsers(map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+		}),
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapLimits()},
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
 	}, &types.MsgSwapExactAmountIn{}
 }
 
@@ -66,7 +75,10 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Example:          "osmosisd tx poolmanager swap-exact-amount-out 100uion 1000000 --swap-route-pool-ids 1 --swap-route-denoms uosmo --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		NumArgs:          2,
 		ParseAndBuildMsg: NewBuildSwapExactAmountOutMsg,
-		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapLimits()},
+		},
 	}, &types.MsgSwapExactAmountOut{}
 }
 
@@ -107,11 +119,12 @@ func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteS
 			]
 		}
 		`,
-		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+		CustomFieldParsers: withSwapLimitsFieldParsers(map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
-		},
+		}),
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapLimits()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountIn{}
 }
@@ -153,11 +166,12 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 			]
 			}
 		`,
-		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+		CustomFieldParsers: withSwapLimitsFieldParsers(map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountOut),
-		},
+		}),
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapLimits()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
@@ -220,6 +234,114 @@ func NewMsgNewSplitRouteSwapExactAmountIn(fs *flag.FlagSet) ([]types.SwapAmountI
 	return splitRouteProto, nil
 }
 
+func NewBatchSwapCmd() (*osmocli.TxCliDesc, *types.MsgBatchSwap) {
+	return &osmocli.TxCliDesc{
+		Use:   "batch-swap",
+		Short: "execute several swaps in a single message",
+		Long: `Execute the swaps of the legs file in order. With the all-or-nothing policy, any failing swap reverts
+all of them. With the best-effort policy, a failing swap only reverts itself, and its error is reported in the response.
+Each leg can set the optional deadline, max_price_impact and limit_spot_price limits of the swap messages.`,
+		Example: `osmosisd tx poolmanager batch-swap --legs-file="./legs.json" --policy best-effort --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo
+		- legs.json
+		{
+			"legs": [
+				{
+				"exact_in": {
+					"routes": [{"pool_id": 1, "token_out_denom": "uion"}],
+					"token_in": "1000000uosmo",
+					"token_out_min_amount": 1,
+					"deadline": "2024-08-01T12:00:00Z",
+					"max_price_impact": "0.01"
+				}
+				},
+				{
+				"exact_out": {
+					"routes": [{"pool_id": 2, "token_in_denom": "uosmo"}],
+					"token_in_max_amount": 2000000,
+					"token_out": "1000000uatom"
+				}
+				}
+			]
+		}
+		`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Legs":   osmocli.FlagOnlyParser(NewBatchSwapLegs),
+			"Policy": osmocli.FlagOnlyParser(batchSwapPolicy),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetBatchSwap()},
+			OptionalFlags: []*flag.FlagSet{FlagSetBatchSwapPolicy()},
+		},
+	}, &types.MsgBatchSwap{}
+}
+
+func NewBatchSwapLegs(fs *flag.FlagSet) ([]types.BatchSwapLeg, error) {
+	legsFile, _ := fs.GetString(FlagLegsFile)
+	if legsFile == "" {
+		return nil, fmt.Errorf("must pass in a legs json using the --%s flag", FlagLegsFile)
+	}
+
+	contents, err := os.ReadFile(legsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var legsJSONdata batchSwapLegsInputs
+	err = json.Unmarshal(contents, &legsJSONdata)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := make([]types.BatchSwapLeg, len(legsJSONdata.Legs))
+	for i, leg := range legsJSONdata.Legs {
+		if leg.ExactIn != nil {
+			tokenIn, err := sdk.ParseCoinNormalized(leg.ExactIn.TokenIn)
+			if err != nil {
+				return nil, err
+			}
+			legs[i].ExactIn = &types.SwapExactAmountInLeg{
+				Routes:            leg.ExactIn.Routes,
+				TokenIn:           tokenIn,
+				TokenOutMinAmount: osmomath.NewInt(leg.ExactIn.TokenOutMinAmount),
+				Deadline:          leg.ExactIn.Deadline,
+				MaxPriceImpact:    leg.ExactIn.MaxPriceImpact,
+				LimitSpotPrice:    leg.ExactIn.LimitSpotPrice,
+			}
+		}
+		if leg.ExactOut != nil {
+			tokenOut, err := sdk.ParseCoinNormalized(leg.ExactOut.TokenOut)
+			if err != nil {
+				return nil, err
+			}
+			legs[i].ExactOut = &types.SwapExactAmountOutLeg{
+				Routes:           leg.ExactOut.Routes,
+				TokenInMaxAmount: osmomath.NewInt(leg.ExactOut.TokenInMaxAmount),
+				TokenOut:         tokenOut,
+				Deadline:         leg.ExactOut.Deadline,
+				MaxPriceImpact:   leg.ExactOut.MaxPriceImpact,
+				LimitSpotPrice:   leg.ExactOut.LimitSpotPrice,
+			}
+		}
+	}
+
+	return legs, nil
+}
+
+func batchSwapPolicy(fs *flag.FlagSet) (types.BatchSwapPolicy, error) {
+	policy, err := fs.GetString(FlagPolicy)
+	if err != nil {
+		return 0, err
+	}
+	switch policy {
+	case "", "all-or-nothing":
+		return types.AllOrNothing, nil
+	case "best-effort":
+		return types.BestEffort, nil
+	default:
+		return 0, fmt.Errorf("invalid --%s %q, must be all-or-nothing or best-effort", FlagPolicy, policy)
+	}
+}
+
 func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	tokenOutStr, tokenInMaxAmountStr := args[0], args[1]
 	routes, err := swapAmountOutRoutes(fs)
@@ -236,11 +358,28 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *
 	if !ok {
 		return nil, errors.New("invalid token in max amount")
 	}
+
+	deadline, err := swapDeadline(fs)
+	if err != nil {
+		return nil, err
+	}
+	maxPriceImpact, err := swapMaxPriceImpact(fs)
+	if err != nil {
+		return nil, err
+	}
+	limitSpotPrice, err := swapLimitSpotPrice(fs)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSwapExactAmountOut{
 		Sender:           clientCtx.GetFromAddress().String(),
 		Routes:           routes,
 		TokenInMaxAmount: tokenInMaxAmount,
 		TokenOut:         tokenOut,
+		Deadline:         deadline,
+		MaxPriceImpact:   maxPriceImpact,
+		LimitSpotPrice:   limitSpotPrice,
 	}, nil
 }
 
@@ -544,6 +683,148 @@ set-denom-pair-taker-fee uosmo,uion,0.0016,uion,uosmo,0.0016
 	return cmd
 }
 
+func NewSetPoolTakerFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-taker-fee [pool-ids-with-taker-fee] [flags]",
+		Short: "allows admin addresses to set the taker fee override of pools",
+		Long: strings.TrimSpace(`Allows admin addresses to set the taker fee override of pools.
+A pool taker fee takes precedence over the taker fee of the denom pair being swapped.
+
+Passing in pool ids with taker fees separated by commas would be parsed automatically to poolTakerFee records.
+Ex) set-pool-taker-fee 1,0.001,1400,0.0005 ->
+
+[pool 1, takerFee 0.1%]
+[pool 1400, takerFee 0.05%]
+
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolTakerFees, err := ParsePoolTakerFee(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPoolTakerFee{
+				Sender:        clientCtx.GetFromAddress().String(),
+				PoolTakerFees: poolTakerFees,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemovePoolTakerFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-pool-taker-fee [pool-ids] [flags]",
+		Short: "allows admin addresses to remove the taker fee override of pools",
+		Long: strings.TrimSpace(`Allows admin addresses to remove the taker fee override of pools, which then charge the denom pair taker fee.
+
+Ex) remove-pool-taker-fee 1,1400
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemovePoolTakerFee{
+				Sender:  clientCtx.GetFromAddress().String(),
+				PoolIds: poolIds,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewPausePoolsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-pools [pool-ids] [flags]",
+		Short: "allows admin addresses to pause pools",
+		Long: strings.TrimSpace(`Allows admin addresses to pause pools. Swaps, joins and concentrated liquidity position creations are rejected on a paused pool, while exits and withdrawals are still allowed.
+
+Ex) pause-pools 1,1400
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPausePools{
+				Sender:  clientCtx.GetFromAddress().String(),
+				PoolIds: poolIds,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnpausePoolsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-pools [pool-ids] [flags]",
+		Short: "allows admin addresses to unpause pools",
+		Long: strings.TrimSpace(`Allows admin addresses to unpause pools.
+
+Ex) unpause-pools 1,1400
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnpausePools{
+				Sender:  clientCtx.GetFromAddress().String(),
+				PoolIds: poolIds,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseDenomPairTakerFeeArgToContent(cmd *cobra.Command, arg string) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -614,3 +895,31 @@ func ParseDenomPairTakerFee(arg string) ([]types.DenomPairTakerFee, error) {
 
 	return finaldenomPairTakerFeeRecordsRecords, nil
 }
+
+func ParsePoolTakerFee(arg string) ([]types.PoolTakerFee, error) {
+	poolTakerFeeRecords := strings.Split(arg, ",")
+
+	if len(poolTakerFeeRecords)%2 != 0 {
+		return nil, fmt.Errorf("poolTakerFeeRecords must be a list of poolId and takerFee separated by commas")
+	}
+
+	poolTakerFees := []types.PoolTakerFee{}
+	for i := 0; i < len(poolTakerFeeRecords); i += 2 {
+		poolId, err := strconv.ParseUint(poolTakerFeeRecords[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		takerFee, err := osmomath.NewDecFromStr(poolTakerFeeRecords[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		poolTakerFees = append(poolTakerFees, types.PoolTakerFee{
+			PoolId:   poolId,
+			TakerFee: takerFee,
+		})
+	}
+
+	return poolTakerFees, nil
+}
@@ -121,6 +121,16 @@ func (q Querier) Pool(grpcCtx context.Context,
 	return q.Q.Pool(ctx, *req)
 }
 
+func (q Querier) PausedPools(grpcCtx context.Context,
+	req *queryproto.PausedPoolsRequest,
+) (*queryproto.PausedPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PausedPools(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -151,6 +161,16 @@ func (q Querier) ListPoolsByDenom(grpcCtx context.Context,
 	return q.Q.ListPoolsByDenom(ctx, *req)
 }
 
+func (q Querier) FindBestRoute(grpcCtx context.Context,
+	req *queryproto.FindBestRouteRequest,
+) (*queryproto.FindBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.FindBestRoute(ctx, *req)
+}
+
 func (q Querier) EstimateTradeBasedOnPriceImpact(grpcCtx context.Context,
 	req *queryproto.EstimateTradeBasedOnPriceImpactRequest,
 ) (*queryproto.EstimateTradeBasedOnPriceImpactResponse, error) {
@@ -261,3 +281,23 @@ func (q Querier) AllPools(grpcCtx context.Context,
 	return q.Q.AllPools(ctx, *req)
 }
 
+func (q Querier) AllPoolTakerFees(grpcCtx context.Context,
+	req *queryproto.AllPoolTakerFeesRequest,
+) (*queryproto.AllPoolTakerFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllPoolTakerFees(ctx, *req)
+}
+
+func (q Querier) AccountVolumeTakerFeeTier(grpcCtx context.Context,
+	req *queryproto.AccountVolumeTakerFeeTierRequest,
+) (*queryproto.AccountVolumeTakerFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AccountVolumeTakerFeeTier(ctx, *req)
+}
+
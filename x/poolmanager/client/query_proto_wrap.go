@@ -333,6 +333,92 @@ func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPair
 	}, nil
 }
 
+// AccountVolumeTakerFeeTier returns the trailing volume of the account and the volume taker fee tier it reaches.
+func (q Querier) AccountVolumeTakerFeeTier(ctx sdk.Context, req queryproto.AccountVolumeTakerFeeTierRequest) (*queryproto.AccountVolumeTakerFeeTierResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	trailingVolume, tier, found := q.K.GetAccountVolumeTakerFeeTier(ctx, account)
+	if !found {
+		tier = types.VolumeTakerFeeTier{MinVolumes: sdk.NewCoins(), DiscountMultiplier: osmomath.OneDec()}
+	}
+
+	return &queryproto.AccountVolumeTakerFeeTierResponse{
+		TrailingVolume: trailingVolume,
+		WindowDays:     q.K.GetParams(ctx).TakerFeeParams.VolumeTakerFeeWindowDays,
+		HasTier:        found,
+		Tier:           tier,
+	}, nil
+}
+
+// AllPoolTakerFees returns the taker fee overrides of all pools.
+func (q Querier) AllPoolTakerFees(ctx sdk.Context, req queryproto.AllPoolTakerFeesRequest) (*queryproto.AllPoolTakerFeesResponse, error) {
+	poolTakerFees, err := q.K.GetAllPoolTakerFees(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.AllPoolTakerFeesResponse{
+		PoolTakerFees: poolTakerFees,
+	}, nil
+}
+
+// FindBestRoute returns the routes from the token in to the token out denom with the highest expected output.
+func (q Querier) FindBestRoute(ctx sdk.Context, req queryproto.FindBestRouteRequest) (*queryproto.FindBestRouteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	maxHops := int(req.MaxHops)
+	if maxHops == 0 {
+		maxHops = types.DefaultFindRouteMaxHops
+	}
+	if maxHops > types.MaxFindRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops can't exceed %d", types.MaxFindRouteHops)
+	}
+
+	maxRoutes := int(req.MaxRoutes)
+	if maxRoutes == 0 {
+		maxRoutes = types.DefaultFindRouteMaxRoutes
+	}
+	if maxRoutes > types.MaxFindRouteRoutes {
+		return nil, status.Errorf(codes.InvalidArgument, "max routes can't exceed %d", types.MaxFindRouteRoutes)
+	}
+
+	routes, tokenOutAmount, err := q.K.FindBestRoute(ctx, tokenIn, req.TokenOutDenom, maxHops, maxRoutes, req.Split)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.FindBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// PausedPools returns the ids of the paused pools.
+func (q Querier) PausedPools(ctx sdk.Context, req queryproto.PausedPoolsRequest) (*queryproto.PausedPoolsResponse, error) {
+	poolIds, err := q.K.GetAllPausedPools(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.PausedPoolsResponse{
+		PoolIds: poolIds,
+	}, nil
+}
+
 // EstimateTradeBasedOnPriceImpact returns the input and output amount of coins for a pool trade
 // based on external price and maximum price impact.
 func (q Querier) EstimateTradeBasedOnPriceImpact(
@@ -360,6 +446,9 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 	if poolErr != nil {
 		return nil, status.Error(codes.Internal, poolErr.Error())
 	}
+	if q.K.IsPoolPaused(ctx, req.PoolId) {
+		return nil, status.Error(codes.FailedPrecondition, types.PoolPausedError{PoolId: req.PoolId}.Error())
+	}
 
 	spotPriceBigDec, err := swapModule.CalculateSpotPrice(ctx, req.PoolId, req.FromCoin.Denom, req.ToCoinDenom)
 	if err != nil {
@@ -2158,1862 +2158,1902 @@ func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyCon
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
-	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
-	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
-	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
-	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
-	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
-	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
-	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
-	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
-	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
-	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
-	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
-	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
-	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
-	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
-	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
-	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
-	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
-	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
-	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
-	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
-	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
-	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
-	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
-	proto.RegisterType((*RegisteredAlloyedPoolFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomResponse")
-	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdRequest")
-	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
-	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
-	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+type AccountVolumeTakerFeeTierRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
+func (m *AccountVolumeTakerFeeTierRequest) Reset()         { *m = AccountVolumeTakerFeeTierRequest{} }
+func (m *AccountVolumeTakerFeeTierRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeTakerFeeTierRequest) ProtoMessage()    {}
+func (*AccountVolumeTakerFeeTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{44}
 }
-
-var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4e, 0x8f, 0x1d, 0xaf, 0xfd, 0x12, 0xff, 0x49, 0x6d, 0x1c, 0xdb, 0x93, 0xfc, 0x3c, 0x4e,
-	0x39, 0x71, 0x9c, 0x4d, 0x3c, 0xb3, 0xb6, 0x93, 0xdf, 0x2e, 0xd9, 0x75, 0x92, 0x19, 0xff, 0x49,
-	0xcc, 0x26, 0xc4, 0x19, 0x9b, 0x2c, 0x2c, 0x9b, 0x6d, 0xb5, 0x67, 0x2a, 0xe3, 0x96, 0xa7, 0xbb,
-	0x27, 0xdd, 0xd5, 0x8e, 0x47, 0x28, 0x07, 0x90, 0x10, 0x9c, 0x50, 0x60, 0x91, 0x16, 0x09, 0xa4,
-	0xd5, 0x1e, 0xb8, 0xc0, 0x01, 0x21, 0x21, 0x24, 0x2e, 0x70, 0xe1, 0x10, 0x21, 0x81, 0x22, 0x71,
-	0x00, 0x21, 0x31, 0xa0, 0x84, 0x03, 0x02, 0x4e, 0x73, 0xe4, 0x02, 0xea, 0xaa, 0xea, 0x9e, 0x9e,
-	0xf1, 0x4c, 0x77, 0xcf, 0x4c, 0x40, 0x7b, 0x8a, 0x5d, 0xf5, 0xde, 0xab, 0xf7, 0x7d, 0xf5, 0x5e,
-	0x55, 0xf5, 0x17, 0xc3, 0x39, 0xc3, 0xd2, 0x0c, 0x4b, 0xb5, 0x52, 0x25, 0xc3, 0x28, 0x6a, 0x8a,
-	0xae, 0x14, 0x88, 0x99, 0xda, 0x9b, 0xdf, 0x26, 0x54, 0x99, 0x4f, 0x3d, 0xb4, 0x89, 0x59, 0x4e,
-	0x96, 0x4c, 0x83, 0x1a, 0xe8, 0xa4, 0x30, 0x4c, 0xfa, 0x0c, 0x93, 0xc2, 0x30, 0x7e, 0xbc, 0x60,
-	0x14, 0x0c, 0x66, 0x97, 0x72, 0x7e, 0xe2, 0x2e, 0xf1, 0xf3, 0x41, 0xb1, 0x0b, 0x44, 0x27, 0x2c,
-	0x1c, 0x33, 0x3d, 0x13, 0x64, 0x4a, 0xf7, 0x85, 0xd5, 0xc5, 0x20, 0x2b, 0xeb, 0x91, 0x52, 0x92,
-	0x4d, 0xc3, 0xa6, 0x44, 0x58, 0xcf, 0x07, 0xc6, 0x54, 0x76, 0x89, 0x29, 0x3f, 0x20, 0x44, 0xb6,
-	0x76, 0x14, 0xd3, 0x75, 0x99, 0xcc, 0x31, 0x9f, 0xd4, 0xb6, 0x62, 0x11, 0xcf, 0x34, 0x67, 0xa8,
-	0xba, 0x98, 0x7f, 0xcd, 0x3f, 0xcf, 0xd8, 0xf1, 0xac, 0x4a, 0x4a, 0x41, 0xd5, 0x15, 0xaa, 0x1a,
-	0xae, 0xed, 0xa9, 0x82, 0x61, 0x14, 0x8a, 0x24, 0xa5, 0x94, 0xd4, 0x94, 0xa2, 0xeb, 0x06, 0x65,
-	0x93, 0x2e, 0xe0, 0x09, 0x31, 0xcb, 0x7e, 0xdb, 0xb6, 0x1f, 0xa4, 0x14, 0xbd, 0xec, 0x4e, 0xf1,
-	0x45, 0x64, 0xce, 0x27, 0xff, 0x45, 0x4c, 0x25, 0x1a, 0xbd, 0xa8, 0xaa, 0x11, 0x8b, 0x2a, 0x5a,
-	0x89, 0x1b, 0xe0, 0x61, 0x18, 0xdc, 0x50, 0x4c, 0x45, 0xb3, 0xb2, 0xe4, 0xa1, 0x4d, 0x2c, 0x8a,
-	0x37, 0x61, 0xc8, 0x1d, 0xb0, 0x4a, 0x86, 0x6e, 0x11, 0x94, 0x86, 0xbe, 0x12, 0x1b, 0x19, 0x97,
-	0xa6, 0xa4, 0xd9, 0x23, 0x0b, 0xd3, 0xc9, 0x80, 0x9d, 0x4d, 0x72, 0xe7, 0x4c, 0xef, 0xd3, 0x4a,
-	0xe2, 0x50, 0x56, 0x38, 0xe2, 0x9f, 0xc4, 0x60, 0x6a, 0xd5, 0xa2, 0xaa, 0xa6, 0x50, 0xb2, 0xf9,
-	0x48, 0x29, 0xad, 0xee, 0x2b, 0x39, 0x9a, 0xd6, 0x0c, 0x5b, 0xa7, 0xeb, 0xba, 0x58, 0x19, 0x2d,
-	0x41, 0x9f, 0x45, 0xf4, 0x3c, 0x31, 0xd9, 0x3a, 0x03, 0x99, 0xb3, 0xd5, 0x4a, 0x22, 0x51, 0x56,
-	0xb4, 0xe2, 0x15, 0xcc, 0xc7, 0xf1, 0xc5, 0x3c, 0x29, 0x99, 0x24, 0xa7, 0x50, 0x92, 0xbf, 0x82,
-	0xa9, 0x69, 0x13, 0x3c, 0x2e, 0x65, 0x85, 0x13, 0xba, 0x06, 0xaf, 0x38, 0xf9, 0xc8, 0x6a, 0x7e,
-	0x3c, 0x36, 0x25, 0xcd, 0xf6, 0x66, 0x66, 0xaa, 0x95, 0xc4, 0x14, 0xf7, 0x17, 0x13, 0x2d, 0x02,
-	0x38, 0xb3, 0xeb, 0x79, 0x94, 0x84, 0x7e, 0x6a, 0xec, 0x12, 0x5d, 0x56, 0xf5, 0xf1, 0x1e, 0x96,
-	0xc1, 0xab, 0xd5, 0x4a, 0x62, 0x98, 0x47, 0x70, 0x67, 0x70, 0xf6, 0x15, 0xf6, 0xe3, 0xba, 0x8e,
-	0xee, 0x43, 0x1f, 0xab, 0x1e, 0x6b, 0xbc, 0x77, 0xaa, 0x67, 0xf6, 0xc8, 0x42, 0x32, 0x90, 0x17,
-	0x07, 0xb6, 0x87, 0xd8, 0x71, 0xcb, 0x8c, 0x3a, 0x14, 0x55, 0x2b, 0x89, 0x41, 0xbe, 0x02, 0x8f,
-	0x85, 0xb3, 0x22, 0x28, 0xfe, 0x45, 0x0c, 0x16, 0x5a, 0x72, 0xf6, 0xae, 0x4a, 0x77, 0x36, 0x4c,
-	0x55, 0x53, 0xa9, 0xba, 0x47, 0xb6, 0xca, 0x25, 0xe2, 0xee, 0x9f, 0x9f, 0x06, 0xa9, 0x6b, 0x1a,
-	0x62, 0x11, 0x68, 0xb8, 0x06, 0x43, 0x3c, 0x63, 0xd9, 0x5d, 0xb7, 0x67, 0xaa, 0x67, 0xb6, 0x37,
-	0x33, 0x51, 0xad, 0x24, 0x46, 0xfd, 0xd0, 0xdc, 0x79, 0x9c, 0x3d, 0xca, 0x07, 0x36, 0xf8, 0x82,
-	0xf7, 0xe0, 0x84, 0x30, 0xe0, 0xd1, 0x0d, 0x9b, 0xca, 0x79, 0xa2, 0x1b, 0x1a, 0xe3, 0x75, 0x20,
-	0x73, 0xba, 0x5a, 0x49, 0xfc, 0x5f, 0x5d, 0xa0, 0x06, 0x3b, 0x9c, 0x7d, 0x95, 0x4f, 0x6c, 0x39,
-	0xe3, 0x77, 0x6c, 0xba, 0xc2, 0x46, 0x7f, 0x23, 0xc1, 0x6b, 0x1e, 0x81, 0xaa, 0x5e, 0x28, 0x12,
-	0x67, 0xc1, 0x96, 0xe5, 0x77, 0xa1, 0x91, 0x38, 0x54, 0xad, 0x24, 0x86, 0xea, 0x89, 0xeb, 0x98,
-	0xa4, 0x0c, 0x0c, 0x37, 0x82, 0xe3, 0x25, 0x16, 0xaf, 0x56, 0x12, 0x27, 0xfc, 0x6e, 0x3e, 0x54,
-	0x83, 0xb4, 0x0e, 0xcf, 0xd7, 0x25, 0x38, 0x1d, 0xd0, 0x44, 0xa2, 0x5b, 0xb7, 0x61, 0xa4, 0x16,
-	0x48, 0x61, 0xb3, 0xa2, 0x9f, 0xde, 0x74, 0xea, 0xed, 0x8f, 0x95, 0xc4, 0x28, 0x3f, 0x21, 0xac,
-	0xfc, 0x6e, 0x52, 0x35, 0x52, 0x9a, 0x42, 0x77, 0x92, 0xeb, 0x3a, 0xad, 0x56, 0x12, 0x63, 0x8d,
-	0x79, 0x70, 0x77, 0x9c, 0x1d, 0x72, 0x13, 0xe1, 0xab, 0xe1, 0x9f, 0xc5, 0x5a, 0x66, 0x72, 0xc7,
-	0xa6, 0x9f, 0x96, 0x7e, 0xfe, 0xc0, 0xeb, 0xcf, 0x1e, 0xd6, 0x9f, 0xa9, 0x88, 0xfd, 0xe9, 0x40,
-	0x88, 0xd0, 0xa0, 0x68, 0x1e, 0x06, 0x3c, 0xaa, 0xc6, 0x7b, 0x19, 0xc4, 0xe3, 0xd5, 0x4a, 0x62,
-	0xa4, 0x81, 0x45, 0x9c, 0xed, 0x77, 0xe9, 0xc3, 0xbf, 0x8c, 0xc1, 0x62, 0x6b, 0xe2, 0xfe, 0x8b,
-	0x4d, 0x7d, 0xb0, 0x49, 0x63, 0xed, 0x35, 0xe9, 0x26, 0x8c, 0xd6, 0x35, 0x9f, 0xaa, 0x7b, 0x65,
-	0xec, 0xf4, 0xe8, 0x54, 0xb5, 0x92, 0x38, 0xd5, 0xa4, 0x47, 0x5d, 0x33, 0x9c, 0x45, 0xbe, 0x16,
-	0x5d, 0xd7, 0x59, 0x45, 0x77, 0xc2, 0xe0, 0x6f, 0x25, 0xb8, 0x10, 0xda, 0xd4, 0xbe, 0x22, 0x6c,
-	0xab, 0xab, 0xaf, 0xc1, 0x50, 0x03, 0x3a, 0xde, 0xdb, 0x3e, 0x96, 0x1a, 0x61, 0x1d, 0xa5, 0x2d,
-	0x01, 0xf5, 0x44, 0x02, 0xf4, 0x35, 0x09, 0x70, 0x50, 0x2f, 0x89, 0xb6, 0x96, 0xdd, 0x03, 0x44,
-	0xd5, 0xeb, 0xbb, 0xfa, 0x8d, 0xb0, 0xae, 0x3e, 0xd1, 0x90, 0xb8, 0xdb, 0xd4, 0x83, 0x22, 0x73,
-	0xd1, 0xd3, 0xc7, 0x60, 0xf8, 0x73, 0xb6, 0xe6, 0x90, 0xe9, 0x3d, 0x05, 0x56, 0x61, 0xa4, 0x36,
-	0x24, 0xf2, 0x98, 0x87, 0x01, 0xdd, 0xd6, 0x58, 0x95, 0x58, 0x82, 0x51, 0x1f, 0x42, 0x6f, 0x0a,
-	0x67, 0xfb, 0x75, 0xe1, 0x8a, 0xaf, 0xc0, 0x11, 0xe7, 0x87, 0x4e, 0x76, 0x04, 0x2f, 0xc3, 0x51,
-	0xee, 0x2b, 0x96, 0x5f, 0x84, 0x5e, 0x67, 0x46, 0xbc, 0x44, 0x8e, 0x27, 0xf9, 0xf3, 0x26, 0xe9,
-	0x3e, 0x6f, 0x92, 0x69, 0xbd, 0x9c, 0x19, 0xf8, 0xf5, 0x4f, 0xe7, 0x0e, 0xb3, 0xb2, 0xcd, 0x32,
-	0x63, 0x07, 0x5a, 0xba, 0x58, 0xac, 0x83, 0xb6, 0x0e, 0x23, 0xb5, 0x21, 0x11, 0xfb, 0x32, 0x1c,
-	0x76, 0x61, 0xf5, 0x44, 0x09, 0xce, 0xad, 0x71, 0x1a, 0xc6, 0x6e, 0xa9, 0x16, 0x65, 0xb1, 0x32,
-	0x65, 0x56, 0x07, 0x2e, 0xd4, 0x19, 0x38, 0xcc, 0xcb, 0x88, 0x6f, 0xd5, 0x48, 0xb5, 0x92, 0x38,
-	0xca, 0x81, 0x8a, 0xea, 0xe1, 0xd3, 0xf8, 0x2e, 0x8c, 0x1f, 0x0c, 0xd1, 0x5d, 0x56, 0xcf, 0x24,
-	0x18, 0xd9, 0x2c, 0x19, 0x74, 0xc3, 0x54, 0x73, 0xa4, 0xa3, 0x66, 0x58, 0x85, 0x11, 0xe7, 0xd5,
-	0x2a, 0x2b, 0x96, 0x45, 0x68, 0x5d, 0x3b, 0x9c, 0xac, 0xdd, 0x15, 0x8d, 0x16, 0x38, 0x3b, 0xe4,
-	0x0c, 0xa5, 0x9d, 0x11, 0xde, 0x12, 0x37, 0xe1, 0xd8, 0x43, 0xdb, 0xa0, 0xf5, 0x71, 0x78, 0x6b,
-	0x9c, 0xaa, 0x56, 0x12, 0xe3, 0x3c, 0xce, 0x01, 0x13, 0x9c, 0x1d, 0x66, 0x63, 0xb5, 0x48, 0x78,
-	0x1d, 0x8e, 0xf9, 0x10, 0x09, 0x7a, 0x2e, 0x01, 0x58, 0x25, 0x83, 0xca, 0x25, 0x67, 0x54, 0xf0,
-	0x3c, 0x5a, 0xad, 0x24, 0x8e, 0x89, 0x8b, 0xc6, 0x9b, 0xc3, 0xd9, 0x01, 0xcb, 0xf5, 0xc6, 0x37,
-	0x61, 0x62, 0xcb, 0xa0, 0x0a, 0x2b, 0x80, 0x5b, 0xea, 0x43, 0x5b, 0xcd, 0xab, 0xb4, 0xdc, 0x51,
-	0x81, 0x7e, 0x4f, 0x82, 0x78, 0xb3, 0x50, 0x22, 0xbd, 0xc7, 0x30, 0x50, 0x74, 0x07, 0xc5, 0x0e,
-	0x4e, 0x24, 0xc5, 0x0b, 0xdd, 0x21, 0xca, 0xbb, 0x7e, 0x96, 0x0d, 0x55, 0xcf, 0xac, 0x88, 0x0b,
-	0x47, 0x74, 0x93, 0xe7, 0x89, 0x7f, 0xf8, 0xe7, 0xc4, 0x6c, 0x41, 0xa5, 0x3b, 0xf6, 0x76, 0x32,
-	0x67, 0x68, 0xe2, 0x89, 0x2f, 0xfe, 0x99, 0xb3, 0xf2, 0xbb, 0x29, 0xea, 0xdc, 0x16, 0x2c, 0x88,
-	0x95, 0xad, 0xad, 0x88, 0xc7, 0x60, 0x94, 0x25, 0xd7, 0x88, 0x11, 0x7f, 0x24, 0xc1, 0x89, 0xc6,
-	0x99, 0x4f, 0x47, 0xca, 0xee, 0xd6, 0xdc, 0x33, 0x8a, 0xb6, 0x46, 0xd6, 0x0c, 0xb3, 0xe3, 0xb3,
-	0xe3, 0xdb, 0xee, 0xd6, 0x34, 0x84, 0x12, 0x38, 0x29, 0xf4, 0xed, 0xb1, 0x89, 0x70, 0x90, 0xe9,
-	0xfa, 0x87, 0x00, 0x77, 0x6b, 0x0f, 0xa1, 0x58, 0x0b, 0xef, 0x41, 0x7c, 0xcb, 0x54, 0xf2, 0xaa,
-	0x5e, 0xd8, 0x50, 0x54, 0x73, 0xcb, 0xf9, 0xa8, 0x5c, 0x23, 0xfe, 0x06, 0x65, 0xd5, 0x2f, 0xbf,
-	0x2e, 0x4a, 0xd9, 0x87, 0x4f, 0x4c, 0xe0, 0x6c, 0x1f, 0xfb, 0xe9, 0xf5, 0x9a, 0xf1, 0xbc, 0xe8,
-	0xcb, 0x03, 0xc6, 0xf3, 0xae, 0xf1, 0x3c, 0x96, 0xe1, 0x64, 0xd3, 0x75, 0x05, 0x19, 0xd7, 0x61,
-	0xc0, 0xfb, 0xc0, 0x15, 0x4b, 0x4f, 0x8b, 0x8b, 0xe5, 0xe4, 0xc1, 0x8b, 0xe5, 0x16, 0x29, 0x28,
-	0xb9, 0xf2, 0x0a, 0xc9, 0x65, 0xfb, 0xa9, 0x88, 0xe4, 0x7c, 0xae, 0xcc, 0xb8, 0xf7, 0x98, 0xb3,
-	0x12, 0xc9, 0x28, 0x16, 0xc9, 0xdf, 0xd1, 0x59, 0xc3, 0xad, 0x6b, 0x25, 0x25, 0xe7, 0xdd, 0xc9,
-	0x6f, 0xc3, 0xc0, 0x03, 0xd3, 0xd0, 0x64, 0xe7, 0x3b, 0x59, 0x9c, 0xe4, 0x01, 0xe4, 0xf3, 0x2f,
-	0xc9, 0x7e, 0xc7, 0xc3, 0xf9, 0x1d, 0x61, 0x18, 0xa4, 0x06, 0xf3, 0xf5, 0x1f, 0x4a, 0xd9, 0x23,
-	0xd4, 0x70, 0xa6, 0xf9, 0xa1, 0x33, 0x56, 0xab, 0x13, 0xe7, 0xa8, 0xe9, 0xf5, 0x0e, 0xb5, 0xdb,
-	0x30, 0xa2, 0x29, 0xfb, 0xfc, 0x44, 0x90, 0x55, 0x96, 0x95, 0x78, 0x78, 0x44, 0x82, 0x3b, 0xa4,
-	0x29, 0xfb, 0x3e, 0x40, 0xe8, 0xb3, 0x30, 0x44, 0xf6, 0x29, 0x31, 0x75, 0xa5, 0x28, 0x4e, 0xa0,
-	0xc3, 0xd1, 0x83, 0x0d, 0xba, 0xae, 0xfc, 0x4c, 0xfa, 0x91, 0x04, 0xe7, 0x42, 0x09, 0x14, 0xdb,
-	0x75, 0x15, 0x40, 0xd5, 0x4b, 0x36, 0x6d, 0x8b, 0xc2, 0x01, 0xe6, 0xc2, 0x38, 0xbc, 0x0e, 0x47,
-	0x0c, 0x9b, 0x7a, 0x01, 0x62, 0xd1, 0x02, 0x00, 0xf7, 0x71, 0x46, 0xf0, 0x34, 0x9c, 0x4e, 0x17,
-	0x8b, 0x6e, 0x1d, 0x6d, 0xee, 0x28, 0x26, 0x49, 0x17, 0x4c, 0x42, 0x34, 0xa2, 0x53, 0xef, 0x96,
-	0xfd, 0xbe, 0x04, 0x38, 0xc8, 0x4a, 0xa0, 0xd9, 0x83, 0x78, 0x83, 0xba, 0x22, 0x2b, 0x9e, 0x95,
-	0xe8, 0xce, 0xc5, 0xc0, 0xc7, 0x7b, 0xf3, 0x15, 0x44, 0xda, 0x63, 0xb4, 0xf9, 0xfa, 0xf8, 0x2a,
-	0xcc, 0x34, 0x77, 0x5c, 0x33, 0x0d, 0xad, 0xee, 0x22, 0x3f, 0x5e, 0x77, 0x91, 0xbb, 0xd7, 0xf6,
-	0xc7, 0x12, 0x9c, 0x0b, 0x0d, 0xe0, 0x9d, 0x36, 0x13, 0x2d, 0x31, 0x8a, 0x0d, 0xec, 0x02, 0xe2,
-	0x89, 0xe6, 0x10, 0xf1, 0x03, 0x98, 0xad, 0xf3, 0x63, 0x39, 0x59, 0x5b, 0x46, 0x3a, 0x97, 0x33,
-	0x6d, 0x92, 0xbf, 0xa7, 0x14, 0x6d, 0x12, 0x88, 0x11, 0x9d, 0x81, 0x41, 0x37, 0xf6, 0x8a, 0xaf,
-	0xdb, 0xea, 0x07, 0xb1, 0x05, 0xe7, 0x23, 0xac, 0x23, 0xa8, 0x58, 0x83, 0xbe, 0xba, 0x17, 0x6c,
-	0x32, 0xec, 0x05, 0x2b, 0x8e, 0x5d, 0xf7, 0xe1, 0x2a, 0xbc, 0xf1, 0x59, 0x98, 0x3e, 0x50, 0x5c,
-	0xb9, 0x9c, 0xad, 0xd9, 0x45, 0x85, 0x1a, 0xa6, 0x57, 0x84, 0x9f, 0x48, 0x70, 0x26, 0xd8, 0x4e,
-	0xe4, 0x55, 0x86, 0x93, 0xbe, 0x2d, 0xda, 0x55, 0x35, 0x59, 0xf1, 0x99, 0x89, 0x3a, 0xbc, 0x14,
-	0x6d, 0x93, 0x76, 0x55, 0xcd, 0xb7, 0x86, 0xd8, 0xa5, 0x71, 0xda, 0x7c, 0xda, 0xc2, 0x4b, 0x70,
-	0x36, 0x4b, 0x0a, 0xaa, 0x45, 0x89, 0x49, 0xf2, 0xe9, 0x62, 0xd1, 0x28, 0x93, 0xbc, 0x73, 0x59,
-	0x45, 0x2c, 0xc4, 0x0f, 0x25, 0x98, 0x09, 0xf3, 0x17, 0x20, 0x55, 0x18, 0xca, 0x19, 0x3a, 0x35,
-	0x95, 0x1c, 0x95, 0x2d, 0xaa, 0x50, 0x22, 0x8a, 0xef, 0xed, 0x40, 0x5c, 0x2c, 0xe4, 0xb2, 0xf0,
-	0xab, 0x63, 0x72, 0xd3, 0x89, 0x21, 0xf0, 0x0d, 0xba, 0x91, 0xd9, 0x20, 0x4e, 0x07, 0x24, 0xc5,
-	0xbf, 0x2a, 0x5d, 0x54, 0x63, 0x0d, 0xd7, 0xba, 0x77, 0x85, 0x7f, 0x47, 0x82, 0x73, 0xa1, 0x31,
-	0xfe, 0xf7, 0xc8, 0x30, 0x4c, 0xa5, 0x8b, 0xc5, 0xa6, 0x89, 0x79, 0x65, 0xf7, 0x44, 0x62, 0x27,
-	0x64, 0x2b, 0x23, 0x91, 0xf4, 0x2e, 0x0c, 0xd7, 0x27, 0xed, 0xd6, 0xd9, 0xcb, 0xc8, 0x7a, 0xa8,
-	0x2e, 0x6b, 0x6b, 0xe1, 0xf7, 0xe7, 0xe1, 0xf0, 0x5d, 0x9b, 0x98, 0x65, 0xf4, 0x4d, 0x09, 0xfa,
-	0xb8, 0x50, 0x8b, 0x5e, 0x8b, 0xa0, 0xe6, 0x0a, 0x4c, 0xf1, 0x0b, 0x91, 0x6c, 0x39, 0x34, 0x7c,
-	0xe1, 0xab, 0xbf, 0xfb, 0xeb, 0x87, 0xb1, 0xb3, 0x68, 0x3a, 0x15, 0x24, 0xab, 0x8b, 0x2c, 0xfe,
-	0x26, 0xc1, 0x44, 0x4b, 0x6d, 0x0b, 0x2d, 0x05, 0xae, 0x1b, 0x26, 0x2c, 0xc7, 0xaf, 0x76, 0xea,
-	0x2e, 0x90, 0xdc, 0x62, 0x48, 0xd6, 0xd0, 0x4a, 0x20, 0x92, 0x2f, 0x8b, 0x12, 0x7e, 0x9c, 0x22,
-	0x22, 0x22, 0xff, 0x1f, 0x06, 0xe2, 0xc4, 0x14, 0x5f, 0xdd, 0xb2, 0xaa, 0xa3, 0x4f, 0x62, 0x3e,
-	0x05, 0x23, 0x5c, 0xd7, 0x45, 0x77, 0x3a, 0xcb, 0xbe, 0xa5, 0x98, 0xd4, 0x35, 0x1d, 0x0a, 0xa3,
-	0xe3, 0x4b, 0xe8, 0x8b, 0x2f, 0x83, 0x0e, 0xf9, 0x91, 0x4a, 0x77, 0x9c, 0xb7, 0x13, 0x4f, 0x54,
-	0x66, 0x6f, 0x66, 0xf4, 0x8d, 0x18, 0x4c, 0x47, 0x90, 0x6e, 0xd1, 0x8d, 0x68, 0x50, 0x42, 0xc5,
-	0xdf, 0xae, 0x39, 0xf9, 0x02, 0xe3, 0x24, 0x8b, 0x36, 0xda, 0xe6, 0x84, 0xe5, 0xc6, 0x55, 0xb7,
-	0xa6, 0xe5, 0xf2, 0x4f, 0x09, 0xe2, 0xad, 0xf5, 0x21, 0xd4, 0x51, 0xe2, 0x35, 0x7d, 0x2c, 0x7e,
-	0xad, 0x63, 0x7f, 0x81, 0xfc, 0x36, 0x43, 0x7e, 0x03, 0xad, 0x76, 0x5f, 0x0d, 0x86, 0x4d, 0xd1,
-	0x0f, 0x62, 0x70, 0xb1, 0x1d, 0x85, 0x14, 0x6d, 0x74, 0x08, 0xa0, 0x75, 0x7f, 0x74, 0x4d, 0xc9,
-	0x36, 0xa3, 0xe4, 0x7d, 0xf4, 0xde, 0x4b, 0xa1, 0xa4, 0x79, 0x87, 0x3c, 0x89, 0xc1, 0x99, 0x28,
-	0x3a, 0x28, 0xba, 0xd9, 0x5d, 0x8b, 0xbc, 0xcc, 0x52, 0xb9, 0xcf, 0x78, 0x79, 0x17, 0x7d, 0xbe,
-	0x4d, 0x5e, 0x1c, 0x16, 0x42, 0x1a, 0xc5, 0x29, 0x9d, 0x8f, 0x24, 0xe8, 0x77, 0xf5, 0x4a, 0x74,
-	0x31, 0x30, 0xd9, 0x06, 0xa5, 0x33, 0x3e, 0x17, 0xd1, 0x5a, 0x00, 0x49, 0x32, 0x20, 0xb3, 0x68,
-	0x26, 0x10, 0x88, 0x27, 0x86, 0xa2, 0x6f, 0x49, 0xd0, 0xeb, 0x44, 0x40, 0xb3, 0xc1, 0x17, 0x68,
-	0x4d, 0xe9, 0x88, 0x9f, 0x8f, 0x60, 0x29, 0xb2, 0xb9, 0xc4, 0xb2, 0x49, 0xa2, 0x8b, 0x81, 0xd9,
-	0xb0, 0x4c, 0x6a, 0xe4, 0x32, 0xb6, 0x5c, 0x09, 0x34, 0x84, 0xad, 0x06, 0xf1, 0x34, 0x84, 0xad,
-	0x46, 0x5d, 0x35, 0x22, 0x5b, 0x4a, 0xb1, 0x38, 0xc7, 0xd9, 0xfa, 0xb9, 0x04, 0x23, 0x8d, 0x72,
-	0x28, 0x0a, 0x7e, 0x77, 0xb7, 0x10, 0x60, 0xe3, 0x97, 0xdb, 0xf4, 0x12, 0x19, 0xbf, 0xc9, 0x32,
-	0x5e, 0x40, 0xaf, 0x07, 0x66, 0x5c, 0x54, 0x2d, 0xca, 0x53, 0x9e, 0xdb, 0x2e, 0xcf, 0xf1, 0xcf,
-	0xa5, 0x8f, 0x25, 0x18, 0xf0, 0x44, 0x4a, 0x14, 0x4c, 0x54, 0xa3, 0x3c, 0x1b, 0x4f, 0x46, 0x35,
-	0x17, 0x69, 0x2e, 0xb2, 0x34, 0xe7, 0xd0, 0x85, 0xa6, 0x69, 0x36, 0x6c, 0x78, 0x8a, 0xe9, 0x13,
-	0x16, 0x7a, 0x26, 0x01, 0x3a, 0x28, 0x58, 0xa2, 0xff, 0x0f, 0xfe, 0xae, 0x69, 0x25, 0x96, 0xc6,
-	0xdf, 0x68, 0xdb, 0x4f, 0x24, 0xbf, 0xce, 0x92, 0x5f, 0x46, 0xe9, 0x76, 0xaa, 0x36, 0x45, 0x9d,
-	0x80, 0xfc, 0x10, 0xf0, 0x24, 0x43, 0xf4, 0x63, 0x09, 0x86, 0xea, 0xc5, 0x4c, 0xb4, 0x10, 0x9e,
-	0xd6, 0x01, 0x28, 0x8b, 0x6d, 0xf9, 0xb4, 0xd5, 0x7c, 0x3c, 0xed, 0x5a, 0xc6, 0x4f, 0xdd, 0x4d,
-	0xa8, 0x93, 0x26, 0xa3, 0x6c, 0x42, 0x33, 0x59, 0x34, 0xca, 0x26, 0x34, 0xd5, 0x40, 0x71, 0x9a,
-	0x65, 0xff, 0x16, 0xfa, 0x4c, 0x07, 0x9b, 0xc0, 0x05, 0x4d, 0xf4, 0x2b, 0x09, 0x5e, 0x6d, 0xa2,
-	0x2c, 0xa2, 0x90, 0x9c, 0x5a, 0x6a, 0xa0, 0xf1, 0x37, 0xdb, 0x77, 0x14, 0x68, 0xae, 0x30, 0x34,
-	0x97, 0xd0, 0x42, 0xf0, 0x5e, 0xf0, 0x08, 0x72, 0x49, 0x51, 0x4d, 0x99, 0x7d, 0x91, 0x3f, 0x20,
-	0x04, 0xfd, 0x43, 0x82, 0x44, 0x88, 0xfa, 0x86, 0x96, 0x23, 0x5d, 0x80, 0xc1, 0xe2, 0x67, 0x7c,
-	0xa5, 0xbb, 0x20, 0x02, 0xea, 0x12, 0x83, 0xfa, 0x06, 0xba, 0xdc, 0xee, 0x55, 0xea, 0xa0, 0x27,
-	0xe8, 0xb9, 0x04, 0xf1, 0xd6, 0xc2, 0x5c, 0xc8, 0xa3, 0x32, 0x54, 0xf7, 0x0b, 0x79, 0x29, 0x84,
-	0x2b, 0x82, 0x78, 0x99, 0xc1, 0x5b, 0x42, 0x6f, 0x85, 0x5d, 0x19, 0x72, 0x6b, 0xe1, 0x10, 0xfd,
-	0x5b, 0x82, 0x44, 0x88, 0x3c, 0x17, 0xb2, 0xa5, 0xd1, 0xd4, 0xc1, 0x90, 0x2d, 0x8d, 0xa8, 0x10,
-	0xe2, 0xbb, 0x0c, 0xf3, 0x3b, 0x68, 0x3d, 0x78, 0x4b, 0xd9, 0x3d, 0xf3, 0x38, 0xd5, 0x12, 0xb7,
-	0xcc, 0xa4, 0x75, 0x7e, 0x1b, 0x7d, 0x37, 0x06, 0xa7, 0x43, 0x75, 0x39, 0xb4, 0x1a, 0x3d, 0xfd,
-	0x00, 0xfd, 0x30, 0xbe, 0xd6, 0x6d, 0x18, 0xc1, 0x43, 0x9e, 0xf1, 0xf0, 0x01, 0x7a, 0x3f, 0x98,
-	0x87, 0x3a, 0x01, 0xf2, 0x71, 0x4b, 0x5e, 0xd8, 0xb0, 0x25, 0x53, 0x43, 0x56, 0xf8, 0x62, 0xf2,
-	0x1e, 0x03, 0xfd, 0x77, 0x09, 0x4e, 0x05, 0xa9, 0x82, 0xe8, 0x7a, 0x7b, 0x35, 0x7c, 0x50, 0x78,
-	0x8c, 0xa7, 0xbb, 0x88, 0x20, 0xb8, 0x58, 0x65, 0x5c, 0x5c, 0x43, 0x4b, 0xed, 0xf7, 0x81, 0x1f,
-	0xcb, 0xbf, 0x24, 0x98, 0x0c, 0xd6, 0x07, 0x51, 0x26, 0x30, 0xd9, 0x48, 0xe2, 0x64, 0x7c, 0xb9,
-	0xab, 0x18, 0x02, 0xf2, 0x1d, 0x06, 0x79, 0x1d, 0xdd, 0x88, 0xd4, 0x06, 0xa6, 0x17, 0x54, 0x56,
-	0x78, 0x54, 0xfe, 0x38, 0xf0, 0x35, 0xc1, 0x57, 0x62, 0x90, 0x08, 0xd1, 0x10, 0x51, 0x87, 0x99,
-	0xd7, 0xa9, 0x98, 0x21, 0xc7, 0x40, 0x44, 0x19, 0x13, 0x6f, 0x32, 0xfc, 0xb7, 0xd1, 0x3b, 0x11,
-	0x4f, 0xf6, 0x40, 0x06, 0x84, 0x15, 0xfa, 0x93, 0x04, 0x13, 0x2d, 0xc5, 0xc8, 0x10, 0x79, 0x2d,
-	0x4c, 0xe9, 0x8c, 0x5f, 0xed, 0xd4, 0xbd, 0xad, 0x47, 0x88, 0x53, 0xe4, 0x2d, 0xb0, 0x5a, 0x99,
-	0xfb, 0x4f, 0x9f, 0x4f, 0x4a, 0xcf, 0x9e, 0x4f, 0x4a, 0x7f, 0x79, 0x3e, 0x29, 0x3d, 0x79, 0x31,
-	0x79, 0xe8, 0xd9, 0x8b, 0xc9, 0x43, 0x7f, 0x78, 0x31, 0x79, 0xe8, 0xbd, 0x65, 0xdf, 0xff, 0xd0,
-	0x8a, 0xf0, 0x73, 0x45, 0x65, 0xdb, 0xf2, 0xd6, 0xda, 0x5b, 0xb8, 0x9c, 0xda, 0xaf, 0x5b, 0x31,
-	0x57, 0x54, 0x89, 0x4e, 0xf9, 0x5f, 0xeb, 0xf2, 0xbf, 0xb2, 0xe8, 0x63, 0xff, 0x2c, 0xfe, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x0c, 0x52, 0xb8, 0xfc, 0x2c, 0x00, 0x00,
+func (m *AccountVolumeTakerFeeTierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVolumeTakerFeeTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVolumeTakerFeeTierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVolumeTakerFeeTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeTakerFeeTierRequest.Merge(m, src)
+}
+func (m *AccountVolumeTakerFeeTierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVolumeTakerFeeTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeTakerFeeTierRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_AccountVolumeTakerFeeTierRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
-	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
-	// primitive types instead of repeated structs. Each index in the
-	// routes_pool_id field corresponds to the respective routes_token_out_denom
-	// value, thus they are required to have the same length and are grouped
-	// together as pairs.
-	// example usage:
-	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
-	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
-	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
-	EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
-	// ListPoolsByDenom return all pools by denom
-	ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
-	// TotalPoolLiquidity returns the total liquidity of the specified pool.
-	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
-	// TotalLiquidity returns the total liquidity across all pools.
-	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
-	// TotalVolumeForPool returns the total volume of the specified pool.
-	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
-	// TradingPairTakerFee returns the taker fee for a given set of denoms
-	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
-	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
-	// impact, if a trade cannot be estimated a 0 input and 0 output would be
-	// returned.
-	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
-	// AllTakerFeeShareAgreements returns all taker fee share agreements.
-	// A taker fee share agreement includes the denom of the denom getting the
-	// taker fees, the percent of the taker fees that the denom gets when it is
-	// in the route being traded against, and the address that the taker fees are
-	// sent to at epoch.
-	AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error)
-	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
-	// given denom. A taker fee share agreement includes the denom of the denom
-	// getting the taker fees, the percent of the taker fees that the denom gets
-	// when it is in the route being traded against, and the address that the
-	// taker fees are sent to at epoch.
-	TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error)
-	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
-	// the given taker fee denom (the collected fees) for the given fee share
-	// denom (the denom with the taker fee share agreement)
-	TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error)
-	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
-	// taker fee share accumulator includes the denom of the denom getting the
-	// taker fees, and an accumulated value of coins that the denom has accrued
-	// since the last time it was distributed in the epoch prior.
-	AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error)
-	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
-	// from the given denom. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error)
-	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
-	// from the given pool id. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
-	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
-	// registered alloyed pool contains the pool's contract address, along with
-	// the current distribution composition of taker fee share denoms within the
-	// alloyed pool.
-	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+func (m *AccountVolumeTakerFeeTierRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type AccountVolumeTakerFeeTierResponse struct {
+	// trailing_volume is the swap volume of the account over the last
+	// window_days days, in each of the denoms of the tiers.
+	TrailingVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=trailing_volume,json=trailingVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"trailing_volume" yaml:"trailing_volume"`
+	WindowDays     uint64                                   `protobuf:"varint,2,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty" yaml:"window_days"`
+	// has_tier is false if the account doesn't reach any tier, in which case
+	// the taker fee isn't discounted.
+	HasTier bool                     `protobuf:"varint,3,opt,name=has_tier,json=hasTier,proto3" json:"has_tier,omitempty" yaml:"has_tier"`
+	Tier    types.VolumeTakerFeeTier `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier" yaml:"tier"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *AccountVolumeTakerFeeTierResponse) Reset()         { *m = AccountVolumeTakerFeeTierResponse{} }
+func (m *AccountVolumeTakerFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeTakerFeeTierResponse) ProtoMessage()    {}
+func (*AccountVolumeTakerFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{45}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AccountVolumeTakerFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AccountVolumeTakerFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVolumeTakerFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AccountVolumeTakerFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeTakerFeeTierResponse.Merge(m, src)
+}
+func (m *AccountVolumeTakerFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVolumeTakerFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeTakerFeeTierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AccountVolumeTakerFeeTierResponse proto.InternalMessageInfo
+
+func (m *AccountVolumeTakerFeeTierResponse) GetTrailingVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TrailingVolume
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AccountVolumeTakerFeeTierResponse) GetWindowDays() uint64 {
+	if m != nil {
+		return m.WindowDays
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AccountVolumeTakerFeeTierResponse) GetHasTier() bool {
+	if m != nil {
+		return m.HasTier
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AccountVolumeTakerFeeTierResponse) GetTier() types.VolumeTakerFeeTier {
+	if m != nil {
+		return m.Tier
 	}
-	return out, nil
+	return types.VolumeTakerFeeTier{}
 }
 
-func (c *queryClient) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AllPoolTakerFeesRequest struct {
 }
 
-func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
-	out := new(NumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AllPoolTakerFeesRequest) Reset()         { *m = AllPoolTakerFeesRequest{} }
+func (m *AllPoolTakerFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*AllPoolTakerFeesRequest) ProtoMessage()    {}
+func (*AllPoolTakerFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{46}
+}
+func (m *AllPoolTakerFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllPoolTakerFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllPoolTakerFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AllPoolTakerFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllPoolTakerFeesRequest.Merge(m, src)
+}
+func (m *AllPoolTakerFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllPoolTakerFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllPoolTakerFeesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
-	out := new(PoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_AllPoolTakerFeesRequest proto.InternalMessageInfo
+
+type AllPoolTakerFeesResponse struct {
+	PoolTakerFees []types.PoolTakerFee `protobuf:"bytes,1,rep,name=pool_taker_fees,json=poolTakerFees,proto3" json:"pool_taker_fees" yaml:"pool_taker_fees"`
 }
 
-func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
-	out := new(AllPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AllPoolTakerFeesResponse) Reset()         { *m = AllPoolTakerFeesResponse{} }
+func (m *AllPoolTakerFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*AllPoolTakerFeesResponse) ProtoMessage()    {}
+func (*AllPoolTakerFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{47}
+}
+func (m *AllPoolTakerFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllPoolTakerFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllPoolTakerFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AllPoolTakerFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllPoolTakerFeesResponse.Merge(m, src)
+}
+func (m *AllPoolTakerFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllPoolTakerFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllPoolTakerFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error) {
-	out := new(ListPoolsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllPoolTakerFeesResponse proto.InternalMessageInfo
+
+func (m *AllPoolTakerFeesResponse) GetPoolTakerFees() []types.PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
-	out := new(SpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+type PausedPoolsRequest struct {
+}
+
+func (m *PausedPoolsRequest) Reset()         { *m = PausedPoolsRequest{} }
+func (m *PausedPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*PausedPoolsRequest) ProtoMessage()    {}
+func (*PausedPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{48}
+}
+func (m *PausedPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PausedPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedPoolsRequest.Merge(m, src)
+}
+func (m *PausedPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedPoolsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
-	out := new(TotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PausedPoolsRequest proto.InternalMessageInfo
+
+type PausedPoolsResponse struct {
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *PausedPoolsResponse) Reset()         { *m = PausedPoolsResponse{} }
+func (m *PausedPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*PausedPoolsResponse) ProtoMessage()    {}
+func (*PausedPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{49}
+}
+func (m *PausedPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PausedPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedPoolsResponse.Merge(m, src)
+}
+func (m *PausedPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedPoolsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error) {
-	out := new(TotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PausedPoolsResponse proto.InternalMessageInfo
+
+func (m *PausedPoolsResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error) {
-	out := new(TotalVolumeForPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+type FindBestRouteRequest struct {
+	// token_in is the coin to swap, e.g. 1000000uosmo.
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools of a route. Zero uses the
+	// default of 3, and it can't exceed 4.
+	MaxHops uint32 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_routes is the maximum number of routes returned. Zero uses the
+	// default of 3, and it can't exceed 10.
+	MaxRoutes uint32 `protobuf:"varint,4,opt,name=max_routes,json=maxRoutes,proto3" json:"max_routes,omitempty" yaml:"max_routes"`
+	// split splits token_in across the best routes that don't share any pool
+	// to maximize the total output.
+	Split bool `protobuf:"varint,5,opt,name=split,proto3" json:"split,omitempty" yaml:"split"`
+}
+
+func (m *FindBestRouteRequest) Reset()         { *m = FindBestRouteRequest{} }
+func (m *FindBestRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*FindBestRouteRequest) ProtoMessage()    {}
+func (*FindBestRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{50}
+}
+func (m *FindBestRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindBestRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindBestRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *FindBestRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBestRouteRequest.Merge(m, src)
+}
+func (m *FindBestRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindBestRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBestRouteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
-	out := new(TradingPairTakerFeeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FindBestRouteRequest proto.InternalMessageInfo
+
+func (m *FindBestRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
-	out := new(EstimateTradeBasedOnPriceImpactResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FindBestRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error) {
-	out := new(AllTakerFeeShareAgreementsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FindBestRouteRequest) GetMaxHops() uint32 {
+	if m != nil {
+		return m.MaxHops
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error) {
-	out := new(TakerFeeShareAgreementFromDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FindBestRouteRequest) GetMaxRoutes() uint32 {
+	if m != nil {
+		return m.MaxRoutes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
-	out := new(TakerFeeShareDenomsToAccruedValueResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FindBestRouteRequest) GetSplit() bool {
+	if m != nil {
+		return m.Split
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error) {
-	out := new(AllTakerFeeShareAccumulatorsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators", in, out, opts...)
-	if err != nil {
-		return nil, err
+type FindBestRouteResponse struct {
+	// routes are sorted by decreasing token_out_amount. Without split, each
+	// route is quoted with the whole token_in. With split, the token_in_amount
+	// of the routes add up to token_in and the routes can be used as is in
+	// MsgSplitRouteSwapExactAmountIn.
+	Routes []RouteQuote `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// token_out_amount is the expected output of the best route, or the total
+	// expected output of the routes with split.
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *FindBestRouteResponse) Reset()         { *m = FindBestRouteResponse{} }
+func (m *FindBestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*FindBestRouteResponse) ProtoMessage()    {}
+func (*FindBestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{51}
+}
+func (m *FindBestRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindBestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindBestRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *FindBestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBestRouteResponse.Merge(m, src)
+}
+func (m *FindBestRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindBestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBestRouteResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error) {
-	out := new(RegisteredAlloyedPoolFromDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FindBestRouteResponse proto.InternalMessageInfo
+
+func (m *FindBestRouteResponse) GetRoutes() []RouteQuote {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
-	out := new(RegisteredAlloyedPoolFromPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
+// RouteQuote is a route and its expected output, taker fees included.
+type RouteQuote struct {
+	Pools          []types.SwapAmountInRoute `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount  cosmossdk_io_math.Int     `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	TokenOutAmount cosmossdk_io_math.Int     `protobuf:"bytes,3,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *RouteQuote) Reset()         { *m = RouteQuote{} }
+func (m *RouteQuote) String() string { return proto.CompactTextString(m) }
+func (*RouteQuote) ProtoMessage()    {}
+func (*RouteQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{52}
+}
+func (m *RouteQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RouteQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteQuote.Merge(m, src)
+}
+func (m *RouteQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteQuote.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error) {
-	out := new(AllRegisteredAlloyedPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RouteQuote proto.InternalMessageInfo
+
+func (m *RouteQuote) GetPools() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
-	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
-	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
-	// primitive types instead of repeated structs. Each index in the
-	// routes_pool_id field corresponds to the respective routes_token_out_denom
-	// value, thus they are required to have the same length and are grouped
-	// together as pairs.
-	// example usage:
-	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
-	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
-	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
-	EstimateSwapExactAmountInWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error)
-	EstimateSinglePoolSwapExactAmountIn(context.Context, *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOutWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error)
-	EstimateSinglePoolSwapExactAmountOut(context.Context, *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
-	// ListPoolsByDenom return all pools by denom
-	ListPoolsByDenom(context.Context, *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
-	// TotalPoolLiquidity returns the total liquidity of the specified pool.
-	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
-	// TotalLiquidity returns the total liquidity across all pools.
-	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
-	// TotalVolumeForPool returns the total volume of the specified pool.
-	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
-	// TradingPairTakerFee returns the taker fee for a given set of denoms
-	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
-	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
+	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
+	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
+	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
+	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
+	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
+	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
+	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
+	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
+	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
+	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
+	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
+	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
+	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
+	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
+	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
+	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
+	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
+	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
+	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
+	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
+	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
+	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
+	proto.RegisterType((*RegisteredAlloyedPoolFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomResponse")
+	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdRequest")
+	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
+	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
+	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+	proto.RegisterType((*AccountVolumeTakerFeeTierRequest)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeTakerFeeTierRequest")
+	proto.RegisterType((*AccountVolumeTakerFeeTierResponse)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeTakerFeeTierResponse")
+	proto.RegisterType((*AllPoolTakerFeesRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolTakerFeesRequest")
+	proto.RegisterType((*AllPoolTakerFeesResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolTakerFeesResponse")
+	proto.RegisterType((*PausedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.PausedPoolsRequest")
+	proto.RegisterType((*PausedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.PausedPoolsResponse")
+	proto.RegisterType((*FindBestRouteRequest)(nil), "osmosis.poolmanager.v1beta1.FindBestRouteRequest")
+	proto.RegisterType((*FindBestRouteResponse)(nil), "osmosis.poolmanager.v1beta1.FindBestRouteResponse")
+	proto.RegisterType((*RouteQuote)(nil), "osmosis.poolmanager.v1beta1.RouteQuote")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
+}
+
+var fileDescriptor_6256a4106f701b7d = []byte{
+	// 3160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x52, 0xb2, 0x2c, 0x3d, 0x59, 0x3f, 0x1e, 0x4b, 0x96, 0xb4, 0x76, 0x45, 0x79, 0xfc,
+	0x27, 0x47, 0x16, 0x69, 0xc9, 0x76, 0x9d, 0x3a, 0xb1, 0x1d, 0x52, 0x3f, 0xb1, 0x12, 0xa7, 0x96,
+	0x57, 0xaa, 0x93, 0xa6, 0x49, 0x16, 0x2b, 0x72, 0x4c, 0x2d, 0x44, 0xee, 0xd2, 0xbb, 0x43, 0x59,
+	0x42, 0xe0, 0x43, 0x0b, 0x14, 0xed, 0xa5, 0x45, 0xda, 0x14, 0x48, 0x80, 0x16, 0x08, 0x82, 0xa2,
+	0x97, 0xfe, 0xa0, 0x28, 0x50, 0x14, 0xe8, 0xa5, 0xbd, 0xf4, 0x10, 0xb4, 0x68, 0x61, 0x20, 0x97,
+	0xa2, 0x40, 0xd9, 0xc0, 0xee, 0xa1, 0x68, 0x7b, 0xe2, 0xa5, 0x40, 0x2f, 0x2d, 0x76, 0x66, 0x76,
+	0xb9, 0xa4, 0xc8, 0xdd, 0x25, 0xa9, 0x16, 0x39, 0x59, 0x9c, 0x79, 0xef, 0xcd, 0xfb, 0xde, 0xbc,
+	0x37, 0x33, 0xfb, 0xbe, 0x04, 0xce, 0x99, 0x76, 0xc1, 0xb4, 0x75, 0x3b, 0x59, 0x34, 0xcd, 0x7c,
+	0x41, 0x33, 0xb4, 0x1c, 0xb1, 0x92, 0xdb, 0x73, 0x1b, 0x84, 0x6a, 0x73, 0xc9, 0x07, 0x25, 0x62,
+	0xed, 0x26, 0x8a, 0x96, 0x49, 0x4d, 0x74, 0x5c, 0x08, 0x26, 0x7c, 0x82, 0x09, 0x21, 0x28, 0x8f,
+	0xe4, 0xcc, 0x9c, 0xc9, 0xe4, 0x92, 0xce, 0x5f, 0x5c, 0x45, 0x3e, 0x1f, 0x64, 0x3b, 0x47, 0x0c,
+	0xc2, 0xcc, 0x31, 0xd1, 0xd3, 0x41, 0xa2, 0x74, 0x47, 0x48, 0x5d, 0x08, 0x92, 0xb2, 0x1f, 0x6a,
+	0x45, 0xd5, 0x32, 0x4b, 0x94, 0x08, 0xe9, 0xb9, 0x40, 0x9b, 0xda, 0x16, 0xb1, 0xd4, 0xfb, 0x84,
+	0xa8, 0xf6, 0xa6, 0x66, 0xb9, 0x2a, 0x93, 0x19, 0xa6, 0x93, 0xdc, 0xd0, 0x6c, 0xe2, 0x89, 0x66,
+	0x4c, 0xdd, 0x10, 0xf3, 0xcf, 0xf8, 0xe7, 0x59, 0x74, 0x3c, 0xa9, 0xa2, 0x96, 0xd3, 0x0d, 0x8d,
+	0xea, 0xa6, 0x2b, 0x7b, 0x22, 0x67, 0x9a, 0xb9, 0x3c, 0x49, 0x6a, 0x45, 0x3d, 0xa9, 0x19, 0x86,
+	0x49, 0xd9, 0xa4, 0x0b, 0x78, 0x42, 0xcc, 0xb2, 0x5f, 0x1b, 0xa5, 0xfb, 0x49, 0xcd, 0xd8, 0x75,
+	0xa7, 0xf8, 0x22, 0x2a, 0x8f, 0x27, 0xff, 0x21, 0xa6, 0xe2, 0xf5, 0x5a, 0x54, 0x2f, 0x10, 0x9b,
+	0x6a, 0x85, 0x22, 0x17, 0xc0, 0x43, 0x30, 0xb0, 0xaa, 0x59, 0x5a, 0xc1, 0x56, 0xc8, 0x83, 0x12,
+	0xb1, 0x29, 0x5e, 0x83, 0x41, 0x77, 0xc0, 0x2e, 0x9a, 0x86, 0x4d, 0x50, 0x0a, 0x7a, 0x8a, 0x6c,
+	0x64, 0x5c, 0x9a, 0x92, 0xa6, 0xfb, 0xe7, 0x4f, 0x25, 0x02, 0x76, 0x36, 0xc1, 0x95, 0xd3, 0xdd,
+	0x1f, 0x95, 0xe3, 0x07, 0x14, 0xa1, 0x88, 0x7f, 0x16, 0x83, 0xa9, 0x25, 0x9b, 0xea, 0x05, 0x8d,
+	0x92, 0xb5, 0x87, 0x5a, 0x71, 0x69, 0x47, 0xcb, 0xd0, 0x54, 0xc1, 0x2c, 0x19, 0x74, 0xc5, 0x10,
+	0x2b, 0xa3, 0xeb, 0xd0, 0x63, 0x13, 0x23, 0x4b, 0x2c, 0xb6, 0x4e, 0x5f, 0xfa, 0x4c, 0xa5, 0x1c,
+	0x8f, 0xef, 0x6a, 0x85, 0xfc, 0x35, 0xcc, 0xc7, 0xf1, 0x85, 0x2c, 0x29, 0x5a, 0x24, 0xa3, 0x51,
+	0x92, 0xbd, 0x86, 0xa9, 0x55, 0x22, 0x78, 0x5c, 0x52, 0x84, 0x12, 0xba, 0x09, 0x87, 0x1c, 0x7f,
+	0x54, 0x3d, 0x3b, 0x1e, 0x9b, 0x92, 0xa6, 0xbb, 0xd3, 0x67, 0x2b, 0xe5, 0xf8, 0x14, 0xd7, 0x17,
+	0x13, 0x4d, 0x0c, 0x38, 0xb3, 0x2b, 0x59, 0x94, 0x80, 0x5e, 0x6a, 0x6e, 0x11, 0x43, 0xd5, 0x8d,
+	0xf1, 0x2e, 0xe6, 0xc1, 0xd1, 0x4a, 0x39, 0x3e, 0xc4, 0x2d, 0xb8, 0x33, 0x58, 0x39, 0xc4, 0xfe,
+	0x5c, 0x31, 0xd0, 0x9b, 0xd0, 0xc3, 0xb2, 0xc7, 0x1e, 0xef, 0x9e, 0xea, 0x9a, 0xee, 0x9f, 0x4f,
+	0x04, 0xc6, 0xc5, 0x81, 0xed, 0x21, 0x76, 0xd4, 0xd2, 0xa3, 0x4e, 0x88, 0x2a, 0xe5, 0xf8, 0x00,
+	0x5f, 0x81, 0xdb, 0xc2, 0x8a, 0x30, 0x8a, 0x7f, 0x15, 0x83, 0xf9, 0xa6, 0x31, 0x7b, 0x55, 0xa7,
+	0x9b, 0xab, 0x96, 0x5e, 0xd0, 0xa9, 0xbe, 0x4d, 0xd6, 0x77, 0x8b, 0xc4, 0xdd, 0x3f, 0x7f, 0x18,
+	0xa4, 0x8e, 0xc3, 0x10, 0x8b, 0x10, 0x86, 0x9b, 0x30, 0xc8, 0x3d, 0x56, 0xdd, 0x75, 0xbb, 0xa6,
+	0xba, 0xa6, 0xbb, 0xd3, 0x13, 0x95, 0x72, 0x7c, 0xd4, 0x0f, 0xcd, 0x9d, 0xc7, 0xca, 0x61, 0x3e,
+	0xb0, 0xca, 0x17, 0xbc, 0x07, 0xc7, 0x84, 0x00, 0xb7, 0x6e, 0x96, 0xa8, 0x9a, 0x25, 0x86, 0x59,
+	0x60, 0x71, 0xed, 0x4b, 0x9f, 0xac, 0x94, 0xe3, 0x9f, 0xa9, 0x31, 0x54, 0x27, 0x87, 0x95, 0xa3,
+	0x7c, 0x62, 0xdd, 0x19, 0xbf, 0x53, 0xa2, 0x8b, 0x6c, 0xf4, 0xf7, 0x12, 0x3c, 0xe3, 0x05, 0x50,
+	0x37, 0x72, 0x79, 0xe2, 0x2c, 0xd8, 0x34, 0xfd, 0x66, 0xea, 0x03, 0x87, 0x2a, 0xe5, 0xf8, 0x60,
+	0x6d, 0xe0, 0xda, 0x0e, 0x52, 0x1a, 0x86, 0xea, 0xc1, 0xf1, 0x14, 0x93, 0x2b, 0xe5, 0xf8, 0x31,
+	0xbf, 0x9a, 0x0f, 0xd5, 0x00, 0xad, 0xc1, 0xf3, 0x35, 0x09, 0x4e, 0x06, 0x14, 0x91, 0xa8, 0xd6,
+	0x0d, 0x18, 0xae, 0x1a, 0xd2, 0xd8, 0xac, 0xa8, 0xa7, 0x67, 0x9d, 0x7c, 0xfb, 0x53, 0x39, 0x3e,
+	0xca, 0x4f, 0x08, 0x3b, 0xbb, 0x95, 0xd0, 0xcd, 0x64, 0x41, 0xa3, 0x9b, 0x89, 0x15, 0x83, 0x56,
+	0xca, 0xf1, 0xb1, 0x7a, 0x3f, 0xb8, 0x3a, 0x56, 0x06, 0x5d, 0x47, 0xf8, 0x6a, 0xf8, 0x17, 0xb1,
+	0xa6, 0x9e, 0xdc, 0x29, 0xd1, 0x4f, 0x4b, 0x3d, 0xbf, 0xe5, 0xd5, 0x67, 0x17, 0xab, 0xcf, 0x64,
+	0xc4, 0xfa, 0x74, 0x20, 0x44, 0x28, 0x50, 0x34, 0x07, 0x7d, 0x5e, 0xa8, 0xc6, 0xbb, 0x19, 0xc4,
+	0x91, 0x4a, 0x39, 0x3e, 0x5c, 0x17, 0x45, 0xac, 0xf4, 0xba, 0xe1, 0xc3, 0xbf, 0x8e, 0xc1, 0xa5,
+	0xe6, 0x81, 0xfb, 0x1f, 0x16, 0xf5, 0xde, 0x22, 0x8d, 0xb5, 0x56, 0xa4, 0x6b, 0x30, 0x5a, 0x53,
+	0x7c, 0xba, 0xe1, 0xa5, 0xb1, 0x53, 0xa3, 0x53, 0x95, 0x72, 0xfc, 0x44, 0x83, 0x1a, 0x75, 0xc5,
+	0xb0, 0x82, 0x7c, 0x25, 0xba, 0x62, 0xb0, 0x8c, 0x6e, 0x27, 0x82, 0x7f, 0x90, 0x60, 0x26, 0xb4,
+	0xa8, 0x7d, 0x49, 0xd8, 0x52, 0x55, 0xdf, 0x84, 0xc1, 0x3a, 0x74, 0xbc, 0xb6, 0x7d, 0x51, 0xaa,
+	0x87, 0x75, 0x98, 0x36, 0x05, 0xd4, 0x15, 0x09, 0xd0, 0x57, 0x25, 0xc0, 0x41, 0xb5, 0x24, 0xca,
+	0x5a, 0x75, 0x0f, 0x10, 0xdd, 0xa8, 0xad, 0xea, 0xab, 0x61, 0x55, 0x7d, 0xac, 0xce, 0x71, 0xb7,
+	0xa8, 0x07, 0x84, 0xe7, 0xa2, 0xa6, 0x8f, 0xc0, 0xd0, 0xe7, 0x4b, 0x05, 0x27, 0x98, 0xde, 0x53,
+	0x60, 0x09, 0x86, 0xab, 0x43, 0xc2, 0x8f, 0x39, 0xe8, 0x33, 0x4a, 0x05, 0x96, 0x25, 0xb6, 0x88,
+	0xa8, 0x0f, 0xa1, 0x37, 0x85, 0x95, 0x5e, 0x43, 0xa8, 0xe2, 0x6b, 0xd0, 0xef, 0xfc, 0xd1, 0xce,
+	0x8e, 0xe0, 0x05, 0x38, 0xcc, 0x75, 0xc5, 0xf2, 0x97, 0xa0, 0xdb, 0x99, 0x11, 0x2f, 0x91, 0x91,
+	0x04, 0x7f, 0xde, 0x24, 0xdc, 0xe7, 0x4d, 0x22, 0x65, 0xec, 0xa6, 0xfb, 0x7e, 0xfb, 0xf3, 0xd9,
+	0x83, 0x2c, 0x6d, 0x15, 0x26, 0xec, 0x40, 0x4b, 0xe5, 0xf3, 0x35, 0xd0, 0x56, 0x60, 0xb8, 0x3a,
+	0x24, 0x6c, 0x5f, 0x81, 0x83, 0x2e, 0xac, 0xae, 0x28, 0xc6, 0xb9, 0x34, 0x4e, 0xc1, 0xd8, 0x6d,
+	0xdd, 0xa6, 0xcc, 0x56, 0x7a, 0x97, 0xe5, 0x81, 0x0b, 0xf5, 0x2c, 0x1c, 0xe4, 0x69, 0xc4, 0xb7,
+	0x6a, 0xb8, 0x52, 0x8e, 0x1f, 0xe6, 0x40, 0x45, 0xf6, 0xf0, 0x69, 0x7c, 0x17, 0xc6, 0xf7, 0x9a,
+	0xe8, 0xcc, 0xab, 0xc7, 0x12, 0x0c, 0xaf, 0x15, 0x4d, 0xba, 0x6a, 0xe9, 0x19, 0xd2, 0x56, 0x31,
+	0x2c, 0xc1, 0xb0, 0xf3, 0x6a, 0x55, 0x35, 0xdb, 0x26, 0xb4, 0xa6, 0x1c, 0x8e, 0x57, 0xef, 0x8a,
+	0x7a, 0x09, 0xac, 0x0c, 0x3a, 0x43, 0x29, 0x67, 0x84, 0x97, 0xc4, 0x2d, 0x38, 0xf2, 0xa0, 0x64,
+	0xd2, 0x5a, 0x3b, 0xbc, 0x34, 0x4e, 0x54, 0xca, 0xf1, 0x71, 0x6e, 0x67, 0x8f, 0x08, 0x56, 0x86,
+	0xd8, 0x58, 0xd5, 0x12, 0x5e, 0x81, 0x23, 0x3e, 0x44, 0x22, 0x3c, 0x97, 0x01, 0xec, 0xa2, 0x49,
+	0xd5, 0xa2, 0x33, 0x2a, 0xe2, 0x3c, 0x5a, 0x29, 0xc7, 0x8f, 0x70, 0xbb, 0xd5, 0x39, 0xac, 0xf4,
+	0xd9, 0xae, 0x36, 0xbe, 0x05, 0x13, 0xeb, 0x26, 0xd5, 0x58, 0x02, 0xdc, 0xd6, 0x1f, 0x94, 0xf4,
+	0xac, 0x4e, 0x77, 0xdb, 0x4a, 0xd0, 0xef, 0x4a, 0x20, 0x37, 0x32, 0x25, 0xdc, 0x7b, 0x04, 0x7d,
+	0x79, 0x77, 0x50, 0xec, 0xe0, 0x44, 0x42, 0xbc, 0xd0, 0x9d, 0x40, 0x79, 0xd7, 0xcf, 0x82, 0xa9,
+	0x1b, 0xe9, 0x45, 0x71, 0xe1, 0x88, 0x6a, 0xf2, 0x34, 0xf1, 0x0f, 0xff, 0x12, 0x9f, 0xce, 0xe9,
+	0x74, 0xb3, 0xb4, 0x91, 0xc8, 0x98, 0x05, 0xf1, 0xc4, 0x17, 0xff, 0xcc, 0xda, 0xd9, 0xad, 0x24,
+	0x75, 0x6e, 0x0b, 0x66, 0xc4, 0x56, 0xaa, 0x2b, 0xe2, 0x31, 0x18, 0x65, 0xce, 0xd5, 0x63, 0xc4,
+	0xef, 0x49, 0x70, 0xac, 0x7e, 0xe6, 0xd3, 0xe1, 0xb2, 0xbb, 0x35, 0xf7, 0xcc, 0x7c, 0xa9, 0x40,
+	0x96, 0x4d, 0xab, 0xed, 0xb3, 0xe3, 0xdb, 0xee, 0xd6, 0xd4, 0x99, 0x12, 0x38, 0x29, 0xf4, 0x6c,
+	0xb3, 0x89, 0x70, 0x90, 0xa9, 0xda, 0x87, 0x00, 0x57, 0x6b, 0x0d, 0xa1, 0x58, 0x0b, 0x6f, 0x83,
+	0xbc, 0x6e, 0x69, 0x59, 0xdd, 0xc8, 0xad, 0x6a, 0xba, 0xb5, 0xee, 0x7c, 0x54, 0x2e, 0x13, 0x7f,
+	0x81, 0xb2, 0xec, 0x57, 0x2f, 0x8a, 0x54, 0xf6, 0xe1, 0x13, 0x13, 0x58, 0xe9, 0x61, 0x7f, 0x5d,
+	0xac, 0x0a, 0xcf, 0x8d, 0xc7, 0x1a, 0x0b, 0xcf, 0xb9, 0xc2, 0x73, 0x58, 0x85, 0xe3, 0x0d, 0xd7,
+	0x15, 0xc1, 0x78, 0x01, 0xfa, 0xbc, 0x0f, 0x5c, 0xb1, 0xf4, 0x29, 0x71, 0xb1, 0x1c, 0xdf, 0x7b,
+	0xb1, 0xdc, 0x26, 0x39, 0x2d, 0xb3, 0xbb, 0x48, 0x32, 0x4a, 0x2f, 0x15, 0x96, 0x9c, 0xcf, 0x95,
+	0xb3, 0xee, 0x3d, 0xe6, 0xac, 0x44, 0xd2, 0x9a, 0x4d, 0xb2, 0x77, 0x0c, 0x56, 0x70, 0x2b, 0x85,
+	0xa2, 0x96, 0xf1, 0xee, 0xe4, 0xe7, 0xa1, 0xef, 0xbe, 0x65, 0x16, 0x54, 0xe7, 0x3b, 0x59, 0x9c,
+	0xe4, 0x01, 0xc1, 0xe7, 0x5f, 0x92, 0xbd, 0x8e, 0x86, 0xf3, 0x1b, 0x61, 0x18, 0xa0, 0x26, 0xd3,
+	0xf5, 0x1f, 0x4a, 0x4a, 0x3f, 0x35, 0x9d, 0x69, 0x7e, 0xe8, 0x8c, 0x55, 0xf3, 0xc4, 0x39, 0x6a,
+	0xba, 0xbd, 0x43, 0xed, 0x15, 0x18, 0x2e, 0x68, 0x3b, 0xfc, 0x44, 0x50, 0x75, 0xe6, 0xd5, 0x78,
+	0x77, 0x74, 0xb8, 0x83, 0x05, 0x6d, 0xc7, 0x07, 0x08, 0xbd, 0x04, 0x83, 0x64, 0x87, 0x12, 0xcb,
+	0xd0, 0xf2, 0xe2, 0x04, 0x3a, 0x18, 0xdd, 0xd8, 0x80, 0xab, 0xca, 0xcf, 0xa4, 0x1f, 0x49, 0x70,
+	0x2e, 0x34, 0x80, 0x62, 0xbb, 0x6e, 0x00, 0xe8, 0x46, 0xb1, 0x44, 0x5b, 0x0a, 0x61, 0x1f, 0x53,
+	0x61, 0x31, 0x7c, 0x01, 0xfa, 0xcd, 0x12, 0xf5, 0x0c, 0xc4, 0xa2, 0x19, 0x00, 0xae, 0xe3, 0x8c,
+	0xe0, 0x53, 0x70, 0x32, 0x95, 0xcf, 0xbb, 0x79, 0xb4, 0xe6, 0xb4, 0x44, 0x52, 0x39, 0x8b, 0x90,
+	0x02, 0x31, 0xa8, 0x77, 0xcb, 0x7e, 0x4f, 0x02, 0x1c, 0x24, 0x25, 0xd0, 0x6c, 0x83, 0x5c, 0xd7,
+	0x5d, 0x51, 0x35, 0x4f, 0x4a, 0x54, 0xe7, 0xa5, 0xc0, 0xc7, 0x7b, 0xe3, 0x15, 0x84, 0xdb, 0x63,
+	0xb4, 0xf1, 0xfa, 0xf8, 0x06, 0x9c, 0x6d, 0xac, 0xb8, 0x6c, 0x99, 0x85, 0x9a, 0x8b, 0x7c, 0xa4,
+	0xe6, 0x22, 0x77, 0xaf, 0xed, 0x0f, 0x24, 0x38, 0x17, 0x6a, 0xc0, 0x3b, 0x6d, 0x26, 0x9a, 0x62,
+	0x14, 0x1b, 0xd8, 0x01, 0xc4, 0x63, 0x8d, 0x21, 0xe2, 0xfb, 0x30, 0x5d, 0xa3, 0xc7, 0x7c, 0xb2,
+	0xd7, 0xcd, 0x54, 0x26, 0x63, 0x95, 0x48, 0xf6, 0x9e, 0x96, 0x2f, 0x91, 0x40, 0x8c, 0xe8, 0x34,
+	0x0c, 0xb8, 0xb6, 0x17, 0x7d, 0xd5, 0x56, 0x3b, 0x88, 0x6d, 0x38, 0x1f, 0x61, 0x1d, 0x11, 0x8a,
+	0x65, 0xe8, 0xa9, 0x79, 0xc1, 0x26, 0xc2, 0x5e, 0xb0, 0xe2, 0xd8, 0x75, 0x1f, 0xae, 0x42, 0x1b,
+	0x9f, 0x81, 0x53, 0x7b, 0x92, 0x2b, 0x93, 0x29, 0x15, 0x4a, 0x79, 0x8d, 0x9a, 0x96, 0x97, 0x84,
+	0x1f, 0x4a, 0x70, 0x3a, 0x58, 0x4e, 0xf8, 0xb5, 0x0b, 0xc7, 0x7d, 0x5b, 0xb4, 0xa5, 0x17, 0x54,
+	0xcd, 0x27, 0x26, 0xf2, 0xf0, 0x72, 0xb4, 0x4d, 0xda, 0xd2, 0x0b, 0xbe, 0x35, 0xc4, 0x2e, 0x8d,
+	0xd3, 0xc6, 0xd3, 0x36, 0xbe, 0x0e, 0x67, 0x14, 0x92, 0xd3, 0x6d, 0x4a, 0x2c, 0x92, 0x4d, 0xe5,
+	0xf3, 0xe6, 0x2e, 0xc9, 0x3a, 0x97, 0x55, 0xc4, 0x44, 0x7c, 0x57, 0x82, 0xb3, 0x61, 0xfa, 0x02,
+	0xa4, 0x0e, 0x83, 0x19, 0xd3, 0xa0, 0x96, 0x96, 0xa1, 0xaa, 0x4d, 0x35, 0x4a, 0x44, 0xf2, 0x3d,
+	0x1f, 0x88, 0x8b, 0x99, 0x5c, 0x10, 0x7a, 0x35, 0x91, 0x5c, 0x73, 0x6c, 0x08, 0x7c, 0x03, 0xae,
+	0x65, 0x36, 0x88, 0x53, 0x01, 0x4e, 0xf1, 0xaf, 0x4a, 0x17, 0xd5, 0x58, 0xdd, 0xb5, 0xee, 0x5d,
+	0xe1, 0xdf, 0x91, 0xe0, 0x5c, 0xa8, 0x8d, 0xff, 0x3f, 0x32, 0x0c, 0x53, 0xa9, 0x7c, 0xbe, 0xa1,
+	0x63, 0x5e, 0xda, 0xbd, 0x23, 0xc1, 0xc9, 0x00, 0x21, 0xe1, 0xf4, 0x16, 0x0c, 0xd5, 0x3a, 0xed,
+	0xe6, 0xd9, 0x7e, 0x78, 0x3d, 0x58, 0xe3, 0xb5, 0x8d, 0x57, 0x61, 0x2a, 0x95, 0xc9, 0x38, 0xb5,
+	0xc3, 0x5f, 0x44, 0xae, 0xe2, 0xba, 0x4e, 0x2c, 0x77, 0x2b, 0x2e, 0xc0, 0x21, 0x2d, 0x9b, 0xb5,
+	0x88, 0x6d, 0xef, 0x7d, 0x81, 0x88, 0x09, 0xac, 0xb8, 0x22, 0xf8, 0x5f, 0x31, 0x38, 0x19, 0x60,
+	0x52, 0x80, 0xfc, 0xa6, 0x04, 0x43, 0xd4, 0xd2, 0xf4, 0xbc, 0x6e, 0xe4, 0xd4, 0xa8, 0x6f, 0xae,
+	0x97, 0xc4, 0x9b, 0xcb, 0xfd, 0x7c, 0xad, 0xd5, 0x6f, 0xed, 0xf1, 0x35, 0xe8, 0x6a, 0x73, 0x27,
+	0xd1, 0x55, 0xe8, 0x7f, 0xa8, 0x1b, 0x59, 0xf3, 0xa1, 0x9a, 0xd5, 0x76, 0x6d, 0xd1, 0x5e, 0x3a,
+	0x56, 0x29, 0xc7, 0x11, 0x5f, 0xcc, 0x37, 0x89, 0x15, 0xe0, 0xbf, 0x16, 0xb5, 0x5d, 0xdb, 0x69,
+	0xfb, 0x6d, 0x6a, 0xb6, 0x4a, 0x75, 0x62, 0xb1, 0x87, 0x45, 0xaf, 0xbf, 0xed, 0xe7, 0xce, 0x60,
+	0xe5, 0xd0, 0xa6, 0x66, 0x3b, 0x11, 0x40, 0xaf, 0x41, 0x37, 0x93, 0xed, 0x9e, 0x92, 0x42, 0x1b,
+	0x50, 0x7b, 0x03, 0x98, 0x3e, 0x2a, 0x62, 0xd0, 0x2f, 0x62, 0xc0, 0x8c, 0x33, 0x8b, 0x78, 0x02,
+	0xc6, 0xc4, 0x07, 0xac, 0xab, 0xe1, 0x65, 0xde, 0x37, 0x24, 0x18, 0xdf, 0x3b, 0x27, 0xf6, 0xe2,
+	0x01, 0x0c, 0xb1, 0x52, 0xf3, 0x4e, 0x3a, 0x37, 0xe1, 0xce, 0x07, 0x77, 0xf5, 0x7d, 0xc6, 0xd2,
+	0x93, 0xb5, 0x5b, 0x53, 0x67, 0x0f, 0x2b, 0x03, 0x45, 0xff, 0xd2, 0x78, 0x04, 0xd0, 0xaa, 0x56,
+	0xb2, 0xeb, 0xea, 0x63, 0x09, 0x8e, 0xd6, 0x8c, 0x0a, 0xff, 0x12, 0xd0, 0x2b, 0x8e, 0x02, 0xee,
+	0x58, 0xb7, 0x3f, 0xc2, 0xee, 0x0c, 0x56, 0x0e, 0xf1, 0x03, 0xc2, 0xc6, 0xef, 0xc7, 0x60, 0x64,
+	0x59, 0x37, 0xb2, 0x69, 0x62, 0xf3, 0xae, 0x9d, 0x9b, 0xc8, 0xfe, 0x0e, 0xad, 0xd4, 0x5e, 0x87,
+	0x36, 0xd6, 0x62, 0x87, 0xd6, 0x59, 0xd3, 0x79, 0x5d, 0x6e, 0x9a, 0x45, 0x9b, 0xa5, 0xc7, 0x80,
+	0x7f, 0x4d, 0x77, 0x06, 0x2b, 0x87, 0x0a, 0xda, 0xce, 0x2d, 0xb3, 0x68, 0x3b, 0x1f, 0xaf, 0xce,
+	0xa8, 0xc7, 0x22, 0x38, 0x1a, 0xbe, 0x8f, 0xd7, 0xea, 0x1c, 0x56, 0xfa, 0x0a, 0xda, 0x0e, 0xc3,
+	0x67, 0x3b, 0x5d, 0x05, 0xbb, 0x98, 0xd7, 0x29, 0x7b, 0x6b, 0xf6, 0xfa, 0xbb, 0x0a, 0x6c, 0x18,
+	0x2b, 0x7c, 0x1a, 0x7f, 0x2c, 0xc1, 0x68, 0x5d, 0x68, 0x44, 0x90, 0xef, 0x79, 0x9d, 0x51, 0xbe,
+	0xf7, 0xe7, 0x02, 0xf7, 0x9e, 0xe9, 0xde, 0x2d, 0x99, 0xe1, 0x1d, 0xd1, 0x46, 0xbd, 0xe7, 0xd8,
+	0x3e, 0xf7, 0x9e, 0x7f, 0x12, 0x03, 0xa8, 0x7a, 0x84, 0x5e, 0xaf, 0x6d, 0x8f, 0xb4, 0xca, 0xc1,
+	0x8c, 0x08, 0x40, 0x87, 0xab, 0x09, 0x66, 0x63, 0xd1, 0x43, 0x69, 0xd4, 0x73, 0x8b, 0xed, 0x67,
+	0xcf, 0xad, 0x61, 0xbc, 0xba, 0xf6, 0x37, 0x5e, 0xf3, 0xbf, 0x4b, 0xc2, 0xc1, 0xbb, 0x0e, 0xf1,
+	0xe8, 0x1c, 0xc3, 0x3d, 0x9c, 0x9d, 0x43, 0xcf, 0x44, 0xa0, 0xf0, 0x44, 0x21, 0xc9, 0x33, 0x91,
+	0x64, 0x79, 0x66, 0xe1, 0x99, 0xaf, 0x7c, 0xfc, 0xd7, 0x77, 0x63, 0x67, 0xd0, 0xa9, 0x64, 0x10,
+	0x97, 0x2a, 0xbc, 0xf8, 0x9b, 0x04, 0x13, 0x4d, 0x09, 0x0d, 0x74, 0x3d, 0x70, 0xdd, 0x30, 0x36,
+	0x51, 0xbe, 0xd1, 0xae, 0xba, 0x40, 0x72, 0x9b, 0x21, 0x59, 0x46, 0x8b, 0x81, 0x48, 0xde, 0x16,
+	0x47, 0xd2, 0xa3, 0x24, 0x11, 0x16, 0x39, 0xad, 0x4c, 0x1c, 0x9b, 0x62, 0x4f, 0x54, 0xdd, 0x40,
+	0x1f, 0xc6, 0x60, 0xa6, 0xe9, 0x9a, 0x7b, 0xfb, 0xfe, 0xe8, 0x4e, 0x7b, 0xde, 0x37, 0x65, 0x10,
+	0x3a, 0x0e, 0x87, 0xc6, 0xc2, 0xf1, 0x25, 0xf4, 0xc5, 0xfd, 0x08, 0x87, 0xfa, 0x50, 0xa7, 0x9b,
+	0x6a, 0xd1, 0x75, 0x54, 0x65, 0x77, 0x35, 0xfa, 0x7a, 0x0c, 0x4e, 0x45, 0xe0, 0xeb, 0xd0, 0x8b,
+	0xd1, 0xa0, 0x84, 0x32, 0x7e, 0x1d, 0xc7, 0xe4, 0x35, 0x16, 0x13, 0x05, 0xad, 0xb6, 0x1c, 0x13,
+	0xe6, 0x1b, 0xa7, 0x5a, 0x1a, 0xa6, 0xcb, 0x3f, 0x25, 0x90, 0x9b, 0x93, 0x02, 0xa8, 0x2d, 0xc7,
+	0xab, 0xa4, 0x88, 0x7c, 0xb3, 0x6d, 0x7d, 0x81, 0xfc, 0x15, 0x86, 0xfc, 0x45, 0xb4, 0xd4, 0x79,
+	0x36, 0x98, 0x25, 0x8a, 0x7e, 0x10, 0x83, 0x0b, 0xad, 0xd0, 0x62, 0x68, 0xb5, 0x4d, 0x00, 0xcd,
+	0xeb, 0xa3, 0xe3, 0x90, 0x6c, 0xb0, 0x90, 0xbc, 0x81, 0x5e, 0xdf, 0x97, 0x90, 0x34, 0xae, 0x90,
+	0x77, 0x62, 0x70, 0x3a, 0x0a, 0xf9, 0x85, 0x6e, 0x75, 0x56, 0x22, 0xfb, 0x99, 0x2a, 0x6f, 0xb2,
+	0xb8, 0xbc, 0x8a, 0xbe, 0xd0, 0x62, 0x5c, 0x9c, 0x28, 0x84, 0x14, 0x8a, 0x93, 0x3a, 0xef, 0x49,
+	0xd0, 0xeb, 0x92, 0x54, 0xe8, 0x42, 0xa0, 0xb3, 0x75, 0xf4, 0x96, 0x3c, 0x1b, 0x51, 0x5a, 0x00,
+	0x49, 0x30, 0x20, 0xd3, 0xe8, 0x6c, 0x20, 0x10, 0x8f, 0x01, 0x43, 0xdf, 0x92, 0xa0, 0xdb, 0xb1,
+	0x80, 0xa6, 0x43, 0x5f, 0xd6, 0xae, 0x47, 0xe7, 0x23, 0x48, 0x0a, 0x6f, 0x2e, 0x33, 0x6f, 0x12,
+	0xe8, 0x42, 0xa0, 0x37, 0xcc, 0x93, 0x6a, 0x70, 0x59, 0xb4, 0x5c, 0xde, 0x2b, 0x24, 0x5a, 0x75,
+	0x8c, 0x99, 0x3c, 0x1b, 0x51, 0xba, 0xa5, 0x68, 0x69, 0xf9, 0xfc, 0x2c, 0x8f, 0xd6, 0x2f, 0x25,
+	0x18, 0xae, 0xe7, 0xc0, 0x50, 0x70, 0xb3, 0xa5, 0x09, 0xeb, 0x26, 0x5f, 0x69, 0x51, 0x4b, 0x78,
+	0xfc, 0x2c, 0xf3, 0x78, 0x1e, 0x5d, 0x0c, 0xf4, 0x38, 0xaf, 0xdb, 0x94, 0xbb, 0x3c, 0xbb, 0xb1,
+	0x3b, 0xcb, 0x7b, 0x64, 0x1f, 0x48, 0xd0, 0xe7, 0x31, 0x53, 0x28, 0x38, 0x50, 0xf5, 0x9c, 0x9c,
+	0x9c, 0x88, 0x2a, 0x2e, 0xdc, 0xbc, 0xc4, 0xdc, 0x9c, 0x45, 0x33, 0x0d, 0xdd, 0xac, 0xdb, 0xf0,
+	0x24, 0x6b, 0x4a, 0xdb, 0xe8, 0xb1, 0x04, 0x68, 0x2f, 0x4b, 0x85, 0x3e, 0x1b, 0xdc, 0xcc, 0x6a,
+	0xc6, 0x90, 0xc9, 0x57, 0x5b, 0xd6, 0x13, 0xce, 0xaf, 0x30, 0xe7, 0x17, 0x50, 0xaa, 0x95, 0xac,
+	0x4d, 0x52, 0xc7, 0x20, 0x3f, 0x04, 0x3c, 0x9e, 0x08, 0xfd, 0x54, 0x82, 0xc1, 0x5a, 0x06, 0x0b,
+	0xcd, 0x87, 0xbb, 0xb5, 0x07, 0xca, 0xa5, 0x96, 0x74, 0x5a, 0x2a, 0x3e, 0xee, 0x76, 0xd5, 0xe3,
+	0x8f, 0xdc, 0x4d, 0xa8, 0xe1, 0xa3, 0xa2, 0x6c, 0x42, 0x23, 0x2e, 0x4c, 0xbe, 0xda, 0xb2, 0x9e,
+	0xf0, 0x3e, 0xc5, 0xbc, 0x7f, 0x0e, 0x7d, 0xae, 0x8d, 0x4d, 0xe0, 0xdd, 0x17, 0xf4, 0x1b, 0x09,
+	0x8e, 0x36, 0xa0, 0x93, 0x50, 0x88, 0x4f, 0x4d, 0x89, 0x2f, 0xf9, 0xd9, 0xd6, 0x15, 0x05, 0x9a,
+	0x6b, 0x0c, 0xcd, 0x65, 0x34, 0x1f, 0xbc, 0x17, 0xdc, 0x82, 0x5a, 0xd4, 0x74, 0x8b, 0xf7, 0x2a,
+	0xee, 0x13, 0x82, 0xfe, 0x21, 0x41, 0x3c, 0x84, 0x72, 0x41, 0x0b, 0x91, 0x2e, 0xc0, 0x60, 0xc6,
+	0x4b, 0x5e, 0xec, 0xcc, 0x88, 0x80, 0x7a, 0x9d, 0x41, 0xbd, 0x8a, 0xae, 0xb4, 0x7a, 0x95, 0x3a,
+	0xe8, 0x09, 0x7a, 0x22, 0x81, 0xdc, 0x9c, 0x8d, 0x09, 0x79, 0x54, 0x86, 0x92, 0x3d, 0xf2, 0xcd,
+	0xb6, 0xf5, 0x05, 0xbc, 0x05, 0x06, 0xef, 0x3a, 0x7a, 0x2e, 0xec, 0xca, 0x50, 0x9b, 0xb3, 0x45,
+	0xe8, 0x3f, 0x12, 0xc4, 0x43, 0x38, 0x99, 0x90, 0x2d, 0x8d, 0x46, 0x09, 0xc9, 0x8b, 0x9d, 0x19,
+	0x11, 0x98, 0xef, 0x32, 0xcc, 0x2f, 0xa3, 0x95, 0xe0, 0x2d, 0x65, 0xf7, 0xcc, 0xa3, 0x64, 0x53,
+	0xdc, 0x2a, 0xe3, 0x53, 0xf9, 0x6d, 0xf4, 0x7e, 0x0c, 0x4e, 0x86, 0x92, 0x31, 0x68, 0x29, 0xba,
+	0xfb, 0x01, 0xa4, 0x91, 0xbc, 0xdc, 0xa9, 0x19, 0x11, 0x87, 0x2c, 0x8b, 0xc3, 0x5b, 0xe8, 0x8d,
+	0xe0, 0x38, 0xd4, 0xb0, 0x4e, 0x8f, 0x9a, 0xc6, 0x85, 0x0d, 0xdb, 0x2a, 0x35, 0x55, 0x8d, 0x2f,
+	0xa6, 0x6e, 0x33, 0xd0, 0x7f, 0x97, 0xe0, 0x44, 0x10, 0x15, 0x84, 0x5e, 0x68, 0x2d, 0x87, 0xf7,
+	0xb2, 0x4d, 0x72, 0xaa, 0x03, 0x0b, 0x22, 0x16, 0x4b, 0x2c, 0x16, 0x37, 0xd1, 0xf5, 0xd6, 0xeb,
+	0xc0, 0x8f, 0xe5, 0xdf, 0x12, 0x4c, 0x06, 0x93, 0x42, 0x28, 0x1d, 0xdc, 0xf7, 0x8b, 0xc2, 0x48,
+	0xc9, 0x0b, 0x1d, 0xd9, 0x10, 0x90, 0xef, 0x30, 0xc8, 0x2b, 0xe8, 0xc5, 0x48, 0x65, 0x60, 0x79,
+	0x46, 0x55, 0x8d, 0x5b, 0xe5, 0x8f, 0x03, 0x5f, 0x11, 0x7c, 0x39, 0x06, 0xf1, 0x10, 0xe2, 0x08,
+	0xb5, 0xe9, 0x79, 0x0d, 0x75, 0x25, 0x2f, 0x76, 0x66, 0x44, 0xe0, 0x5f, 0x63, 0xf8, 0x5f, 0x41,
+	0x2f, 0x47, 0x3c, 0xd9, 0x03, 0x23, 0x20, 0xa4, 0xd0, 0x9f, 0x25, 0x98, 0x68, 0xca, 0x40, 0x85,
+	0xb4, 0xd7, 0xc2, 0xe8, 0x2d, 0xf9, 0x46, 0xbb, 0xea, 0x2d, 0x3d, 0x42, 0x9c, 0x24, 0x6f, 0x82,
+	0xd5, 0x46, 0x9f, 0x38, 0xf8, 0x9a, 0x91, 0x4f, 0x61, 0xf8, 0x42, 0x78, 0x30, 0xf9, 0x46, 0xbb,
+	0xea, 0x02, 0xdf, 0x22, 0xc3, 0x77, 0x03, 0x3d, 0x1f, 0x88, 0x8f, 0x3f, 0xa7, 0x7c, 0x75, 0x4c,
+	0x75, 0x62, 0x25, 0xdf, 0x16, 0xf4, 0xda, 0x23, 0xf6, 0x55, 0x54, 0x4f, 0xe5, 0x84, 0x7c, 0x15,
+	0x35, 0x61, 0x85, 0xe4, 0x2b, 0x2d, 0x6a, 0xb5, 0xf4, 0x55, 0xe4, 0xec, 0x53, 0x1d, 0x0d, 0x84,
+	0xbe, 0x2f, 0x41, 0xbf, 0x8f, 0xe1, 0x41, 0xc9, 0x90, 0x3e, 0x72, 0x3d, 0x43, 0x24, 0x5f, 0x8c,
+	0xae, 0x20, 0x9c, 0x9d, 0x63, 0xce, 0xce, 0xa0, 0xf3, 0xc1, 0x2f, 0x5b, 0xa6, 0x29, 0x92, 0xe8,
+	0xc7, 0x12, 0x0c, 0xd4, 0x90, 0x24, 0x68, 0x2e, 0x70, 0xd9, 0x46, 0x5c, 0x93, 0x3c, 0xdf, 0x8a,
+	0x4a, 0x4b, 0xdf, 0x10, 0xf7, 0x75, 0x23, 0xab, 0x6e, 0x10, 0x9b, 0x72, 0x22, 0x28, 0xfd, 0xe6,
+	0x47, 0x4f, 0x26, 0xa5, 0xc7, 0x4f, 0x26, 0xa5, 0x4f, 0x9e, 0x4c, 0x4a, 0xef, 0x3c, 0x9d, 0x3c,
+	0xf0, 0xf8, 0xe9, 0xe4, 0x81, 0x3f, 0x3e, 0x9d, 0x3c, 0xf0, 0xfa, 0x82, 0x8f, 0x0d, 0x15, 0x16,
+	0x67, 0xf3, 0xda, 0x86, 0xed, 0x99, 0xdf, 0x9e, 0xbf, 0x92, 0xdc, 0xa9, 0x59, 0x24, 0x93, 0xd7,
+	0x89, 0x41, 0xf9, 0xff, 0x96, 0xc4, 0xff, 0x73, 0xd2, 0x1e, 0xf6, 0xcf, 0xa5, 0xff, 0x0e, 0x00,
+	0xf3, 0x8f, 0xfc, 0xe3, 0xe5, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
+	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
+	// primitive types instead of repeated structs. Each index in the
+	// routes_pool_id field corresponds to the respective routes_token_out_denom
+	// value, thus they are required to have the same length and are grouped
+	// together as pairs.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
+	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
+	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
+	EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
+	// ListPoolsByDenom return all pools by denom
+	ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
+	// TotalPoolLiquidity returns the total liquidity of the specified pool.
+	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
+	// TotalLiquidity returns the total liquidity across all pools.
+	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
+	// TotalVolumeForPool returns the total volume of the specified pool.
+	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
+	// TradingPairTakerFee returns the taker fee for a given set of denoms
+	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
+	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
-	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
 	// AllTakerFeeShareAgreements returns all taker fee share agreements.
 	// A taker fee share agreement includes the denom of the denom getting the
 	// taker fees, the percent of the taker fees that the denom gets when it is
 	// in the route being traded against, and the address that the taker fees are
 	// sent to at epoch.
-	AllTakerFeeShareAgreements(context.Context, *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error)
+	AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error)
 	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
 	// given denom. A taker fee share agreement includes the denom of the denom
 	// getting the taker fees, the percent of the taker fees that the denom gets
 	// when it is in the route being traded against, and the address that the
 	// taker fees are sent to at epoch.
-	TakerFeeShareAgreementFromDenom(context.Context, *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error)
+	TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error)
 	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
 	// the given taker fee denom (the collected fees) for the given fee share
 	// denom (the denom with the taker fee share agreement)
-	TakerFeeShareDenomsToAccruedValue(context.Context, *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error)
+	TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error)
 	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
 	// taker fee share accumulator includes the denom of the denom getting the
 	// taker fees, and an accumulated value of coins that the denom has accrued
 	// since the last time it was distributed in the epoch prior.
-	AllTakerFeeShareAccumulators(context.Context, *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error)
+	AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error)
 	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
 	// from the given denom. The registered alloyed pool contains the pool's
 	// contract address, along with the current distribution composition of taker
 	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromDenom(context.Context, *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error)
+	RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error)
 	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
 	// from the given pool id. The registered alloyed pool contains the pool's
 	// contract address, along with the current distribution composition of taker
 	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromPoolId(context.Context, *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
+	RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
 	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
 	// registered alloyed pool contains the pool's contract address, along with
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
-	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+	// AccountVolumeTakerFeeTier returns the trailing swap volume of an account
+	// and the volume taker fee tier it currently reaches.
+	AccountVolumeTakerFeeTier(ctx context.Context, in *AccountVolumeTakerFeeTierRequest, opts ...grpc.CallOption) (*AccountVolumeTakerFeeTierResponse, error)
+	// AllPoolTakerFees returns the taker fee overrides of all pools.
+	AllPoolTakerFees(ctx context.Context, in *AllPoolTakerFeesRequest, opts ...grpc.CallOption) (*AllPoolTakerFeesResponse, error)
+	// PausedPools returns the ids of the paused pools.
+	PausedPools(ctx context.Context, in *PausedPoolsRequest, opts ...grpc.CallOption) (*PausedPoolsResponse, error)
+	// FindBestRoute searches the pools for the routes from token_in to
+	// token_out_denom with the highest expected output, and optionally splits
+	// token_in across them.
+	FindBestRoute(ctx context.Context, in *FindBestRouteRequest, opts ...grpc.CallOption) (*FindBestRouteResponse, error)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInWithPrimitiveTypes not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, req *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountIn not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOutWithPrimitiveTypes not implemented")
-}
-func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, req *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountOut not implemented")
-}
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
-}
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
-}
-func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
-}
-func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
-}
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
-}
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
-}
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalLiquidityRequest) (*TotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
-}
-func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
-}
-func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
-}
-func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
-}
-func (*UnimplementedQueryServer) AllTakerFeeShareAgreements(ctx context.Context, req *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAgreements not implemented")
-}
-func (*UnimplementedQueryServer) TakerFeeShareAgreementFromDenom(ctx context.Context, req *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareAgreementFromDenom not implemented")
-}
-func (*UnimplementedQueryServer) TakerFeeShareDenomsToAccruedValue(ctx context.Context, req *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareDenomsToAccruedValue not implemented")
-}
-func (*UnimplementedQueryServer) AllTakerFeeShareAccumulators(ctx context.Context, req *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAccumulators not implemented")
-}
-func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromDenom(ctx context.Context, req *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromDenom not implemented")
-}
-func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Context, req *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromPoolId not implemented")
-}
-func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountInWithPrimitiveTypesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountInWithPrimitiveTypesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSinglePoolSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSinglePoolSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, req.(*EstimateSinglePoolSwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountOutWithPrimitiveTypesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountOutWithPrimitiveTypesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSinglePoolSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSinglePoolSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
+	out := new(NumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, req.(*EstimateSinglePoolSwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumPoolsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
+	out := new(PoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
+	out := new(AllPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllPoolsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error) {
+	out := new(ListPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPoolsByDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
+	out := new(SpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*ListPoolsByDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpotPriceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
+	out := new(TotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalPoolLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error) {
+	out := new(TotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*TotalPoolLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error) {
+	out := new(TotalVolumeForPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*TotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalVolumeForPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
+	out := new(TradingPairTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalVolumeForPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalVolumeForPool(ctx, req.(*TotalVolumeForPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TradingPairTakerFeeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
+	out := new(EstimateTradeBasedOnPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TradingPairTakerFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TradingPairTakerFee(ctx, req.(*TradingPairTakerFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateTradeBasedOnPriceImpactRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error) {
+	out := new(AllTakerFeeShareAgreementsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, req.(*EstimateTradeBasedOnPriceImpactRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllTakerFeeShareAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTakerFeeShareAgreementsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error) {
+	out := new(TakerFeeShareAgreementFromDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, req.(*AllTakerFeeShareAgreementsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TakerFeeShareAgreementFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TakerFeeShareAgreementFromDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
+	out := new(TakerFeeShareDenomsToAccruedValueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, req.(*TakerFeeShareAgreementFromDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TakerFeeShareDenomsToAccruedValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TakerFeeShareDenomsToAccruedValueRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error) {
+	out := new(AllTakerFeeShareAccumulatorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, req.(*TakerFeeShareDenomsToAccruedValueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllTakerFeeShareAccumulators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTakerFeeShareAccumulatorsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error) {
+	out := new(RegisteredAlloyedPoolFromDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, req.(*AllTakerFeeShareAccumulatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RegisteredAlloyedPoolFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisteredAlloyedPoolFromDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
+	out := new(RegisteredAlloyedPoolFromPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, req.(*RegisteredAlloyedPoolFromDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RegisteredAlloyedPoolFromPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisteredAlloyedPoolFromPoolIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error) {
+	out := new(AllRegisteredAlloyedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, req.(*RegisteredAlloyedPoolFromPoolIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllRegisteredAlloyedPoolsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AccountVolumeTakerFeeTier(ctx context.Context, in *AccountVolumeTakerFeeTierRequest, opts ...grpc.CallOption) (*AccountVolumeTakerFeeTierResponse, error) {
+	out := new(AccountVolumeTakerFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AccountVolumeTakerFeeTier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) AllPoolTakerFees(ctx context.Context, in *AllPoolTakerFeesRequest, opts ...grpc.CallOption) (*AllPoolTakerFeesResponse, error) {
+	out := new(AllPoolTakerFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPoolTakerFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools",
+	return out, nil
+}
+
+func (c *queryClient) PausedPools(ctx context.Context, in *PausedPoolsRequest, opts ...grpc.CallOption) (*PausedPoolsResponse, error) {
+	out := new(PausedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PausedPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, req.(*AllRegisteredAlloyedPoolsRequest))
+	return out, nil
+}
+
+func (c *queryClient) FindBestRoute(ctx context.Context, in *FindBestRouteRequest, opts ...grpc.CallOption) (*FindBestRouteResponse, error) {
+	out := new(FindBestRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/FindBestRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.poolmanager.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountInWithPrimitiveTypes",
-			Handler:    _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler,
-		},
-		{
-			MethodName: "EstimateSinglePoolSwapExactAmountIn",
-			Handler:    _Query_EstimateSinglePoolSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOutWithPrimitiveTypes",
-			Handler:    _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler,
-		},
-		{
-			MethodName: "EstimateSinglePoolSwapExactAmountOut",
-			Handler:    _Query_EstimateSinglePoolSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "AllPools",
-			Handler:    _Query_AllPools_Handler,
-		},
-		{
-			MethodName: "ListPoolsByDenom",
-			Handler:    _Query_ListPoolsByDenom_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-		{
-			MethodName: "TotalPoolLiquidity",
-			Handler:    _Query_TotalPoolLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalLiquidity",
-			Handler:    _Query_TotalLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalVolumeForPool",
-			Handler:    _Query_TotalVolumeForPool_Handler,
-		},
-		{
-			MethodName: "TradingPairTakerFee",
-			Handler:    _Query_TradingPairTakerFee_Handler,
-		},
-		{
-			MethodName: "EstimateTradeBasedOnPriceImpact",
-			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
-		},
-		{
-			MethodName: "AllTakerFeeShareAgreements",
-			Handler:    _Query_AllTakerFeeShareAgreements_Handler,
-		},
-		{
-			MethodName: "TakerFeeShareAgreementFromDenom",
-			Handler:    _Query_TakerFeeShareAgreementFromDenom_Handler,
-		},
-		{
-			MethodName: "TakerFeeShareDenomsToAccruedValue",
-			Handler:    _Query_TakerFeeShareDenomsToAccruedValue_Handler,
-		},
-		{
-			MethodName: "AllTakerFeeShareAccumulators",
-			Handler:    _Query_AllTakerFeeShareAccumulators_Handler,
-		},
-		{
-			MethodName: "RegisteredAlloyedPoolFromDenom",
-			Handler:    _Query_RegisteredAlloyedPoolFromDenom_Handler,
-		},
-		{
-			MethodName: "RegisteredAlloyedPoolFromPoolId",
-			Handler:    _Query_RegisteredAlloyedPoolFromPoolId_Handler,
-		},
-		{
-			MethodName: "AllRegisteredAlloyedPools",
-			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
+	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
+	// primitive types instead of repeated structs. Each index in the
+	// routes_pool_id field corresponds to the respective routes_token_out_denom
+	// value, thus they are required to have the same length and are grouped
+	// together as pairs.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
+	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
+	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
+	EstimateSwapExactAmountInWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error)
+	EstimateSinglePoolSwapExactAmountIn(context.Context, *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOutWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error)
+	EstimateSinglePoolSwapExactAmountOut(context.Context, *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
+	// ListPoolsByDenom return all pools by denom
+	ListPoolsByDenom(context.Context, *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
+	// TotalPoolLiquidity returns the total liquidity of the specified pool.
+	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
+	// TotalLiquidity returns the total liquidity across all pools.
+	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
+	// TotalVolumeForPool returns the total volume of the specified pool.
+	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
+	// TradingPairTakerFee returns the taker fee for a given set of denoms
+	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
+	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
+	// impact, if a trade cannot be estimated a 0 input and 0 output would be
+	// returned.
+	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// AllTakerFeeShareAgreements returns all taker fee share agreements.
+	// A taker fee share agreement includes the denom of the denom getting the
+	// taker fees, the percent of the taker fees that the denom gets when it is
+	// in the route being traded against, and the address that the taker fees are
+	// sent to at epoch.
+	AllTakerFeeShareAgreements(context.Context, *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error)
+	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
+	// given denom. A taker fee share agreement includes the denom of the denom
+	// getting the taker fees, the percent of the taker fees that the denom gets
+	// when it is in the route being traded against, and the address that the
+	// taker fees are sent to at epoch.
+	TakerFeeShareAgreementFromDenom(context.Context, *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error)
+	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
+	// the given taker fee denom (the collected fees) for the given fee share
+	// denom (the denom with the taker fee share agreement)
+	TakerFeeShareDenomsToAccruedValue(context.Context, *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error)
+	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
+	// taker fee share accumulator includes the denom of the denom getting the
+	// taker fees, and an accumulated value of coins that the denom has accrued
+	// since the last time it was distributed in the epoch prior.
+	AllTakerFeeShareAccumulators(context.Context, *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error)
+	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
+	// from the given denom. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromDenom(context.Context, *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error)
+	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
+	// from the given pool id. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromPoolId(context.Context, *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
+	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
+	// registered alloyed pool contains the pool's contract address, along with
+	// the current distribution composition of taker fee share denoms within the
+	// alloyed pool.
+	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+	// AccountVolumeTakerFeeTier returns the trailing swap volume of an account
+	// and the volume taker fee tier it currently reaches.
+	AccountVolumeTakerFeeTier(context.Context, *AccountVolumeTakerFeeTierRequest) (*AccountVolumeTakerFeeTierResponse, error)
+	// AllPoolTakerFees returns the taker fee overrides of all pools.
+	AllPoolTakerFees(context.Context, *AllPoolTakerFeesRequest) (*AllPoolTakerFeesResponse, error)
+	// PausedPools returns the ids of the paused pools.
+	PausedPools(context.Context, *PausedPoolsRequest) (*PausedPoolsResponse, error)
+	// FindBestRoute searches the pools for the routes from token_in to
+	// token_out_denom with the highest expected output, and optionally splits
+	// token_in across them.
+	FindBestRoute(context.Context, *FindBestRouteRequest) (*FindBestRouteResponse, error)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
 }
-
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInWithPrimitiveTypes not implemented")
 }
-
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, req *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOutWithPrimitiveTypes not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, req *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
+}
+func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalLiquidityRequest) (*TotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
+}
+func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
+}
+func (*UnimplementedQueryServer) AllTakerFeeShareAgreements(ctx context.Context, req *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAgreements not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeShareAgreementFromDenom(ctx context.Context, req *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareAgreementFromDenom not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeShareDenomsToAccruedValue(ctx context.Context, req *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareDenomsToAccruedValue not implemented")
+}
+func (*UnimplementedQueryServer) AllTakerFeeShareAccumulators(ctx context.Context, req *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAccumulators not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromDenom(ctx context.Context, req *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromDenom not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Context, req *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromPoolId not implemented")
+}
+func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
+}
+func (*UnimplementedQueryServer) AccountVolumeTakerFeeTier(ctx context.Context, req *AccountVolumeTakerFeeTierRequest) (*AccountVolumeTakerFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountVolumeTakerFeeTier not implemented")
+}
+func (*UnimplementedQueryServer) AllPoolTakerFees(ctx context.Context, req *AllPoolTakerFeesRequest) (*AllPoolTakerFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPoolTakerFees not implemented")
+}
+func (*UnimplementedQueryServer) PausedPools(ctx context.Context, req *PausedPoolsRequest) (*PausedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedPools not implemented")
+}
+func (*UnimplementedQueryServer) FindBestRoute(ctx context.Context, req *FindBestRouteRequest) (*FindBestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBestRoute not implemented")
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountInWithPrimitiveTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountInWithPrimitiveTypesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSinglePoolSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSinglePoolSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, req.(*EstimateSinglePoolSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RoutesTokenOutDenom) > 0 {
-		for iNdEx := len(m.RoutesTokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RoutesTokenOutDenom[iNdEx])
-			copy(dAtA[i:], m.RoutesTokenOutDenom[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenOutDenom[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RoutesPoolId) > 0 {
-		dAtA3 := make([]byte, len(m.RoutesPoolId)*10)
-		var j2 int
-		for _, num := range m.RoutesPoolId {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountOutWithPrimitiveTypesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountOutWithPrimitiveTypesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSinglePoolSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSinglePoolSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, req.(*EstimateSinglePoolSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*ListPoolsByDenomRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpotPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*TotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RoutesTokenInDenom) > 0 {
-		for iNdEx := len(m.RoutesTokenInDenom) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RoutesTokenInDenom[iNdEx])
-			copy(dAtA[i:], m.RoutesTokenInDenom[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenInDenom[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
 	}
-	if len(m.RoutesPoolId) > 0 {
-		dAtA5 := make([]byte, len(m.RoutesPoolId)*10)
-		var j4 int
-		for _, num := range m.RoutesPoolId {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintQuery(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*TotalLiquidityRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalVolumeForPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVolumeForPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVolumeForPool(ctx, req.(*TotalVolumeForPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradingPairTakerFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradingPairTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradingPairTakerFee(ctx, req.(*TradingPairTakerFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateTradeBasedOnPriceImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, req.(*EstimateTradeBasedOnPriceImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllTakerFeeShareAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTakerFeeShareAgreementsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, req.(*AllTakerFeeShareAgreementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TakerFeeShareAgreementFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeShareAgreementFromDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, req.(*TakerFeeShareAgreementFromDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_TakerFeeShareDenomsToAccruedValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeShareDenomsToAccruedValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, req.(*TakerFeeShareDenomsToAccruedValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllTakerFeeShareAccumulators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTakerFeeShareAccumulatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, req.(*AllTakerFeeShareAccumulatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RegisteredAlloyedPoolFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredAlloyedPoolFromDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, req.(*RegisteredAlloyedPoolFromDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RegisteredAlloyedPoolFromPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredAlloyedPoolFromPoolIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, req.(*RegisteredAlloyedPoolFromPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRegisteredAlloyedPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, req.(*AllRegisteredAlloyedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountVolumeTakerFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountVolumeTakerFeeTierRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).AccountVolumeTakerFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AccountVolumeTakerFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountVolumeTakerFeeTier(ctx, req.(*AccountVolumeTakerFeeTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_AllPoolTakerFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPoolTakerFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPoolTakerFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPoolTakerFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPoolTakerFees(ctx, req.(*AllPoolTakerFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PausedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausedPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PausedPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PausedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedPools(ctx, req.(*PausedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FindBestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindBestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/FindBestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindBestRoute(ctx, req.(*FindBestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.poolmanager.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountInWithPrimitiveTypes",
+			Handler:    _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler,
+		},
+		{
+			MethodName: "EstimateSinglePoolSwapExactAmountIn",
+			Handler:    _Query_EstimateSinglePoolSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOutWithPrimitiveTypes",
+			Handler:    _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler,
+		},
+		{
+			MethodName: "EstimateSinglePoolSwapExactAmountOut",
+			Handler:    _Query_EstimateSinglePoolSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "AllPools",
+			Handler:    _Query_AllPools_Handler,
+		},
+		{
+			MethodName: "ListPoolsByDenom",
+			Handler:    _Query_ListPoolsByDenom_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+		{
+			MethodName: "TotalPoolLiquidity",
+			Handler:    _Query_TotalPoolLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalLiquidity",
+			Handler:    _Query_TotalLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalVolumeForPool",
+			Handler:    _Query_TotalVolumeForPool_Handler,
+		},
+		{
+			MethodName: "TradingPairTakerFee",
+			Handler:    _Query_TradingPairTakerFee_Handler,
+		},
+		{
+			MethodName: "EstimateTradeBasedOnPriceImpact",
+			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
+		},
+		{
+			MethodName: "AllTakerFeeShareAgreements",
+			Handler:    _Query_AllTakerFeeShareAgreements_Handler,
+		},
+		{
+			MethodName: "TakerFeeShareAgreementFromDenom",
+			Handler:    _Query_TakerFeeShareAgreementFromDenom_Handler,
+		},
+		{
+			MethodName: "TakerFeeShareDenomsToAccruedValue",
+			Handler:    _Query_TakerFeeShareDenomsToAccruedValue_Handler,
+		},
+		{
+			MethodName: "AllTakerFeeShareAccumulators",
+			Handler:    _Query_AllTakerFeeShareAccumulators_Handler,
+		},
+		{
+			MethodName: "RegisteredAlloyedPoolFromDenom",
+			Handler:    _Query_RegisteredAlloyedPoolFromDenom_Handler,
+		},
+		{
+			MethodName: "RegisteredAlloyedPoolFromPoolId",
+			Handler:    _Query_RegisteredAlloyedPoolFromPoolId_Handler,
+		},
+		{
+			MethodName: "AllRegisteredAlloyedPools",
+			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
+		},
+		{
+			MethodName: "AccountVolumeTakerFeeTier",
+			Handler:    _Query_AccountVolumeTakerFeeTier_Handler,
+		},
+		{
+			MethodName: "AllPoolTakerFees",
+			Handler:    _Query_AllPoolTakerFees_Handler,
+		},
+		{
+			MethodName: "PausedPools",
+			Handler:    _Query_PausedPools_Handler,
+		},
+		{
+			MethodName: "FindBestRoute",
+			Handler:    _Query_FindBestRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
 }
 
-func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4023,27 +4063,20 @@ func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4053,34 +4086,30 @@ func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4090,39 +4119,53 @@ func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4132,27 +4175,59 @@ func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for iNdEx := len(m.RoutesTokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutesTokenOutDenom[iNdEx])
+			copy(dAtA[i:], m.RoutesTokenOutDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenOutDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RoutesPoolId) > 0 {
+		dAtA3 := make([]byte, len(m.RoutesPoolId)*10)
+		var j2 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4162,16 +4237,30 @@ func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -4180,7 +4269,7 @@ func (m *TotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4190,34 +4279,30 @@ func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4227,20 +4312,53 @@ func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4250,34 +4368,59 @@ func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RoutesTokenInDenom) > 0 {
+		for iNdEx := len(m.RoutesTokenInDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutesTokenInDenom[iNdEx])
+			copy(dAtA[i:], m.RoutesTokenInDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenInDenom[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoutesPoolId) > 0 {
+		dAtA5 := make([]byte, len(m.RoutesPoolId)*10)
+		var j4 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4287,16 +4430,30 @@ func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalVolumeForPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalVolumeForPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -4305,7 +4462,7 @@ func (m *TotalVolumeForPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4315,34 +4472,30 @@ func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalVolumeForPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Volume) > 0 {
-		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4352,34 +4505,20 @@ func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom_1) > 0 {
-		i -= len(m.Denom_1)
-		copy(dAtA[i:], m.Denom_1)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom_0) > 0 {
-		i -= len(m.Denom_0)
-		copy(dAtA[i:], m.Denom_0)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4389,30 +4528,25 @@ func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4422,62 +4556,25 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ExternalPrice.Size()
-		i -= size
-		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPriceImpact.Size()
-		i -= size
-		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ToCoinDenom) > 0 {
-		i -= len(m.ToCoinDenom)
-		copy(dAtA[i:], m.ToCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x8
 	}
-	{
-		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4487,40 +4584,32 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4530,12 +4619,12 @@ func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4543,7 +4632,7 @@ func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4553,20 +4642,20 @@ func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeShareAgreements) > 0 {
-		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TakerFeeShareAgreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4580,7 +4669,7 @@ func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4590,12 +4679,12 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4610,7 +4699,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4620,30 +4709,34 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TakerFeeShareAgreement.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err error) {
+func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4653,34 +4746,39 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeDenom) > 0 {
-		i -= len(m.TakerFeeDenom)
-		copy(dAtA[i:], m.TakerFeeDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TakerFeeDenom)))
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err error) {
+func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4690,30 +4788,27 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4723,20 +4818,25 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4746,20 +4846,20 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeSkimAccumulators) > 0 {
-		for iNdEx := len(m.TakerFeeSkimAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TakerFeeSkimAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4773,7 +4873,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4783,27 +4883,20 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4813,30 +4906,34 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4846,12 +4943,12 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4864,7 +4961,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4874,30 +4971,34 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4907,20 +5008,34 @@ func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4930,686 +5045,2260 @@ func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractStates) > 0 {
-		for iNdEx := len(m.ContractStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.ExternalPrice.Size()
+		i -= size
+		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ToCoinDenom) > 0 {
+		i -= len(m.ToCoinDenom)
+		copy(dAtA[i:], m.ToCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RoutesPoolId) > 0 {
-		l = 0
-		for _, e := range m.RoutesPoolId {
-			l += sovQuery(uint64(e))
+	{
+		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.RoutesTokenOutDenom) > 0 {
-		for _, s := range m.RoutesTokenOutDenom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.RoutesPoolId) > 0 {
-		l = 0
-		for _, e := range m.RoutesPoolId {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	if len(m.RoutesTokenInDenom) > 0 {
-		for _, s := range m.RoutesTokenInDenom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.TakerFeeShareAgreements) > 0 {
+		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeShareAgreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *TakerFeeShareAgreementFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeShareAgreementFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+	{
+		size, err := m.TakerFeeShareAgreement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TakerFeeDenom) > 0 {
+		i -= len(m.TakerFeeDenom)
+		copy(dAtA[i:], m.TakerFeeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TakerFeeDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ListPoolsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllTakerFeeShareAccumulatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TakerFeeSkimAccumulators) > 0 {
+		for iNdEx := len(m.TakerFeeSkimAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeSkimAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Volume) > 0 {
-		for _, e := range m.Volume {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom_0)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom_1)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TakerFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *AllRegisteredAlloyedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllRegisteredAlloyedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FromCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ToCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = m.MaxPriceImpact.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ExternalPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InputCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.OutputCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *AllRegisteredAlloyedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeShareAgreements) > 0 {
-		for _, e := range m.TakerFeeShareAgreements {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractStates) > 0 {
+		for iNdEx := len(m.ContractStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountVolumeTakerFeeTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolumeTakerFeeTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeTakerFeeTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountVolumeTakerFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolumeTakerFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeTakerFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TakerFeeShareAgreement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Tier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HasTier {
+		i--
+		if m.HasTier {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowDays != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowDays))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TrailingVolume) > 0 {
+		for iNdEx := len(m.TrailingVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrailingVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllPoolTakerFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllPoolTakerFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllPoolTakerFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AllPoolTakerFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TakerFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AllPoolTakerFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllPoolTakerFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolTakerFees) > 0 {
+		for iNdEx := len(m.PoolTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PausedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PausedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.PoolIds) > 0 {
+		dAtA15 := make([]byte, len(m.PoolIds)*10)
+		var j14 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FindBestRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FindBestRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindBestRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeSkimAccumulators) > 0 {
-		for _, e := range m.TakerFeeSkimAccumulators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Split {
+		i--
+		if m.Split {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRoutes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxRoutes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindBestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindBestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindBestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for _, s := range m.RoutesTokenOutDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenInDenom) > 0 {
+		for _, s := range m.RoutesTokenInDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *PoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPoolsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalVolumeForPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TotalVolumeForPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradingPairTakerFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom_1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradingPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ToCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExternalPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InputCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutputCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeeShareAgreements) > 0 {
+		for _, e := range m.TakerFeeShareAgreements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeShareAgreementFromDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFeeShareAgreement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TakerFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeeSkimAccumulators) > 0 {
+		for _, e := range m.TakerFeeSkimAccumulators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllRegisteredAlloyedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractStates) > 0 {
+		for _, e := range m.ContractStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountVolumeTakerFeeTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountVolumeTakerFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrailingVolume) > 0 {
+		for _, e := range m.TrailingVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.WindowDays != 0 {
+		n += 1 + sovQuery(uint64(m.WindowDays))
+	}
+	if m.HasTier {
+		n += 2
+	}
+	l = m.Tier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllPoolTakerFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllPoolTakerFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolTakerFees) > 0 {
+		for _, e := range m.PoolTakerFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PausedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PausedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FindBestRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxRoutes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxRoutes))
+	}
+	if m.Split {
+		n += 2
+	}
+	return n
+}
+
+func (m *FindBestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RouteQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *RegisteredAlloyedPoolFromDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *RegisteredAlloyedPoolFromDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
-}
-
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *AllRegisteredAlloyedPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractStates) > 0 {
-		for _, e := range m.ContractStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,12 +7321,95 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5659,7 +7431,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5682,17 +7454,17 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5702,22 +7474,23 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5742,7 +7515,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5765,10 +7538,10 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5824,7 +7597,41 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5852,13 +7659,158 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5868,25 +7820,55 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5909,7 +7891,7 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5932,10 +7914,10 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5959,7 +7941,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5987,87 +7969,11 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,7 +8001,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6118,7 +8024,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6141,34 +8047,15 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6196,13 +8083,115 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6212,24 +8201,11 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumPools |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6251,7 +8227,7 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6274,17 +8250,17 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6294,26 +8270,11 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6335,7 +8296,7 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,66 +8319,15 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6444,42 +8354,12 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pool == nil {
+				m.Pool = &types1.Any{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6502,7 +8382,7 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6525,144 +8405,67 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6672,23 +8475,25 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6711,7 +8516,7 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,66 +8539,15 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6821,7 +8575,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6844,7 +8598,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6867,17 +8621,17 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6887,23 +8641,23 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6928,7 +8682,7 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6951,12 +8705,95 @@ func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6978,7 +8815,7 @@ func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7001,17 +8838,17 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
-			m.NumPools = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7021,11 +8858,24 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7047,7 +8897,7 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7070,10 +8920,10 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7116,7 +8966,7 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7139,15 +8989,15 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7174,10 +9024,8 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types1.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7202,7 +9050,7 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7225,10 +9073,10 @@ func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7252,7 +9100,7 @@ func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,15 +9123,15 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7310,8 +9158,8 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7336,7 +9184,7 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,17 +9207,17 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7379,24 +9227,11 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7418,7 +9253,7 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7441,15 +9276,15 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7476,8 +9311,8 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7502,7 +9337,7 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7525,34 +9360,15 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7580,11 +9396,11 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7612,7 +9428,7 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7635,7 +9451,7 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7658,15 +9474,15 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7694,7 +9510,9 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7717,7 +9535,7 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7740,13 +9558,78 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -7765,6 +9648,74 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7786,7 +9737,7 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7809,15 +9760,15 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7844,8 +9795,40 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7870,7 +9853,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7893,10 +9876,10 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7920,7 +9903,7 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7943,15 +9926,15 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7978,8 +9961,8 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
+			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8004,7 +9987,7 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8027,17 +10010,17 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8047,11 +10030,24 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8073,7 +10069,7 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8096,15 +10092,15 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8131,8 +10127,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = append(m.Volume, types2.Coin{})
-			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8157,7 +10152,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8180,15 +10175,15 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8216,11 +10211,11 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8248,7 +10243,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8271,7 +10266,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8294,15 +10289,15 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8330,7 +10325,141 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
+			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8355,7 +10484,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8378,133 +10507,15 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8532,9 +10543,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8557,7 +10566,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,48 +10589,15 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8648,7 +10624,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8673,7 +10649,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8696,12 +10672,31 @@ func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8723,7 +10718,7 @@ func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8746,15 +10741,15 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8781,8 +10776,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
-			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8807,7 +10801,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8830,44 +10824,12 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8889,7 +10851,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8912,15 +10874,15 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8947,7 +10909,8 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
+			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8972,7 +10935,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeTakerFeeTierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8995,47 +10958,15 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeTakerFeeTierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeTakerFeeTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9063,7 +10994,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9086,7 +11017,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeTakerFeeTierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9109,17 +11040,17 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeTakerFeeTierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeTakerFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingVolume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9129,23 +11060,95 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TrailingVolume = append(m.TrailingVolume, types2.Coin{})
+			if err := m.TrailingVolume[len(m.TrailingVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDays", wireType)
+			}
+			m.WindowDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTier", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTier = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9170,7 +11173,7 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *AllPoolTakerFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9193,10 +11196,10 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllPoolTakerFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllPoolTakerFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9220,7 +11223,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *AllPoolTakerFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9243,15 +11246,15 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllPoolTakerFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllPoolTakerFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9278,8 +11281,8 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
-			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTakerFees = append(m.PoolTakerFees, types.PoolTakerFee{})
+			if err := m.PoolTakerFees[len(m.PoolTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9304,7 +11307,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *PausedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9327,44 +11330,12 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PausedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PausedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9386,7 +11357,7 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *PausedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9409,45 +11380,88 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PausedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PausedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9469,7 +11483,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *FindBestRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9492,17 +11506,81 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindBestRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindBestRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
 			}
-			m.PoolId = 0
+			m.MaxHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9512,11 +11590,50 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.MaxHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoutes", wireType)
+			}
+			m.MaxRoutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoutes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Split = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9538,7 +11655,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *FindBestRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9561,15 +11678,15 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindBestRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindBestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9596,60 +11713,45 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, RouteQuote{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9671,7 +11773,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *RouteQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9694,15 +11796,15 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9729,8 +11831,76 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
-			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, types.SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	alloyedpooltypes "github.com/osmosis-labs/osmosis/v25/x/cosmwasmpool/cosmwasm/msg/v3"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
 )
 
@@ -54,8 +55,8 @@ func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	k.trackVolume(ctx, poolId, volumeGenerated)
 }
 
-func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
-	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn)
+func (k Keeper) ChargeTakerFee(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
+	return k.chargeTakerFee(ctx, poolId, tokenIn, tokenOutDenom, sender, exactIn)
 }
 
 func (k Keeper) QueryAndCheckAlloyedDenom(ctx sdk.Context, contractAddr sdk.AccAddress) (string, error) {
@@ -154,3 +155,11 @@ func (k *Keeper) SetCacheTrackers(takerFeeShareAgreement map[string]types.TakerF
 func (k Keeper) FundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) error {
 	return k.fundCommunityPoolIfNotWhitelisted(ctx, sender)
 }
+
+func (k Keeper) AddAccountVolume(ctx sdk.Context, account sdk.AccAddress, tokenIn sdk.Coin) {
+	k.addAccountVolume(ctx, account, tokenIn)
+}
+
+func (k Keeper) FindBestRouteWithMaxEstimates(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops, maxRoutes int, split bool, maxEstimates int) ([]queryproto.RouteQuote, osmomath.Int, error) {
+	return k.findBestRoute(ctx, tokenIn, tokenOutDenom, maxHops, maxRoutes, split, maxEstimates)
+}
@@ -149,6 +149,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pool taker fees KVStore.
+	for _, poolTakerFee := range genState.PoolTakerFeeStore {
+		if err := k.SetPoolTakerFee(ctx, poolTakerFee.PoolId, poolTakerFee.TakerFee); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the paused pools KVStore.
+	for _, poolId := range genState.PausedPools {
+		if err := k.PausePool(ctx, poolId); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -174,6 +188,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolTakerFees, err := k.GetAllPoolTakerFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pausedPools, err := k.GetAllPausedPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -187,6 +211,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolTakerFeeStore:      poolTakerFees,
+		PausedPools:            pausedPools,
 	}
 }
 
@@ -79,6 +79,19 @@ var (
 			TakerFee:      osmomath.MustNewDecFromStr("0.002"),
 		},
 	}
+
+	testPoolTakerFees = []types.PoolTakerFee{
+		{
+			PoolId:   1,
+			TakerFee: osmomath.MustNewDecFromStr("0.001"),
+		},
+		{
+			PoolId:   2,
+			TakerFee: osmomath.MustNewDecFromStr("0.0005"),
+		},
+	}
+
+	testPausedPools = []uint64{2}
 )
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -143,6 +156,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolTakerFeeStore:      testPoolTakerFees,
+		PausedPools:            testPausedPools,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -167,6 +182,14 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].TokenInDenom, testDenomPairTakerFees[1].TokenOutDenom)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	poolTakerFees, err := s.App.PoolManagerKeeper.GetAllPoolTakerFees(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(testPoolTakerFees, poolTakerFees)
+
+	pausedPools, err := s.App.PoolManagerKeeper.GetAllPausedPools(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(testPausedPools, pausedPools)
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -191,6 +214,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolTakerFeeStore:      testPoolTakerFees,
+		PausedPools:            testPausedPools,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -209,6 +234,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testPoolTakerFees, genesis.PoolTakerFeeStore)
+	s.Require().Equal(testPausedPools, genesis.PausedPools)
 }
 
 // TestBeginBlock tests that, if any one of the cache trackers is empty, all cache trackers are updated.
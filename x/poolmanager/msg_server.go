@@ -29,7 +29,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.SwapLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.SwapLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount, msg.SwapLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount, msg.SwapLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +94,24 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
+func (server msgServer) BatchSwap(goCtx context.Context, msg *types.MsgBatchSwap) (*types.MsgBatchSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := server.keeper.BatchSwap(ctx, sender, msg.Legs, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap events are handled in each pool module's swap
+
+	return &types.MsgBatchSwapResponse{Results: results}, nil
+}
+
 func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.MsgSetDenomPairTakerFee) (*types.MsgSetDenomPairTakerFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -170,3 +188,63 @@ func (server msgServer) SetRegisteredAlloyedPool(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRegisteredAlloyedPoolResponse{}, nil
 }
+
+func (server msgServer) SetPoolTakerFee(goCtx context.Context, msg *types.MsgSetPoolTakerFee) (*types.MsgSetPoolTakerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, poolTakerFee := range msg.PoolTakerFees {
+		err := server.keeper.SenderValidationSetPoolTakerFee(ctx, msg.Sender, poolTakerFee.PoolId, poolTakerFee.TakerFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Set pool taker fee event is handled in each iteration of the loop above
+
+	return &types.MsgSetPoolTakerFeeResponse{}, nil
+}
+
+func (server msgServer) RemovePoolTakerFee(goCtx context.Context, msg *types.MsgRemovePoolTakerFee) (*types.MsgRemovePoolTakerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, poolId := range msg.PoolIds {
+		err := server.keeper.SenderValidationRemovePoolTakerFee(ctx, msg.Sender, poolId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Remove pool taker fee event is handled in each iteration of the loop above
+
+	return &types.MsgRemovePoolTakerFeeResponse{}, nil
+}
+
+func (server msgServer) PausePools(goCtx context.Context, msg *types.MsgPausePools) (*types.MsgPausePoolsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, poolId := range msg.PoolIds {
+		err := server.keeper.SenderValidationPausePool(ctx, msg.Sender, poolId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pool paused event is handled in each iteration of the loop above
+
+	return &types.MsgPausePoolsResponse{}, nil
+}
+
+func (server msgServer) UnpausePools(goCtx context.Context, msg *types.MsgUnpausePools) (*types.MsgUnpausePoolsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, poolId := range msg.PoolIds {
+		err := server.keeper.SenderValidationUnpausePool(ctx, msg.Sender, poolId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pool unpaused event is handled in each iteration of the loop above
+
+	return &types.MsgUnpausePoolsResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package poolmanager_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -345,6 +347,91 @@ func (s *KeeperTestSuite) TestSetDenomPairTakerFee() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSetPoolTakerFeeMsg() {
+	adminAcc := s.TestAccs[0].String()
+	nonAdminAcc := s.TestAccs[1].String()
+	govAddr := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+	takerFee := osmomath.MustNewDecFromStr("0.001")
+
+	testcases := map[string]struct {
+		sender  string
+		poolIds []uint64
+
+		expectedError bool
+	}{
+		"valid case: admin account": {
+			sender:  adminAcc,
+			poolIds: []uint64{1, 2},
+		},
+		"valid case: governance": {
+			sender:  govAddr,
+			poolIds: []uint64{1},
+		},
+		"error: not admin account": {
+			sender:        nonAdminAcc,
+			poolIds:       []uint64{1},
+			expectedError: true,
+		},
+		"error: pool does not exist": {
+			sender:        adminAcc,
+			poolIds:       []uint64{1, 3},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Setup()
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.AdminAddresses = []string{adminAcc}
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+			poolTakerFees := make([]types.PoolTakerFee, len(tc.poolIds))
+			for i, poolId := range tc.poolIds {
+				poolTakerFees[i] = types.PoolTakerFee{PoolId: poolId, TakerFee: takerFee}
+			}
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err := msgServer.SetPoolTakerFee(s.Ctx, &types.MsgSetPoolTakerFee{
+				Sender:        tc.sender,
+				PoolTakerFees: poolTakerFees,
+			})
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgSetPoolTakerFee, len(tc.poolIds))
+
+			storedPoolTakerFees, err := s.App.PoolManagerKeeper.GetAllPoolTakerFees(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(poolTakerFees, storedPoolTakerFees)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = msgServer.RemovePoolTakerFee(s.Ctx, &types.MsgRemovePoolTakerFee{
+				Sender:  nonAdminAcc,
+				PoolIds: tc.poolIds,
+			})
+			s.Require().Error(err)
+
+			_, err = msgServer.RemovePoolTakerFee(s.Ctx, &types.MsgRemovePoolTakerFee{
+				Sender:  tc.sender,
+				PoolIds: tc.poolIds,
+			})
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgRemovePoolTakerFee, len(tc.poolIds))
+
+			storedPoolTakerFees, err = s.App.PoolManagerKeeper.GetAllPoolTakerFees(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Empty(storedPoolTakerFees)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSetTakerFeeShareAgreementForDenomMsg() {
 	govAddr := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
 	nonGovAddr := s.TestAccs[0].String()
@@ -454,3 +541,216 @@ func (s *KeeperTestSuite) TestSetRegisteredAlloyedPoolMsg() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBatchSwap() {
+	exactInLeg := func(poolId uint64, tokenOutMinAmount int64) types.BatchSwapLeg {
+		return types.BatchSwapLeg{ExactIn: &types.SwapExactAmountInLeg{
+			Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uion"}},
+			TokenIn:           sdk.NewInt64Coin("uosmo", 100_000),
+			TokenOutMinAmount: osmomath.NewInt(tokenOutMinAmount),
+		}}
+	}
+	exactOutLeg := func(poolId uint64) types.BatchSwapLeg {
+		return types.BatchSwapLeg{ExactOut: &types.SwapExactAmountOutLeg{
+			Routes:           []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "uosmo"}},
+			TokenInMaxAmount: osmomath.NewInt(1_000_000),
+			TokenOut:         sdk.NewInt64Coin("uion", 50_000),
+		}}
+	}
+
+	tests := map[string]struct {
+		policy           types.BatchSwapPolicy
+		failSecondLeg    bool
+		expireSecondLeg  bool
+		expectedError    bool
+		expectedSuccess  []bool
+		expectedBackruns []uint64
+	}{
+		"all or nothing: all legs succeed": {
+			policy:           types.AllOrNothing,
+			expectedSuccess:  []bool{true, true},
+			expectedBackruns: []uint64{1, 2},
+		},
+		"all or nothing: a failing leg reverts all legs": {
+			policy:        types.AllOrNothing,
+			failSecondLeg: true,
+			expectedError: true,
+		},
+		"best effort: all legs succeed": {
+			policy:           types.BestEffort,
+			expectedSuccess:  []bool{true, true},
+			expectedBackruns: []uint64{1, 2},
+		},
+		"best effort: a failing leg only reverts itself": {
+			policy:           types.BestEffort,
+			failSecondLeg:    true,
+			expectedSuccess:  []bool{true, false},
+			expectedBackruns: []uint64{1},
+		},
+		"all or nothing: a leg past its deadline reverts all legs": {
+			policy:          types.AllOrNothing,
+			expireSecondLeg: true,
+			expectedError:   true,
+		},
+		"best effort: a leg past its deadline only reverts itself": {
+			policy:           types.BestEffort,
+			expireSecondLeg:  true,
+			expectedSuccess:  []bool{true, false},
+			expectedBackruns: []uint64{1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			firstPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+			secondPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000)))
+			s.App.ProtoRevKeeper.DeleteSwapsToBackrun(s.Ctx)
+
+			secondLeg := exactOutLeg(secondPool)
+			if tc.failSecondLeg {
+				secondLeg = exactInLeg(secondPool, 1_000_000)
+			}
+			if tc.expireSecondLeg {
+				deadline := s.Ctx.BlockTime().Add(-time.Second)
+				secondLeg.ExactOut.Deadline = &deadline
+			}
+			legs := []types.BatchSwapLeg{exactInLeg(firstPool, 1), secondLeg}
+
+			expectedAmounts := make([]osmomath.Int, len(legs))
+			expectedAmounts[0], _ = s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, legs[0].ExactIn.Routes, legs[0].ExactIn.TokenIn)
+			if tc.expectedSuccess != nil && tc.expectedSuccess[1] {
+				expectedAmounts[1], _ = s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, legs[1].ExactOut.Routes, legs[1].ExactOut.TokenOut)
+			}
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+			response, err := msgServer.BatchSwap(s.Ctx, &types.MsgBatchSwap{
+				Sender: sender.String(),
+				Legs:   legs,
+				Policy: tc.policy,
+			})
+
+			swapsToBackrun, backrunErr := s.App.ProtoRevKeeper.GetSwapsToBackrun(s.Ctx)
+			s.Require().NoError(backrunErr)
+			backrunPools := []uint64{}
+			for _, trade := range swapsToBackrun.Trades {
+				backrunPools = append(backrunPools, trade.Pool)
+			}
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &types.BatchSwapLegError{})
+				s.Require().Nil(response)
+				s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				s.Require().Empty(backrunPools)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(response.Results, len(legs))
+			for i, result := range response.Results {
+				s.Require().Equal(tc.expectedSuccess[i], result.Success)
+				if result.Success {
+					s.Require().Equal(expectedAmounts[i].String(), result.Amount.String())
+					s.Require().Empty(result.Error)
+				} else {
+					s.Require().True(result.Amount.IsZero())
+					s.Require().NotEmpty(result.Error)
+				}
+			}
+			s.Require().Equal(tc.expectedBackruns, backrunPools)
+
+			// Only the legs that succeeded moved the balances of the sender.
+			expectedUosmoSpent := legs[0].ExactIn.TokenIn.Amount
+			expectedUionReceived := expectedAmounts[0]
+			if tc.expectedSuccess[1] {
+				expectedUosmoSpent = expectedUosmoSpent.Add(expectedAmounts[1])
+				expectedUionReceived = expectedUionReceived.Add(legs[1].ExactOut.TokenOut.Amount)
+			}
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			s.Require().Equal(balancesBefore.AmountOf("uosmo").Sub(expectedUosmoSpent).String(), balancesAfter.AmountOf("uosmo").String())
+			s.Require().Equal(expectedUionReceived.String(), balancesAfter.AmountOf("uion").String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPausePoolsMsg() {
+	adminAcc := s.TestAccs[0].String()
+	nonAdminAcc := s.TestAccs[1].String()
+	govAddr := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+
+	testcases := map[string]struct {
+		sender  string
+		poolIds []uint64
+
+		expectedError bool
+	}{
+		"valid case: admin account": {
+			sender:  adminAcc,
+			poolIds: []uint64{1, 2},
+		},
+		"valid case: governance": {
+			sender:  govAddr,
+			poolIds: []uint64{1},
+		},
+		"error: not admin account": {
+			sender:        nonAdminAcc,
+			poolIds:       []uint64{1},
+			expectedError: true,
+		},
+		"error: pool does not exist": {
+			sender:        adminAcc,
+			poolIds:       []uint64{1, 3},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Setup()
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.AdminAddresses = []string{adminAcc}
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err := msgServer.PausePools(s.Ctx, &types.MsgPausePools{
+				Sender:  tc.sender,
+				PoolIds: tc.poolIds,
+			})
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolPaused, len(tc.poolIds))
+
+			pausedPools, err := s.App.PoolManagerKeeper.GetAllPausedPools(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.poolIds, pausedPools)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = msgServer.UnpausePools(s.Ctx, &types.MsgUnpausePools{
+				Sender:  nonAdminAcc,
+				PoolIds: tc.poolIds,
+			})
+			s.Require().Error(err)
+
+			_, err = msgServer.UnpausePools(s.Ctx, &types.MsgUnpausePools{
+				Sender:  tc.sender,
+				PoolIds: tc.poolIds,
+			})
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolUnpaused, len(tc.poolIds))
+
+			pausedPools, err = s.App.PoolManagerKeeper.GetAllPausedPools(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Empty(pausedPools)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package poolmanager
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// PausePool pauses the given pool. Swaps, joins and concentrated liquidity position creations are rejected
+// on a paused pool, while exits and withdrawals are still allowed. Returns an error if the pool doesn't exist.
+func (k Keeper) PausePool(ctx sdk.Context, poolId uint64) error {
+	if _, err := k.GetPoolModule(ctx, poolId); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyPausedPool(poolId), []byte{1})
+	return nil
+}
+
+// UnpausePool unpauses the given pool, if it is paused.
+func (k Keeper) UnpausePool(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPausedPool(poolId))
+}
+
+// IsPoolPaused returns true if the given pool is paused.
+func (k Keeper) IsPoolPaused(ctx sdk.Context, poolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyPausedPool(poolId))
+}
+
+// GetAllPausedPools returns the ids of the paused pools, in increasing order.
+func (k Keeper) GetAllPausedPools(ctx sdk.Context) ([]uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPausedPoolPrefix)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolId, err := types.ParsePausedPoolKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, nil
+}
+
+// SenderValidationPausePool pauses the given pool iff the sender is in the pool manager taker fee
+// admin address list or is the governance module.
+func (k Keeper) SenderValidationPausePool(ctx sdk.Context, sender string, poolId uint64) error {
+	if !k.isPoolTakerFeeAuthority(ctx, sender) {
+		return fmt.Errorf("%s is neither in the pool manager taker fee admin address list nor the governance module", sender)
+	}
+
+	if err := k.PausePool(ctx, poolId); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolPaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+	})
+
+	return nil
+}
+
+// SenderValidationUnpausePool unpauses the given pool iff the sender is in the pool manager taker fee
+// admin address list or is the governance module.
+func (k Keeper) SenderValidationUnpausePool(ctx sdk.Context, sender string, poolId uint64) error {
+	if !k.isPoolTakerFeeAuthority(ctx, sender) {
+		return fmt.Errorf("%s is neither in the pool manager taker fee admin address list nor the governance module", sender)
+	}
+
+	k.UnpausePool(ctx, poolId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolUnpaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/client"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// validates that swaps through a paused pool are rejected until the pool is unpaused.
+func (s *KeeperTestSuite) TestPausedPoolSwaps() {
+	s.SetupTest()
+	pausedPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	otherPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uion", 1_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000)))
+	sender := s.TestAccs[1]
+
+	s.Require().NoError(s.App.PoolManagerKeeper.PausePool(s.Ctx, pausedPool))
+	s.Require().True(s.App.PoolManagerKeeper.IsPoolPaused(s.Ctx, pausedPool))
+	s.Require().False(s.App.PoolManagerKeeper.IsPoolPaused(s.Ctx, otherPool))
+
+	routeIn := []types.SwapAmountInRoute{{PoolId: pausedPool, TokenOutDenom: "uion"}, {PoolId: otherPool, TokenOutDenom: "uatom"}}
+	routeOut := []types.SwapAmountOutRoute{{PoolId: pausedPool, TokenInDenom: "uosmo"}, {PoolId: otherPool, TokenInDenom: "uion"}}
+	tokenIn := sdk.NewInt64Coin("uosmo", 1_000)
+	tokenOut := sdk.NewInt64Coin("uatom", 1_000)
+
+	_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, routeIn, tokenIn, osmomath.OneInt(), types.SwapLimits{})
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, sender, routeOut, osmomath.NewInt(10_000), tokenOut, types.SwapLimits{})
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	_, err = s.App.PoolManagerKeeper.SwapExactAmountInNoTakerFee(s.Ctx, sender, pausedPool, tokenIn, "uion", osmomath.OneInt())
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	// The other pool can still be swapped through.
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, routeIn[1:], sdk.NewInt64Coin("uion", 1_000), osmomath.OneInt(), types.SwapLimits{})
+	s.Require().NoError(err)
+
+	s.App.PoolManagerKeeper.UnpausePool(s.Ctx, pausedPool)
+	s.Require().False(s.App.PoolManagerKeeper.IsPoolPaused(s.Ctx, pausedPool))
+
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, routeIn, tokenIn, osmomath.OneInt(), types.SwapLimits{})
+	s.Require().NoError(err)
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, sender, routeOut, osmomath.NewInt(10_000), tokenOut, types.SwapLimits{})
+	s.Require().NoError(err)
+}
+
+// validates that estimates through a paused pool are rejected until the pool is unpaused.
+func (s *KeeperTestSuite) TestPausedPoolEstimates() {
+	s.SetupTest()
+	pausedPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	otherPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uion", 1_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+	querier := client.NewQuerier(s.App.PoolManagerKeeper)
+
+	routeIn := []types.SwapAmountInRoute{{PoolId: otherPool, TokenOutDenom: "uion"}, {PoolId: pausedPool, TokenOutDenom: "uosmo"}}
+	routeOut := []types.SwapAmountOutRoute{{PoolId: otherPool, TokenInDenom: "uatom"}, {PoolId: pausedPool, TokenInDenom: "uion"}}
+	tokenIn := sdk.NewInt64Coin("uatom", 1_000)
+	tokenOut := sdk.NewInt64Coin("uosmo", 1_000)
+	priceImpactReq := queryproto.EstimateTradeBasedOnPriceImpactRequest{
+		FromCoin:       sdk.NewInt64Coin("uosmo", 1_000),
+		ToCoinDenom:    "uion",
+		PoolId:         pausedPool,
+		MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+		ExternalPrice:  osmomath.OneDec(),
+	}
+
+	s.Require().NoError(s.App.PoolManagerKeeper.PausePool(s.Ctx, pausedPool))
+
+	_, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routeIn, tokenIn)
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	_, err = s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountInNoTakerFee(s.Ctx, routeIn, tokenIn)
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	_, err = s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routeOut, tokenOut)
+	s.Require().ErrorIs(err, types.PoolPausedError{PoolId: pausedPool})
+
+	_, err = querier.EstimateTradeBasedOnPriceImpact(s.Ctx, priceImpactReq)
+	s.Require().ErrorContains(err, types.PoolPausedError{PoolId: pausedPool}.Error())
+
+	// The other pool can still be estimated.
+	_, err = s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routeIn[:1], tokenIn)
+	s.Require().NoError(err)
+
+	s.App.PoolManagerKeeper.UnpausePool(s.Ctx, pausedPool)
+
+	_, err = s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routeIn, tokenIn)
+	s.Require().NoError(err)
+	_, err = s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routeOut, tokenOut)
+	s.Require().NoError(err)
+	_, err = querier.EstimateTradeBasedOnPriceImpact(s.Ctx, priceImpactReq)
+	s.Require().NoError(err)
+}
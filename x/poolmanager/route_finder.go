@@ -0,0 +1,354 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// partialRoute is a route from the token in denom, the denoms it goes through and its expected output in its last denom.
+type partialRoute struct {
+	pools     []types.SwapAmountInRoute
+	denoms    []string
+	amountOut osmomath.Int
+}
+
+// FindBestRoute searches the routes of at most maxHops pools from the token in to the token out denom and
+// returns the maxRoutes routes with the highest expected output, taker fees included, sorted by decreasing output.
+// If split is true, the token in is split across the best routes that don't share any pool instead, and only the
+// routes that receive part of it are returned. It also returns the output of the best route, or the total output
+// of the routes if split is true.
+//
+// The routes are searched hop by hop, keeping for each intermediate denom the maxRoutes partial routes with the
+// highest output in that denom, and extending the routes of at most types.MaxFindRouteFrontierDenoms intermediate
+// denoms at each hop. The routes are extended through the types.MaxFindRoutePoolsPerDenom most liquid pools of each
+// denom in the protorev pools by denom index, which only indexes pools with two denoms and some liquidity in uosmo, so
+// routes through other pools are not found. The search stops once types.MaxFindRouteEstimates swaps have been estimated, and the best
+// routes found so far are returned. Splitting the token in uses the estimates left by the search. Inactive and paused
+// pools and pools that fail to estimate the swap are skipped. A route never goes through the same pool or denom twice.
+func (k Keeper) FindBestRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops, maxRoutes int,
+	split bool,
+) ([]queryproto.RouteQuote, osmomath.Int, error) {
+	if err := tokenIn.Validate(); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if !tokenIn.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("token in must be positive, got %s", tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("token in and token out denoms must differ, got %s", tokenOutDenom)
+	}
+	if maxHops <= 0 || maxHops > types.MaxFindRouteHops {
+		return nil, osmomath.Int{}, fmt.Errorf("max hops must be between 1 and %d, got %d", types.MaxFindRouteHops, maxHops)
+	}
+	if maxRoutes <= 0 || maxRoutes > types.MaxFindRouteRoutes {
+		return nil, osmomath.Int{}, fmt.Errorf("max routes must be between 1 and %d, got %d", types.MaxFindRouteRoutes, maxRoutes)
+	}
+
+	return k.findBestRoute(ctx, tokenIn, tokenOutDenom, maxHops, maxRoutes, split, types.MaxFindRouteEstimates)
+}
+
+// findBestRoute implements FindBestRoute, estimating at most maxEstimates swaps to search the routes.
+func (k Keeper) findBestRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops, maxRoutes int,
+	split bool,
+	maxEstimates int,
+) ([]queryproto.RouteQuote, osmomath.Int, error) {
+	graph := newRouteGraph(k)
+	estimates := 0
+
+	var found []partialRoute
+	frontier := map[string][]partialRoute{
+		tokenIn.Denom: {{denoms: []string{tokenIn.Denom}, amountOut: tokenIn.Amount}},
+	}
+search:
+	for hop := 0; hop < maxHops && len(frontier) > 0; hop++ {
+		next := map[string][]partialRoute{}
+		for _, denom := range frontierDenoms(frontier) {
+			poolIds, err := graph.poolsByDenom(ctx, denom)
+			if err != nil {
+				return nil, osmomath.Int{}, err
+			}
+			for _, route := range frontier[denom] {
+				for _, poolId := range poolIds {
+					if routeContainsPool(route.pools, poolId) {
+						continue
+					}
+					for _, nextDenom := range graph.poolDenoms[poolId] {
+						if osmoutils.Contains(route.denoms, nextDenom) {
+							continue
+						}
+						// The last hop must reach the token out denom.
+						if hop == maxHops-1 && nextDenom != tokenOutDenom {
+							continue
+						}
+
+						if estimates >= maxEstimates {
+							break search
+						}
+						estimates++
+
+						step := types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: nextDenom}
+						amountOut, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, []types.SwapAmountInRoute{step}, sdk.NewCoin(denom, route.amountOut))
+						if err != nil {
+							continue
+						}
+
+						extended := partialRoute{
+							pools:     append(append([]types.SwapAmountInRoute{}, route.pools...), step),
+							denoms:    append(append([]string{}, route.denoms...), nextDenom),
+							amountOut: amountOut,
+						}
+						if nextDenom == tokenOutDenom {
+							found = insertPartialRoute(found, extended, maxRoutes)
+						} else {
+							next[nextDenom] = insertPartialRoute(next[nextDenom], extended, maxRoutes)
+						}
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+
+	if len(found) == 0 {
+		return nil, osmomath.Int{}, fmt.Errorf("no route found from %s to %s within %d hops and the search limits", tokenIn.Denom, tokenOutDenom, maxHops)
+	}
+
+	if split {
+		return k.splitAcrossRoutes(ctx, tokenIn, found, maxEstimates-estimates)
+	}
+
+	quotes := make([]queryproto.RouteQuote, len(found))
+	for i, route := range found {
+		quotes[i] = queryproto.RouteQuote{
+			Pools:          route.pools,
+			TokenInAmount:  tokenIn.Amount,
+			TokenOutAmount: route.amountOut,
+		}
+	}
+	return quotes, quotes[0].TokenOutAmount, nil
+}
+
+// splitAcrossRoutes splits the token in across the given routes, sorted by decreasing output, that don't share any
+// pool with a better route. The token in is divided into types.FindRouteSplitSteps parts, and each part goes to the
+// route whose output increases the most with it. If the split doesn't beat the best route, the parts are too
+// small to be swapped, or more than maxEstimates swaps would have to be estimated, the whole token in goes through
+// the best route.
+func (k Keeper) splitAcrossRoutes(ctx sdk.Context, tokenIn sdk.Coin, found []partialRoute, maxEstimates int) ([]queryproto.RouteQuote, osmomath.Int, error) {
+	var routes []partialRoute
+	for _, route := range found {
+		disjoint := true
+		for _, selected := range routes {
+			for _, step := range route.pools {
+				if routeContainsPool(selected.pools, step.PoolId) {
+					disjoint = false
+				}
+			}
+		}
+		if disjoint {
+			routes = append(routes, route)
+		}
+	}
+
+	amountsIn := make([]osmomath.Int, len(routes))
+	amountsOut := make([]osmomath.Int, len(routes))
+	for i := range routes {
+		amountsIn[i] = osmomath.ZeroInt()
+		amountsOut[i] = osmomath.ZeroInt()
+	}
+
+	stepAmount := tokenIn.Amount.QuoRaw(types.FindRouteSplitSteps)
+	remaining := tokenIn.Amount
+	for remaining.IsPositive() {
+		part := stepAmount
+		if !part.IsPositive() || remaining.LT(part.MulRaw(2)) {
+			part = remaining
+		}
+
+		if maxEstimates < len(routes) {
+			return bestRouteQuote(tokenIn, found), found[0].amountOut, nil
+		}
+		maxEstimates -= len(routes)
+
+		best := -1
+		var bestAmountOut, bestIncrease osmomath.Int
+		for i, route := range routes {
+			amountOut, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route.pools, sdk.NewCoin(tokenIn.Denom, amountsIn[i].Add(part)))
+			if err != nil {
+				continue
+			}
+			increase := amountOut.Sub(amountsOut[i])
+			if best == -1 || increase.GT(bestIncrease) {
+				best, bestAmountOut, bestIncrease = i, amountOut, increase
+			}
+		}
+		if best == -1 {
+			// The part is too small to be swapped through any route.
+			return bestRouteQuote(tokenIn, found), found[0].amountOut, nil
+		}
+
+		amountsIn[best] = amountsIn[best].Add(part)
+		amountsOut[best] = bestAmountOut
+		remaining = remaining.Sub(part)
+	}
+
+	var quotes []queryproto.RouteQuote
+	totalAmountOut := osmomath.ZeroInt()
+	for i, route := range routes {
+		if !amountsIn[i].IsPositive() {
+			continue
+		}
+		quotes = append(quotes, queryproto.RouteQuote{
+			Pools:          route.pools,
+			TokenInAmount:  amountsIn[i],
+			TokenOutAmount: amountsOut[i],
+		})
+		totalAmountOut = totalAmountOut.Add(amountsOut[i])
+	}
+	if totalAmountOut.LT(found[0].amountOut) {
+		return bestRouteQuote(tokenIn, found), found[0].amountOut, nil
+	}
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].TokenOutAmount.GT(quotes[j].TokenOutAmount)
+	})
+	return quotes, totalAmountOut, nil
+}
+
+// bestRouteQuote returns the quote of swapping the whole token in through the best of the found routes.
+func bestRouteQuote(tokenIn sdk.Coin, found []partialRoute) []queryproto.RouteQuote {
+	return []queryproto.RouteQuote{{
+		Pools:          found[0].pools,
+		TokenInAmount:  tokenIn.Amount,
+		TokenOutAmount: found[0].amountOut,
+	}}
+}
+
+// routeGraph loads the pools paired with each denom reached by the route search, and the denoms of these pools.
+// The pools of a denom are only loaded once the search extends routes from that denom.
+type routeGraph struct {
+	k             Keeper
+	poolsOfDenoms map[string][]uint64
+	poolDenoms    map[uint64][]string
+}
+
+func newRouteGraph(k Keeper) *routeGraph {
+	return &routeGraph{
+		k:             k,
+		poolsOfDenoms: map[string][]uint64{},
+		poolDenoms:    map[uint64][]string{},
+	}
+}
+
+// poolsByDenom returns the ids of the types.MaxFindRoutePoolsPerDenom most liquid pools containing the denom in the
+// protorev pools by denom index, without the inactive and paused ones. Pools whose denoms can't be read are skipped.
+func (g *routeGraph) poolsByDenom(ctx sdk.Context, denom string) ([]uint64, error) {
+	if poolIds, ok := g.poolsOfDenoms[denom]; ok {
+		return poolIds, nil
+	}
+
+	indexedPoolIds, err := g.k.protorevKeeper.GetPoolIdsForDenom(ctx, denom, types.MaxFindRoutePoolsPerDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := make([]uint64, 0, len(indexedPoolIds))
+	for _, poolId := range indexedPoolIds {
+		pool, err := g.k.GetPool(ctx, poolId)
+		if err != nil || !pool.IsActive(ctx) || g.k.IsPoolPaused(ctx, poolId) {
+			continue
+		}
+		if _, ok := g.poolDenoms[poolId]; !ok {
+			denoms, err := g.k.RouteGetPoolDenoms(ctx, poolId)
+			if err != nil {
+				ctx.Logger().Debug(fmt.Sprintf("Error getting pool denoms for pool %d: %s", poolId, err.Error()))
+				continue
+			}
+			g.poolDenoms[poolId] = denoms
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	g.poolsOfDenoms[denom] = poolIds
+	return poolIds, nil
+}
+
+// frontierDenoms returns the denoms whose routes are extended at the next hop: the types.MaxFindRouteFrontierDenoms
+// denoms reached by the most routes, sorted by decreasing number of routes and then by denom.
+func frontierDenoms(frontier map[string][]partialRoute) []string {
+	denoms := sortedKeys(frontier)
+	sort.SliceStable(denoms, func(i, j int) bool {
+		return len(frontier[denoms[i]]) > len(frontier[denoms[j]])
+	})
+	if len(denoms) > types.MaxFindRouteFrontierDenoms {
+		denoms = denoms[:types.MaxFindRouteFrontierDenoms]
+	}
+	return denoms
+}
+
+// insertPartialRoute inserts the route in the routes sorted by decreasing output, fewer pools and increasing
+// pool ids, and keeps at most maxRoutes routes.
+func insertPartialRoute(routes []partialRoute, route partialRoute, maxRoutes int) []partialRoute {
+	i := sort.Search(len(routes), func(i int) bool {
+		return lessPartialRoute(route, routes[i])
+	})
+	if i >= maxRoutes {
+		return routes
+	}
+	routes = append(routes, partialRoute{})
+	copy(routes[i+1:], routes[i:])
+	routes[i] = route
+	if len(routes) > maxRoutes {
+		routes = routes[:maxRoutes]
+	}
+	return routes
+}
+
+// lessPartialRoute returns true if route a is better than route b.
+func lessPartialRoute(a, b partialRoute) bool {
+	if !a.amountOut.Equal(b.amountOut) {
+		return a.amountOut.GT(b.amountOut)
+	}
+	if len(a.pools) != len(b.pools) {
+		return len(a.pools) < len(b.pools)
+	}
+	for i := range a.pools {
+		if a.pools[i].PoolId != b.pools[i].PoolId {
+			return a.pools[i].PoolId < b.pools[i].PoolId
+		}
+	}
+	return false
+}
+
+func routeContainsPool(route []types.SwapAmountInRoute, poolId uint64) bool {
+	for _, step := range route {
+		if step.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string][]partialRoute) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
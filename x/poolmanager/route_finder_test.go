@@ -0,0 +1,145 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// prepareRouteFinderPools creates pools such that uosmo can be swapped to uion directly through a shallow and a
+// deep pool, or through uatom.
+func (s *KeeperTestSuite) prepareRouteFinderPools() (shallowPool, deepPool, osmoAtomPool, atomIonPool uint64) {
+	shallowPool = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	deepPool = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 10_000_000), sdk.NewInt64Coin("uion", 10_000_000))
+	osmoAtomPool = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 5_000_000), sdk.NewInt64Coin("uatom", 5_000_000))
+	atomIonPool = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uatom", 5_000_000), sdk.NewInt64Coin("uion", 5_000_000))
+	return shallowPool, deepPool, osmoAtomPool, atomIonPool
+}
+
+func (s *KeeperTestSuite) TestFindBestRoute() {
+	s.SetupTest()
+	shallowPool, deepPool, osmoAtomPool, atomIonPool := s.prepareRouteFinderPools()
+	tokenIn := sdk.NewInt64Coin("uosmo", 100_000)
+
+	routes, tokenOutAmount, err := s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 3, 3, false)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 3)
+	s.Require().Equal([]uint64{deepPool}, types.SwapAmountInRoutes(routes[0].Pools).PoolIds())
+	s.Require().Equal([]uint64{osmoAtomPool, atomIonPool}, types.SwapAmountInRoutes(routes[1].Pools).PoolIds())
+	s.Require().Equal([]uint64{shallowPool}, types.SwapAmountInRoutes(routes[2].Pools).PoolIds())
+	s.Require().Equal(routes[0].TokenOutAmount.String(), tokenOutAmount.String())
+	for _, route := range routes {
+		s.Require().Equal(tokenIn.Amount.String(), route.TokenInAmount.String())
+		expectedAmountOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route.Pools, tokenIn)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedAmountOut.String(), route.TokenOutAmount.String())
+	}
+
+	// A single hop only finds the direct pools.
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 1, 3, false)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 2)
+
+	// The number of routes is bounded by max routes.
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 3, 1, false)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 1)
+	s.Require().Equal([]uint64{deepPool}, types.SwapAmountInRoutes(routes[0].Pools).PoolIds())
+}
+
+// Validates that paused pools are not part of the routes, and that the search stops once the maximum number of
+// swap estimates is reached.
+func (s *KeeperTestSuite) TestFindBestRouteLimits() {
+	s.SetupTest()
+	shallowPool, deepPool, osmoAtomPool, atomIonPool := s.prepareRouteFinderPools()
+	tokenIn := sdk.NewInt64Coin("uosmo", 100_000)
+
+	s.Require().NoError(s.App.PoolManagerKeeper.PausePool(s.Ctx, deepPool))
+	routes, _, err := s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 3, 3, false)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 2)
+	s.Require().Equal([]uint64{osmoAtomPool, atomIonPool}, types.SwapAmountInRoutes(routes[0].Pools).PoolIds())
+	s.Require().Equal([]uint64{shallowPool}, types.SwapAmountInRoutes(routes[1].Pools).PoolIds())
+	s.App.PoolManagerKeeper.UnpausePool(s.Ctx, deepPool)
+
+	// The first estimate is the swap through the deep pool, the most liquid pool of the token in denom.
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRouteWithMaxEstimates(s.Ctx, tokenIn, "uion", 3, 3, false, 1)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 1)
+	s.Require().Equal([]uint64{deepPool}, types.SwapAmountInRoutes(routes[0].Pools).PoolIds())
+
+	_, _, err = s.App.PoolManagerKeeper.FindBestRouteWithMaxEstimates(s.Ctx, tokenIn, "uion", 3, 3, false, 0)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestFindBestRouteSplit() {
+	s.SetupTest()
+	s.prepareRouteFinderPools()
+	tokenIn := sdk.NewInt64Coin("uosmo", 2_000_000)
+
+	_, bestAmountOut, err := s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 3, 3, false)
+	s.Require().NoError(err)
+
+	routes, tokenOutAmount, err := s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tokenIn, "uion", 3, 3, true)
+	s.Require().NoError(err)
+	s.Require().Greater(len(routes), 1)
+	s.Require().True(tokenOutAmount.GT(bestAmountOut))
+
+	totalAmountIn, totalAmountOut := osmomath.ZeroInt(), osmomath.ZeroInt()
+	usedPools := map[uint64]bool{}
+	for _, route := range routes {
+		for _, poolId := range types.SwapAmountInRoutes(route.Pools).PoolIds() {
+			s.Require().False(usedPools[poolId])
+			usedPools[poolId] = true
+		}
+		totalAmountIn = totalAmountIn.Add(route.TokenInAmount)
+		totalAmountOut = totalAmountOut.Add(route.TokenOutAmount)
+	}
+	s.Require().Equal(tokenIn.Amount.String(), totalAmountIn.String())
+	s.Require().Equal(tokenOutAmount.String(), totalAmountOut.String())
+
+	// The single hop search estimates the swaps through the two direct pools, and the split estimates the swap
+	// through each route for each part, so without estimates left for the split the best route is returned.
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRouteWithMaxEstimates(s.Ctx, tokenIn, "uion", 1, 3, true, 2)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 1)
+	s.Require().Equal(tokenIn.Amount.String(), routes[0].TokenInAmount.String())
+
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRouteWithMaxEstimates(s.Ctx, tokenIn, "uion", 1, 3, true, 2+2*types.FindRouteSplitSteps)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 2)
+
+	// Amounts too small to be split go through the best route.
+	routes, _, err = s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, sdk.NewInt64Coin("uosmo", 10), "uion", 3, 3, true)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 1)
+	s.Require().Equal("10", routes[0].TokenInAmount.String())
+}
+
+func (s *KeeperTestSuite) TestFindBestRouteErrors() {
+	s.SetupTest()
+	s.prepareRouteFinderPools()
+	tokenIn := sdk.NewInt64Coin("uosmo", 100_000)
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       int
+		maxRoutes     int
+	}{
+		"zero token in":         {tokenIn: sdk.NewInt64Coin("uosmo", 0), tokenOutDenom: "uion", maxHops: 3, maxRoutes: 3},
+		"same denoms":           {tokenIn: tokenIn, tokenOutDenom: "uosmo", maxHops: 3, maxRoutes: 3},
+		"invalid denom out":     {tokenIn: tokenIn, tokenOutDenom: "", maxHops: 3, maxRoutes: 3},
+		"too many hops":         {tokenIn: tokenIn, tokenOutDenom: "uion", maxHops: types.MaxFindRouteHops + 1, maxRoutes: 3},
+		"too many routes":       {tokenIn: tokenIn, tokenOutDenom: "uion", maxHops: 3, maxRoutes: types.MaxFindRouteRoutes + 1},
+		"no route to the denom": {tokenIn: tokenIn, tokenOutDenom: "ufoo", maxHops: 3, maxRoutes: 3},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			_, _, err := s.App.PoolManagerKeeper.FindBestRoute(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxRoutes, false)
+			s.Require().Error(err)
+		})
+	}
+}
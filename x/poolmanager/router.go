@@ -48,14 +48,30 @@ func (k *Keeper) GetPoolModuleAndPool(ctx sdk.Context, poolId uint64) (swapModul
 // corresponding to poolID's pool type. It takes in the input denom and amount for
 // the initial swap against the first pool and chains the output as the input for the
 // next routed pool until the last pool is reached.
-// Transaction succeeds if final amount out is greater than tokenOutMinAmount defined
-// and no errors are encountered along the way.
+// Transaction succeeds if final amount out is greater than tokenOutMinAmount defined,
+// the swap is within the given limits and no errors are encountered along the way.
+// Callers without limits pass empty limits.
 func (k Keeper) RouteExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
+	limits types.SwapLimits,
+) (osmomath.Int, error) {
+	paths := []swapPath{swapAmountInPath(route, tokenIn.Denom)}
+	return k.swapWithLimits(ctx, limits, paths, func() (osmomath.Int, error) {
+		return k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
+	})
+}
+
+// routeExactAmountIn implements RouteExactAmountIn, without checking any swap limit.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
@@ -64,6 +80,7 @@ func (k Keeper) RouteExactAmountIn(
 
 	totalTakerFeesCharged := sdk.Coins{}
 	denomsInvolvedInRoute := []string{tokenIn.Denom}
+	routeTokenIn := tokenIn
 
 	// Iterate through the route and execute a series of swaps through each pool.
 	for i, routeStep := range route {
@@ -98,6 +115,9 @@ func (k Keeper) RouteExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	// Track the volume of the sender for volume taker fee tiers, once per route
+	k.addAccountVolume(ctx, sender, routeTokenIn)
+
 	return tokenOutAmount, nil
 }
 
@@ -116,12 +136,32 @@ func (k Keeper) RouteExactAmountIn(
 //   - one of the multihop swaps fails for internal reasons
 //   - final token out computed is not positive
 //   - final token out computed is smaller than tokenOutMinAmount
+//   - the swap is not within the given limits, whose spot price limits are checked for every route once all the
+//     routes are swapped
 func (k Keeper) SplitRouteExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	routes []types.SwapAmountInSplitRoute,
 	tokenInDenom string,
 	tokenOutMinAmount osmomath.Int,
+	limits types.SwapLimits,
+) (osmomath.Int, error) {
+	paths := make([]swapPath, len(routes))
+	for i, route := range routes {
+		paths[i] = swapAmountInPath(route.Pools, tokenInDenom)
+	}
+	return k.swapWithLimits(ctx, limits, paths, func() (osmomath.Int, error) {
+		return k.splitRouteExactAmountIn(ctx, sender, routes, tokenInDenom, tokenOutMinAmount)
+	})
+}
+
+// splitRouteExactAmountIn implements SplitRouteExactAmountIn, without checking any swap limit.
+func (k Keeper) splitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount osmomath.Int,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
 		return osmomath.Int{}, err
@@ -136,7 +176,7 @@ func (k Keeper) SplitRouteExactAmountIn(
 	)
 
 	for _, multihopRoute := range routes {
-		tokenOutAmount, err := k.RouteExactAmountIn(
+		tokenOutAmount, err := k.routeExactAmountIn(
 			ctx,
 			sender,
 			types.SwapAmountInRoutes(multihopRoute.Pools),
@@ -191,8 +231,11 @@ func (k Keeper) SwapExactAmountIn(
 	if !pool.IsActive(ctx) {
 		return osmomath.Int{}, sdk.Coin{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
+	if k.IsPoolPaused(ctx, poolId) {
+		return osmomath.Int{}, sdk.Coin{}, types.PoolPausedError{PoolId: poolId}
+	}
 
-	tokenInAfterSubTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	tokenInAfterSubTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, poolId, tokenIn, tokenOutDenom, sender, true)
 	if err != nil {
 		return osmomath.Int{}, sdk.Coin{}, err
 	}
@@ -231,6 +274,9 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 	if !pool.IsActive(ctx) {
 		return osmomath.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
+	if k.IsPoolPaused(ctx, poolId) {
+		return osmomath.Int{}, types.PoolPausedError{PoolId: poolId}
+	}
 
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
 	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx))
@@ -287,13 +333,17 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		if err != nil {
 			return osmomath.Int{}, err
 		}
+		// Paused pools can't be swapped through, so they can't be estimated either.
+		if k.IsPoolPaused(ctx, routeStep.PoolId) {
+			return osmomath.Int{}, types.PoolPausedError{PoolId: routeStep.PoolId}
+		}
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
 		actualTokenIn := tokenIn
 		// apply taker fee if applicable
 		if applyTakerFee {
-			takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom)
+			takerFee, err := k.GetSwapTakerFee(ctx, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
 			if err != nil {
 				return osmomath.Int{}, err
 			}
@@ -324,12 +374,26 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 // for a given input amount when swapping tokens, taking into account the current price of the
 // tokens in the pool and any slippage.
 // Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined
-// tokenInMaxAmount defined.
+// tokenInMaxAmount defined and the swap is within the given limits. Callers without limits pass empty limits.
 func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountOutRoute,
 	tokenInMaxAmount osmomath.Int,
 	tokenOut sdk.Coin,
+	limits types.SwapLimits,
+) (osmomath.Int, error) {
+	paths := []swapPath{swapAmountOutPath(route, tokenOut.Denom)}
+	return k.swapWithLimits(ctx, limits, paths, func() (osmomath.Int, error) {
+		return k.routeExactAmountOut(ctx, sender, route, tokenInMaxAmount, tokenOut)
+	})
+}
+
+// routeExactAmountOut implements RouteExactAmountOut, without checking any swap limit.
+func (k Keeper) routeExactAmountOut(ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenInMaxAmount osmomath.Int,
+	tokenOut sdk.Coin,
 ) (tokenInAmount osmomath.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
@@ -383,6 +447,9 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		if !pool.IsActive(ctx) {
 			return osmomath.Int{}, types.InactivePoolError{PoolId: pool.GetId()}
 		}
+		if k.IsPoolPaused(ctx, routeStep.PoolId) {
+			return osmomath.Int{}, types.PoolPausedError{PoolId: routeStep.PoolId}
+		}
 
 		spreadFactor := pool.GetSpreadFactor(ctx)
 		// If we determined the routeStep is an osmo multi-hop and both route are incentivized,
@@ -397,7 +464,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+		tokenInAfterAddTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, pool.GetId(), tokenIn, _tokenOut.Denom, sender, false)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -427,6 +494,9 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		return osmomath.Int{}, err
 	}
 
+	// Track the volume of the sender for volume taker fee tiers, once per route
+	k.addAccountVolume(ctx, sender, sdk.NewCoin(route[0].TokenInDenom, tokenInAmount))
+
 	return tokenInAmount, nil
 }
 
@@ -445,12 +515,32 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 //   - one of the multihop swaps fails for internal reasons
 //   - final token out computed is not positive
 //   - final token out computed is smaller than tokenInMaxAmount
+//   - the swap is not within the given limits, whose spot price limits are checked for every route once all the
+//     routes are swapped
 func (k Keeper) SplitRouteExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountOutSplitRoute,
 	tokenOutDenom string,
 	tokenInMaxAmount osmomath.Int,
+	limits types.SwapLimits,
+) (osmomath.Int, error) {
+	paths := make([]swapPath, len(route))
+	for i, multihopRoute := range route {
+		paths[i] = swapAmountOutPath(multihopRoute.Pools, tokenOutDenom)
+	}
+	return k.swapWithLimits(ctx, limits, paths, func() (osmomath.Int, error) {
+		return k.splitRouteExactAmountOut(ctx, sender, route, tokenOutDenom, tokenInMaxAmount)
+	})
+}
+
+// splitRouteExactAmountOut implements SplitRouteExactAmountOut, without checking any swap limit.
+func (k Keeper) splitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount osmomath.Int,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountOutSplitRoute(route); err != nil {
 		return osmomath.Int{}, err
@@ -466,7 +556,7 @@ func (k Keeper) SplitRouteExactAmountOut(
 	)
 
 	for _, multihopRoute := range route {
-		tokenOutAmount, err := k.RouteExactAmountOut(
+		tokenOutAmount, err := k.routeExactAmountOut(
 			ctx,
 			sender,
 			types.SwapAmountOutRoutes(multihopRoute.Pools),
@@ -651,10 +741,14 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 		if err != nil {
 			return nil, err
 		}
+		// Paused pools can't be swapped through, so they can't be estimated either.
+		if k.IsPoolPaused(ctx, routeStep.PoolId) {
+			return nil, types.PoolPausedError{PoolId: routeStep.PoolId}
+		}
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, err := k.GetSwapTakerFee(ctx, routeStep.PoolId, routeStep.TokenInDenom, tokenOut.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -721,14 +721,14 @@ func (s *KeeperTestSuite) TestMultihopSwapExactAmountIn() {
 
 			if tc.expectError {
 				// execute the swap
-				_, err := poolmanagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenIn, tc.tokenOutMinAmount)
+				_, err := poolmanagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenIn, tc.tokenOutMinAmount, types.SwapLimits{})
 				s.Require().Error(err)
 			} else {
 				// calculate the swap as separate swaps
 				expectedMultihopTokenOutAmount := s.calcOutGivenInAmountAsSeparatePoolSwaps(tc.routes, tc.tokenIn)
 
 				// execute the swap
-				multihopTokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenIn, tc.tokenOutMinAmount)
+				multihopTokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenIn, tc.tokenOutMinAmount, types.SwapLimits{})
 				// compare the expected tokenOut to the actual tokenOut
 				s.Require().NoError(err)
 				s.Require().Equal(expectedMultihopTokenOutAmount.Amount.String(), multihopTokenOutAmount.String())
@@ -959,13 +959,13 @@ func (s *KeeperTestSuite) TestMultihopSwapExactAmountOut() {
 
 			if tc.expectError {
 				// execute the swap
-				_, err := poolmanagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenInMaxAmount, tc.tokenOut)
+				_, err := poolmanagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenInMaxAmount, tc.tokenOut, types.SwapLimits{})
 				s.Require().Error(err)
 			} else {
 				// calculate the swap as separate swaps
 				expectedMultihopTokenInAmount := s.calcInGivenOutAmountAsSeparateSwaps(tc.routes, tc.tokenOut)
 				// execute the swap
-				multihopTokenInAmount, err := poolmanagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenInMaxAmount, tc.tokenOut)
+				multihopTokenInAmount, err := poolmanagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], tc.routes, tc.tokenInMaxAmount, tc.tokenOut, types.SwapLimits{})
 				// compare the expected tokenOut to the actual tokenOut
 				s.Require().NoError(err)
 				s.Require().Equal(expectedMultihopTokenInAmount.Amount.String(), multihopTokenInAmount.String())
@@ -1128,7 +1128,8 @@ func (s *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
 				s.TestAccs[0],
 				test.param.routes,
 				test.param.tokenIn,
-				test.param.tokenOutMinAmount)
+				test.param.tokenOutMinAmount,
+				types.SwapLimits{})
 
 			// calculate token out amount using `EstimateMultihopSwapExactAmountIn`
 			estimateMultihopTokenOutAmount, errEstimate := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(
@@ -1309,7 +1310,8 @@ func (s *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 				s.TestAccs[0],
 				test.param.routes,
 				test.param.tokenInMaxAmount,
-				test.param.tokenOut)
+				test.param.tokenOut,
+				types.SwapLimits{})
 
 			estimateMultihopTokenInAmount, errEstimate := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(
 				s.Ctx,
@@ -2818,7 +2820,7 @@ func (s *KeeperTestSuite) TestSplitRouteExactAmountIn() {
 				}
 			}
 
-			tokenOut, err := k.SplitRouteExactAmountIn(s.Ctx, sender, tc.routes, tc.tokenInDenom, tc.tokenOutMinAmount)
+			tokenOut, err := k.SplitRouteExactAmountIn(s.Ctx, sender, tc.routes, tc.tokenInDenom, tc.tokenOutMinAmount, types.SwapLimits{})
 
 			if tc.expectError != nil {
 				s.Require().Error(err)
@@ -3121,7 +3123,7 @@ func (s *KeeperTestSuite) TestSplitRouteExactAmountOut() {
 				}
 			}
 
-			tokenIn, err := k.SplitRouteExactAmountOut(s.Ctx, sender, tc.routes, tc.tokenOutDenom, tc.tokenInMaxAmount)
+			tokenIn, err := k.SplitRouteExactAmountOut(s.Ctx, sender, tc.routes, tc.tokenOutDenom, tc.tokenInMaxAmount, types.SwapLimits{})
 
 			if tc.expectError != nil {
 				s.Require().Error(err)
@@ -3734,7 +3736,7 @@ func (s *KeeperTestSuite) TestTakerFee() {
 			takerFeeCollectorBalancePreHook := bk.GetAllBalances(s.Ctx, ak.GetModuleAddress(takerFeeAddrName))
 
 			// Execute swap
-			tokenOut, err := k.SplitRouteExactAmountIn(s.Ctx, sender, tc.routes, tc.tokenInDenom, tc.tokenOutMinAmount)
+			tokenOut, err := k.SplitRouteExactAmountIn(s.Ctx, sender, tc.routes, tc.tokenInDenom, tc.tokenOutMinAmount, types.SwapLimits{})
 
 			if tc.expectError != nil {
 				s.Require().Error(err)
@@ -0,0 +1,93 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+// swapPath is the pools of a route and the denoms it goes through, from the token in to the token out.
+type swapPath struct {
+	poolIds []uint64
+	denoms  []string
+}
+
+// swapWithLimits checks the deadline of the limits, runs the swap and, if the limits have price limits, checks the
+// spot price of each path after the swap against them.
+func (k Keeper) swapWithLimits(ctx sdk.Context, limits types.SwapLimits, paths []swapPath, swap func() (osmomath.Int, error)) (osmomath.Int, error) {
+	if limits.Deadline != nil && ctx.BlockTime().After(*limits.Deadline) {
+		return osmomath.Int{}, types.SwapDeadlineExceededError{Deadline: *limits.Deadline, BlockTime: ctx.BlockTime()}
+	}
+	if !limits.HasPriceLimits() {
+		return swap()
+	}
+
+	spotPricesBefore := make([]osmomath.BigDec, len(paths))
+	for i, path := range paths {
+		spotPrice, err := k.pathSpotPrice(ctx, path)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		spotPricesBefore[i] = spotPrice
+	}
+
+	amount, err := swap()
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	for i, path := range paths {
+		spotPriceAfter, err := k.pathSpotPrice(ctx, path)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		if err := checkSpotPriceLimits(spotPricesBefore[i], spotPriceAfter, limits); err != nil {
+			return osmomath.Int{}, err
+		}
+	}
+	return amount, nil
+}
+
+// checkSpotPriceLimits returns an error if the change from the spot price before the swap to the spot price after it
+// exceeds the max price impact, or if the spot price after the swap is below the limit spot price.
+func checkSpotPriceLimits(spotPriceBefore, spotPriceAfter osmomath.BigDec, limits types.SwapLimits) error {
+	if limits.MaxPriceImpact != nil && spotPriceBefore.IsPositive() {
+		priceImpact := spotPriceBefore.Sub(spotPriceAfter).AbsMut().QuoMut(spotPriceBefore)
+		if priceImpact.GT(osmomath.BigDecFromDec(*limits.MaxPriceImpact)) {
+			return types.PriceImpactExceededError{PriceImpact: priceImpact, MaxPriceImpact: *limits.MaxPriceImpact}
+		}
+	}
+	if limits.LimitSpotPrice != nil && spotPriceAfter.LT(osmomath.BigDecFromDec(*limits.LimitSpotPrice)) {
+		return types.SpotPriceLimitExceededError{SpotPrice: spotPriceAfter, LimitSpotPrice: *limits.LimitSpotPrice}
+	}
+	return nil
+}
+
+// pathSpotPrice returns the spot price of the first denom of the path in terms of its last denom, which is the
+// product of the spot prices of its pools.
+func (k Keeper) pathSpotPrice(ctx sdk.Context, path swapPath) (osmomath.BigDec, error) {
+	spotPrice := osmomath.OneBigDec()
+	for i, poolId := range path.poolIds {
+		poolSpotPrice, err := k.RouteCalculateSpotPrice(ctx, poolId, path.denoms[i+1], path.denoms[i])
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+		spotPrice = spotPrice.MulMut(poolSpotPrice)
+	}
+	return spotPrice, nil
+}
+
+func swapAmountInPath(route []types.SwapAmountInRoute, tokenInDenom string) swapPath {
+	return swapPath{
+		poolIds: types.SwapAmountInRoutes(route).PoolIds(),
+		denoms:  types.SwapAmountInSplitRouteWrapper{Pools: route, InDenom: tokenInDenom}.TokenDenomsOnPath(),
+	}
+}
+
+func swapAmountOutPath(route []types.SwapAmountOutRoute, tokenOutDenom string) swapPath {
+	return swapPath{
+		poolIds: types.SwapAmountOutRoutes(route).PoolIds(),
+		denoms:  types.SwapAmountOutSplitRouteWrapper{Pools: route, OutDenom: tokenOutDenom}.TokenDenomsOnPath(),
+	}
+}
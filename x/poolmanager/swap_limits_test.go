@@ -0,0 +1,112 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+func decPtr(s string) *osmomath.Dec {
+	dec := osmomath.MustNewDecFromStr(s)
+	return &dec
+}
+
+// validates that swaps are rejected when they execute after their deadline or move the spot price past their limits.
+func (s *KeeperTestSuite) TestRouteExactAmountInSwapLimits() {
+	past := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokenIn := sdk.NewInt64Coin("uosmo", 100_000)
+
+	tests := map[string]struct {
+		limits        types.SwapLimits
+		expectedError error
+	}{
+		"no limits": {},
+		"before deadline": {
+			limits: types.SwapLimits{Deadline: &future},
+		},
+		"after deadline": {
+			limits:        types.SwapLimits{Deadline: &past},
+			expectedError: types.SwapDeadlineExceededError{Deadline: past, BlockTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		// Swapping 10% of the reserves moves the spot price by about 20%.
+		"within max price impact": {
+			limits: types.SwapLimits{MaxPriceImpact: decPtr("0.25")},
+		},
+		"exceeds max price impact": {
+			limits:        types.SwapLimits{MaxPriceImpact: decPtr("0.1")},
+			expectedError: types.PriceImpactExceededError{},
+		},
+		"above limit spot price": {
+			limits: types.SwapLimits{LimitSpotPrice: decPtr("0.8")},
+		},
+		"below limit spot price": {
+			limits:        types.SwapLimits{LimitSpotPrice: decPtr("0.9")},
+			expectedError: types.SpotPriceLimitExceededError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+			route := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uion"}}
+
+			expectedTokenOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+			s.Require().NoError(err)
+
+			tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt(), tc.limits)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				if _, ok := tc.expectedError.(types.SwapDeadlineExceededError); ok {
+					s.Require().Equal(tc.expectedError, err)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.String(), tokenOutAmount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRouteExactAmountOutSwapLimits() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000)))
+	route := []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "uosmo"}}
+	tokenOut := sdk.NewInt64Coin("uion", 100_000)
+
+	// Buying 10% of the reserves of uion moves the spot price of uosmo by about 20%.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := s.App.PoolManagerKeeper.RouteExactAmountOut(cacheCtx, s.TestAccs[0], route, osmomath.NewInt(1_000_000), tokenOut, types.SwapLimits{MaxPriceImpact: decPtr("0.1")})
+	s.Require().ErrorAs(err, &types.PriceImpactExceededError{})
+
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], route, osmomath.NewInt(1_000_000), tokenOut, types.SwapLimits{MaxPriceImpact: decPtr("0.3")})
+	s.Require().NoError(err)
+}
+
+// validates that the price limits are checked on every route of a split route swap.
+func (s *KeeperTestSuite) TestSplitRouteExactAmountInSwapLimits() {
+	s.SetupTest()
+	shallowPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	deepPool := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 100_000_000), sdk.NewInt64Coin("uion", 100_000_000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 200_000)))
+	routes := []types.SwapAmountInSplitRoute{
+		{Pools: []types.SwapAmountInRoute{{PoolId: deepPool, TokenOutDenom: "uion"}}, TokenInAmount: osmomath.NewInt(100_000)},
+		{Pools: []types.SwapAmountInRoute{{PoolId: shallowPool, TokenOutDenom: "uion"}}, TokenInAmount: osmomath.NewInt(100_000)},
+	}
+
+	// The swap through the deep pool barely moves its price, but the one through the shallow pool does.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := s.App.PoolManagerKeeper.SplitRouteExactAmountIn(cacheCtx, s.TestAccs[0], routes, "uosmo", osmomath.OneInt(), types.SwapLimits{MaxPriceImpact: decPtr("0.05")})
+	s.Require().ErrorAs(err, &types.PriceImpactExceededError{})
+
+	_, err = s.App.PoolManagerKeeper.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], routes, "uosmo", osmomath.OneInt(), types.SwapLimits{MaxPriceImpact: decPtr("0.25")})
+	s.Require().NoError(err)
+}
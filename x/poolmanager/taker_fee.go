@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 
@@ -121,12 +123,129 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 	return takerFees, nil
 }
 
+// SetPoolTakerFee sets the taker fee override of the given pool, which takes precedence over the
+// taker fee of the trading pair. Returns an error if the pool doesn't exist.
+func (k Keeper) SetPoolTakerFee(ctx sdk.Context, poolId uint64, takerFee osmomath.Dec) error {
+	if _, err := k.GetPoolModule(ctx, poolId); err != nil {
+		return err
+	}
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.KeyPoolTakerFee(poolId), takerFee)
+	return nil
+}
+
+// DeletePoolTakerFee deletes the taker fee override of the given pool, if any.
+func (k Keeper) DeletePoolTakerFee(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPoolTakerFee(poolId))
+}
+
+// GetPoolTakerFee returns the taker fee override of the given pool.
+// Returns false if the pool has no override.
+func (k Keeper) GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error) {
+	takerFee := &sdk.DecProto{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPoolTakerFee(poolId), takerFee)
+	if err != nil || !found {
+		return osmomath.Dec{}, false, err
+	}
+	return takerFee.Dec, true, nil
+}
+
+// GetAllPoolTakerFees returns the taker fee overrides of all pools, ordered by pool id.
+func (k Keeper) GetAllPoolTakerFees(ctx sdk.Context) ([]types.PoolTakerFee, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPoolTakerFeePrefix)
+	defer iterator.Close()
+
+	poolTakerFees := []types.PoolTakerFee{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolId, err := types.ParsePoolTakerFeeKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		takerFee := &sdk.DecProto{}
+		if err := takerFee.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		poolTakerFees = append(poolTakerFees, types.PoolTakerFee{
+			PoolId:   poolId,
+			TakerFee: takerFee.Dec,
+		})
+	}
+	return poolTakerFees, nil
+}
+
+// GetSwapTakerFee returns the taker fee charged when swapping tokenInDenom for tokenOutDenom in the given pool:
+// the taker fee override of the pool if it has one, otherwise the taker fee of the trading pair.
+func (k Keeper) GetSwapTakerFee(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (osmomath.Dec, error) {
+	poolTakerFee, found, err := k.GetPoolTakerFee(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if found {
+		return poolTakerFee, nil
+	}
+	return k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+}
+
+// SenderValidationSetPoolTakerFee sets the taker fee override of the given pool iff the sender is
+// in the pool manager taker fee admin address list or is the governance module.
+func (k Keeper) SenderValidationSetPoolTakerFee(ctx sdk.Context, sender string, poolId uint64, takerFee osmomath.Dec) error {
+	if !k.isPoolTakerFeeAuthority(ctx, sender) {
+		return fmt.Errorf("%s is neither in the pool manager taker fee admin address list nor the governance module", sender)
+	}
+
+	if err := k.SetPoolTakerFee(ctx, poolId, takerFee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetPoolTakerFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		),
+	})
+
+	return nil
+}
+
+// SenderValidationRemovePoolTakerFee removes the taker fee override of the given pool iff the sender is
+// in the pool manager taker fee admin address list or is the governance module.
+func (k Keeper) SenderValidationRemovePoolTakerFee(ctx sdk.Context, sender string, poolId uint64) error {
+	if !k.isPoolTakerFeeAuthority(ctx, sender) {
+		return fmt.Errorf("%s is neither in the pool manager taker fee admin address list nor the governance module", sender)
+	}
+
+	k.DeletePoolTakerFee(ctx, poolId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemovePoolTakerFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+	})
+
+	return nil
+}
+
+// isPoolTakerFeeAuthority returns true if the sender is allowed to set pool taker fees, i.e. if it is
+// a taker fee admin or the governance module.
+func (k Keeper) isPoolTakerFeeAuthority(ctx sdk.Context, sender string) bool {
+	if osmoutils.Contains(k.GetParams(ctx).TakerFeeParams.AdminAddresses, sender) {
+		return true
+	}
+	return sender == k.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).GetAddress().String()
+}
+
 // chargeTakerFee extracts the taker fee from the given tokenIn and sends it to the appropriate
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
-// In the future, we might charge a lower taker fee as opposed to no fee at all.
+// Otherwise, the taker fee of the pool, or of the trading pair if the pool has no override, is discounted by the volume taker fee tier reached by the sender, if any.
 // TODO: Gas optimize this function, its expensive in both gas and CPU.
-func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
+func (k Keeper) chargeTakerFee(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
 	takerFeeModuleAccountName := txfeestypes.TakerFeeCollectorName
 
 	reducedFeeWhitelist := []string{}
@@ -137,10 +256,11 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 		return tokenIn, sdk.Coin{}, nil
 	}
 
-	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, tokenOutDenom)
+	takerFee, err := k.GetSwapTakerFee(ctx, poolId, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
+	takerFee = k.applyVolumeTakerFeeDiscount(ctx, sender, takerFee)
 
 	var tokenInAfterTakerFee sdk.Coin
 	var takerFeeCoin sdk.Coin
@@ -109,7 +109,7 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 			}
 
 			// Create pool.
-			s.PrepareConcentratedPool()
+			pool := s.PrepareConcentratedPool()
 
 			// Set taker fee.
 			poolManager.SetDenomPairTakerFee(s.Ctx, tc.tokenIn.Denom, tc.tokenOutDenom, tc.takerFee)
@@ -123,7 +123,7 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 			}
 
 			// System under test.
-			tokenInAfterTakerFee, _, err := poolManager.ChargeTakerFee(s.Ctx, tc.tokenIn, tc.tokenOutDenom, s.TestAccs[tc.senderIndex], tc.exactIn)
+			tokenInAfterTakerFee, _, err := poolManager.ChargeTakerFee(s.Ctx, pool.GetId(), tc.tokenIn, tc.tokenOutDenom, s.TestAccs[tc.senderIndex], tc.exactIn)
 
 			if tc.expectError != nil {
 				s.Require().Error(err)
@@ -143,6 +143,52 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 	}
 }
 
+// validates that the taker fee override of a pool takes precedence over the trading pair taker fee.
+func (s *KeeperTestSuite) TestPoolTakerFee() {
+	var (
+		pairTakerFee = osmomath.MustNewDecFromStr("0.01")
+		poolTakerFee = osmomath.MustNewDecFromStr("0.002")
+		tokenIn      = sdk.NewCoin(apptesting.ETH, osmomath.NewInt(10_000_000))
+	)
+
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	pool := s.PrepareConcentratedPool()
+	otherPool := s.PrepareConcentratedPool()
+	poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.ETH, apptesting.USDC, pairTakerFee)
+
+	s.Require().Error(poolManager.SetPoolTakerFee(s.Ctx, otherPool.GetId()+1, poolTakerFee))
+	s.Require().NoError(poolManager.SetPoolTakerFee(s.Ctx, pool.GetId(), poolTakerFee))
+
+	takerFee, err := poolManager.GetSwapTakerFee(s.Ctx, pool.GetId(), apptesting.ETH, apptesting.USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(poolTakerFee, takerFee)
+
+	// The override also applies in the other direction.
+	takerFee, err = poolManager.GetSwapTakerFee(s.Ctx, pool.GetId(), apptesting.USDC, apptesting.ETH)
+	s.Require().NoError(err)
+	s.Require().Equal(poolTakerFee, takerFee)
+
+	// Other pools of the pair keep charging the pair taker fee.
+	takerFee, err = poolManager.GetSwapTakerFee(s.Ctx, otherPool.GetId(), apptesting.ETH, apptesting.USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(pairTakerFee, takerFee)
+
+	poolTakerFees, err := poolManager.GetAllPoolTakerFees(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolTakerFee{{PoolId: pool.GetId(), TakerFee: poolTakerFee}}, poolTakerFees)
+
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, takerFeeCharged, err := poolManager.ChargeTakerFee(s.Ctx, pool.GetId(), tokenIn, apptesting.USDC, s.TestAccs[0], true)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(20_000).String(), takerFeeCharged.Amount.String())
+
+	poolManager.DeletePoolTakerFee(s.Ctx, pool.GetId())
+	takerFee, err = poolManager.GetSwapTakerFee(s.Ctx, pool.GetId(), apptesting.ETH, apptesting.USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(pairTakerFee, takerFee)
+}
+
 func (s *KeeperTestSuite) TestTakerFeeSkim() {
 	tests := map[string]struct {
 		alloyedPoolSetup                 func() []string
@@ -14,6 +14,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgBatchSwap{}, "osmosis/poolmanager/batch-swap", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +24,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapExactAmountOut{},
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgBatchSwap{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -24,6 +25,8 @@ var (
 	ErrSetRegisteredAlloyedPool                  = errors.New("error setting registered alloyed pool")
 	ErrInvalidKeyFormat                          = errors.New("invalid key format")
 	ErrTotalAlloyedLiquidityIsZero               = errors.New("totalAlloyedLiquidity is zero")
+	ErrEmptyBatchSwapLegs                        = errors.New("batch swap must have at least one leg")
+	ErrInvalidBatchSwapLeg                       = errors.New("batch swap leg must have exactly one of exact in and exact out set")
 )
 
 type nonPositiveAmountError struct {
@@ -204,3 +207,68 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type SwapDeadlineExceededError struct {
+	Deadline  time.Time
+	BlockTime time.Time
+}
+
+func (e SwapDeadlineExceededError) Error() string {
+	return fmt.Sprintf("swap deadline (%s) exceeded, block time is (%s)", e.Deadline, e.BlockTime)
+}
+
+type PriceImpactExceededError struct {
+	PriceImpact    osmomath.BigDec
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e PriceImpactExceededError) Error() string {
+	return fmt.Sprintf("price impact (%s) exceeds max price impact (%s)", e.PriceImpact, e.MaxPriceImpact)
+}
+
+type SpotPriceLimitExceededError struct {
+	SpotPrice      osmomath.BigDec
+	LimitSpotPrice osmomath.Dec
+}
+
+func (e SpotPriceLimitExceededError) Error() string {
+	return fmt.Sprintf("spot price after swap (%s) is below limit spot price (%s)", e.SpotPrice, e.LimitSpotPrice)
+}
+
+type TooManyBatchSwapLegsError struct {
+	NumLegs int
+	MaxLegs int
+}
+
+func (e TooManyBatchSwapLegsError) Error() string {
+	return fmt.Sprintf("batch swap has too many legs (%d), maximum is (%d)", e.NumLegs, e.MaxLegs)
+}
+
+type InvalidBatchSwapPolicyError struct {
+	Policy BatchSwapPolicy
+}
+
+func (e InvalidBatchSwapPolicyError) Error() string {
+	return fmt.Sprintf("invalid batch swap policy (%d)", e.Policy)
+}
+
+type BatchSwapLegError struct {
+	Index int
+	Err   error
+}
+
+func (e BatchSwapLegError) Error() string {
+	return fmt.Sprintf("batch swap leg (%d) failed: %s", e.Index, e.Err)
+}
+
+func (e BatchSwapLegError) Unwrap() error {
+	return e.Err
+}
+
+type PoolPausedError struct {
+	PoolId uint64
+}
+
+func (e PoolPausedError) Error() string {
+	return fmt.Sprintf("pool (%d) is paused", e.PoolId)
+}
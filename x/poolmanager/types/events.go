@@ -4,6 +4,8 @@ const (
 	AttributeValueCategory               = ModuleName
 	TypeEvtPoolCreated                   = "pool_created"
 	TypeEvtSplitRouteSwapExactIn         = "split_route_swap_exact_in"
+	TypeEvtPoolPaused                    = "pool_paused"
+	TypeEvtPoolUnpaused                  = "pool_unpaused"
 	AttributeKeyTokensIn                 = "tokens_in"
 	AttributeKeyTokensOut                = "tokens_out"
 	AttributeKeyPoolId                   = "pool_id"
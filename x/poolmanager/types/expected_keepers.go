@@ -118,6 +118,7 @@ type StakingKeeper interface {
 
 type ProtorevKeeper interface {
 	GetPoolForDenomPair(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
+	GetPoolIdsForDenom(ctx sdk.Context, denom string, limit int) ([]uint64, error)
 }
 
 type WasmKeeper interface {
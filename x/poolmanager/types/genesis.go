@@ -28,5 +28,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := validatePoolTakerFees(gs.PoolTakerFeeStore); err != nil {
+		return err
+	}
+	if err := validatePausedPools(gs.PausedPools); err != nil {
+		return err
+	}
 	return nil
 }
@@ -111,6 +111,10 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// pool_taker_fee_store are the taker fee overrides of pools.
+	PoolTakerFeeStore []PoolTakerFee `protobuf:"bytes,7,rep,name=pool_taker_fee_store,json=poolTakerFeeStore,proto3" json:"pool_taker_fee_store"`
+	// paused_pools are the ids of the paused pools.
+	PausedPools []uint64 `protobuf:"varint,8,rep,packed,name=paused_pools,json=pausedPools,proto3" json:"paused_pools,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +192,20 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolTakerFeeStore() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFeeStore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedPools() []uint64 {
+	if m != nil {
+		return m.PausedPools
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -227,6 +245,18 @@ type TakerFeeParams struct {
 	// Initially, the taker fee is allowed to be bypassed completely. However
 	// In the future, we will charge a reduced taker fee instead of no fee at all.
 	ReducedFeeWhitelist []string `protobuf:"bytes,6,rep,name=reduced_fee_whitelist,json=reducedFeeWhitelist,proto3" json:"reduced_fee_whitelist,omitempty" yaml:"reduced_fee_whitelist"`
+	// volume_taker_fee_tiers is a list of tiers, sorted by decreasing
+	// discount_multiplier, that discount the taker fee of accounts based on
+	// their trailing swap volume. The taker fee of an account is multiplied by
+	// the discount_multiplier of the last tier it reaches.
+	// Accounts in the reduced_fee_whitelist don't pay any taker fee
+	// regardless of their tier. Account volume is only tracked while tiers
+	// are configured.
+	VolumeTakerFeeTiers []VolumeTakerFeeTier `protobuf:"bytes,7,rep,name=volume_taker_fee_tiers,json=volumeTakerFeeTiers,proto3" json:"volume_taker_fee_tiers" yaml:"volume_taker_fee_tiers"`
+	// volume_taker_fee_window_days is the number of days, including the
+	// current one, over which the volume of an account is summed to determine
+	// its tier.
+	VolumeTakerFeeWindowDays uint64 `protobuf:"varint,8,opt,name=volume_taker_fee_window_days,json=volumeTakerFeeWindowDays,proto3" json:"volume_taker_fee_window_days,omitempty" yaml:"volume_taker_fee_window_days"`
 }
 
 func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
@@ -297,6 +327,74 @@ func (m *TakerFeeParams) GetReducedFeeWhitelist() []string {
 	return nil
 }
 
+func (m *TakerFeeParams) GetVolumeTakerFeeTiers() []VolumeTakerFeeTier {
+	if m != nil {
+		return m.VolumeTakerFeeTiers
+	}
+	return nil
+}
+
+func (m *TakerFeeParams) GetVolumeTakerFeeWindowDays() uint64 {
+	if m != nil {
+		return m.VolumeTakerFeeWindowDays
+	}
+	return 0
+}
+
+// VolumeTakerFeeTier defines the taker fee discount of accounts whose
+// trailing swap volume reaches one of min_volumes.
+type VolumeTakerFeeTier struct {
+	// min_volumes are the trailing volumes required to reach the tier, in
+	// native units of each denom so that they can't be reached by moving a
+	// price. The volume of an account in a denom is the sum of the token in of
+	// the routes it swapped from that denom, and the tier is reached if any of
+	// these volumes is at least the min volume of its denom.
+	MinVolumes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_volumes,json=minVolumes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_volumes" yaml:"min_volumes"`
+	// discount_multiplier is multiplied with the taker fee of the trading pair.
+	// It must be between 0 and 1, i.e. 0.8 charges 80% of the taker fee.
+	DiscountMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount_multiplier,json=discountMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount_multiplier" yaml:"discount_multiplier"`
+}
+
+func (m *VolumeTakerFeeTier) Reset()         { *m = VolumeTakerFeeTier{} }
+func (m *VolumeTakerFeeTier) String() string { return proto.CompactTextString(m) }
+func (*VolumeTakerFeeTier) ProtoMessage()    {}
+func (*VolumeTakerFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+}
+func (m *VolumeTakerFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeTakerFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeTakerFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeTakerFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeTakerFeeTier.Merge(m, src)
+}
+func (m *VolumeTakerFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeTakerFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeTakerFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeTakerFeeTier proto.InternalMessageInfo
+
+func (m *VolumeTakerFeeTier) GetMinVolumes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinVolumes
+	}
+	return nil
+}
+
 // TakerFeeDistributionPercentage defines what percent of the taker fee category
 // gets distributed to the available categories.
 type TakerFeeDistributionPercentage struct {
@@ -308,7 +406,7 @@ func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistribu
 func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
 func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
 }
 func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +445,7 @@ func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
 func (m *TakerFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TakerFeesTracker) ProtoMessage()    {}
 func (*TakerFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
 }
 func (m *TakerFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +508,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,6 +555,7 @@ func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
+	proto.RegisterType((*VolumeTakerFeeTier)(nil), "osmosis.poolmanager.v1beta1.VolumeTakerFeeTier")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
@@ -467,75 +566,87 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1082 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8f, 0xb3, 0xe9, 0xfe, 0x95, 0x49, 0xfe, 0x49, 0x3a, 0x90, 0xc6, 0x4d, 0xca, 0x7a, 0xe5,
-	0x56, 0x62, 0x11, 0x8a, 0x97, 0x06, 0x51, 0x24, 0xa0, 0x87, 0x6c, 0xa2, 0x20, 0x50, 0x69, 0x53,
-	0x27, 0x02, 0xa9, 0x1c, 0x46, 0xb3, 0xf6, 0x13, 0xaf, 0xb5, 0xb6, 0x67, 0x99, 0x19, 0xe7, 0x85,
-	0xaf, 0xc0, 0x05, 0xa9, 0x57, 0xce, 0x1c, 0xb8, 0x21, 0xf1, 0x21, 0x7a, 0xec, 0x11, 0x71, 0xd8,
-	0xa2, 0xe4, 0xcc, 0x65, 0x3f, 0x01, 0xf2, 0xcc, 0xec, 0x6b, 0x93, 0x6d, 0x80, 0xd3, 0xae, 0x9f,
-	0xe7, 0xf9, 0xfd, 0xfc, 0x7b, 0xde, 0x66, 0x8c, 0xde, 0x63, 0x22, 0x65, 0x22, 0x16, 0xf5, 0x0e,
-	0x63, 0x49, 0x4a, 0x33, 0x1a, 0x01, 0xaf, 0x1f, 0xdf, 0x6f, 0x82, 0xa4, 0xf7, 0xeb, 0x11, 0x64,
-	0x20, 0x62, 0xe1, 0x75, 0x38, 0x93, 0x0c, 0x6f, 0x98, 0x50, 0x6f, 0x24, 0xd4, 0x33, 0xa1, 0xeb,
-	0x6f, 0x47, 0x2c, 0x62, 0x2a, 0xae, 0x5e, 0xfc, 0xd3, 0x90, 0xf5, 0xdb, 0x11, 0x63, 0x51, 0x02,
-	0x75, 0xf5, 0xd4, 0xcc, 0x8f, 0xea, 0x34, 0x3b, 0xeb, 0xbb, 0x02, 0x45, 0x47, 0x34, 0x46, 0x3f,
-	0x18, 0x57, 0x65, 0x12, 0x15, 0xe6, 0x9c, 0xca, 0x98, 0x65, 0x7d, 0xbf, 0x8e, 0xae, 0x37, 0xa9,
-	0x80, 0x81, 0xd6, 0x80, 0xc5, 0x7d, 0xbf, 0x37, 0x2d, 0xa7, 0x94, 0x85, 0x79, 0x02, 0x84, 0xb3,
-	0x5c, 0x82, 0x89, 0xbf, 0x37, 0x2d, 0x5e, 0x9e, 0xea, 0x28, 0xb7, 0x37, 0x8b, 0xca, 0xfb, 0x94,
-	0xd3, 0x54, 0xe0, 0xe7, 0x16, 0xba, 0x59, 0xc4, 0x92, 0x80, 0x83, 0x12, 0x46, 0x8e, 0x00, 0x6c,
-	0xab, 0x5a, 0xaa, 0x2d, 0x6c, 0xdd, 0xf6, 0x4c, 0x2e, 0x85, 0xba, 0x7e, 0x79, 0xbc, 0x1d, 0x16,
-	0x67, 0x8d, 0x47, 0x2f, 0xba, 0xce, 0x4c, 0xaf, 0xeb, 0xd8, 0x67, 0x34, 0x4d, 0x3e, 0x71, 0x5f,
-	0x63, 0x70, 0x7f, 0x79, 0xe5, 0xd4, 0xa2, 0x58, 0xb6, 0xf2, 0xa6, 0x17, 0xb0, 0xd4, 0x14, 0xc5,
-	0xfc, 0x6c, 0x8a, 0xb0, 0x5d, 0x97, 0x67, 0x1d, 0x10, 0x8a, 0x4c, 0xf8, 0xcb, 0x05, 0x7e, 0xc7,
-	0xc0, 0xf7, 0x00, 0xf0, 0x31, 0x5a, 0x91, 0xb4, 0x0d, 0xbc, 0xa0, 0x22, 0x1d, 0xa5, 0xd4, 0x9e,
-	0xad, 0x5a, 0xb5, 0x85, 0xad, 0xf7, 0xbd, 0x29, 0xad, 0xf3, 0x0e, 0x0b, 0xd0, 0x1e, 0x80, 0x4e,
-	0xae, 0xe1, 0x18, 0x95, 0x6b, 0x5a, 0xe5, 0x24, 0xa5, 0xeb, 0x2f, 0xc9, 0x31, 0x00, 0x7e, 0x86,
-	0xd6, 0x68, 0x2e, 0x5b, 0x8c, 0xc7, 0xdf, 0x43, 0x48, 0xbe, 0xcb, 0x99, 0x04, 0x12, 0x42, 0xc6,
-	0x52, 0x61, 0x97, 0xaa, 0xa5, 0xda, 0x7c, 0xc3, 0xed, 0x75, 0x9d, 0x8a, 0x66, 0xbb, 0x22, 0xd0,
-	0xf5, 0x57, 0x87, 0x9e, 0xa7, 0x85, 0x63, 0x57, 0xdb, 0x5f, 0x95, 0xd0, 0xe2, 0xe7, 0x7a, 0x0a,
-	0x0f, 0x24, 0x95, 0x80, 0xab, 0x68, 0x31, 0x83, 0x53, 0x49, 0x54, 0xf1, 0xe2, 0xd0, 0xb6, 0xaa,
-	0x56, 0x6d, 0xce, 0x47, 0x85, 0x6d, 0x9f, 0xb1, 0xe4, 0x8b, 0x10, 0x6f, 0xa3, 0xf2, 0x58, 0xf2,
-	0x77, 0xa7, 0x26, 0x6f, 0x92, 0x9e, 0x2b, 0x92, 0xf6, 0x0d, 0x10, 0x3f, 0x41, 0x0b, 0x8a, 0x5f,
-	0x0d, 0x89, 0xce, 0x62, 0x61, 0xab, 0x36, 0x95, 0xe7, 0x2b, 0x35, 0x56, 0x7e, 0x01, 0x30, 0x64,
-	0xa8, 0x08, 0x53, 0x06, 0x81, 0xbf, 0x45, 0x78, 0x50, 0x47, 0x41, 0x24, 0xa7, 0x41, 0x1b, 0xb8,
-	0x3d, 0xa7, 0xf4, 0x6d, 0x5e, 0xab, 0x39, 0xe2, 0x50, 0x83, 0xfc, 0x15, 0x39, 0x61, 0xc1, 0x5f,
-	0xa2, 0x45, 0xa5, 0xf6, 0x98, 0x25, 0x79, 0x0a, 0xc2, 0xbe, 0xa1, 0xe4, 0xbe, 0x3b, 0x3d, 0x6d,
-	0xc6, 0x92, 0xaf, 0x55, 0xbc, 0xaf, 0x52, 0xd5, 0xff, 0x05, 0xee, 0xa0, 0x75, 0xd5, 0x11, 0xd2,
-	0xa1, 0x31, 0x27, 0xc3, 0xde, 0x0b, 0xc9, 0x38, 0xd8, 0x65, 0xc5, 0xec, 0x4d, 0x65, 0x56, 0x8d,
-	0xdb, 0xa7, 0x31, 0xef, 0x2b, 0x37, 0xe5, 0xb8, 0x15, 0x4e, 0x3a, 0x0e, 0x0a, 0x4e, 0xf7, 0x87,
-	0x32, 0x5a, 0x1a, 0x9f, 0x40, 0xdc, 0x44, 0x37, 0x43, 0x38, 0xa2, 0x79, 0x22, 0x87, 0x0a, 0x54,
-	0xa3, 0xe7, 0x1b, 0x0f, 0x0a, 0xae, 0x3f, 0xba, 0xce, 0x86, 0x5e, 0x0a, 0x11, 0xb6, 0xbd, 0x98,
-	0xd5, 0x53, 0x2a, 0x5b, 0xde, 0x23, 0x88, 0x68, 0x70, 0xb6, 0x0b, 0xc1, 0x79, 0xd7, 0x59, 0xde,
-	0xd5, 0xf8, 0x3e, 0xb1, 0xbf, 0x1c, 0x8e, 0x1b, 0xf0, 0x4f, 0x16, 0x52, 0xe7, 0xd9, 0x48, 0x8e,
-	0x61, 0x2c, 0x24, 0x8f, 0x9b, 0x79, 0xb1, 0x4f, 0x66, 0x76, 0x3e, 0xbd, 0x56, 0x6f, 0x76, 0x47,
-	0x80, 0xfb, 0xc0, 0x03, 0xc8, 0x24, 0x8d, 0xa0, 0x51, 0x2d, 0xb4, 0x9e, 0x77, 0x1d, 0xfb, 0x89,
-	0x48, 0xd9, 0x65, 0xb1, 0xbe, 0xcd, 0xae, 0xf0, 0xe0, 0x9f, 0x2d, 0xe4, 0x64, 0x2c, 0x23, 0xd3,
-	0x24, 0x96, 0xfe, 0xbb, 0xc4, 0xbb, 0x46, 0xe2, 0xc6, 0x63, 0x96, 0x5d, 0xa9, 0x72, 0x23, 0xbb,
-	0xda, 0x89, 0x77, 0xd0, 0x32, 0x0d, 0xd3, 0x38, 0x23, 0x34, 0x0c, 0x39, 0x08, 0x01, 0xc2, 0x9e,
-	0x53, 0x4b, 0xbf, 0xde, 0xeb, 0x3a, 0xb7, 0xcc, 0xd2, 0x8f, 0x07, 0xb8, 0xfe, 0x92, 0xb2, 0x6c,
-	0xf7, 0x0d, 0xf8, 0x57, 0x0b, 0x3d, 0x08, 0x58, 0x9a, 0xe6, 0x59, 0x2c, 0xcf, 0xf4, 0x6a, 0xeb,
-	0x29, 0x94, 0x8c, 0x88, 0x13, 0xda, 0x21, 0x45, 0x29, 0x4e, 0x5a, 0xb1, 0x84, 0x24, 0x16, 0x12,
-	0x42, 0x42, 0x85, 0x00, 0x29, 0x88, 0x64, 0xf6, 0x0d, 0x35, 0x16, 0xdb, 0xbd, 0xae, 0xf3, 0x50,
-	0xbf, 0xec, 0xdf, 0xf1, 0xb8, 0xbe, 0x37, 0x00, 0x16, 0xbb, 0xa1, 0xa6, 0xf8, 0x90, 0x1d, 0x9c,
-	0xd0, 0xce, 0x63, 0x96, 0x7d, 0x33, 0x84, 0x6c, 0x2b, 0xc4, 0x21, 0xc3, 0x87, 0x68, 0x95, 0x43,
-	0x98, 0x07, 0x10, 0xaa, 0xce, 0x0c, 0x58, 0xd5, 0x92, 0xcc, 0x37, 0xaa, 0xbd, 0xae, 0x73, 0x47,
-	0x2b, 0xba, 0x34, 0xcc, 0xf5, 0xdf, 0x32, 0xf6, 0x3d, 0x80, 0x01, 0xbf, 0xfb, 0x97, 0x85, 0x2a,
-	0xd3, 0x7b, 0x86, 0x8f, 0xd0, 0xb2, 0x90, 0xb4, 0x1d, 0x67, 0x11, 0xe1, 0x70, 0x42, 0x79, 0x28,
-	0xcc, 0x6e, 0x3c, 0xbc, 0xc6, 0x6e, 0x0c, 0x9b, 0x32, 0xc1, 0xe1, 0xfa, 0x4b, 0xc6, 0xe2, 0x6b,
-	0x03, 0x0e, 0xd0, 0xd2, 0x78, 0x2d, 0xd5, 0x4e, 0xcc, 0x37, 0x3e, 0xbb, 0xde, 0x6b, 0x56, 0x2f,
-	0x6b, 0x87, 0xeb, 0xff, 0x7f, 0xac, 0xcc, 0xee, 0x6f, 0xb3, 0x68, 0x65, 0xf2, 0x88, 0xc3, 0x3e,
-	0x5a, 0x1d, 0x3d, 0x2d, 0x19, 0x11, 0xea, 0x51, 0xbc, 0xf9, 0x86, 0xd5, 0x47, 0x0d, 0x1e, 0x1e,
-	0x91, 0xec, 0x40, 0x43, 0x31, 0x41, 0x77, 0xc6, 0x39, 0x5f, 0xcb, 0xed, 0x5a, 0xd4, 0xf6, 0x08,
-	0xf5, 0xce, 0x68, 0x26, 0xb8, 0x8d, 0xde, 0x69, 0x41, 0x1c, 0xb5, 0x24, 0xa1, 0x41, 0xc0, 0xf2,
-	0x4c, 0x16, 0xc5, 0x15, 0x92, 0x72, 0x29, 0xc8, 0x11, 0x67, 0xa9, 0x5a, 0xd7, 0x52, 0xa3, 0xd6,
-	0xeb, 0x3a, 0xf7, 0x74, 0x69, 0xa6, 0x86, 0xbb, 0xfe, 0xba, 0xf6, 0x6f, 0x0f, 0xdc, 0x07, 0xca,
-	0xbb, 0x57, 0x38, 0x9f, 0x5b, 0x08, 0x0d, 0x8f, 0x70, 0xbc, 0x86, 0xfe, 0x37, 0x7e, 0x1f, 0x96,
-	0x3b, 0xfa, 0x2e, 0x4c, 0xcc, 0x45, 0xa6, 0xaf, 0x86, 0x37, 0x27, 0xf9, 0x41, 0x91, 0xe4, 0x3f,
-	0xfa, 0x0a, 0x41, 0xc3, 0xdb, 0xa3, 0xf1, 0xf4, 0xc5, 0x79, 0xc5, 0x7a, 0x79, 0x5e, 0xb1, 0xfe,
-	0x3c, 0xaf, 0x58, 0x3f, 0x5e, 0x54, 0x66, 0x5e, 0x5e, 0x54, 0x66, 0x7e, 0xbf, 0xa8, 0xcc, 0x3c,
-	0xfb, 0x78, 0x84, 0xcf, 0x1c, 0x57, 0x9b, 0x09, 0x6d, 0x8a, 0xfe, 0x43, 0xfd, 0x78, 0xeb, 0xa3,
-	0xfa, 0xe9, 0xd8, 0xf7, 0x97, 0x7a, 0x49, 0xb3, 0xac, 0xbe, 0xbd, 0x3e, 0xfc, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x4e, 0x2e, 0x0e, 0x3e, 0xa7, 0x0a, 0x00, 0x00,
+	// 1272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6f, 0xdc, 0x44,
+	0x18, 0x8f, 0x93, 0x6d, 0xda, 0xcc, 0x86, 0xa4, 0x9d, 0x36, 0xad, 0x9b, 0xb4, 0xeb, 0xc5, 0x2d,
+	0xea, 0x56, 0xa8, 0x5e, 0x1a, 0x44, 0x91, 0x80, 0x1e, 0xb2, 0x89, 0x82, 0x40, 0x7d, 0xa4, 0x4e,
+	0x44, 0xa5, 0x72, 0x30, 0xb3, 0xf6, 0x64, 0x33, 0x8a, 0xed, 0x31, 0x33, 0xe3, 0xa4, 0xe1, 0xd8,
+	0x2b, 0x42, 0x42, 0xea, 0x95, 0x33, 0x07, 0x6e, 0x48, 0xfc, 0x11, 0x3d, 0xf6, 0x88, 0x38, 0x2c,
+	0x28, 0xe5, 0xca, 0x65, 0xff, 0x02, 0x34, 0x0f, 0xef, 0x2b, 0xc9, 0x66, 0x0b, 0xa7, 0xc4, 0xdf,
+	0xe3, 0xf7, 0xfd, 0xbe, 0xf9, 0x1e, 0x33, 0x0b, 0x6e, 0x53, 0x9e, 0x50, 0x4e, 0x78, 0x3d, 0xa3,
+	0x34, 0x4e, 0x50, 0x8a, 0x5a, 0x98, 0xd5, 0xf7, 0xee, 0x36, 0xb1, 0x40, 0x77, 0xeb, 0x2d, 0x9c,
+	0x62, 0x4e, 0xb8, 0x97, 0x31, 0x2a, 0x28, 0x5c, 0x32, 0xa6, 0x5e, 0x9f, 0xa9, 0x67, 0x4c, 0x17,
+	0x2f, 0xb5, 0x68, 0x8b, 0x2a, 0xbb, 0xba, 0xfc, 0x4f, 0xbb, 0x2c, 0x5e, 0x6d, 0x51, 0xda, 0x8a,
+	0x71, 0x5d, 0x7d, 0x35, 0xf3, 0xed, 0x3a, 0x4a, 0x0f, 0x0a, 0x55, 0xa8, 0xe0, 0x02, 0xed, 0xa3,
+	0x3f, 0x8c, 0xaa, 0x32, 0xec, 0x15, 0xe5, 0x0c, 0x09, 0x42, 0xd3, 0x42, 0xaf, 0xad, 0xeb, 0x4d,
+	0xc4, 0x71, 0x97, 0x6b, 0x48, 0x49, 0xa1, 0xf7, 0x46, 0xe5, 0x94, 0xd0, 0x28, 0x8f, 0x71, 0xc0,
+	0x68, 0x2e, 0xb0, 0xb1, 0xbf, 0x39, 0xca, 0x5e, 0x3c, 0xd7, 0x56, 0x6e, 0x67, 0x12, 0x4c, 0x6f,
+	0x20, 0x86, 0x12, 0x0e, 0x5f, 0x5a, 0xe0, 0x82, 0xb4, 0x0d, 0x42, 0x86, 0x15, 0xb1, 0x60, 0x1b,
+	0x63, 0xdb, 0xaa, 0x4e, 0xd5, 0xca, 0xcb, 0x57, 0x3d, 0x93, 0x8b, 0x64, 0x57, 0x1c, 0x8f, 0xb7,
+	0x4a, 0x49, 0xda, 0x78, 0xf0, 0xaa, 0xed, 0x4c, 0x74, 0xda, 0x8e, 0x7d, 0x80, 0x92, 0xf8, 0x13,
+	0xf7, 0x08, 0x82, 0xfb, 0xcb, 0x9f, 0x4e, 0xad, 0x45, 0xc4, 0x4e, 0xde, 0xf4, 0x42, 0x9a, 0x98,
+	0x43, 0x31, 0x7f, 0xee, 0xf0, 0x68, 0xb7, 0x2e, 0x0e, 0x32, 0xcc, 0x15, 0x18, 0xf7, 0xe7, 0xa5,
+	0xff, 0xaa, 0x71, 0x5f, 0xc7, 0x18, 0xee, 0x81, 0xf3, 0x02, 0xed, 0x62, 0x26, 0xa1, 0x82, 0x4c,
+	0x31, 0xb5, 0x27, 0xab, 0x56, 0xad, 0xbc, 0xfc, 0xbe, 0x37, 0xa2, 0x74, 0xde, 0x96, 0x74, 0x5a,
+	0xc7, 0x58, 0x27, 0xd7, 0x70, 0x0c, 0xcb, 0x2b, 0x9a, 0xe5, 0x30, 0xa4, 0xeb, 0xcf, 0x89, 0x01,
+	0x07, 0xf8, 0x0c, 0x5c, 0x41, 0xb9, 0xd8, 0xa1, 0x8c, 0x7c, 0x87, 0xa3, 0xe0, 0xdb, 0x9c, 0x0a,
+	0x1c, 0x44, 0x38, 0xa5, 0x09, 0xb7, 0xa7, 0xaa, 0x53, 0xb5, 0x99, 0x86, 0xdb, 0x69, 0x3b, 0x15,
+	0x8d, 0x76, 0x82, 0xa1, 0xeb, 0x2f, 0xf4, 0x34, 0x4f, 0xa4, 0x62, 0x4d, 0xcb, 0xff, 0x2e, 0x81,
+	0xd9, 0xcf, 0x75, 0x17, 0x6e, 0x0a, 0x24, 0x30, 0xac, 0x82, 0xd9, 0x14, 0x3f, 0x17, 0x81, 0x3a,
+	0x3c, 0x12, 0xd9, 0x56, 0xd5, 0xaa, 0x95, 0x7c, 0x20, 0x65, 0x1b, 0x94, 0xc6, 0x5f, 0x44, 0x70,
+	0x05, 0x4c, 0x0f, 0x24, 0x7f, 0x63, 0x64, 0xf2, 0x26, 0xe9, 0x92, 0x4c, 0xda, 0x37, 0x8e, 0xf0,
+	0x31, 0x28, 0x2b, 0x7c, 0xd5, 0x24, 0x3a, 0x8b, 0xf2, 0x72, 0x6d, 0x24, 0xce, 0x43, 0xd5, 0x56,
+	0xbe, 0x74, 0x30, 0x60, 0x40, 0x9a, 0x29, 0x01, 0x87, 0x5f, 0x03, 0xd8, 0x3d, 0x47, 0x1e, 0x08,
+	0x86, 0xc2, 0x5d, 0xcc, 0xec, 0x92, 0xe2, 0x77, 0x67, 0xac, 0xe2, 0xf0, 0x2d, 0xed, 0xe4, 0x9f,
+	0x17, 0x43, 0x12, 0xf8, 0x25, 0x98, 0x55, 0x6c, 0xf7, 0x68, 0x9c, 0x27, 0x98, 0xdb, 0x67, 0x14,
+	0xdd, 0x5b, 0xa3, 0xd3, 0xa6, 0x34, 0xfe, 0x4a, 0xd9, 0xfb, 0xe5, 0xac, 0xfb, 0x3f, 0x87, 0x19,
+	0x58, 0x54, 0x15, 0x09, 0x32, 0x44, 0x58, 0xd0, 0xab, 0x3d, 0x17, 0x94, 0x61, 0x7b, 0x5a, 0x21,
+	0x7b, 0x23, 0x91, 0x55, 0xe1, 0x36, 0x10, 0x61, 0x05, 0x73, 0x73, 0x1c, 0x97, 0xa3, 0x61, 0xc5,
+	0xa6, 0xc4, 0x84, 0xdf, 0x80, 0x4b, 0x8a, 0xfd, 0x70, 0xac, 0xb3, 0x2a, 0xd6, 0xed, 0x53, 0xb3,
+	0x18, 0x0a, 0x73, 0x21, 0xeb, 0x93, 0xe9, 0x08, 0xef, 0x82, 0xd9, 0x0c, 0xe5, 0x1c, 0x47, 0xaa,
+	0x69, 0xb8, 0x7d, 0xae, 0x3a, 0x55, 0x2b, 0xf9, 0x65, 0x2d, 0x93, 0x10, 0xdc, 0x7d, 0x71, 0x0e,
+	0xcc, 0x0d, 0x8e, 0x01, 0x6c, 0x82, 0x0b, 0x11, 0xde, 0x46, 0x79, 0x2c, 0x7a, 0xd4, 0x54, 0xb7,
+	0xcd, 0x34, 0xee, 0xc9, 0x48, 0x7f, 0xb4, 0x9d, 0x25, 0x3d, 0x99, 0x3c, 0xda, 0xf5, 0x08, 0xad,
+	0x27, 0x48, 0xec, 0x78, 0x0f, 0x70, 0x0b, 0x85, 0x07, 0x6b, 0x38, 0x3c, 0x6c, 0x3b, 0xf3, 0x6b,
+	0xda, 0xbf, 0x00, 0xf6, 0xe7, 0xa3, 0x41, 0x01, 0xfc, 0xc9, 0x02, 0x6a, 0xa9, 0xf6, 0x25, 0x1f,
+	0x11, 0x2e, 0x18, 0x69, 0xe6, 0x72, 0xa8, 0x4d, 0x03, 0x7f, 0x3a, 0x56, 0x83, 0xac, 0xf5, 0x39,
+	0x6e, 0x60, 0x16, 0xe2, 0x54, 0xa0, 0x16, 0x6e, 0x54, 0x25, 0xd7, 0xc3, 0xb6, 0x63, 0x3f, 0xe6,
+	0x09, 0x3d, 0xce, 0xd6, 0xb7, 0xe9, 0x09, 0x1a, 0xf8, 0xb3, 0x05, 0x9c, 0x94, 0xa6, 0xc1, 0x28,
+	0x8a, 0x53, 0xff, 0x9f, 0xe2, 0x0d, 0x43, 0x71, 0xe9, 0x11, 0x4d, 0x4f, 0x64, 0xb9, 0x94, 0x9e,
+	0xac, 0x84, 0xab, 0x60, 0x1e, 0x45, 0x09, 0x49, 0x03, 0x14, 0x45, 0x0c, 0x73, 0x8e, 0xb9, 0x5d,
+	0x52, 0x9b, 0x67, 0xb1, 0xd3, 0x76, 0x2e, 0x9b, 0xcd, 0x33, 0x68, 0xe0, 0xfa, 0x73, 0x4a, 0xb2,
+	0x52, 0x08, 0xe0, 0xaf, 0x16, 0xb8, 0x17, 0xd2, 0x24, 0xc9, 0x53, 0x22, 0x0e, 0xf4, 0x7e, 0xd1,
+	0xa3, 0x20, 0x68, 0xc0, 0xf7, 0x51, 0x16, 0xc8, 0xa3, 0xd8, 0xdf, 0x21, 0x02, 0xc7, 0x84, 0x0b,
+	0x1c, 0x05, 0x88, 0x73, 0x2c, 0x78, 0x20, 0xa8, 0x7d, 0x46, 0xb5, 0xc5, 0x4a, 0xa7, 0xed, 0xdc,
+	0xd7, 0xc1, 0xfe, 0x1b, 0x8e, 0xeb, 0x7b, 0x5d, 0x47, 0xd9, 0x97, 0x6a, 0x94, 0xb6, 0xe8, 0xe6,
+	0x3e, 0xca, 0x1e, 0xd1, 0xf4, 0x69, 0xcf, 0x65, 0x45, 0x79, 0x6c, 0x51, 0xb8, 0x05, 0x16, 0x18,
+	0x8e, 0xf2, 0x10, 0x47, 0xaa, 0x32, 0x5d, 0x54, 0x35, 0xa9, 0x33, 0x8d, 0x6a, 0xa7, 0xed, 0x5c,
+	0xd3, 0x8c, 0x8e, 0x35, 0x73, 0xfd, 0x8b, 0x46, 0xbe, 0x8e, 0x71, 0x17, 0x1f, 0x7e, 0x6f, 0x81,
+	0xcb, 0x7a, 0x99, 0xf4, 0x55, 0x5d, 0x10, 0xcc, 0xb8, 0x99, 0xca, 0xfa, 0xc8, 0x72, 0xeb, 0x5d,
+	0x52, 0x14, 0x6a, 0x8b, 0x60, 0xd6, 0x78, 0xcf, 0xdc, 0x29, 0xd7, 0x35, 0x99, 0xe3, 0xc1, 0x5d,
+	0xff, 0xe2, 0xde, 0x11, 0x57, 0x0e, 0x5b, 0xe0, 0xda, 0x11, 0xfb, 0x7d, 0x92, 0x46, 0x74, 0x3f,
+	0x88, 0xd0, 0x81, 0x1c, 0x67, 0xab, 0x56, 0x6a, 0xdc, 0xea, 0xb4, 0x9d, 0x1b, 0x27, 0xa0, 0xf7,
+	0x59, 0xbb, 0xbe, 0x3d, 0x18, 0xe3, 0xa9, 0xd2, 0xad, 0x49, 0xd5, 0x0f, 0x93, 0x00, 0x1e, 0xe5,
+	0x0e, 0x5f, 0x58, 0xa0, 0x2c, 0x5b, 0xa7, 0x58, 0xaf, 0xa7, 0x5e, 0xf3, 0xeb, 0x26, 0x59, 0xa8,
+	0xe9, 0xf4, 0xf9, 0xbe, 0xdd, 0x05, 0x0f, 0x12, 0x92, 0x16, 0x7b, 0x99, 0x81, 0x8b, 0x11, 0xe1,
+	0x21, 0xcd, 0x53, 0x11, 0x24, 0x79, 0x2c, 0x48, 0x16, 0x13, 0xcc, 0xd4, 0x82, 0x98, 0x69, 0xac,
+	0x8c, 0xb1, 0x8f, 0x3a, 0x6d, 0x67, 0x51, 0xf3, 0x39, 0x06, 0xc7, 0xf5, 0x61, 0x21, 0x7d, 0xd8,
+	0x13, 0xfe, 0x63, 0x81, 0xca, 0xe8, 0xd1, 0x85, 0xdb, 0x60, 0x9e, 0x0b, 0xb4, 0x4b, 0xd2, 0x56,
+	0xc0, 0xf0, 0x3e, 0x62, 0x11, 0x37, 0x2b, 0xf2, 0xfe, 0x78, 0x94, 0xcc, 0x6c, 0x0e, 0x61, 0xb8,
+	0xfe, 0x9c, 0x91, 0xf8, 0x5a, 0x00, 0x43, 0x30, 0x37, 0x38, 0x52, 0x26, 0xf3, 0xcf, 0xc6, 0x0b,
+	0xb3, 0x70, 0xdc, 0x54, 0xba, 0xfe, 0x3b, 0x03, 0xd3, 0xe6, 0xfe, 0x36, 0x09, 0xce, 0x0f, 0x5f,
+	0xb7, 0xd0, 0x07, 0x0b, 0xfd, 0x37, 0x37, 0x0d, 0xb8, 0xfa, 0x1c, 0xa3, 0x0d, 0xf4, 0x7d, 0x04,
+	0x7b, 0xd7, 0x35, 0xdd, 0xd4, 0xae, 0x30, 0x00, 0xd7, 0x06, 0x31, 0x8f, 0xe4, 0x36, 0x16, 0xb4,
+	0xdd, 0x07, 0xbd, 0xda, 0x9f, 0x09, 0xdc, 0x05, 0xd7, 0x77, 0x30, 0x69, 0xed, 0x88, 0x00, 0x85,
+	0xaa, 0xaa, 0xf2, 0x70, 0xb9, 0x40, 0x4c, 0xf0, 0x60, 0x9b, 0xd1, 0x44, 0x6d, 0xed, 0xa9, 0x46,
+	0xad, 0xd3, 0x76, 0x6e, 0xea, 0xa3, 0x19, 0x69, 0xee, 0xfa, 0x8b, 0x5a, 0xbf, 0xd2, 0x55, 0x6f,
+	0x2a, 0xed, 0xba, 0x54, 0xbe, 0xb4, 0x00, 0xe8, 0x3d, 0x27, 0xe0, 0x15, 0x70, 0x76, 0xf0, 0x6d,
+	0x36, 0x9d, 0xe9, 0x77, 0x59, 0x6c, 0x1e, 0x55, 0x7a, 0x16, 0x4e, 0x4f, 0xf2, 0x03, 0x99, 0xe4,
+	0xdb, 0x0d, 0x4c, 0xef, 0x25, 0xd3, 0x78, 0xf2, 0xea, 0xb0, 0x62, 0xbd, 0x3e, 0xac, 0x58, 0x7f,
+	0x1d, 0x56, 0xac, 0x1f, 0xdf, 0x54, 0x26, 0x5e, 0xbf, 0xa9, 0x4c, 0xfc, 0xfe, 0xa6, 0x32, 0xf1,
+	0xec, 0xe3, 0x3e, 0x3c, 0xb3, 0xc6, 0xee, 0xc4, 0xa8, 0xc9, 0x8b, 0x8f, 0xfa, 0xde, 0xf2, 0x47,
+	0xf5, 0xe7, 0x03, 0xbf, 0x05, 0x54, 0x90, 0xe6, 0xb4, 0xfa, 0x1d, 0xf0, 0xe1, 0xbf, 0x03, 0x00,
+	0x1d, 0x9e, 0x14, 0x29, 0x33, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +725,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedPools) > 0 {
+		dAtA3 := make([]byte, len(m.PausedPools)*10)
+		var j2 int
+		for _, num := range m.PausedPools {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for iNdEx := len(m.PoolTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFeeStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +849,25 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VolumeTakerFeeWindowDays != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VolumeTakerFeeWindowDays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.VolumeTakerFeeTiers) > 0 {
+		for iNdEx := len(m.VolumeTakerFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeTakerFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ReducedFeeWhitelist) > 0 {
 		for iNdEx := len(m.ReducedFeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReducedFeeWhitelist[iNdEx])
@@ -764,6 +926,53 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolumeTakerFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeTakerFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeTakerFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DiscountMultiplier.Size()
+		i -= size
+		if _, err := m.DiscountMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinVolumes) > 0 {
+		for iNdEx := len(m.MinVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TakerFeeDistributionPercentage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -972,6 +1181,19 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for _, e := range m.PoolTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedPools) > 0 {
+		l = 0
+		for _, e := range m.PausedPools {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1003,6 +1225,32 @@ func (m *TakerFeeParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VolumeTakerFeeTiers) > 0 {
+		for _, e := range m.VolumeTakerFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VolumeTakerFeeWindowDays != 0 {
+		n += 1 + sovGenesis(uint64(m.VolumeTakerFeeWindowDays))
+	}
+	return n
+}
+
+func (m *VolumeTakerFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinVolumes) > 0 {
+		for _, e := range m.MinVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DiscountMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1435,6 +1683,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFeeStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTakerFeeStore = append(m.PoolTakerFeeStore, PoolTakerFee{})
+			if err := m.PoolTakerFeeStore[len(m.PoolTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedPools = append(m.PausedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedPools) == 0 {
+					m.PausedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedPools = append(m.PausedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1681,6 +2039,177 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ReducedFeeWhitelist = append(m.ReducedFeeWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTakerFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeTakerFeeTiers = append(m.VolumeTakerFeeTiers, VolumeTakerFeeTier{})
+			if err := m.VolumeTakerFeeTiers[len(m.VolumeTakerFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTakerFeeWindowDays", wireType)
+			}
+			m.VolumeTakerFeeWindowDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolumeTakerFeeWindowDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeTakerFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeTakerFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeTakerFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinVolumes = append(m.MinVolumes, types.Coin{})
+			if err := m.MinVolumes[len(m.MinVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DiscountMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -57,6 +58,15 @@ var (
 
 	// KeyRegisteredAlloyPool defines the key to store registered alloy pool data.
 	KeyRegisteredAlloyPool = []byte{0x0C}
+
+	// KeyAccountVolumePrefix defines the prefix to store the daily OSMO denominated swap volume of accounts.
+	KeyAccountVolumePrefix = []byte{0x0D}
+
+	// KeyPoolTakerFeePrefix defines the prefix to store the taker fee overrides of pools.
+	KeyPoolTakerFeePrefix = []byte{0x0E}
+
+	// KeyPausedPoolPrefix defines the prefix to store the paused pools.
+	KeyPausedPoolPrefix = []byte{0x0F}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -97,6 +107,51 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// KeyAccountVolumes returns the prefix of the daily volumes of the given account.
+func KeyAccountVolumes(account sdk.AccAddress) []byte {
+	return append(bytes.Clone(KeyAccountVolumePrefix), address.MustLengthPrefix(account)...)
+}
+
+// KeyAccountVolume returns the key for the volume of the given account on the given day.
+// Days are big endian encoded so that the volumes of an account are iterated in chronological order.
+func KeyAccountVolume(account sdk.AccAddress, day uint64) []byte {
+	return append(KeyAccountVolumes(account), sdk.Uint64ToBigEndian(day)...)
+}
+
+// ParseAccountVolumeDay parses the day of a key returned by KeyAccountVolume.
+func ParseAccountVolumeDay(key []byte) (uint64, error) {
+	if len(key) < 8 {
+		return 0, fmt.Errorf("invalid account volume key: %x", key)
+	}
+	return sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
+
+// KeyPoolTakerFee returns the key for the taker fee override of the given pool.
+func KeyPoolTakerFee(poolId uint64) []byte {
+	return append(bytes.Clone(KeyPoolTakerFeePrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// ParsePoolTakerFeeKey parses the pool id of a key returned by KeyPoolTakerFee.
+func ParsePoolTakerFeeKey(key []byte) (uint64, error) {
+	if len(key) != len(KeyPoolTakerFeePrefix)+8 {
+		return 0, fmt.Errorf("invalid pool taker fee key: %x", key)
+	}
+	return sdk.BigEndianToUint64(key[len(KeyPoolTakerFeePrefix):]), nil
+}
+
+// KeyPausedPool returns the key marking the given pool as paused.
+func KeyPausedPool(poolId uint64) []byte {
+	return append(bytes.Clone(KeyPausedPoolPrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// ParsePausedPoolKey parses the pool id of a key returned by KeyPausedPool.
+func ParsePausedPoolKey(key []byte) (uint64, error) {
+	if len(key) != len(KeyPausedPoolPrefix)+8 {
+		return 0, fmt.Errorf("invalid paused pool key: %x", key)
+	}
+	return sdk.BigEndianToUint64(key[len(KeyPausedPoolPrefix):]), nil
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (tokenInDenom, tokenOutDenom string, err error) {
 	keyStr := string(key)
@@ -17,6 +17,14 @@ const (
 	TypeMsgSetDenomPairTakerFee                  = "set_denom_pair_taker_fee"
 	TypeMsgSetTakerFeeShareAgreementForDenomPair = "set_taker_fee_share_agreement_for_denom_pair"
 	TypeMsgSetRegisteredAlloyedPool              = "set_registered_alloyed_pool"
+	TypeMsgSetPoolTakerFee                       = "set_pool_taker_fee"
+	TypeMsgRemovePoolTakerFee                    = "remove_pool_taker_fee"
+	TypeMsgBatchSwap                             = "batch_swap"
+	TypeMsgPausePools                            = "pause_pools"
+	TypeMsgUnpausePools                          = "unpause_pools"
+
+	// MaxBatchSwapLegs bounds the number of swaps of a MsgBatchSwap.
+	MaxBatchSwapLegs = 10
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -43,7 +51,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return msg.SwapLimits().Validate()
 }
 
 func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -77,7 +85,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
-	return nil
+	return msg.SwapLimits().Validate()
 }
 
 func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
@@ -111,7 +119,7 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return msg.SwapLimits().Validate()
 }
 
 func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -145,7 +153,7 @@ func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
-	return nil
+	return msg.SwapLimits().Validate()
 }
 
 func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
@@ -156,6 +164,73 @@ func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgBatchSwap{}
+
+func (msg MsgBatchSwap) Route() string { return RouterKey }
+func (msg MsgBatchSwap) Type() string  { return TypeMsgBatchSwap }
+
+func (msg MsgBatchSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.Legs) == 0 {
+		return ErrEmptyBatchSwapLegs
+	}
+	if len(msg.Legs) > MaxBatchSwapLegs {
+		return TooManyBatchSwapLegsError{NumLegs: len(msg.Legs), MaxLegs: MaxBatchSwapLegs}
+	}
+
+	for i, leg := range msg.Legs {
+		if err := leg.validate(msg.Sender); err != nil {
+			return BatchSwapLegError{Index: i, Err: err}
+		}
+	}
+
+	if _, ok := BatchSwapPolicy_name[int32(msg.Policy)]; !ok {
+		return InvalidBatchSwapPolicyError{Policy: msg.Policy}
+	}
+
+	return nil
+}
+
+func (msg MsgBatchSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validate validates the leg as the swap message it's equivalent to.
+func (leg BatchSwapLeg) validate(sender string) error {
+	switch {
+	case leg.ExactIn != nil && leg.ExactOut == nil:
+		return MsgSwapExactAmountIn{
+			Sender:            sender,
+			Routes:            leg.ExactIn.Routes,
+			TokenIn:           leg.ExactIn.TokenIn,
+			TokenOutMinAmount: leg.ExactIn.TokenOutMinAmount,
+			Deadline:          leg.ExactIn.Deadline,
+			MaxPriceImpact:    leg.ExactIn.MaxPriceImpact,
+			LimitSpotPrice:    leg.ExactIn.LimitSpotPrice,
+		}.ValidateBasic()
+	case leg.ExactIn == nil && leg.ExactOut != nil:
+		return MsgSwapExactAmountOut{
+			Sender:           sender,
+			Routes:           leg.ExactOut.Routes,
+			TokenInMaxAmount: leg.ExactOut.TokenInMaxAmount,
+			TokenOut:         leg.ExactOut.TokenOut,
+			Deadline:         leg.ExactOut.Deadline,
+			MaxPriceImpact:   leg.ExactOut.MaxPriceImpact,
+			LimitSpotPrice:   leg.ExactOut.LimitSpotPrice,
+		}.ValidateBasic()
+	default:
+		return ErrInvalidBatchSwapLeg
+	}
+}
+
 var _ sdk.Msg = &MsgSetDenomPairTakerFee{}
 
 func (msg MsgSetDenomPairTakerFee) Route() string { return RouterKey }
@@ -242,3 +317,113 @@ func (msg MsgSetRegisteredAlloyedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetPoolTakerFee{}
+
+func (msg MsgSetPoolTakerFee) Route() string { return RouterKey }
+func (msg MsgSetPoolTakerFee) Type() string  { return TypeMsgSetPoolTakerFee }
+
+func (msg MsgSetPoolTakerFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.PoolTakerFees) == 0 {
+		return fmt.Errorf("empty pool taker fees")
+	}
+
+	return validatePoolTakerFees(msg.PoolTakerFees)
+}
+
+func (msg MsgSetPoolTakerFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRemovePoolTakerFee{}
+
+func (msg MsgRemovePoolTakerFee) Route() string { return RouterKey }
+func (msg MsgRemovePoolTakerFee) Type() string  { return TypeMsgRemovePoolTakerFee }
+
+func (msg MsgRemovePoolTakerFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.PoolIds) == 0 {
+		return fmt.Errorf("empty pool ids")
+	}
+
+	for _, poolId := range msg.PoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("invalid pool id: %d", poolId)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgRemovePoolTakerFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPausePools{}
+
+func (msg MsgPausePools) Route() string { return RouterKey }
+func (msg MsgPausePools) Type() string  { return TypeMsgPausePools }
+
+func (msg MsgPausePools) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.PoolIds) == 0 {
+		return fmt.Errorf("empty pool ids")
+	}
+
+	return validatePausedPools(msg.PoolIds)
+}
+
+func (msg MsgPausePools) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnpausePools{}
+
+func (msg MsgUnpausePools) Route() string { return RouterKey }
+func (msg MsgUnpausePools) Type() string  { return TypeMsgUnpausePools }
+
+func (msg MsgUnpausePools) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.PoolIds) == 0 {
+		return fmt.Errorf("empty pool ids")
+	}
+
+	return validatePausedPools(msg.PoolIds)
+}
+
+func (msg MsgUnpausePools) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
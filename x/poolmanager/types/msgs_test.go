@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -159,6 +160,44 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid swap limits",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				deadline := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+				maxPriceImpact := osmomath.MustNewDecFromStr("0.01")
+				limitSpotPrice := osmomath.MustNewDecFromStr("0.5")
+				msg.Deadline, msg.MaxPriceImpact, msg.LimitSpotPrice = &deadline, &maxPriceImpact, &limitSpotPrice
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "max price impact above one",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				maxPriceImpact := osmomath.MustNewDecFromStr("1.1")
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				maxPriceImpact := osmomath.ZeroDec()
+				msg.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative limit spot price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				limitSpotPrice := osmomath.MustNewDecFromStr("-0.5")
+				msg.LimitSpotPrice = &limitSpotPrice
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -801,3 +840,444 @@ func TestMsgSetRegisteredAlloyedPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetPoolTakerFee(t *testing.T) {
+	createMsg := func(after func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+		properMsg := types.MsgSetPoolTakerFee{
+			Sender: addr1,
+			PoolTakerFees: []types.PoolTakerFee{
+				{
+					PoolId:   1,
+					TakerFee: osmomath.MustNewDecFromStr("0.001"),
+				},
+				{
+					PoolId:   2,
+					TakerFee: osmomath.MustNewDecFromStr("0.0005"),
+				},
+			},
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSetPoolTakerFee)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSetPoolTakerFee
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+				// Do nothing
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"no pool taker fees": {
+			msg: createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+				msg.PoolTakerFees = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid pool id": {
+			msg: createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+				msg.PoolTakerFees[0].PoolId = 0
+				return msg
+			}),
+			expectError: true,
+		},
+		"negative taker fee": {
+			msg: createMsg(func(msg types.MsgSetPoolTakerFee) types.MsgSetPoolTakerFee {
+				msg.PoolTakerFees[0].TakerFee = osmomath.MustNewDecFromStr("-0.001")
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemovePoolTakerFee(t *testing.T) {
+	createMsg := func(after func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+		properMsg := types.MsgRemovePoolTakerFee{
+			Sender:  addr1,
+			PoolIds: []uint64{1, 2},
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgRemovePoolTakerFee)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgRemovePoolTakerFee
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+				// Do nothing
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"no pool ids": {
+			msg: createMsg(func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+				msg.PoolIds = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid pool id": {
+			msg: createMsg(func(msg types.MsgRemovePoolTakerFee) types.MsgRemovePoolTakerFee {
+				msg.PoolIds[1] = 0
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchSwap(t *testing.T) {
+	createMsg := func(after func(msg types.MsgBatchSwap) types.MsgBatchSwap) types.MsgBatchSwap {
+		properMsg := types.MsgBatchSwap{
+			Sender: addr1,
+			Legs: []types.BatchSwapLeg{
+				{
+					ExactIn: &types.SwapExactAmountInLeg{
+						Routes:            validSwapExactAmountInRoutes,
+						TokenIn:           sdk.NewCoin("test", osmomath.NewInt(100)),
+						TokenOutMinAmount: osmomath.NewInt(200),
+					},
+				},
+				{
+					ExactOut: &types.SwapExactAmountOutLeg{
+						Routes:           validSwapExactAmountOutRoutes,
+						TokenInMaxAmount: osmomath.NewInt(200),
+						TokenOut:         sdk.NewCoin("test", osmomath.NewInt(100)),
+					},
+				},
+			},
+			Policy: types.BestEffort,
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgBatchSwap)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgBatchSwap
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				// Do nothing
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"no legs": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Legs = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"too many legs": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				for len(msg.Legs) <= types.MaxBatchSwapLegs {
+					msg.Legs = append(msg.Legs, msg.Legs[0])
+				}
+				return msg
+			}),
+			expectError: true,
+		},
+		"leg with no swap": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Legs[0].ExactIn = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"leg with both swaps": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Legs[0].ExactOut = msg.Legs[1].ExactOut
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid exact in leg": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Legs[0].ExactIn.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid exact out leg": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Legs[1].ExactOut.Routes = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"exact in leg with limits": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				deadline := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+				maxPriceImpact := osmomath.MustNewDecFromStr("0.01")
+				msg.Legs[0].ExactIn.Deadline = &deadline
+				msg.Legs[0].ExactIn.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+		},
+		"exact in leg with invalid max price impact": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				maxPriceImpact := osmomath.MustNewDecFromStr("1.5")
+				msg.Legs[0].ExactIn.MaxPriceImpact = &maxPriceImpact
+				return msg
+			}),
+			expectError: true,
+		},
+		"exact out leg with invalid limit spot price": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				limitSpotPrice := osmomath.ZeroDec()
+				msg.Legs[1].ExactOut.LimitSpotPrice = &limitSpotPrice
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid policy": {
+			msg: createMsg(func(msg types.MsgBatchSwap) types.MsgBatchSwap {
+				msg.Policy = 2
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPausePools(t *testing.T) {
+	createMsg := func(after func(msg types.MsgPausePools) types.MsgPausePools) types.MsgPausePools {
+		properMsg := types.MsgPausePools{
+			Sender:  addr1,
+			PoolIds: []uint64{1, 2},
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgPausePools)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgPausePools
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+				// Do nothing
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"no pool ids": {
+			msg: createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+				msg.PoolIds = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid pool id": {
+			msg: createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+				msg.PoolIds[0] = 0
+				return msg
+			}),
+			expectError: true,
+		},
+		"duplicate pool id": {
+			msg: createMsg(func(msg types.MsgPausePools) types.MsgPausePools {
+				msg.PoolIds[1] = msg.PoolIds[0]
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnpausePools(t *testing.T) {
+	createMsg := func(after func(msg types.MsgUnpausePools) types.MsgUnpausePools) types.MsgUnpausePools {
+		properMsg := types.MsgUnpausePools{
+			Sender:  addr1,
+			PoolIds: []uint64{1, 2},
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgUnpausePools) types.MsgUnpausePools {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgUnpausePools)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgUnpausePools
+		expectError bool
+	}{
+		"valid": {
+			msg: createMsg(func(msg types.MsgUnpausePools) types.MsgUnpausePools {
+				// Do nothing
+				return msg
+			}),
+		},
+		"invalid sender": {
+			msg: createMsg(func(msg types.MsgUnpausePools) types.MsgUnpausePools {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"no pool ids": {
+			msg: createMsg(func(msg types.MsgUnpausePools) types.MsgUnpausePools {
+				msg.PoolIds = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid pool id": {
+			msg: createMsg(func(msg types.MsgUnpausePools) types.MsgUnpausePools {
+				msg.PoolIds[0] = 0
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
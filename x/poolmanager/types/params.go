@@ -21,11 +21,17 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyVolumeTakerFeeTiers                            = []byte("VolumeTakerFeeTiers")
+	KeyVolumeTakerFeeWindowDays                       = []byte("VolumeTakerFeeWindowDays")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
 )
 
+// MaxVolumeTakerFeeWindowDays is the maximum number of days over which the volume of an account is tracked,
+// which bounds the number of volume entries stored per account.
+const MaxVolumeTakerFeeWindowDays = 90
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -66,6 +72,8 @@ func DefaultParams() Params {
 			AdminAddresses: []string{},
 			CommunityPoolDenomToSwapNonWhitelistedAssetsTo: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 			ReducedFeeWhitelist:                            []string{},
+			VolumeTakerFeeTiers:                            []VolumeTakerFeeTier{},
+			VolumeTakerFeeWindowDays:                       30,
 		},
 		AuthorizedQuoteDenoms: []string{
 			appparams.BaseCoinUnit,
@@ -99,6 +107,15 @@ func (p Params) Validate() error {
 	if err := osmoutils.ValidateAddressList(p.TakerFeeParams.ReducedFeeWhitelist); err != nil {
 		return err
 	}
+	if err := validateVolumeTakerFeeTiers(p.TakerFeeParams.VolumeTakerFeeTiers); err != nil {
+		return err
+	}
+	if err := validateVolumeTakerFeeWindowDays(p.TakerFeeParams.VolumeTakerFeeWindowDays); err != nil {
+		return err
+	}
+	if len(p.TakerFeeParams.VolumeTakerFeeTiers) > 0 && p.TakerFeeParams.VolumeTakerFeeWindowDays == 0 {
+		return fmt.Errorf("volume taker fee window days must be positive when volume taker fee tiers are set")
+	}
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
@@ -117,6 +134,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyVolumeTakerFeeTiers, &p.TakerFeeParams.VolumeTakerFeeTiers, validateVolumeTakerFeeTiers),
+		paramtypes.NewParamSetPair(KeyVolumeTakerFeeWindowDays, &p.TakerFeeParams.VolumeTakerFeeWindowDays, validateVolumeTakerFeeWindowDays),
 	}
 }
 
@@ -167,6 +186,45 @@ func validateTakerFeeDistribution(i interface{}) error {
 	return nil
 }
 
+// validateVolumeTakerFeeTiers validates that the min volumes of the tiers are valid and positive, and that the tiers
+// are sorted by strictly decreasing discount multiplier between 0 and 1.
+func validateVolumeTakerFeeTiers(i interface{}) error {
+	tiers, ok := i.([]VolumeTakerFeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, tier := range tiers {
+		if tier.MinVolumes.Empty() {
+			return fmt.Errorf("volume taker fee tier %d must have min volumes", idx)
+		}
+		if err := tier.MinVolumes.Validate(); err != nil {
+			return fmt.Errorf("volume taker fee tier %d min volumes are invalid: %w", idx, err)
+		}
+		if tier.DiscountMultiplier.IsNil() || tier.DiscountMultiplier.IsNegative() || tier.DiscountMultiplier.GT(OneDec) {
+			return fmt.Errorf("volume taker fee tier %d discount multiplier must be between 0 and 1: %s", idx, tier.DiscountMultiplier)
+		}
+		if idx > 0 && tier.DiscountMultiplier.GTE(tiers[idx-1].DiscountMultiplier) {
+			return fmt.Errorf("volume taker fee tiers must be sorted by strictly decreasing discount multiplier: %s >= %s", tier.DiscountMultiplier, tiers[idx-1].DiscountMultiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateVolumeTakerFeeWindowDays(i interface{}) error {
+	windowDays, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if windowDays > MaxVolumeTakerFeeWindowDays {
+		return fmt.Errorf("volume taker fee window days must be at most %d: %d", MaxVolumeTakerFeeWindowDays, windowDays)
+	}
+
+	return nil
+}
+
 func validateAdminAddresses(i interface{}) error {
 	adminAddresses, ok := i.([]string)
 	if !ok {
@@ -252,3 +310,39 @@ func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	}
 	return nil
 }
+
+// validatePoolTakerFees validates that the pool ids are positive and unique, and that the taker fees are
+// between 0 and 1.
+func validatePoolTakerFees(poolTakerFees []PoolTakerFee) error {
+	seen := make(map[uint64]struct{}, len(poolTakerFees))
+	for _, poolTakerFee := range poolTakerFees {
+		if poolTakerFee.PoolId == 0 {
+			return fmt.Errorf("invalid pool id: %d", poolTakerFee.PoolId)
+		}
+		if _, ok := seen[poolTakerFee.PoolId]; ok {
+			return fmt.Errorf("duplicate taker fee for pool %d", poolTakerFee.PoolId)
+		}
+		seen[poolTakerFee.PoolId] = struct{}{}
+
+		takerFee := poolTakerFee.TakerFee
+		if takerFee.IsNil() || takerFee.IsNegative() || takerFee.GTE(OneDec) {
+			return fmt.Errorf("taker fee must be between 0 and 1: %s", takerFee)
+		}
+	}
+	return nil
+}
+
+// validatePausedPools validates that the pool ids are positive and unique.
+func validatePausedPools(poolIds []uint64) error {
+	seen := make(map[uint64]struct{}, len(poolIds))
+	for _, poolId := range poolIds {
+		if poolId == 0 {
+			return fmt.Errorf("invalid pool id: %d", poolId)
+		}
+		if _, ok := seen[poolId]; ok {
+			return fmt.Errorf("duplicate pool id: %d", poolId)
+		}
+		seen[poolId] = struct{}{}
+	}
+	return nil
+}
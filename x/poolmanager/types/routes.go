@@ -6,6 +6,27 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
+const (
+	// DefaultFindRouteMaxHops is the maximum number of pools of the routes searched by FindBestRoute when
+	// none is given.
+	DefaultFindRouteMaxHops = 3
+	// MaxFindRouteHops bounds the number of pools of the routes searched by FindBestRoute.
+	MaxFindRouteHops = 4
+	// DefaultFindRouteMaxRoutes is the number of routes returned by FindBestRoute when none is given.
+	DefaultFindRouteMaxRoutes = 3
+	// MaxFindRouteRoutes bounds the number of routes returned by FindBestRoute.
+	MaxFindRouteRoutes = 10
+	// MaxFindRouteFrontierDenoms bounds the number of intermediate denoms FindBestRoute extends routes from at each hop.
+	MaxFindRouteFrontierDenoms = 10
+	// MaxFindRoutePoolsPerDenom bounds the number of pools, the most liquid ones, FindBestRoute extends routes through
+	// from each denom.
+	MaxFindRoutePoolsPerDenom = 10
+	// MaxFindRouteEstimates bounds the number of swaps FindBestRoute estimates to search and split the routes.
+	MaxFindRouteEstimates = 500
+	// FindRouteSplitSteps is the number of parts the token in is divided into when splitting it across routes.
+	FindRouteSplitSteps = 10
+)
+
 type SwapAmountInRoutes []SwapAmountInRoute
 
 func (routes SwapAmountInRoutes) Validate() error {
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// SwapLimits are the optional protections of a swap, in addition to its minimum amount out or maximum amount in.
+type SwapLimits struct {
+	// Deadline is the latest block time at which the swap can execute.
+	Deadline *time.Time
+	// MaxPriceImpact is the maximum relative change of the spot price of a route caused by the swap.
+	MaxPriceImpact *osmomath.Dec
+	// LimitSpotPrice is the minimum spot price of the token in, in terms of the token out, of a route after the swap.
+	LimitSpotPrice *osmomath.Dec
+}
+
+// HasPriceLimits returns true if the spot price of the routes must be checked after the swap.
+func (l SwapLimits) HasPriceLimits() bool {
+	return l.MaxPriceImpact != nil || l.LimitSpotPrice != nil
+}
+
+// Validate returns an error if a price limit is out of range.
+func (l SwapLimits) Validate() error {
+	if l.MaxPriceImpact != nil && (l.MaxPriceImpact.IsNil() || !l.MaxPriceImpact.IsPositive() || l.MaxPriceImpact.GT(osmomath.OneDec())) {
+		return fmt.Errorf("max price impact must be positive and at most 1, was (%s)", l.MaxPriceImpact)
+	}
+	if l.LimitSpotPrice != nil && (l.LimitSpotPrice.IsNil() || !l.LimitSpotPrice.IsPositive()) {
+		return fmt.Errorf("limit spot price must be positive, was (%s)", l.LimitSpotPrice)
+	}
+	return nil
+}
+
+func (msg MsgSwapExactAmountIn) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, LimitSpotPrice: msg.LimitSpotPrice}
+}
+
+func (msg MsgSwapExactAmountOut) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, LimitSpotPrice: msg.LimitSpotPrice}
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, LimitSpotPrice: msg.LimitSpotPrice}
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, LimitSpotPrice: msg.LimitSpotPrice}
+}
+
+func (leg SwapExactAmountInLeg) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: leg.Deadline, MaxPriceImpact: leg.MaxPriceImpact, LimitSpotPrice: leg.LimitSpotPrice}
+}
+
+func (leg SwapExactAmountOutLeg) SwapLimits() SwapLimits {
+	return SwapLimits{Deadline: leg.Deadline, MaxPriceImpact: leg.MaxPriceImpact, LimitSpotPrice: leg.LimitSpotPrice}
+}
@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,12 +36,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BatchSwapPolicy defines what happens to a batch of swaps when one of them
+// fails.
+type BatchSwapPolicy int32
+
+const (
+	// AllOrNothing reverts the whole batch if any swap fails.
+	AllOrNothing BatchSwapPolicy = 0
+	// BestEffort reverts only the swaps that fail, and reports their errors in
+	// the response.
+	BestEffort BatchSwapPolicy = 1
+)
+
+var BatchSwapPolicy_name = map[int32]string{
+	0: "AllOrNothing",
+	1: "BestEffort",
+}
+
+var BatchSwapPolicy_value = map[string]int32{
+	"AllOrNothing": 0,
+	"BestEffort":   1,
+}
+
+func (x BatchSwapPolicy) String() string {
+	return proto.EnumName(BatchSwapPolicy_name, int32(x))
+}
+
+func (BatchSwapPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{0}
+}
+
 // ===================== MsgSwapExactAmountIn
 type MsgSwapExactAmountIn struct {
 	Sender            string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the latest block time at which the swap can execute. The swap
+	// is rejected if it executes later. It is optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of the route caused by the swap. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of the route after the swap. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -94,6 +137,13 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -137,6 +187,15 @@ type MsgSplitRouteSwapExactAmountIn struct {
 	Routes            []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInDenom      string                   `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 	TokenOutMinAmount cosmossdk_io_math.Int    `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the latest block time at which the swap can execute. The swap
+	// is rejected if it executes later. It is optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of each route caused by the swap. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of each route after the swap. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
@@ -193,6 +252,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -238,6 +304,15 @@ type MsgSwapExactAmountOut struct {
 	Routes           []SwapAmountOutRoute  `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin            `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// deadline is the latest block time at which the swap can execute. The swap
+	// is rejected if it executes later. It is optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of the route caused by the swap. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of the route after the swap. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -294,6 +369,13 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -337,6 +419,15 @@ type MsgSplitRouteSwapExactAmountOut struct {
 	Routes           []SwapAmountOutSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenOutDenom    string                    `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 	TokenInMaxAmount cosmossdk_io_math.Int     `protobuf:"bytes,4,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+	// deadline is the latest block time at which the swap can execute. The swap
+	// is rejected if it executes later. It is optional.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of each route caused by the swap. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of each route after the swap. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSwapExactAmountOut{} }
@@ -393,6 +484,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -432,6 +530,365 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// MsgBatchSwap executes a list of independent swaps, in order.
+type MsgBatchSwap struct {
+	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Legs   []BatchSwapLeg  `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs" yaml:"legs"`
+	Policy BatchSwapPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=osmosis.poolmanager.v1beta1.BatchSwapPolicy" json:"policy,omitempty" yaml:"policy"`
+}
+
+func (m *MsgBatchSwap) Reset()         { *m = MsgBatchSwap{} }
+func (m *MsgBatchSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSwap) ProtoMessage()    {}
+func (*MsgBatchSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgBatchSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSwap.Merge(m, src)
+}
+func (m *MsgBatchSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSwap proto.InternalMessageInfo
+
+func (m *MsgBatchSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBatchSwap) GetLegs() []BatchSwapLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *MsgBatchSwap) GetPolicy() BatchSwapPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return AllOrNothing
+}
+
+// BatchSwapLeg is a swap of a batch. Exactly one of exact_in and exact_out
+// must be set.
+type BatchSwapLeg struct {
+	ExactIn  *SwapExactAmountInLeg  `protobuf:"bytes,1,opt,name=exact_in,json=exactIn,proto3" json:"exact_in,omitempty" yaml:"exact_in"`
+	ExactOut *SwapExactAmountOutLeg `protobuf:"bytes,2,opt,name=exact_out,json=exactOut,proto3" json:"exact_out,omitempty" yaml:"exact_out"`
+}
+
+func (m *BatchSwapLeg) Reset()         { *m = BatchSwapLeg{} }
+func (m *BatchSwapLeg) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapLeg) ProtoMessage()    {}
+func (*BatchSwapLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *BatchSwapLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapLeg.Merge(m, src)
+}
+func (m *BatchSwapLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapLeg proto.InternalMessageInfo
+
+func (m *BatchSwapLeg) GetExactIn() *SwapExactAmountInLeg {
+	if m != nil {
+		return m.ExactIn
+	}
+	return nil
+}
+
+func (m *BatchSwapLeg) GetExactOut() *SwapExactAmountOutLeg {
+	if m != nil {
+		return m.ExactOut
+	}
+	return nil
+}
+
+// SwapExactAmountInLeg is a swap of a batch with the same fields as
+// MsgSwapExactAmountIn, including its optional limits.
+type SwapExactAmountInLeg struct {
+	Routes            []SwapAmountInRoute   `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenIn           types.Coin            `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the latest block time at which the leg can execute. It is
+	// optional.
+	Deadline *time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of the route caused by the leg. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of the route after the leg. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
+}
+
+func (m *SwapExactAmountInLeg) Reset()         { *m = SwapExactAmountInLeg{} }
+func (m *SwapExactAmountInLeg) String() string { return proto.CompactTextString(m) }
+func (*SwapExactAmountInLeg) ProtoMessage()    {}
+func (*SwapExactAmountInLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{10}
+}
+func (m *SwapExactAmountInLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapExactAmountInLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapExactAmountInLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapExactAmountInLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapExactAmountInLeg.Merge(m, src)
+}
+func (m *SwapExactAmountInLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapExactAmountInLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapExactAmountInLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapExactAmountInLeg proto.InternalMessageInfo
+
+func (m *SwapExactAmountInLeg) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *SwapExactAmountInLeg) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *SwapExactAmountInLeg) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+// SwapExactAmountOutLeg is a swap of a batch with the same fields as
+// MsgSwapExactAmountOut, including its optional limits.
+type SwapExactAmountOutLeg struct {
+	Routes           []SwapAmountOutRoute  `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+	TokenOut         types.Coin            `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// deadline is the latest block time at which the leg can execute. It is
+	// optional.
+	Deadline *time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative change, between 0 and 1, of the
+	// spot price of the route caused by the leg. It is optional.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// limit_spot_price is the minimum spot price of the token in, in terms of
+	// the token out, of the route after the leg. It is optional.
+	LimitSpotPrice *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=limit_spot_price,json=limitSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limit_spot_price,omitempty" yaml:"limit_spot_price"`
+}
+
+func (m *SwapExactAmountOutLeg) Reset()         { *m = SwapExactAmountOutLeg{} }
+func (m *SwapExactAmountOutLeg) String() string { return proto.CompactTextString(m) }
+func (*SwapExactAmountOutLeg) ProtoMessage()    {}
+func (*SwapExactAmountOutLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *SwapExactAmountOutLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapExactAmountOutLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapExactAmountOutLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapExactAmountOutLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapExactAmountOutLeg.Merge(m, src)
+}
+func (m *SwapExactAmountOutLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapExactAmountOutLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapExactAmountOutLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapExactAmountOutLeg proto.InternalMessageInfo
+
+func (m *SwapExactAmountOutLeg) GetRoutes() []SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *SwapExactAmountOutLeg) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *SwapExactAmountOutLeg) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+type MsgBatchSwapResponse struct {
+	// results are the results of the legs, in the same order.
+	Results []BatchSwapLegResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+}
+
+func (m *MsgBatchSwapResponse) Reset()         { *m = MsgBatchSwapResponse{} }
+func (m *MsgBatchSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSwapResponse) ProtoMessage()    {}
+func (*MsgBatchSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{12}
+}
+func (m *MsgBatchSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSwapResponse.Merge(m, src)
+}
+func (m *MsgBatchSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSwapResponse proto.InternalMessageInfo
+
+func (m *MsgBatchSwapResponse) GetResults() []BatchSwapLegResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// BatchSwapLegResult is the result of a swap of a batch.
+type BatchSwapLegResult struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty" yaml:"success"`
+	// amount is the token out amount of an exact in swap, or the token in
+	// amount of an exact out swap. It is zero if the swap failed.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// error is the error of the swap if it failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+}
+
+func (m *BatchSwapLegResult) Reset()         { *m = BatchSwapLegResult{} }
+func (m *BatchSwapLegResult) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapLegResult) ProtoMessage()    {}
+func (*BatchSwapLegResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{13}
+}
+func (m *BatchSwapLegResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapLegResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapLegResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapLegResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapLegResult.Merge(m, src)
+}
+func (m *BatchSwapLegResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapLegResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapLegResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapLegResult proto.InternalMessageInfo
+
+func (m *BatchSwapLegResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BatchSwapLegResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // ===================== MsgSetDenomPairTakerFee
 type MsgSetDenomPairTakerFee struct {
 	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -442,7 +899,7 @@ func (m *MsgSetDenomPairTakerFee) Reset()         { *m = MsgSetDenomPairTakerFee
 func (m *MsgSetDenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFee) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{8}
+	return fileDescriptor_acd130b4825d67dc, []int{14}
 }
 func (m *MsgSetDenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +950,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Reset()         { *m = MsgSetDenomPair
 func (m *MsgSetDenomPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFeeResponse) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{9}
+	return fileDescriptor_acd130b4825d67dc, []int{15}
 }
 func (m *MsgSetDenomPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +1007,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Reset()         { *m = MsgSetTake
 func (m *MsgSetTakerFeeShareAgreementForDenom) String() string { return proto.CompactTextString(m) }
 func (*MsgSetTakerFeeShareAgreementForDenom) ProtoMessage()    {}
 func (*MsgSetTakerFeeShareAgreementForDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{10}
+	return fileDescriptor_acd130b4825d67dc, []int{16}
 }
 func (m *MsgSetTakerFeeShareAgreementForDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +1068,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) String() string {
 }
 func (*MsgSetTakerFeeShareAgreementForDenomResponse) ProtoMessage() {}
 func (*MsgSetTakerFeeShareAgreementForDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{11}
+	return fileDescriptor_acd130b4825d67dc, []int{17}
 }
 func (m *MsgSetTakerFeeShareAgreementForDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +1110,7 @@ func (m *MsgSetRegisteredAlloyedPool) Reset()         { *m = MsgSetRegisteredAll
 func (m *MsgSetRegisteredAlloyedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgSetRegisteredAlloyedPool) ProtoMessage()    {}
 func (*MsgSetRegisteredAlloyedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{12}
+	return fileDescriptor_acd130b4825d67dc, []int{18}
 }
 func (m *MsgSetRegisteredAlloyedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +1160,7 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) Reset()         { *m = MsgSetRegis
 func (m *MsgSetRegisteredAlloyedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetRegisteredAlloyedPoolResponse) ProtoMessage()    {}
 func (*MsgSetRegisteredAlloyedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{13}
+	return fileDescriptor_acd130b4825d67dc, []int{19}
 }
 func (m *MsgSetRegisteredAlloyedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,22 +1189,390 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetRegisteredAlloyedPoolResponse proto.InternalMessageInfo
 
-type DenomPairTakerFee struct {
-	// DEPRECATED: Now that we are using uni-directional trading pairs, we are
-	// using tokenInDenom and tokenOutDenom instead of denom0 and denom1 to
-	// prevent confusion.
-	Denom0        string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0",deprecated:"true"` // Deprecated: Do not use.
-	Denom1        string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1",deprecated:"true"` // Deprecated: Do not use.
-	TakerFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
-	TokenInDenom  string                      `protobuf:"bytes,4,opt,name=tokenInDenom,proto3" json:"tokenInDenom,omitempty" yaml:"token_in_denom"`
-	TokenOutDenom string                      `protobuf:"bytes,5,opt,name=tokenOutDenom,proto3" json:"tokenOutDenom,omitempty" yaml:"token_out_denom"`
+// ===================== MsgSetPoolTakerFee
+// MsgSetPoolTakerFee sets taker fee overrides for pools. A pool taker fee
+// takes precedence over the denom pair taker fee of the swapped denoms.
+// The sender must be a taker fee admin or the governance module.
+type MsgSetPoolTakerFee struct {
+	Sender        string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolTakerFees []PoolTakerFee `protobuf:"bytes,2,rep,name=pool_taker_fees,json=poolTakerFees,proto3" json:"pool_taker_fees" yaml:"pool_taker_fees"`
 }
 
-func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
-func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
-func (*DenomPairTakerFee) ProtoMessage()    {}
-func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{14}
+func (m *MsgSetPoolTakerFee) Reset()         { *m = MsgSetPoolTakerFee{} }
+func (m *MsgSetPoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolTakerFee) ProtoMessage()    {}
+func (*MsgSetPoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{20}
+}
+func (m *MsgSetPoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolTakerFee.Merge(m, src)
+}
+func (m *MsgSetPoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolTakerFee proto.InternalMessageInfo
+
+func (m *MsgSetPoolTakerFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolTakerFee) GetPoolTakerFees() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFees
+	}
+	return nil
+}
+
+type MsgSetPoolTakerFeeResponse struct {
+}
+
+func (m *MsgSetPoolTakerFeeResponse) Reset()         { *m = MsgSetPoolTakerFeeResponse{} }
+func (m *MsgSetPoolTakerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolTakerFeeResponse) ProtoMessage()    {}
+func (*MsgSetPoolTakerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{21}
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolTakerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolTakerFeeResponse.Merge(m, src)
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolTakerFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolTakerFeeResponse proto.InternalMessageInfo
+
+// ===================== MsgRemovePoolTakerFee
+// MsgRemovePoolTakerFee removes the taker fee overrides of pools, which then
+// charge the denom pair taker fee again.
+// The sender must be a taker fee admin or the governance module.
+type MsgRemovePoolTakerFee struct {
+	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *MsgRemovePoolTakerFee) Reset()         { *m = MsgRemovePoolTakerFee{} }
+func (m *MsgRemovePoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePoolTakerFee) ProtoMessage()    {}
+func (*MsgRemovePoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{22}
+}
+func (m *MsgRemovePoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePoolTakerFee.Merge(m, src)
+}
+func (m *MsgRemovePoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePoolTakerFee proto.InternalMessageInfo
+
+func (m *MsgRemovePoolTakerFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemovePoolTakerFee) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type MsgRemovePoolTakerFeeResponse struct {
+}
+
+func (m *MsgRemovePoolTakerFeeResponse) Reset()         { *m = MsgRemovePoolTakerFeeResponse{} }
+func (m *MsgRemovePoolTakerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePoolTakerFeeResponse) ProtoMessage()    {}
+func (*MsgRemovePoolTakerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{23}
+}
+func (m *MsgRemovePoolTakerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePoolTakerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePoolTakerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePoolTakerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePoolTakerFeeResponse.Merge(m, src)
+}
+func (m *MsgRemovePoolTakerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePoolTakerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePoolTakerFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePoolTakerFeeResponse proto.InternalMessageInfo
+
+// ===================== MsgPausePools
+// MsgPausePools pauses pools. Swaps, joins and concentrated liquidity position
+// creations are rejected on a paused pool, while exits and withdrawals are
+// still allowed so that liquidity providers can get out.
+// The sender must be a taker fee admin or the governance module.
+type MsgPausePools struct {
+	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *MsgPausePools) Reset()         { *m = MsgPausePools{} }
+func (m *MsgPausePools) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePools) ProtoMessage()    {}
+func (*MsgPausePools) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{24}
+}
+func (m *MsgPausePools) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePools.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPausePools) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePools.Merge(m, src)
+}
+func (m *MsgPausePools) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePools) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePools.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPausePools proto.InternalMessageInfo
+
+func (m *MsgPausePools) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPausePools) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type MsgPausePoolsResponse struct {
+}
+
+func (m *MsgPausePoolsResponse) Reset()         { *m = MsgPausePoolsResponse{} }
+func (m *MsgPausePoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPausePoolsResponse) ProtoMessage()    {}
+func (*MsgPausePoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{25}
+}
+func (m *MsgPausePoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPausePoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPausePoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPausePoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPausePoolsResponse.Merge(m, src)
+}
+func (m *MsgPausePoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPausePoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPausePoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPausePoolsResponse proto.InternalMessageInfo
+
+// ===================== MsgUnpausePools
+// MsgUnpausePools unpauses pools.
+// The sender must be a taker fee admin or the governance module.
+type MsgUnpausePools struct {
+	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *MsgUnpausePools) Reset()         { *m = MsgUnpausePools{} }
+func (m *MsgUnpausePools) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpausePools) ProtoMessage()    {}
+func (*MsgUnpausePools) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{26}
+}
+func (m *MsgUnpausePools) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpausePools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpausePools.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpausePools) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpausePools.Merge(m, src)
+}
+func (m *MsgUnpausePools) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpausePools) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpausePools.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpausePools proto.InternalMessageInfo
+
+func (m *MsgUnpausePools) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUnpausePools) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type MsgUnpausePoolsResponse struct {
+}
+
+func (m *MsgUnpausePoolsResponse) Reset()         { *m = MsgUnpausePoolsResponse{} }
+func (m *MsgUnpausePoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpausePoolsResponse) ProtoMessage()    {}
+func (*MsgUnpausePoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{27}
+}
+func (m *MsgUnpausePoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpausePoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpausePoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpausePoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpausePoolsResponse.Merge(m, src)
+}
+func (m *MsgUnpausePoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpausePoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpausePoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpausePoolsResponse proto.InternalMessageInfo
+
+type DenomPairTakerFee struct {
+	// DEPRECATED: Now that we are using uni-directional trading pairs, we are
+	// using tokenInDenom and tokenOutDenom instead of denom0 and denom1 to
+	// prevent confusion.
+	Denom0        string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0",deprecated:"true"` // Deprecated: Do not use.
+	Denom1        string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1",deprecated:"true"` // Deprecated: Do not use.
+	TakerFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+	TokenInDenom  string                      `protobuf:"bytes,4,opt,name=tokenInDenom,proto3" json:"tokenInDenom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string                      `protobuf:"bytes,5,opt,name=tokenOutDenom,proto3" json:"tokenOutDenom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
+func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
+func (*DenomPairTakerFee) ProtoMessage()    {}
+func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{28}
 }
 func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +1631,54 @@ func (m *DenomPairTakerFee) GetTokenOutDenom() string {
 	return ""
 }
 
+// PoolTakerFee is the taker fee override of a pool.
+type PoolTakerFee struct {
+	PoolId   uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *PoolTakerFee) Reset()         { *m = PoolTakerFee{} }
+func (m *PoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*PoolTakerFee) ProtoMessage()    {}
+func (*PoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{29}
+}
+func (m *PoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTakerFee.Merge(m, src)
+}
+func (m *PoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTakerFee proto.InternalMessageInfo
+
+func (m *PoolTakerFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.poolmanager.v1beta1.BatchSwapPolicy", BatchSwapPolicy_name, BatchSwapPolicy_value)
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountIn")
@@ -815,13 +1687,28 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgBatchSwap)(nil), "osmosis.poolmanager.v1beta1.MsgBatchSwap")
+	proto.RegisterType((*BatchSwapLeg)(nil), "osmosis.poolmanager.v1beta1.BatchSwapLeg")
+	proto.RegisterType((*SwapExactAmountInLeg)(nil), "osmosis.poolmanager.v1beta1.SwapExactAmountInLeg")
+	proto.RegisterType((*SwapExactAmountOutLeg)(nil), "osmosis.poolmanager.v1beta1.SwapExactAmountOutLeg")
+	proto.RegisterType((*MsgBatchSwapResponse)(nil), "osmosis.poolmanager.v1beta1.MsgBatchSwapResponse")
+	proto.RegisterType((*BatchSwapLegResult)(nil), "osmosis.poolmanager.v1beta1.BatchSwapLegResult")
 	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
 	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
 	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenom)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenom")
 	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenomResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenomResponse")
 	proto.RegisterType((*MsgSetRegisteredAlloyedPool)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPool")
 	proto.RegisterType((*MsgSetRegisteredAlloyedPoolResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPoolResponse")
+	proto.RegisterType((*MsgSetPoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetPoolTakerFee")
+	proto.RegisterType((*MsgSetPoolTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetPoolTakerFeeResponse")
+	proto.RegisterType((*MsgRemovePoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgRemovePoolTakerFee")
+	proto.RegisterType((*MsgRemovePoolTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgRemovePoolTakerFeeResponse")
+	proto.RegisterType((*MsgPausePools)(nil), "osmosis.poolmanager.v1beta1.MsgPausePools")
+	proto.RegisterType((*MsgPausePoolsResponse)(nil), "osmosis.poolmanager.v1beta1.MsgPausePoolsResponse")
+	proto.RegisterType((*MsgUnpausePools)(nil), "osmosis.poolmanager.v1beta1.MsgUnpausePools")
+	proto.RegisterType((*MsgUnpausePoolsResponse)(nil), "osmosis.poolmanager.v1beta1.MsgUnpausePoolsResponse")
 	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*PoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.PoolTakerFee")
 }
 
 func init() {
@@ -829,89 +1716,135 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0x46, 0x98, 0xf0, 0xb1, 0x10, 0x82, 0x15, 0x28, 0x8e, 0x49, 0x2d, 0x2a, 0x48, 0x4a, 0x52,
-	0x24, 0xc7, 0x40, 0x27, 0xc4, 0xd0, 0x49, 0x70, 0x68, 0x66, 0x98, 0xc6, 0x03, 0x51, 0x72, 0xea,
-	0x4c, 0xc7, 0xb3, 0xb6, 0x36, 0x46, 0xc5, 0xd2, 0x7a, 0xa4, 0x35, 0x81, 0x5b, 0x9b, 0x66, 0xda,
-	0x29, 0xd3, 0x43, 0x4f, 0xbd, 0x76, 0xa6, 0xbf, 0x20, 0xb9, 0xf4, 0x37, 0xe4, 0x98, 0x63, 0xa7,
-	0x07, 0x4f, 0x0b, 0x87, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x85, 0xfc, 0x05, 0x13,
-	0x2e, 0x89, 0xa4, 0x7d, 0x9f, 0xf7, 0xe3, 0x79, 0x9f, 0x7d, 0x77, 0x0d, 0x98, 0xc7, 0x8e, 0x89,
-	0x1d, 0xc3, 0x49, 0x96, 0x31, 0x2e, 0x99, 0xd0, 0x82, 0x45, 0x64, 0x27, 0xf7, 0x53, 0x79, 0x44,
-	0x60, 0x2a, 0x49, 0x0e, 0xd4, 0xb2, 0x8d, 0x09, 0x16, 0x67, 0xb8, 0x95, 0xda, 0x60, 0xa5, 0x72,
-	0xab, 0xf8, 0x64, 0x11, 0x17, 0xb1, 0x6b, 0x97, 0xa4, 0x4f, 0x0c, 0x12, 0x8f, 0x42, 0xd3, 0xb0,
-	0x70, 0xd2, 0xfd, 0x97, 0x7f, 0x4a, 0x14, 0x5c, 0x37, 0xc9, 0x3c, 0x74, 0x90, 0x1f, 0xa3, 0x80,
-	0x0d, 0x8b, 0xaf, 0x2f, 0xb6, 0xcb, 0xc5, 0x79, 0x01, 0xcb, 0x39, 0x1b, 0x57, 0x08, 0xe2, 0xd6,
-	0xd3, 0xdc, 0x9b, 0xe9, 0x14, 0x93, 0xfb, 0x29, 0xfa, 0x1f, 0x5b, 0x90, 0x7f, 0x8a, 0x80, 0xc9,
-	0xac, 0x53, 0x7c, 0xfa, 0x02, 0x96, 0xbf, 0x3c, 0x80, 0x05, 0xb2, 0x61, 0xe2, 0x8a, 0x45, 0xb6,
-	0x2c, 0xf1, 0x16, 0x18, 0x74, 0x90, 0xa5, 0x23, 0x3b, 0x26, 0xcc, 0x0a, 0x0b, 0x23, 0x99, 0x68,
-	0xad, 0x2a, 0x5d, 0x3e, 0x84, 0x66, 0x29, 0x2d, 0xb3, 0xef, 0xb2, 0xc6, 0x0d, 0xc4, 0xc7, 0x60,
-	0xd0, 0x8d, 0xe5, 0xc4, 0xfa, 0x67, 0x23, 0x0b, 0xa3, 0x4b, 0xaa, 0xda, 0x86, 0x01, 0x95, 0x86,
-	0xf2, 0xa2, 0x68, 0x14, 0x96, 0x19, 0x78, 0x5b, 0x95, 0xfa, 0x34, 0xee, 0x43, 0xcc, 0x82, 0x61,
-	0x82, 0xf7, 0x90, 0x95, 0x33, 0xac, 0x58, 0x64, 0x56, 0x58, 0x18, 0x5d, 0xba, 0xa6, 0xb2, 0xec,
-	0x55, 0xca, 0x85, 0xef, 0xe7, 0x21, 0x36, 0xac, 0xcc, 0x34, 0x85, 0xd6, 0xaa, 0xd2, 0x15, 0x96,
-	0x99, 0x07, 0x94, 0xb5, 0x21, 0xf7, 0x71, 0xcb, 0x12, 0x4d, 0x30, 0xc9, 0xbe, 0xe2, 0x0a, 0xc9,
-	0x99, 0x86, 0x95, 0x83, 0x6e, 0xec, 0xd8, 0x80, 0x5b, 0xd5, 0x3a, 0xc5, 0xff, 0x5d, 0x95, 0xa6,
-	0x58, 0x04, 0x47, 0xdf, 0x53, 0x0d, 0x9c, 0x34, 0x21, 0xd9, 0x55, 0xb7, 0x2c, 0x52, 0xab, 0x4a,
-	0x33, 0x8d, 0x8e, 0x9b, 0x5d, 0xc8, 0x5a, 0xd4, 0xfd, 0xbc, 0x5d, 0x21, 0x59, 0xc3, 0x62, 0x25,
-	0xa5, 0x57, 0x5f, 0xbe, 0x7f, 0x7d, 0x9b, 0x13, 0x73, 0xf4, 0xfe, 0xf5, 0xed, 0x85, 0xb0, 0x36,
-	0xd1, 0xf6, 0x28, 0x88, 0xd2, 0xad, 0x30, 0x57, 0x8a, 0x61, 0xc9, 0x2f, 0x05, 0x70, 0x3d, 0xac,
-	0x13, 0x1a, 0x72, 0xca, 0xd8, 0x72, 0x90, 0x98, 0x07, 0x13, 0xf5, 0x34, 0x78, 0x15, 0xac, 0x37,
-	0xab, 0x9d, 0xaa, 0x98, 0x0e, 0x56, 0xe1, 0x55, 0x30, 0xee, 0x55, 0xc0, 0xa2, 0xc9, 0x3f, 0x44,
-	0x40, 0x82, 0x26, 0x51, 0x2e, 0x19, 0xc4, 0x6d, 0xce, 0xb9, 0x84, 0xf1, 0x24, 0x20, 0x8c, 0xe5,
-	0xae, 0x85, 0x51, 0x4f, 0x20, 0xa0, 0x8e, 0xfb, 0x60, 0xdc, 0x6b, 0x72, 0x4e, 0x47, 0x16, 0x36,
-	0x5d, 0x8d, 0x8c, 0x64, 0xae, 0xd5, 0xaa, 0xd2, 0x54, 0xb3, 0x08, 0xd8, 0xba, 0xac, 0x8d, 0x71,
-	0x29, 0x6c, 0xd2, 0xd7, 0x8b, 0xd6, 0xc3, 0x72, 0x40, 0x0f, 0x73, 0xa1, 0x7a, 0xa0, 0xd5, 0x36,
-	0x48, 0xe1, 0x17, 0x01, 0xdc, 0x6c, 0xdf, 0x85, 0x0b, 0x15, 0xc5, 0x51, 0x04, 0x4c, 0x9d, 0x56,
-	0xe6, 0x76, 0x85, 0xf4, 0xa2, 0x85, 0x6c, 0x40, 0x0b, 0xc9, 0x2e, 0xb5, 0xb0, 0x5d, 0x09, 0xd5,
-	0xc1, 0xb7, 0xe0, 0xaa, 0xdf, 0x67, 0x13, 0x1e, 0x78, 0xa5, 0x33, 0x31, 0xac, 0x75, 0x2a, 0x3d,
-	0x1e, 0x50, 0x4a, 0xdd, 0x83, 0xac, 0x4d, 0x70, 0xb9, 0x64, 0xe1, 0x01, 0xcb, 0x40, 0xdc, 0x01,
-	0x23, 0x3e, 0x49, 0xae, 0x4e, 0xda, 0x8e, 0xa4, 0x18, 0x1f, 0x49, 0x13, 0x01, 0x7a, 0x65, 0x6d,
-	0xd8, 0xe3, 0x35, 0x7d, 0x2f, 0xa0, 0x8a, 0x5b, 0xdd, 0x4d, 0x09, 0xea, 0xe5, 0x3b, 0x01, 0x7c,
-	0x1c, 0xda, 0x0c, 0x5f, 0x12, 0x39, 0x70, 0xc5, 0x2f, 0xac, 0x49, 0x11, 0x77, 0x3b, 0xd1, 0xf2,
-	0x51, 0x80, 0x16, 0x8f, 0x92, 0xcb, 0x9c, 0x12, 0xae, 0x87, 0x1f, 0x23, 0x40, 0x6a, 0x27, 0xcf,
-	0x1e, 0x95, 0xa1, 0x05, 0x94, 0xb1, 0xd2, 0xbd, 0x32, 0x5a, 0x8e, 0x89, 0x8c, 0xc7, 0x01, 0xd5,
-	0x75, 0xe3, 0x9c, 0x88, 0x07, 0xcb, 0xf4, 0x0d, 0xbc, 0x32, 0xb7, 0x2b, 0x84, 0x4d, 0x8a, 0x16,
-	0x12, 0x1b, 0xf8, 0x00, 0x12, 0x4b, 0xaf, 0x04, 0x04, 0x31, 0xdf, 0x71, 0x4c, 0x50, 0x2d, 0x1c,
-	0x09, 0xe0, 0xd3, 0x0e, 0x8d, 0xb8, 0x38, 0x55, 0xfc, 0xdc, 0x0f, 0xa6, 0x69, 0x32, 0x88, 0xd1,
-	0xb7, 0x03, 0x0d, 0xfb, 0x19, 0xdc, 0x43, 0xf6, 0x23, 0x84, 0x7a, 0x51, 0xc3, 0x2b, 0x01, 0x4c,
-	0xba, 0xfd, 0xc8, 0x95, 0xa1, 0x61, 0xe7, 0x08, 0x75, 0x91, 0x7b, 0x8e, 0x50, 0x57, 0x77, 0x8b,
-	0x53, 0x91, 0x33, 0x73, 0x7c, 0x37, 0xf2, 0xb9, 0x1d, 0xe6, 0x59, 0xd6, 0xa2, 0x7a, 0x10, 0x97,
-	0x5e, 0x0f, 0x34, 0x24, 0xf4, 0xba, 0xe5, 0x20, 0xa2, 0xb8, 0x50, 0x85, 0x7a, 0x54, 0x5c, 0x8f,
-	0x0a, 0xf5, 0xb8, 0xc6, 0x36, 0x48, 0x08, 0x15, 0x7e, 0x3f, 0x62, 0x60, 0xc8, 0xa9, 0x14, 0x0a,
-	0xc8, 0x71, 0x5c, 0x4e, 0x86, 0x35, 0xef, 0x55, 0xfe, 0xb7, 0x1f, 0xcc, 0x33, 0xb4, 0x07, 0x7a,
-	0xba, 0x0b, 0x6d, 0xb4, 0x51, 0xb4, 0x11, 0x32, 0x91, 0x45, 0x1e, 0x61, 0x9b, 0x09, 0xb4, 0x07,
-	0x56, 0x6f, 0x82, 0x4b, 0x6c, 0x17, 0xf4, 0xbb, 0x96, 0x13, 0xb5, 0xaa, 0x34, 0xd6, 0xc0, 0x88,
-	0xac, 0xb1, 0x65, 0xf1, 0x1b, 0x30, 0xe6, 0xec, 0x19, 0x66, 0xae, 0x8c, 0xec, 0x02, 0xf2, 0xe7,
-	0x69, 0x9a, 0x4b, 0x64, 0xe6, 0xb4, 0x44, 0x1e, 0xa3, 0x22, 0x2c, 0x1c, 0x6e, 0xa2, 0x42, 0xad,
-	0x2a, 0x5d, 0xe5, 0xb1, 0x1b, 0x1c, 0xc8, 0xda, 0x28, 0x7d, 0xdd, 0x61, 0x6f, 0x62, 0x9a, 0xbb,
-	0x87, 0xba, 0x6e, 0xd3, 0xca, 0xd9, 0x5e, 0x9a, 0x0e, 0x60, 0xf9, 0x2a, 0xc7, 0x6e, 0xb0, 0xb7,
-	0xf4, 0x57, 0x81, 0x8e, 0xac, 0xb5, 0xea, 0x88, 0xdf, 0x06, 0xc5, 0xa1, 0xbc, 0x29, 0xd0, 0x23,
-	0x4e, 0x79, 0x8e, 0x6d, 0xd6, 0x2f, 0x59, 0x05, 0x8b, 0xdd, 0x50, 0xec, 0x75, 0x4b, 0xfe, 0x53,
-	0x00, 0x33, 0x0c, 0xa0, 0xa1, 0xa2, 0xe1, 0x10, 0x64, 0x23, 0x7d, 0xa3, 0x54, 0xc2, 0x87, 0x48,
-	0xdf, 0xc1, 0xb8, 0xd4, 0x4b, 0x2b, 0x3e, 0x03, 0x43, 0x34, 0xe3, 0x9c, 0xa1, 0xbb, 0xcd, 0x18,
-	0xc8, 0x88, 0xb5, 0xaa, 0x34, 0xce, 0x6c, 0xf9, 0x82, 0xac, 0x0d, 0xd2, 0xa7, 0x2d, 0x3d, 0x7d,
-	0x3f, 0x50, 0x74, 0xb2, 0x55, 0xd1, 0xb6, 0x9f, 0x96, 0x02, 0x59, 0x5e, 0x0a, 0x35, 0x91, 0x6f,
-	0x80, 0xb9, 0x36, 0x79, 0xfb, 0xf5, 0xfd, 0xd7, 0x0f, 0xa2, 0xa7, 0xb7, 0xed, 0x17, 0x60, 0xd0,
-	0xa5, 0xeb, 0x0e, 0xaf, 0xea, 0x46, 0xad, 0x2a, 0x49, 0x0d, 0xb2, 0xb9, 0x23, 0x2f, 0xea, 0xa8,
-	0x6c, 0xa3, 0x02, 0x24, 0x48, 0x4f, 0xcb, 0xc4, 0xae, 0x20, 0x39, 0x26, 0x68, 0x1c, 0xe4, 0xc3,
-	0x53, 0x5c, 0x75, 0x41, 0x78, 0xaa, 0x1d, 0x3c, 0x25, 0x3e, 0x03, 0x23, 0xf5, 0xdd, 0x1f, 0x69,
-	0x9a, 0x55, 0x1d, 0x84, 0xe8, 0x1d, 0xbd, 0xf5, 0x1d, 0x3e, 0x4c, 0xea, 0x35, 0x35, 0xdd, 0x07,
-	0xb9, 0x04, 0xbb, 0xbe, 0x3e, 0x3e, 0x00, 0xcd, 0xa7, 0x44, 0xec, 0x52, 0x8f, 0xc7, 0xca, 0xd2,
-	0x9b, 0x61, 0x10, 0xc9, 0x3a, 0x45, 0xf1, 0x7b, 0x01, 0x44, 0x4f, 0xdf, 0xae, 0x53, 0x6d, 0xe7,
-	0x5b, 0xd8, 0xef, 0x83, 0xf8, 0xbd, 0x9e, 0x21, 0xfe, 0x10, 0x7a, 0x25, 0x00, 0x31, 0xe4, 0xf0,
-	0x5e, 0xea, 0xd1, 0xe3, 0x76, 0x85, 0xc4, 0xd3, 0xbd, 0x63, 0xfc, 0x34, 0x7e, 0x17, 0xc0, 0x4c,
-	0xbb, 0x9f, 0x1c, 0x6b, 0x1d, 0x7d, 0xb7, 0x06, 0xc7, 0x1f, 0x9e, 0x03, 0xec, 0x67, 0xf8, 0x87,
-	0x00, 0xae, 0xb7, 0xbd, 0xef, 0xac, 0x9f, 0x39, 0x0a, 0x25, 0x6f, 0xf3, 0x3c, 0x68, 0x3f, 0xc9,
-	0x23, 0x01, 0x4c, 0x86, 0x1e, 0xbf, 0x2b, 0x1d, 0xdd, 0x87, 0xa0, 0xe2, 0xeb, 0x67, 0x41, 0xf9,
-	0xc9, 0xbc, 0x11, 0xc0, 0x27, 0x9d, 0x8f, 0xb0, 0x8d, 0x2e, 0x62, 0xb4, 0x77, 0x11, 0xdf, 0x3a,
-	0xb7, 0x0b, 0x3f, 0xe7, 0xdf, 0x04, 0x10, 0x6b, 0x39, 0xe2, 0x57, 0xbb, 0x88, 0x13, 0x8a, 0x8c,
-	0x3f, 0x38, 0x2b, 0xd2, 0x4b, 0x2c, 0xf3, 0xe4, 0xed, 0x71, 0x42, 0x78, 0x77, 0x9c, 0x10, 0xfe,
-	0x39, 0x4e, 0x08, 0xbf, 0x9e, 0x24, 0xfa, 0xde, 0x9d, 0x24, 0xfa, 0xfe, 0x3a, 0x49, 0xf4, 0x7d,
-	0x7d, 0xb7, 0x68, 0x90, 0xdd, 0x4a, 0x5e, 0x2d, 0x60, 0xd3, 0x3b, 0x1b, 0x94, 0x12, 0xcc, 0x3b,
-	0xfe, 0x41, 0xb1, 0xbf, 0xf4, 0x79, 0xf2, 0xa0, 0xe9, 0xb8, 0x20, 0x87, 0x65, 0xe4, 0xe4, 0x07,
-	0xdd, 0xbf, 0xff, 0x2c, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xae, 0xd8, 0x38, 0x74, 0xd4, 0x12,
-	0x00, 0x00,
+	// 2047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xd7, 0x52, 0xd4, 0xeb, 0xb3, 0x9e, 0x6b, 0xc9, 0xa2, 0x57, 0x0e, 0xe9, 0x8c, 0x1d, 0xd7,
+	0x76, 0xc5, 0x65, 0x24, 0xbb, 0xb5, 0x4d, 0xbb, 0x48, 0xc4, 0x38, 0x06, 0x84, 0x9a, 0x95, 0xb2,
+	0x76, 0x51, 0xa0, 0x40, 0x41, 0xac, 0xc8, 0xd1, 0x6a, 0x6b, 0xee, 0xa3, 0xbb, 0x43, 0x47, 0x42,
+	0x2f, 0xad, 0x91, 0x43, 0x6b, 0xf4, 0x90, 0x5e, 0x72, 0xe9, 0x21, 0x45, 0x53, 0xa0, 0xa7, 0x02,
+	0x69, 0x0f, 0xbd, 0x16, 0xbd, 0xe5, 0x98, 0x63, 0x90, 0x03, 0xdb, 0xda, 0x87, 0x14, 0x3d, 0xea,
+	0x2f, 0x28, 0xe6, 0xb1, 0xcb, 0xe5, 0x72, 0xc5, 0x25, 0x65, 0xcb, 0xe8, 0xc1, 0x17, 0x5b, 0xbb,
+	0xfb, 0x3d, 0x7e, 0xdf, 0xe3, 0x37, 0xf3, 0xcd, 0x10, 0x2e, 0x3a, 0xbe, 0xe5, 0xf8, 0xa6, 0x5f,
+	0x72, 0x1d, 0xa7, 0x69, 0xe9, 0xb6, 0x6e, 0x60, 0xaf, 0xf4, 0x78, 0x6d, 0x07, 0x13, 0x7d, 0xad,
+	0x44, 0xf6, 0x55, 0xd7, 0x73, 0x88, 0x23, 0xaf, 0x08, 0x29, 0x35, 0x22, 0xa5, 0x0a, 0x29, 0x65,
+	0xd1, 0x70, 0x0c, 0x87, 0xc9, 0x95, 0xe8, 0x5f, 0x5c, 0x45, 0x59, 0xd0, 0x2d, 0xd3, 0x76, 0x4a,
+	0xec, 0x5f, 0xf1, 0x2a, 0x5f, 0x67, 0x66, 0x4a, 0x3b, 0xba, 0x8f, 0x43, 0x1f, 0x75, 0xc7, 0xb4,
+	0xc5, 0xf7, 0xd5, 0x7e, 0x58, 0xfc, 0x0f, 0x75, 0xb7, 0xe6, 0x39, 0x2d, 0x82, 0x85, 0xf4, 0xb2,
+	0xb0, 0x66, 0xf9, 0x46, 0xe9, 0xf1, 0x1a, 0xfd, 0x4f, 0x7c, 0x28, 0x18, 0x8e, 0x63, 0x34, 0x71,
+	0x89, 0x3d, 0xed, 0xb4, 0x76, 0x4b, 0xc4, 0xb4, 0xb0, 0x4f, 0x74, 0xcb, 0xe5, 0x02, 0xe8, 0x8f,
+	0x63, 0xb0, 0x58, 0xf5, 0x8d, 0x07, 0x1f, 0xea, 0xee, 0xfb, 0xfb, 0x7a, 0x9d, 0x6c, 0x58, 0x4e,
+	0xcb, 0x26, 0x9b, 0xb6, 0x7c, 0x05, 0xc6, 0x7d, 0x6c, 0x37, 0xb0, 0x97, 0x93, 0xce, 0x4b, 0x97,
+	0xa7, 0x2a, 0x0b, 0x87, 0xed, 0xc2, 0xcc, 0x81, 0x6e, 0x35, 0xcb, 0x88, 0xbf, 0x47, 0x9a, 0x10,
+	0x90, 0xef, 0xc3, 0x38, 0x03, 0xe3, 0xe7, 0x32, 0xe7, 0x47, 0x2f, 0x9f, 0x5a, 0x57, 0xd5, 0x3e,
+	0x29, 0x52, 0xa9, 0xab, 0xc0, 0x8b, 0x46, 0xd5, 0x2a, 0xd9, 0x2f, 0xda, 0x85, 0x11, 0x4d, 0xd8,
+	0x90, 0xab, 0x30, 0x49, 0x9c, 0x47, 0xd8, 0xae, 0x99, 0x76, 0x6e, 0xf4, 0xbc, 0x74, 0xf9, 0xd4,
+	0xfa, 0x59, 0x95, 0x87, 0xa7, 0xd2, 0x64, 0x85, 0x76, 0xde, 0x73, 0x4c, 0xbb, 0xb2, 0x4c, 0x55,
+	0x0f, 0xdb, 0x85, 0x39, 0x8e, 0x2c, 0x50, 0x44, 0xda, 0x04, 0xfb, 0x73, 0xd3, 0x96, 0x2d, 0x58,
+	0xe4, 0x6f, 0x9d, 0x16, 0xa9, 0x59, 0xa6, 0x5d, 0xd3, 0x99, 0xef, 0x5c, 0x96, 0x45, 0x75, 0x87,
+	0xea, 0x7f, 0xdd, 0x2e, 0x2c, 0x71, 0x0f, 0x7e, 0xe3, 0x91, 0x6a, 0x3a, 0x25, 0x4b, 0x27, 0x7b,
+	0xea, 0xa6, 0x4d, 0x0e, 0xdb, 0x85, 0x95, 0xa8, 0xe1, 0x6e, 0x13, 0x48, 0x5b, 0x60, 0xaf, 0xb7,
+	0x5a, 0xa4, 0x6a, 0xda, 0x3c, 0x24, 0x79, 0x0b, 0x26, 0x1b, 0x58, 0x6f, 0x34, 0x4d, 0x1b, 0xe7,
+	0xc6, 0x18, 0x7a, 0x45, 0xe5, 0x35, 0x50, 0x83, 0x1a, 0xa8, 0x0f, 0x83, 0x1a, 0x54, 0x96, 0x3b,
+	0xd0, 0x03, 0x2d, 0xf4, 0xf1, 0x3f, 0x0b, 0x92, 0x16, 0x1a, 0x91, 0x1b, 0x30, 0x6f, 0xe9, 0xfb,
+	0x35, 0xd7, 0x33, 0xeb, 0xb8, 0x66, 0x5a, 0xae, 0x5e, 0x27, 0xb9, 0x71, 0x86, 0xbd, 0xfc, 0x75,
+	0xbb, 0xb0, 0xd2, 0x8b, 0xfb, 0x3e, 0x36, 0xf4, 0xfa, 0xc1, 0x5d, 0x5c, 0x3f, 0x6c, 0x17, 0x96,
+	0xb9, 0xed, 0xb8, 0x01, 0xa4, 0xcd, 0x5a, 0xfa, 0xfe, 0x36, 0x7d, 0xb3, 0xc9, 0x5e, 0x50, 0x2f,
+	0x4d, 0xd3, 0x32, 0x49, 0xcd, 0x77, 0x1d, 0xc2, 0x65, 0x73, 0x13, 0x43, 0x7a, 0x89, 0x1b, 0x40,
+	0xda, 0x2c, 0x7b, 0xf5, 0xc0, 0x75, 0x08, 0xf3, 0x55, 0xbe, 0xf9, 0xe4, 0x9b, 0xcf, 0xaf, 0x8a,
+	0xae, 0x79, 0xfa, 0xcd, 0xe7, 0x57, 0x2f, 0x27, 0x35, 0x39, 0x6d, 0xee, 0x22, 0xa6, 0xbd, 0x58,
+	0xe4, 0x79, 0x2e, 0x9a, 0x36, 0x7a, 0x22, 0xc1, 0xb9, 0xa4, 0x36, 0xd5, 0xb0, 0xef, 0x3a, 0xb6,
+	0x8f, 0xe5, 0x1d, 0x98, 0xef, 0xd4, 0x48, 0x94, 0x98, 0x37, 0xee, 0xcd, 0xb4, 0x12, 0x2f, 0xc7,
+	0x4b, 0x1c, 0x94, 0x77, 0x36, 0x28, 0x2f, 0xf7, 0x86, 0x7e, 0x3f, 0x06, 0x79, 0x0a, 0xc2, 0x6d,
+	0x9a, 0x84, 0x75, 0xee, 0x0b, 0xb1, 0xe6, 0x83, 0x18, 0x6b, 0xae, 0x0d, 0xcc, 0x9a, 0x0e, 0x80,
+	0x18, 0x75, 0xde, 0x81, 0xd9, 0x80, 0x01, 0xb5, 0x06, 0xb6, 0x1d, 0x8b, 0x11, 0x68, 0xaa, 0x72,
+	0xf6, 0xb0, 0x5d, 0x58, 0xea, 0x66, 0x08, 0xff, 0x8e, 0xb4, 0x69, 0xc1, 0x93, 0xbb, 0xf4, 0xf1,
+	0x35, 0x59, 0xfe, 0x8f, 0xc8, 0x72, 0x2d, 0x46, 0x96, 0x0b, 0x89, 0x64, 0xa1, 0xad, 0x10, 0xe1,
+	0xc9, 0x6f, 0x24, 0xb8, 0xd4, 0xbf, 0x45, 0x5f, 0x29, 0x63, 0xfe, 0x34, 0x06, 0x4b, 0xbd, 0xb4,
+	0xdd, 0x6a, 0x91, 0x61, 0x88, 0x52, 0x8d, 0x11, 0xa5, 0x34, 0x20, 0x51, 0xb6, 0x5a, 0x89, 0x24,
+	0xf9, 0x29, 0x9c, 0x0e, 0x49, 0x40, 0x6b, 0x2d, 0x42, 0xe7, 0x4c, 0xb9, 0x9d, 0x16, 0xba, 0x12,
+	0xa3, 0x51, 0xc7, 0x02, 0xd2, 0xe6, 0x05, 0x97, 0xaa, 0xfa, 0xbe, 0x68, 0xf0, 0x6d, 0x98, 0x0a,
+	0x93, 0x94, 0xcb, 0xa6, 0x6d, 0x66, 0x39, 0xb1, 0x99, 0xcd, 0xc7, 0xd2, 0x8b, 0xb4, 0xc9, 0x20,
+	0xaf, 0xaf, 0x29, 0xd3, 0x8f, 0x32, 0xb7, 0x62, 0x94, 0xb9, 0x32, 0xd8, 0xfe, 0x42, 0x53, 0xfc,
+	0x0b, 0x09, 0xde, 0x48, 0xec, 0xd4, 0x90, 0x2f, 0x35, 0x98, 0x0b, 0xab, 0xde, 0x45, 0x97, 0x1b,
+	0x69, 0x3d, 0x73, 0x26, 0xd6, 0x33, 0x41, 0xbf, 0xcc, 0x88, 0x7e, 0x11, 0x64, 0xf9, 0x6c, 0x0c,
+	0x0a, 0xfd, 0xb8, 0x3b, 0x24, 0x6d, 0xb4, 0x18, 0x6d, 0xae, 0x0f, 0x4e, 0x9b, 0x23, 0x37, 0x98,
+	0x0a, 0xcc, 0x75, 0x48, 0x1f, 0xdd, 0x61, 0x94, 0x78, 0x98, 0xa1, 0x40, 0x10, 0xe6, 0x56, 0x8b,
+	0xf0, 0x3d, 0xe6, 0x08, 0xfe, 0x65, 0x4f, 0x82, 0x7f, 0xaf, 0xd9, 0xd2, 0x87, 0x2d, 0xd7, 0x63,
+	0x6c, 0xb9, 0x98, 0xba, 0xc1, 0x50, 0xa2, 0x3c, 0x95, 0xe0, 0x5b, 0x29, 0x5d, 0xfa, 0xea, 0x28,
+	0xf3, 0x49, 0x06, 0xa6, 0xab, 0xbe, 0x51, 0xd1, 0x49, 0x7d, 0x8f, 0xe2, 0x18, 0x8e, 0x1f, 0xd9,
+	0x26, 0x36, 0x02, 0x76, 0x5c, 0xe9, 0xcb, 0x8e, 0xd0, 0xc1, 0x7d, 0x6c, 0x54, 0x4e, 0x8b, 0x65,
+	0xfa, 0x94, 0x48, 0x34, 0x36, 0x7c, 0xa4, 0x31, 0x5b, 0xf2, 0x8f, 0x60, 0xdc, 0x75, 0x9a, 0x66,
+	0xfd, 0x80, 0xd1, 0x62, 0x76, 0x7d, 0x75, 0x30, 0xab, 0xdb, 0x4c, 0x27, 0x0a, 0x96, 0x5b, 0x41,
+	0x9a, 0x30, 0x57, 0x56, 0x63, 0xb5, 0xca, 0x27, 0xd5, 0x6a, 0x87, 0xda, 0x2b, 0xd2, 0xf5, 0x0d,
+	0x7d, 0x25, 0xc1, 0x74, 0x14, 0xb4, 0xac, 0xc3, 0x24, 0x5b, 0xf3, 0xe8, 0xa9, 0x4a, 0x62, 0x4c,
+	0x58, 0x4b, 0x5d, 0x0f, 0xba, 0xe6, 0x06, 0x16, 0x79, 0x87, 0x20, 0x81, 0x31, 0xa4, 0x4d, 0xb0,
+	0x3f, 0x37, 0x6d, 0x19, 0xc3, 0x14, 0x7f, 0x4b, 0x37, 0xbb, 0x0c, 0xf3, 0xb1, 0x3e, 0x8c, 0x8f,
+	0xad, 0x16, 0xa1, 0x4e, 0x16, 0x3b, 0x3b, 0x60, 0x68, 0x0e, 0x69, 0x1c, 0xfd, 0x56, 0x8b, 0xa0,
+	0xbf, 0x67, 0x61, 0x31, 0x09, 0x5d, 0xe4, 0x18, 0x2a, 0xbd, 0xe4, 0x63, 0x68, 0xe6, 0xe4, 0x8e,
+	0xa1, 0xa3, 0x27, 0x3f, 0x59, 0x67, 0x4f, 0x6a, 0xe1, 0x1b, 0x7b, 0x25, 0x0b, 0xdf, 0xf8, 0xcb,
+	0x5e, 0xf8, 0xd0, 0x3f, 0xb2, 0xb0, 0x94, 0xd8, 0x7b, 0x72, 0x35, 0xd6, 0x42, 0x27, 0x33, 0x6a,
+	0x66, 0x4e, 0x7c, 0xd4, 0x1c, 0x7d, 0xd9, 0xa3, 0xe6, 0xeb, 0x1e, 0x8a, 0xf5, 0xd0, 0x01, 0xbb,
+	0x36, 0x0b, 0x97, 0xd8, 0x70, 0xcb, 0xd3, 0x61, 0xc2, 0xc3, 0x7e, 0xab, 0x49, 0x06, 0x6b, 0xa1,
+	0xe8, 0x1a, 0xad, 0x31, 0xbd, 0xca, 0x19, 0x51, 0x9a, 0x59, 0x0e, 0x45, 0x58, 0x43, 0x5a, 0x60,
+	0x17, 0xfd, 0x55, 0x02, 0xb9, 0x57, 0x4f, 0x5e, 0x85, 0x09, 0xbf, 0x55, 0xaf, 0x63, 0xdf, 0x67,
+	0x0b, 0xfc, 0x64, 0x45, 0xee, 0x18, 0x11, 0x1f, 0x90, 0x16, 0x88, 0xc8, 0xf7, 0x60, 0xbc, 0xab,
+	0x1b, 0xd5, 0xb4, 0x6e, 0x14, 0x1b, 0x53, 0xd0, 0x80, 0x42, 0x5b, 0xbe, 0x04, 0x63, 0xd8, 0xf3,
+	0x1c, 0x4f, 0x2c, 0x64, 0xf3, 0x87, 0xed, 0xc2, 0x34, 0x97, 0x64, 0xaf, 0x91, 0xc6, 0x3f, 0xa3,
+	0x5f, 0x67, 0x60, 0x99, 0x8e, 0x0d, 0x98, 0x4f, 0x81, 0xdb, 0xba, 0xe9, 0x3d, 0xd4, 0x1f, 0x61,
+	0xef, 0x1e, 0xc6, 0xc3, 0x6c, 0xda, 0x1f, 0x49, 0xb0, 0xc8, 0xc6, 0xca, 0x9a, 0xab, 0x9b, 0x5e,
+	0x8d, 0x50, 0x13, 0xb5, 0x5d, 0x8c, 0x07, 0xba, 0x79, 0xec, 0xf1, 0x5c, 0xb9, 0x20, 0x72, 0xbd,
+	0x12, 0x34, 0x6e, 0xaf, 0x65, 0xa4, 0x2d, 0x34, 0xe2, 0x7a, 0xe5, 0x3b, 0xb1, 0xed, 0x38, 0xf1,
+	0xb6, 0xd6, 0xc7, 0xa4, 0xc8, 0x54, 0x8b, 0xd4, 0x62, 0x91, 0x59, 0x2c, 0x52, 0x8b, 0xb7, 0xa1,
+	0x70, 0x44, 0x2a, 0xc2, 0x36, 0xca, 0xc5, 0x8a, 0x19, 0x16, 0x0e, 0xfd, 0x3b, 0x03, 0x17, 0xb9,
+	0x76, 0xa0, 0xf4, 0x60, 0x4f, 0xf7, 0xf0, 0x86, 0xe1, 0x61, 0x6c, 0x61, 0x9b, 0xdc, 0x73, 0x3c,
+	0x3e, 0x67, 0x0f, 0x91, 0xd5, 0x4b, 0x30, 0xc6, 0x87, 0xf9, 0x4c, 0xbc, 0x88, 0x62, 0x84, 0xe7,
+	0x9f, 0xe5, 0x9f, 0xc0, 0xb4, 0xff, 0xc8, 0xb4, 0x6a, 0x2e, 0xf6, 0xea, 0x38, 0xdc, 0xbc, 0xca,
+	0xa2, 0x75, 0x52, 0xa8, 0x75, 0x5a, 0xf8, 0x8e, 0x18, 0x40, 0xda, 0x29, 0xfa, 0xb8, 0xcd, 0x9f,
+	0xe4, 0xb2, 0x30, 0xaf, 0x37, 0x1a, 0x1e, 0x8d, 0x9c, 0x1f, 0x09, 0x96, 0x63, 0xba, 0xe2, 0xab,
+	0xd0, 0xdd, 0xe0, 0x4f, 0xe5, 0xef, 0xc7, 0x2a, 0x72, 0xfb, 0xa8, 0x8a, 0x84, 0x65, 0x28, 0xfa,
+	0x34, 0x6f, 0x45, 0x3d, 0x48, 0x5c, 0x71, 0xd7, 0xf1, 0x78, 0xbd, 0x90, 0x0a, 0xab, 0x83, 0xa4,
+	0x38, 0xa8, 0x16, 0xfa, 0x9b, 0x04, 0x2b, 0x5c, 0x41, 0xc3, 0x86, 0xe9, 0x13, 0xec, 0xe1, 0xc6,
+	0x46, 0xb3, 0xe9, 0x1c, 0xe0, 0xc6, 0xb6, 0xe3, 0x34, 0x87, 0x29, 0xc5, 0xb7, 0x61, 0x82, 0x22,
+	0xae, 0x99, 0x0d, 0x56, 0x8c, 0x6c, 0x94, 0xc5, 0xe2, 0x03, 0x9b, 0x0a, 0x9d, 0xe6, 0x66, 0xa3,
+	0xfc, 0x4e, 0x2c, 0xe8, 0xd2, 0x51, 0x41, 0x7b, 0x21, 0xac, 0xa2, 0xce, 0x71, 0x15, 0xa9, 0x08,
+	0x7a, 0x0b, 0x2e, 0xf4, 0xc1, 0x1d, 0xc6, 0xf7, 0x5f, 0x09, 0x64, 0x2e, 0x47, 0x5f, 0x1f, 0x87,
+	0xb7, 0x3f, 0x83, 0x39, 0x86, 0x3e, 0xa4, 0xd5, 0x60, 0x73, 0x77, 0xd4, 0x5d, 0x25, 0x2f, 0xc8,
+	0x7a, 0x26, 0x92, 0x8d, 0x8e, 0x3d, 0xa4, 0xcd, 0xb8, 0x11, 0x69, 0xbf, 0xfc, 0xdd, 0x58, 0x72,
+	0x2e, 0x1d, 0x95, 0x1c, 0xfa, 0x1c, 0x61, 0xe7, 0x39, 0x50, 0x7a, 0x63, 0x0d, 0x53, 0xf1, 0x67,
+	0x89, 0xdd, 0x68, 0x69, 0xd8, 0x72, 0x1e, 0xe3, 0xe3, 0x66, 0x43, 0x85, 0x49, 0x51, 0x4b, 0x9e,
+	0x86, 0x6c, 0x74, 0xb2, 0x0e, 0xbe, 0x20, 0x6d, 0x82, 0x97, 0xd9, 0x1f, 0xec, 0x5e, 0xc3, 0x63,
+	0x90, 0xe2, 0xd1, 0x14, 0xe0, 0x8d, 0x44, 0xb8, 0x61, 0x40, 0xbf, 0x93, 0x60, 0xa6, 0xea, 0x1b,
+	0xdb, 0x7a, 0xcb, 0x67, 0x02, 0xfe, 0x49, 0x06, 0x52, 0x8a, 0x05, 0x52, 0x48, 0x0a, 0xc4, 0xa5,
+	0x50, 0x58, 0x1c, 0x3e, 0x5a, 0x86, 0xa5, 0x2e, 0x70, 0x21, 0xec, 0x4f, 0x25, 0x98, 0xab, 0xfa,
+	0xc6, 0x0f, 0x6d, 0xf7, 0x95, 0x00, 0x5f, 0x8b, 0x01, 0x7f, 0x33, 0x09, 0x78, 0xcb, 0x8e, 0x42,
+	0x3f, 0x0b, 0xcb, 0x31, 0x80, 0x21, 0xf8, 0xff, 0x64, 0x60, 0xa1, 0x77, 0x1b, 0xfc, 0x1e, 0x8c,
+	0xb3, 0xe5, 0xe7, 0x6d, 0x01, 0xff, 0xad, 0xc3, 0x76, 0xa1, 0x10, 0x59, 0x86, 0xdf, 0x46, 0xab,
+	0x0d, 0xec, 0x7a, 0xb8, 0xae, 0x13, 0xdc, 0x28, 0x23, 0xe2, 0xb5, 0x30, 0xca, 0x49, 0x9a, 0x50,
+	0x0a, 0xd5, 0xd7, 0x72, 0x99, 0x44, 0xf5, 0xb5, 0x7e, 0xea, 0x6b, 0xf2, 0x43, 0x98, 0xea, 0xec,
+	0xa6, 0xa3, 0x5d, 0xa7, 0xf4, 0x94, 0x85, 0x3d, 0x98, 0x21, 0x3b, 0x3b, 0xe6, 0x24, 0xe9, 0xc4,
+	0xd4, 0xf5, 0x03, 0x43, 0x2e, 0x3b, 0xdc, 0xef, 0x11, 0xef, 0x42, 0xf7, 0xe5, 0x51, 0x6e, 0x6c,
+	0xc8, 0xdb, 0x26, 0xf4, 0x5b, 0x09, 0xa6, 0xbb, 0x68, 0x1a, 0x59, 0x60, 0xa5, 0xb4, 0x05, 0xb6,
+	0x3b, 0x29, 0x99, 0x97, 0x94, 0x94, 0xab, 0xb7, 0x60, 0x2e, 0x76, 0xf4, 0x97, 0xe7, 0x61, 0x7a,
+	0xa3, 0xd9, 0xdc, 0xf2, 0x7e, 0xe0, 0x90, 0x3d, 0xd3, 0x36, 0xe6, 0x47, 0xe4, 0x59, 0x80, 0x0a,
+	0xf6, 0xc9, 0xfb, 0xbb, 0xbb, 0x8e, 0x47, 0xe6, 0x25, 0x25, 0xfb, 0xab, 0xcf, 0xf2, 0x23, 0xeb,
+	0x4f, 0x66, 0x60, 0xb4, 0xea, 0x1b, 0xf2, 0x2f, 0x25, 0x58, 0xe8, 0xfd, 0xf5, 0xa9, 0xff, 0x91,
+	0x3e, 0xe9, 0xf7, 0x33, 0xe5, 0xd6, 0xd0, 0x2a, 0xe1, 0x8c, 0xf2, 0x91, 0x04, 0x72, 0xc2, 0x15,
+	0xe5, 0xfa, 0x90, 0x16, 0xb7, 0x5a, 0x44, 0x29, 0x0f, 0xaf, 0x13, 0xc2, 0xf8, 0x54, 0x82, 0x95,
+	0x7e, 0x3f, 0xc9, 0xdd, 0x4e, 0xb5, 0x7d, 0xb4, 0xb2, 0xf2, 0xde, 0x0b, 0x28, 0x87, 0x08, 0xff,
+	0x20, 0xc1, 0xb9, 0xbe, 0xb7, 0xba, 0x77, 0x8e, 0xed, 0x85, 0x26, 0xef, 0xee, 0x8b, 0x68, 0x87,
+	0x20, 0x4d, 0x98, 0x8a, 0x5c, 0xa3, 0xa5, 0x99, 0x0c, 0x45, 0x95, 0xb5, 0x81, 0x45, 0x43, 0x57,
+	0x4d, 0x80, 0xc8, 0x76, 0x73, 0x35, 0xcd, 0x40, 0x47, 0x56, 0x59, 0x1f, 0x5c, 0x36, 0xf4, 0xe6,
+	0xc1, 0x74, 0xd7, 0x2e, 0xb1, 0x9a, 0x66, 0x23, 0x2a, 0xad, 0x5c, 0x1f, 0x46, 0x3a, 0xf4, 0xf9,
+	0x54, 0x82, 0xc5, 0xc4, 0xa3, 0x4e, 0xaa, 0xb9, 0x24, 0x2d, 0xe5, 0xce, 0x71, 0xb4, 0x42, 0x30,
+	0x7f, 0x91, 0xe0, 0xcd, 0xf4, 0xe3, 0xc2, 0xc6, 0x00, 0x3e, 0xfa, 0x9b, 0x50, 0x36, 0x5f, 0xd8,
+	0x44, 0x88, 0xf9, 0x13, 0x09, 0x72, 0x47, 0x8e, 0xd3, 0x37, 0x07, 0xf0, 0x93, 0xa8, 0xa9, 0xbc,
+	0x7b, 0x5c, 0xcd, 0x10, 0xd8, 0xcf, 0x61, 0x2e, 0x3e, 0x06, 0x97, 0x06, 0x30, 0x1a, 0x55, 0x50,
+	0x6e, 0x0c, 0xa9, 0xd0, 0xb5, 0xe2, 0x26, 0x4c, 0x9e, 0xa9, 0xac, 0xe8, 0xd5, 0x51, 0xca, 0xc3,
+	0xeb, 0x04, 0x30, 0x2a, 0x1f, 0x7c, 0xf1, 0x2c, 0x2f, 0x7d, 0xf9, 0x2c, 0x2f, 0xfd, 0xeb, 0x59,
+	0x5e, 0xfa, 0xf8, 0x79, 0x7e, 0xe4, 0xcb, 0xe7, 0xf9, 0x91, 0xaf, 0x9e, 0xe7, 0x47, 0x7e, 0x7c,
+	0xc3, 0x30, 0xc9, 0x5e, 0x6b, 0x47, 0xad, 0x3b, 0x56, 0x70, 0x16, 0x29, 0x36, 0xf5, 0x1d, 0x3f,
+	0x78, 0x28, 0x3d, 0x5e, 0xff, 0x4e, 0x69, 0xbf, 0x6b, 0x68, 0x22, 0x07, 0x2e, 0xf6, 0x77, 0xc6,
+	0xd9, 0x8d, 0xd2, 0xb5, 0xff, 0x0d, 0x00, 0xb2, 0x38, 0xa0, 0xe1, 0x83, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,9 +1863,14 @@ type MsgClient interface {
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	BatchSwap(ctx context.Context, in *MsgBatchSwap, opts ...grpc.CallOption) (*MsgBatchSwapResponse, error)
+	PausePools(ctx context.Context, in *MsgPausePools, opts ...grpc.CallOption) (*MsgPausePoolsResponse, error)
+	UnpausePools(ctx context.Context, in *MsgUnpausePools, opts ...grpc.CallOption) (*MsgUnpausePoolsResponse, error)
 	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegisteredAlloyedPool, opts ...grpc.CallOption) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	SetPoolTakerFee(ctx context.Context, in *MsgSetPoolTakerFee, opts ...grpc.CallOption) (*MsgSetPoolTakerFeeResponse, error)
+	RemovePoolTakerFee(ctx context.Context, in *MsgRemovePoolTakerFee, opts ...grpc.CallOption) (*MsgRemovePoolTakerFeeResponse, error)
 }
 
 type msgClient struct {
@@ -979,16 +1917,43 @@ func (c *msgClient) SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSpl
 	return out, nil
 }
 
-func (c *msgClient) SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error) {
-	out := new(MsgSetDenomPairTakerFeeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetDenomPairTakerFee", in, out, opts...)
+func (c *msgClient) BatchSwap(ctx context.Context, in *MsgBatchSwap, opts ...grpc.CallOption) (*MsgBatchSwapResponse, error) {
+	out := new(MsgBatchSwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/BatchSwap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error) {
+func (c *msgClient) PausePools(ctx context.Context, in *MsgPausePools, opts ...grpc.CallOption) (*MsgPausePoolsResponse, error) {
+	out := new(MsgPausePoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/PausePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpausePools(ctx context.Context, in *MsgUnpausePools, opts ...grpc.CallOption) (*MsgUnpausePoolsResponse, error) {
+	out := new(MsgUnpausePoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/UnpausePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error) {
+	out := new(MsgSetDenomPairTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetDenomPairTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error) {
 	out := new(MsgSetTakerFeeShareAgreementForDenomResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetTakerFeeShareAgreementForDenom", in, out, opts...)
 	if err != nil {
@@ -1006,15 +1971,38 @@ func (c *msgClient) SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegi
 	return out, nil
 }
 
+func (c *msgClient) SetPoolTakerFee(ctx context.Context, in *MsgSetPoolTakerFee, opts ...grpc.CallOption) (*MsgSetPoolTakerFeeResponse, error) {
+	out := new(MsgSetPoolTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetPoolTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePoolTakerFee(ctx context.Context, in *MsgRemovePoolTakerFee, opts ...grpc.CallOption) (*MsgRemovePoolTakerFeeResponse, error) {
+	out := new(MsgRemovePoolTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/RemovePoolTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	BatchSwap(context.Context, *MsgBatchSwap) (*MsgBatchSwapResponse, error)
+	PausePools(context.Context, *MsgPausePools) (*MsgPausePoolsResponse, error)
+	UnpausePools(context.Context, *MsgUnpausePools) (*MsgUnpausePoolsResponse, error)
 	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(context.Context, *MsgSetTakerFeeShareAgreementForDenom) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(context.Context, *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	SetPoolTakerFee(context.Context, *MsgSetPoolTakerFee) (*MsgSetPoolTakerFeeResponse, error)
+	RemovePoolTakerFee(context.Context, *MsgRemovePoolTakerFee) (*MsgRemovePoolTakerFeeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1033,6 +2021,15 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context, req *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) BatchSwap(ctx context.Context, req *MsgBatchSwap) (*MsgBatchSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSwap not implemented")
+}
+func (*UnimplementedMsgServer) PausePools(ctx context.Context, req *MsgPausePools) (*MsgPausePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePools not implemented")
+}
+func (*UnimplementedMsgServer) UnpausePools(ctx context.Context, req *MsgUnpausePools) (*MsgUnpausePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpausePools not implemented")
+}
 func (*UnimplementedMsgServer) SetDenomPairTakerFee(ctx context.Context, req *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPairTakerFee not implemented")
 }
@@ -1042,6 +2039,12 @@ func (*UnimplementedMsgServer) SetTakerFeeShareAgreementForDenom(ctx context.Con
 func (*UnimplementedMsgServer) SetRegisteredAlloyedPool(ctx context.Context, req *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRegisteredAlloyedPool not implemented")
 }
+func (*UnimplementedMsgServer) SetPoolTakerFee(ctx context.Context, req *MsgSetPoolTakerFee) (*MsgSetPoolTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolTakerFee not implemented")
+}
+func (*UnimplementedMsgServer) RemovePoolTakerFee(ctx context.Context, req *MsgRemovePoolTakerFee) (*MsgRemovePoolTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePoolTakerFee not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1119,6 +2122,60 @@ func _Msg_SplitRouteSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/BatchSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSwap(ctx, req.(*MsgBatchSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PausePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPausePools)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PausePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/PausePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PausePools(ctx, req.(*MsgPausePools))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpausePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpausePools)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpausePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/UnpausePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpausePools(ctx, req.(*MsgUnpausePools))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetDenomPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetDenomPairTakerFee)
 	if err := dec(in); err != nil {
@@ -1173,6 +2230,42 @@ func _Msg_SetRegisteredAlloyedPool_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPoolTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolTakerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SetPoolTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolTakerFee(ctx, req.(*MsgSetPoolTakerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePoolTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePoolTakerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePoolTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/RemovePoolTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePoolTakerFee(ctx, req.(*MsgRemovePoolTakerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1193,6 +2286,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitRouteSwapExactAmountOut",
 			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "BatchSwap",
+			Handler:    _Msg_BatchSwap_Handler,
+		},
+		{
+			MethodName: "PausePools",
+			Handler:    _Msg_PausePools_Handler,
+		},
+		{
+			MethodName: "UnpausePools",
+			Handler:    _Msg_UnpausePools_Handler,
+		},
 		{
 			MethodName: "SetDenomPairTakerFee",
 			Handler:    _Msg_SetDenomPairTakerFee_Handler,
@@ -1205,6 +2310,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRegisteredAlloyedPool",
 			Handler:    _Msg_SetRegisteredAlloyedPool_Handler,
 		},
+		{
+			MethodName: "SetPoolTakerFee",
+			Handler:    _Msg_SetPoolTakerFee_Handler,
+		},
+		{
+			MethodName: "RemovePoolTakerFee",
+			Handler:    _Msg_RemovePoolTakerFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1230,6 +2343,40 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1327,6 +2474,40 @@ func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1421,6 +2602,40 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1518,6 +2733,40 @@ func (m *MsgSplitRouteSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenInMaxAmount.Size()
 		i -= size
@@ -1592,7 +2841,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1602,20 +2851,25 @@ func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomPairTakerFee) > 0 {
-		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Policy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1636,7 +2890,7 @@ func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchSwapLeg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1646,30 +2900,44 @@ func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSwapLeg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchSwapLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Success {
+	if m.ExactOut != nil {
+		{
+			size, err := m.ExactOut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if m.ExactIn != nil {
+		{
+			size, err := m.ExactIn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenom) Marshal() (dAtA []byte, err error) {
+func (m *SwapExactAmountInLeg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1679,51 +2947,88 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenom) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapExactAmountInLeg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapExactAmountInLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SkimAddress) > 0 {
-		i -= len(m.SkimAddress)
-		copy(dAtA[i:], m.SkimAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SkimAddress)))
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x22
 	}
 	{
-		size := m.SkimPercent.Size()
+		size := m.TokenOutMinAmount.Size()
 		i -= size
-		if _, err := m.SkimPercent.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *SwapExactAmountOutLeg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1733,20 +3038,88 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapExactAmountOutLeg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapExactAmountOutLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
+	if m.LimitSpotPrice != nil {
+		{
+			size := m.LimitSpotPrice.Size()
+			i -= size
+			if _, err := m.LimitSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetRegisteredAlloyedPool) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSwapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1756,20 +3129,116 @@ func (m *MsgSetRegisteredAlloyedPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetRegisteredAlloyedPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetRegisteredAlloyedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSwapLegResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwapLegResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwapLegResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairTakerFee) > 0 {
+		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -1781,7 +3250,7 @@ func (m *MsgSetRegisteredAlloyedPool) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetRegisteredAlloyedPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1791,20 +3260,30 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetTakerFeeShareAgreementForDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1814,240 +3293,522 @@ func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetTakerFeeShareAgreementForDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetTakerFeeShareAgreementForDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+	if len(m.SkimAddress) > 0 {
+		i -= len(m.SkimAddress)
+		copy(dAtA[i:], m.SkimAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SkimAddress)))
 		i--
 		dAtA[i] = 0x22
 	}
 	{
-		size := m.TakerFee.Size()
+		size := m.SkimPercent.Size()
 		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SkimPercent.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetRegisteredAlloyedPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetRegisteredAlloyedPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegisteredAlloyedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetRegisteredAlloyedPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPoolTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.PoolTakerFees) > 0 {
+		for iNdEx := len(m.PoolTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPoolTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemovePoolTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.PoolIds) > 0 {
+		dAtA14 := make([]byte, len(m.PoolIds)*10)
+		var j13 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemovePoolTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePoolTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePoolTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPausePools) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePools) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomPairTakerFee) > 0 {
-		for _, e := range m.DenomPairTakerFee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.PoolIds) > 0 {
+		dAtA16 := make([]byte, len(m.PoolIds)*10)
+		var j15 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPausePoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPausePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPausePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpausePools) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
+func (m *MsgUnpausePools) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpausePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA18 := make([]byte, len(m.PoolIds)*10)
+		var j17 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpausePoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpausePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpausePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2057,29 +3818,43 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	l = m.SkimPercent.Size()
+	l = m.TokenIn.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SkimAddress)
-	if l > 0 {
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Size() (n int) {
+func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSetRegisteredAlloyedPool) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2089,55 +3864,2537 @@ func (m *MsgSetRegisteredAlloyedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgSetRegisteredAlloyedPoolResponse) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *DenomPairTakerFee) Size() (n int) {
+func (m *MsgSwapExactAmountOut) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom0)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom1)
-	if l > 0 {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TakerFee.Size()
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TokenInDenom)
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	l = len(m.TokenOutDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBatchSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Policy != 0 {
+		n += 1 + sovTx(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *BatchSwapLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExactIn != nil {
+		l = m.ExactIn.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExactOut != nil {
+		l = m.ExactOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapExactAmountInLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapExactAmountOutLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSpotPrice != nil {
+		l = m.LimitSpotPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchSwapLegResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SkimPercent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SkimAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRegisteredAlloyedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgSetRegisteredAlloyedPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolTakerFees) > 0 {
+		for _, e := range m.PoolTakerFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPoolTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgRemovePoolTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPausePools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPausePoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpausePools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUnpausePoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, BatchSwapLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= BatchSwapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSwapLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwapLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwapLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExactIn == nil {
+				m.ExactIn = &SwapExactAmountInLeg{}
+			}
+			if err := m.ExactIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExactOut == nil {
+				m.ExactOut = &SwapExactAmountOutLeg{}
+			}
+			if err := m.ExactOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountInLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountInLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountInLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountOutLeg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2156,19 +6413,53 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountOutLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountOutLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2196,11 +6487,13 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2227,14 +6520,13 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2261,13 +6553,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,7 +6590,45 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LimitSpotPrice = &v
+			if err := m.LimitSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2320,7 +6653,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,17 +6676,17 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2363,23 +6696,23 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, BatchSwapLegResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2404,7 +6737,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *BatchSwapLegResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,15 +6760,35 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSwapLegResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSwapLegResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2463,13 +6816,15 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2479,29 +6834,77 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2529,13 +6932,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2545,23 +6948,23 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2586,7 +6989,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,17 +7012,17 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2629,26 +7032,12 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2670,7 +7059,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,10 +7082,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2733,9 +7122,9 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2745,29 +7134,27 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,15 +7182,15 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2813,24 +7200,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SkimAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2853,7 +7239,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2876,46 +7262,12 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2937,7 +7289,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,10 +7312,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2999,10 +7351,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3012,29 +7364,114 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3062,13 +7499,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3078,23 +7515,23 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTakerFees = append(m.PoolTakerFees, PoolTakerFee{})
+			if err := m.PoolTakerFees[len(m.PoolTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3119,7 +7556,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,46 +7579,12 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3203,7 +7606,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePoolTakerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3226,10 +7629,10 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePoolTakerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3262,42 +7665,84 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
-			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3319,7 +7764,7 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePoolTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,32 +7787,12 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePoolTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePoolTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3389,7 +7814,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgPausePools) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,10 +7837,10 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPausePools: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPausePools: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3451,103 +7876,81 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SkimAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3569,7 +7972,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPausePoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3592,10 +7995,10 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPausePoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPausePoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3619,7 +8022,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpausePools) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3642,10 +8045,10 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpausePools: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpausePools: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3681,23 +8084,80 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3720,7 +8180,7 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpausePoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3743,10 +8203,10 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpausePoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpausePoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3982,6 +8442,109 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,158 @@
+package poolmanager
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// getVolumeTakerFeeParams returns the volume taker fee tiers and the number of days over which account volume is summed.
+func (k Keeper) getVolumeTakerFeeParams(ctx sdk.Context) ([]types.VolumeTakerFeeTier, uint64) {
+	tiers := []types.VolumeTakerFeeTier{}
+	k.paramSpace.Get(ctx, types.KeyVolumeTakerFeeTiers, &tiers)
+	var windowDays uint64
+	k.paramSpace.Get(ctx, types.KeyVolumeTakerFeeWindowDays, &windowDays)
+	return tiers, windowDays
+}
+
+// currentDay returns the number of days since the unix epoch at the block time.
+func currentDay(ctx sdk.Context) uint64 {
+	return uint64(max(ctx.BlockTime().Unix(), 0)) / secondsPerDay
+}
+
+// addAccountVolume adds the token in of a route swapped by the account to its volume for the current day, and prunes
+// the volumes of the account that are outside of the volume taker fee window.
+// The volume is kept in native units and only for the denoms of the volume taker fee tiers, so that it can't be
+// inflated by moving a price in the same transaction. It is only tracked while tiers are configured.
+func (k Keeper) addAccountVolume(ctx sdk.Context, account sdk.AccAddress, tokenIn sdk.Coin) {
+	if account == nil || !tokenIn.IsPositive() {
+		return
+	}
+	tiers, windowDays := k.getVolumeTakerFeeParams(ctx)
+	if windowDays == 0 || !isVolumeTakerFeeDenom(tiers, tokenIn.Denom) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	today := currentDay(ctx)
+	k.pruneAccountVolumes(store, account, today, windowDays)
+
+	key := types.KeyAccountVolume(account, today)
+	volume := types.TrackedVolume{}
+	if _, err := osmoutils.Get(store, key, &volume); err != nil {
+		panic(err)
+	}
+	volume.Amount = volume.Amount.Add(tokenIn)
+	osmoutils.MustSet(store, key, &volume)
+}
+
+// isVolumeTakerFeeDenom returns true if the denom is used by the min volumes of any of the tiers.
+func isVolumeTakerFeeDenom(tiers []types.VolumeTakerFeeTier, denom string) bool {
+	for _, tier := range tiers {
+		if found, _ := tier.MinVolumes.Find(denom); found {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneAccountVolumes deletes the volumes of the account for the days before the window ending today.
+func (k Keeper) pruneAccountVolumes(store storetypes.KVStore, account sdk.AccAddress, today, windowDays uint64) {
+	firstDay := windowStart(today, windowDays)
+	if firstDay == 0 {
+		return
+	}
+
+	prefix := types.KeyAccountVolumes(account)
+	iterator := store.Iterator(prefix, types.KeyAccountVolume(account, firstDay))
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
+
+// windowStart returns the first day of the window of windowDays days ending today.
+func windowStart(today, windowDays uint64) uint64 {
+	if windowDays > today {
+		return 0
+	}
+	return today - windowDays + 1
+}
+
+// GetAccountTrailingVolume returns the swap volume of the account over the volume taker fee window, in each of the
+// denoms of the volume taker fee tiers.
+func (k Keeper) GetAccountTrailingVolume(ctx sdk.Context, account sdk.AccAddress) sdk.Coins {
+	_, windowDays := k.getVolumeTakerFeeParams(ctx)
+	return k.getAccountTrailingVolume(ctx, account, windowDays)
+}
+
+func (k Keeper) getAccountTrailingVolume(ctx sdk.Context, account sdk.AccAddress, windowDays uint64) sdk.Coins {
+	total := sdk.NewCoins()
+	if windowDays == 0 {
+		return total
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	today := currentDay(ctx)
+	iterator := store.Iterator(
+		types.KeyAccountVolume(account, windowStart(today, windowDays)),
+		storetypes.PrefixEndBytes(types.KeyAccountVolumes(account)),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.TrackedVolume
+		if err := volume.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		total = total.Add(volume.Amount...)
+	}
+	return total
+}
+
+// GetAccountVolumeTakerFeeTier returns the trailing volume of the account and the last volume taker fee tier
+// it reaches. It returns false if the account doesn't reach any tier.
+func (k Keeper) GetAccountVolumeTakerFeeTier(ctx sdk.Context, account sdk.AccAddress) (sdk.Coins, types.VolumeTakerFeeTier, bool) {
+	tiers, windowDays := k.getVolumeTakerFeeParams(ctx)
+	trailingVolume := k.getAccountTrailingVolume(ctx, account, windowDays)
+	tier, found := volumeTakerFeeTierFor(tiers, trailingVolume)
+	return trailingVolume, tier, found
+}
+
+// volumeTakerFeeTierFor returns the last tier, i.e. the one with the lowest discount multiplier, that is reached
+// by the volume in any of the denoms of its min volumes.
+func volumeTakerFeeTierFor(tiers []types.VolumeTakerFeeTier, volume sdk.Coins) (types.VolumeTakerFeeTier, bool) {
+	for i := len(tiers) - 1; i >= 0; i-- {
+		for _, minVolume := range tiers[i].MinVolumes {
+			if volume.AmountOf(minVolume.Denom).GTE(minVolume.Amount) {
+				return tiers[i], true
+			}
+		}
+	}
+	return types.VolumeTakerFeeTier{}, false
+}
+
+// applyVolumeTakerFeeDiscount multiplies the taker fee by the discount multiplier of the volume taker fee tier
+// reached by the sender, if any.
+func (k Keeper) applyVolumeTakerFeeDiscount(ctx sdk.Context, sender sdk.AccAddress, takerFee osmomath.Dec) osmomath.Dec {
+	tiers, windowDays := k.getVolumeTakerFeeParams(ctx)
+	if len(tiers) == 0 || takerFee.IsZero() {
+		return takerFee
+	}
+
+	tier, found := volumeTakerFeeTierFor(tiers, k.getAccountTrailingVolume(ctx, sender, windowDays))
+	if !found {
+		return takerFee
+	}
+	return takerFee.Mul(tier.DiscountMultiplier)
+}
@@ -0,0 +1,206 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v25/x/txfees/types"
+)
+
+var defaultVolumeTakerFeeTiers = []types.VolumeTakerFeeTier{
+	{MinVolumes: sdk.NewCoins(sdk.NewInt64Coin(apptesting.USDC, 1_000), sdk.NewInt64Coin(apptesting.ETH, 500)), DiscountMultiplier: osmomath.MustNewDecFromStr("0.5")},
+	{MinVolumes: sdk.NewCoins(sdk.NewInt64Coin(apptesting.USDC, 10_000)), DiscountMultiplier: osmomath.ZeroDec()},
+}
+
+func usdcVolume(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(apptesting.USDC, amount)
+}
+
+func (s *KeeperTestSuite) setVolumeTakerFeeParams(tiers []types.VolumeTakerFeeTier, windowDays uint64) {
+	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	params.TakerFeeParams.VolumeTakerFeeTiers = tiers
+	params.TakerFeeParams.VolumeTakerFeeWindowDays = windowDays
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, params)
+}
+
+// validates that account volume is summed over the window and that older volume is pruned.
+func (s *KeeperTestSuite) TestAccountTrailingVolume() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	account := s.TestAccs[0]
+	day := 24 * time.Hour
+
+	// Volume isn't tracked while no tiers are configured.
+	poolManager.AddAccountVolume(s.Ctx, account, usdcVolume(100))
+	s.setVolumeTakerFeeParams(defaultVolumeTakerFeeTiers, 3)
+	s.Require().True(poolManager.GetAccountTrailingVolume(s.Ctx, account).IsZero())
+
+	// Nor for denoms without a min volume.
+	poolManager.AddAccountVolume(s.Ctx, account, sdk.NewInt64Coin(apptesting.BAR, 100))
+	s.Require().True(poolManager.GetAccountTrailingVolume(s.Ctx, account).IsZero())
+
+	poolManager.AddAccountVolume(s.Ctx, account, usdcVolume(100))
+	poolManager.AddAccountVolume(s.Ctx, account, usdcVolume(200))
+	s.Require().Equal(sdk.NewCoins(usdcVolume(300)), poolManager.GetAccountTrailingVolume(s.Ctx, account))
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(day))
+	poolManager.AddAccountVolume(s.Ctx, account, usdcVolume(400))
+	s.Require().Equal(sdk.NewCoins(usdcVolume(700)), poolManager.GetAccountTrailingVolume(s.Ctx, account))
+	s.Require().True(poolManager.GetAccountTrailingVolume(s.Ctx, s.TestAccs[1]).IsZero())
+
+	// The volume of the first day leaves the 3 day window.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * day))
+	s.Require().Equal(sdk.NewCoins(usdcVolume(400)), poolManager.GetAccountTrailingVolume(s.Ctx, account))
+
+	// Adding volume prunes the volume outside of the window.
+	poolManager.AddAccountVolume(s.Ctx, account, usdcVolume(800))
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	iterator := store.Iterator(types.KeyAccountVolumes(account), nil)
+	var days []uint64
+	for ; iterator.Valid() && len(days) < 3; iterator.Next() {
+		parsedDay, err := types.ParseAccountVolumeDay(iterator.Key())
+		s.Require().NoError(err)
+		days = append(days, parsedDay)
+	}
+	iterator.Close()
+	s.Require().Len(days, 2)
+	s.Require().Equal(days[0]+2, days[1])
+	s.Require().Equal(sdk.NewCoins(usdcVolume(1200)), poolManager.GetAccountTrailingVolume(s.Ctx, account))
+}
+
+func (s *KeeperTestSuite) TestGetAccountVolumeTakerFeeTier() {
+	tests := map[string]struct {
+		volume sdk.Coins
+
+		expectedFound      bool
+		expectedMultiplier osmomath.Dec
+	}{
+		"no volume": {
+			volume: sdk.NewCoins(),
+		},
+		"below the first tier": {
+			volume: sdk.NewCoins(usdcVolume(999), sdk.NewInt64Coin(apptesting.ETH, 499)),
+		},
+		"first tier": {
+			volume:             sdk.NewCoins(usdcVolume(1_000)),
+			expectedFound:      true,
+			expectedMultiplier: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"first tier reached by another denom": {
+			volume:             sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 500)),
+			expectedFound:      true,
+			expectedMultiplier: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"last tier": {
+			volume:             sdk.NewCoins(usdcVolume(50_000)),
+			expectedFound:      true,
+			expectedMultiplier: osmomath.ZeroDec(),
+		},
+		"volumes aren't summed across denoms": {
+			volume:             sdk.NewCoins(usdcVolume(9_999), sdk.NewInt64Coin(apptesting.ETH, 50_000)),
+			expectedFound:      true,
+			expectedMultiplier: osmomath.MustNewDecFromStr("0.5"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.setVolumeTakerFeeParams(defaultVolumeTakerFeeTiers, 30)
+			for _, coin := range tc.volume {
+				s.App.PoolManagerKeeper.AddAccountVolume(s.Ctx, s.TestAccs[0], coin)
+			}
+
+			volume, tier, found := s.App.PoolManagerKeeper.GetAccountVolumeTakerFeeTier(s.Ctx, s.TestAccs[0])
+			s.Require().Equal(tc.volume, volume)
+			s.Require().Equal(tc.expectedFound, found)
+			if tc.expectedFound {
+				s.Require().Equal(tc.expectedMultiplier.String(), tier.DiscountMultiplier.String())
+			}
+		})
+	}
+}
+
+// validates that the taker fee is discounted by the volume taker fee tier of the sender,
+// and that swaps count towards the volume of the sender.
+func (s *KeeperTestSuite) TestChargeTakerFeeVolumeTiers() {
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		tokenIn  = sdk.NewCoin(apptesting.ETH, osmomath.NewInt(10_000_000))
+	)
+
+	tests := map[string]struct {
+		volume sdk.Coin
+
+		expectedTakerFee osmomath.Int
+	}{
+		"no tier": {
+			volume:           usdcVolume(500),
+			expectedTakerFee: osmomath.NewInt(100_000),
+		},
+		"half taker fee": {
+			volume:           usdcVolume(5_000),
+			expectedTakerFee: osmomath.NewInt(50_000),
+		},
+		"no taker fee": {
+			volume:           usdcVolume(10_000),
+			expectedTakerFee: osmomath.ZeroInt(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			s.setVolumeTakerFeeParams(defaultVolumeTakerFeeTiers, 30)
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+			poolManager.AddAccountVolume(s.Ctx, s.TestAccs[0], tc.volume)
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+			// No pool is created, so the trading pair taker fee is charged.
+			_, takerFeeCharged, err := poolManager.ChargeTakerFee(s.Ctx, 0, tokenIn, apptesting.USDC, s.TestAccs[0], true)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTakerFee.String(), takerFeeCharged.Amount.String())
+
+			takerFeeModuleAccBal := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName))
+			s.Require().Equal(tc.expectedTakerFee.String(), takerFeeModuleAccBal.AmountOf(tokenIn.Denom).String())
+		})
+	}
+
+	s.Run("routes are tracked once in the denom of their token in", func() {
+		s.SetupTest()
+		s.setVolumeTakerFeeParams(defaultVolumeTakerFeeTiers, 30)
+		ethUsdc := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000))
+		usdcAtom := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000), sdk.NewInt64Coin(apptesting.BAR, 1_000_000_000))
+		swapIn := sdk.NewInt64Coin(apptesting.ETH, 10_000)
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.BAR, 1_000_000)))
+
+		// the usdc token in of the second hop doesn't count
+		_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []types.SwapAmountInRoute{
+			{PoolId: ethUsdc, TokenOutDenom: apptesting.USDC},
+			{PoolId: usdcAtom, TokenOutDenom: apptesting.BAR},
+		}, swapIn, osmomath.OneInt(), types.SwapLimits{})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(swapIn), s.App.PoolManagerKeeper.GetAccountTrailingVolume(s.Ctx, s.TestAccs[0]))
+
+		// routes from a denom without min volume aren't tracked
+		tokenInAmount, err := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], []types.SwapAmountOutRoute{
+			{PoolId: usdcAtom, TokenInDenom: apptesting.BAR},
+			{PoolId: ethUsdc, TokenInDenom: apptesting.USDC},
+		}, osmomath.NewInt(1_000_000), sdk.NewInt64Coin(apptesting.ETH, 1_000), types.SwapLimits{})
+		s.Require().NoError(err)
+		s.Require().True(tokenInAmount.IsPositive())
+		s.Require().Equal(sdk.NewCoins(swapIn), s.App.PoolManagerKeeper.GetAccountTrailingVolume(s.Ctx, s.TestAccs[0]))
+
+		// the token in of the first hop of an exact amount out route is tracked
+		tokenInAmount, err = s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], []types.SwapAmountOutRoute{
+			{PoolId: ethUsdc, TokenInDenom: apptesting.ETH},
+		}, osmomath.NewInt(1_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000), types.SwapLimits{})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(swapIn.AddAmount(tokenInAmount)), s.App.PoolManagerKeeper.GetAccountTrailingVolume(s.Ctx, s.TestAccs[0]))
+	})
+}
@@ -31,6 +31,8 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEstimateBackrunCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryRebatesByAddressCmd)
 
 	return cmd
 }
@@ -166,6 +168,24 @@ func NewQueryAllProtocolRevenueCmd() (*osmocli.QueryDescriptor, *types.QueryGetA
 	}, &types.QueryGetAllProtocolRevenueRequest{}
 }
 
+// NewQueryEstimateBackrunCmd returns the command to estimate the backrun protorev would execute after a swap
+func NewQueryEstimateBackrunCmd() (*osmocli.QueryDescriptor, *types.QueryEstimateProtoRevBackrunRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-backrun",
+		Short: "Estimate the backrun protorev would execute after swapping token in for token out on a pool, without executing it",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} estimate-backrun 1 1000000uosmo uatom`,
+	}, &types.QueryEstimateProtoRevBackrunRequest{}
+}
+
+// NewQueryRebatesByAddressCmd returns the command to query the backrun profits rebated to a swapper
+func NewQueryRebatesByAddressCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevRebatesByAddressRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rebates-by-address",
+		Short: "Query the backrun profits protorev has rebated to a swapper",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} rebates-by-address osmo1...`,
+	}, &types.QueryGetProtoRevRebatesByAddressRequest{}
+}
+
 // convert a string array "[1,2,3]" to []uint64
 //
 //nolint:unparam
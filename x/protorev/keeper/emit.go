@@ -34,3 +34,18 @@ func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, p
 	)
 	ctx.EventManager().EmitEvent(backrunEvent)
 }
+
+// EmitSwapperRebateEvent emits the event of a backrun profit rebated to a swapper
+func EmitSwapperRebateEvent(ctx sdk.Context, swapper sdk.AccAddress, rebate sdk.Coin) {
+	// Get tx hash
+	txHash := strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+	rebateEvent := sdk.NewEvent(
+		types.TypeEvtSwapperRebate,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+		sdk.NewAttribute(types.AttributeKeySwapper, swapper.String()),
+		sdk.NewAttribute(types.AttributeKeyRebate, rebate.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyProtorevArbDenom, rebate.Denom),
+	)
+	ctx.EventManager().EmitEvent(rebateEvent)
+}
@@ -101,7 +101,8 @@ func (k Keeper) CurrentBaseDenomProfits(ctx sdk.Context) (sdk.Coins, error) {
 	return protorevBalanceBaseDenoms.Sort(), nil
 }
 
-// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store
+// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store.
+// It also rebuilds the pools paired with every denom that are used to discover cyclic arbitrage routes.
 func (k Keeper) UpdatePools(ctx sdk.Context) error {
 	// baseDenomPools maps each base denom to a map of the highest liquidity pools paired with that base denom
 	// ex. {osmo -> {atom : 100, weth : 200}}
@@ -129,6 +130,27 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 		}
 	}
 
+	// Rebuild the pools paired with every denom after the highest liquidity pools, which their uosmo value depends on
+	return k.UpdateAllPoolsForDenoms(ctx)
+}
+
+// UpdateAllPoolsForDenoms deletes the pools paired with every denom in the store and indexes all of the pools again,
+// with their current liquidity in uosmo. Every pool is revalued, since the uosmo value of a pool changes with the prices
+// of the uosmo pools its denoms are converted through, even if the pool itself was not used. This reads each pool once, as
+// UpdateHighestLiquidityPools already does in the same daily epoch, so it does not change the order of the epoch cost.
+func (k Keeper) UpdateAllPoolsForDenoms(ctx sdk.Context) error {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixPoolsByDenom)
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixPoolLiquidityForDenoms)
+
+	pools, err := k.poolmanagerKeeper.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		k.UpdatePoolForDenoms(ctx, pool.GetId())
+	}
+
 	return nil
 }
 
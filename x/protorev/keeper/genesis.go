@@ -100,6 +100,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	} else {
 		k.SetCyclicArbProfitTrackerStartHeight(ctx, ctx.BlockHeight())
 	}
+
+	// Set the backrun profits that have been rebated to swappers.
+	for _, swapperRebates := range genState.SwapperRebates {
+		swapper, err := sdk.AccAddressFromBech32(swapperRebates.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, rebate := range swapperRebates.Rebates {
+			if err := k.UpdateRebatesByAddress(ctx, swapper, rebate); err != nil {
+				panic(err)
+			}
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -176,5 +189,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.CyclicArbTracker = &cyclicArbTracker
 
+	// Export the backrun profits that have been rebated to swappers.
+	swapperRebates, err := k.GetAllSwapperRebates(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.SwapperRebates = swapperRebates
+
 	return genesis
 }
@@ -68,4 +68,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 	cyclicArbProfitAccountingHeight := s.App.ProtoRevKeeper.GetCyclicArbProfitTrackerStartHeight(s.Ctx)
 	s.Require().Equal(cyclicArbProfitAccountingHeight, exportedGenesis.CyclicArbTracker.HeightAccountingStartsFrom)
+
+	swapperRebates, err := s.App.ProtoRevKeeper.GetAllSwapperRebates(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(swapperRebates, exportedGenesis.SwapperRebates)
 }
@@ -254,3 +254,40 @@ func (q Querier) GetAllProtocolRevenue(c context.Context, req *types.QueryGetAll
 
 	return &types.QueryGetAllProtocolRevenueResponse{AllProtocolRevenue: allProtocolRevenue}, nil
 }
+
+// EstimateProtoRevBackrun simulates a swap and estimates the backrun the module would execute after it
+func (q Querier) EstimateProtoRevBackrun(c context.Context, req *types.QueryEstimateProtoRevBackrunRequest) (*types.QueryEstimateProtoRevBackrunResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.TokenIn.Validate(); err != nil || !req.TokenIn.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	routeEstimates, optimalRoute, poolPointsConsumed, err := q.Keeper.EstimateBackrun(ctx, req.PoolId, req.TokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateProtoRevBackrunResponse{
+		Routes:             routeEstimates,
+		OptimalRoute:       optimalRoute,
+		PoolPointsConsumed: poolPointsConsumed,
+	}, nil
+}
+
+// GetProtoRevRebatesByAddress queries the backrun profits that have been rebated to a swapper
+func (q Querier) GetProtoRevRebatesByAddress(c context.Context, req *types.QueryGetProtoRevRebatesByAddressRequest) (*types.QueryGetProtoRevRebatesByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	swapper, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetProtoRevRebatesByAddressResponse{Rebates: q.Keeper.GetRebatesByAddress(ctx, swapper)}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -389,6 +391,89 @@ func (s *KeeperTestSuite) TestGetProtoRevPool() {
 	s.Require().Equal(res.PoolId, uint64(1))
 }
 
+// TestEstimateProtoRevBackrun tests the query for estimating the backrun of a swap
+func (s *KeeperTestSuite) TestEstimateProtoRevBackrun() {
+	s.SetupPoolsTest()
+	tokenIn := sdk.NewCoin("Atom", osmomath.NewInt(10000))
+
+	// Request with an invalid token in should return an error
+	req := &types.QueryEstimateProtoRevBackrunRequest{
+		PoolId:        37,
+		TokenIn:       sdk.NewCoin("Atom", osmomath.ZeroInt()),
+		TokenOutDenom: "test/2",
+	}
+	res, err := s.queryClient.EstimateProtoRevBackrun(s.Ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	// Request for a swap on a pool that does not exist should return an error
+	req = &types.QueryEstimateProtoRevBackrunRequest{
+		PoolId:        1000,
+		TokenIn:       tokenIn,
+		TokenOutDenom: "test/2",
+	}
+	res, err = s.queryClient.EstimateProtoRevBackrun(s.Ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	// Request for a swap that is backrun by the four hop hot route should return the profitable route, with half of its
+	// profit rebated to the swapper
+	s.App.ProtoRevKeeper.SetParam(s.Ctx, types.ParamStoreKeySwapperRebateShare, osmomath.NewDecWithPrec(5, 1))
+	liquidityBefore, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, 37)
+	s.Require().NoError(err)
+	req = &types.QueryEstimateProtoRevBackrunRequest{
+		PoolId:        37,
+		TokenIn:       tokenIn,
+		TokenOutDenom: "test/2",
+	}
+	res, err = s.queryClient.EstimateProtoRevBackrun(s.Ctx, req)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Routes)
+	s.Require().NotNil(res.OptimalRoute)
+	s.Require().True(res.OptimalRoute.Simulated)
+	s.Require().True(res.OptimalRoute.Profit.IsPositive())
+	s.Require().True(res.OptimalRoute.ProfitInUosmo.IsPositive())
+	s.Require().Equal(sdk.NewCoin(res.OptimalRoute.OptimalInput.Denom, res.OptimalRoute.Profit.QuoRaw(2)), res.OptimalRoute.SwapperRebate)
+	profitInUosmo, err := s.App.ProtoRevKeeper.ConvertProfits(s.Ctx, res.OptimalRoute.OptimalInput, res.OptimalRoute.Profit.Sub(res.OptimalRoute.SwapperRebate.Amount))
+	s.Require().NoError(err)
+	s.Require().Equal(profitInUosmo, res.OptimalRoute.ProfitInUosmo)
+	s.Require().Equal([]types.Trade{
+		{Pool: 34, TokenIn: "Atom", TokenOut: "test/1"},
+		{Pool: 35, TokenIn: "test/1", TokenOut: types.OsmosisDenomination},
+		{Pool: 36, TokenIn: types.OsmosisDenomination, TokenOut: "test/2"},
+		{Pool: 37, TokenIn: "test/2", TokenOut: "Atom"},
+	}, res.OptimalRoute.Route.Trades)
+	s.Require().GreaterOrEqual(res.PoolPointsConsumed, res.OptimalRoute.PoolPoints)
+
+	// The estimate should not change any state
+	liquidityAfter, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, 37)
+	s.Require().NoError(err)
+	s.Require().Equal(liquidityBefore, liquidityAfter)
+	s.Require().Empty(s.App.ProtoRevKeeper.GetAllProfits(s.Ctx))
+
+	// The estimate should match the backrun executed after the swap
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: 37, TokenOutDenom: "test/2"}}
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt(), poolmanagertypes.SwapLimits{})
+	s.Require().NoError(err)
+	s.App.ProtoRevKeeper.SetPointCountForBlock(s.Ctx, 0)
+	err = s.App.ProtoRevKeeper.ProtoRevTrade(s.Ctx, s.App.ProtoRevKeeper.ExtractSwappedPools(s.Ctx), s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(res.OptimalRoute.OptimalInput.Denom, res.OptimalRoute.Profit.Sub(res.OptimalRoute.SwapperRebate.Amount))}, s.App.ProtoRevKeeper.GetAllProfits(s.Ctx))
+	s.Require().Equal([]sdk.Coin{res.OptimalRoute.SwapperRebate}, s.App.ProtoRevKeeper.GetRebatesByAddress(s.Ctx, s.TestAccs[0]))
+
+	// The estimate should be charged to the gas meter of the caller, and fail if the caller runs out of gas
+	gasMeter := storetypes.NewGasMeter(50_000_000)
+	_, _, _, err = s.App.ProtoRevKeeper.EstimateBackrun(s.Ctx.WithGasMeter(gasMeter), 37, tokenIn, "test/2")
+	s.Require().NoError(err)
+	s.Require().Positive(gasMeter.GasConsumed())
+
+	gasMeter = storetypes.NewGasMeter(gasMeter.GasConsumed() / 2)
+	_, _, _, err = s.App.ProtoRevKeeper.EstimateBackrun(s.Ctx.WithGasMeter(gasMeter), 37, tokenIn, "test/2")
+	s.Require().ErrorContains(err, "lack of gas")
+	s.Require().Equal(gasMeter.Limit(), gasMeter.GasConsumed())
+}
+
 // TestGetAllProtocolRevenue tests the query for all protocol revenue profits
 func (s *KeeperTestSuite) TestGetAllProtocolRevenueGRPCQuery() {
 	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
@@ -483,3 +568,28 @@ func (s *KeeperTestSuite) TestGetAllProtocolRevenueGRPCQuery() {
 	s.Require().Equal([]sdk.Coin(expectedTakerFeeToStakers.Add(expectedTakerFeeToStakers...)), res.AllProtocolRevenue.TakerFeesTracker.TakerFeesToStakers)
 	s.Require().Equal([]sdk.Coin(expectedTakerFeeToCommunityPool.Add(expectedTakerFeeToCommunityPool...)), res.AllProtocolRevenue.TakerFeesTracker.TakerFeesToCommunityPool)
 }
+
+// TestGetProtoRevRebatesByAddress tests the query for the rebates of a swapper
+func (s *KeeperTestSuite) TestGetProtoRevRebatesByAddress() {
+	swapper := apptesting.CreateRandomAccounts(1)[0]
+
+	// Invalid address
+	_, err := s.queryClient.GetProtoRevRebatesByAddress(s.Ctx, &types.QueryGetProtoRevRebatesByAddressRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	// No rebates yet
+	res, err := s.queryClient.GetProtoRevRebatesByAddress(s.Ctx, &types.QueryGetProtoRevRebatesByAddressRequest{Address: swapper.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Rebates)
+
+	// Pseudo rebate the swapper
+	s.App.ProtoRevKeeper.SetParam(s.Ctx, types.ParamStoreKeySwapperRebateShare, osmomath.NewDecWithPrec(5, 1))
+	profit := sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(10000))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(profit)))
+	_, err = s.App.ProtoRevKeeper.RebateSwapper(s.Ctx, swapper, profit)
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.GetProtoRevRebatesByAddress(s.Ctx, &types.QueryGetProtoRevRebatesByAddressRequest{Address: swapper.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(5000))}, res.Rebates)
+}
@@ -25,13 +25,18 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 // GAMM HOOKS
 // ----------------------------------------------------------------------------
 
-// AfterCFMMPoolCreated hook checks and potentially stores the pool via the highest liquidity method.
+// AfterCFMMPoolCreated hook checks and potentially stores the pool via the highest liquidity method,
+// and indexes it as paired with its denoms.
 func (h Hooks) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	h.k.AfterPoolCreatedWithCoins(ctx, poolId)
+	h.k.UpdatePoolForDenoms(ctx, poolId)
 }
 
-// AfterJoinPool stores swaps to be checked by protorev given the coins entered into the pool.
+// AfterJoinPool updates the liquidity of the pool in the pools paired with its denoms, and stores swaps to be checked
+// by protorev given the coins entered into the pool.
 func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
+	h.k.UpdatePoolForDenoms(ctx, poolId)
+
 	// Checked to avoid future unintended behavior based on how the hook is called
 	if len(enterCoins) != 1 {
 		return
@@ -40,8 +45,11 @@ func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreJoinExitPoolSwaps(ctx, sender, poolId, enterCoins[0].Denom, true)
 }
 
-// AfterExitPool stores swaps to be checked by protorev given the coins exited from the pool.
+// AfterExitPool updates the liquidity of the pool in the pools paired with its denoms, and stores swaps to be checked
+// by protorev given the coins exited from the pool.
 func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins) {
+	h.k.UpdatePoolForDenoms(ctx, poolId)
+
 	// Added due to ExitSwapShareAmountIn both calling
 	// ExitPoolHook with all denoms of the pool and then also
 	// Swapping which triggers the after swap hook.
@@ -71,13 +79,16 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 }
 
-// AfterInitialPoolPositionCreated checks and potentially stores the pool via the highest liquidity method.
+// AfterInitialPoolPositionCreated checks and potentially stores the pool via the highest liquidity method,
+// and indexes it as paired with its denoms.
 func (h Hooks) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	h.k.AfterPoolCreatedWithCoins(ctx, poolId)
+	h.k.UpdatePoolForDenoms(ctx, poolId)
 }
 
-// AfterLastPoolPositionRemoved is a noop.
+// AfterLastPoolPositionRemoved removes the pool, which no longer has liquidity, from the pools paired with its denoms.
 func (h Hooks) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.UpdatePoolForDenoms(ctx, poolId)
 }
 
 // AfterConcentratedPoolSwap stores swaps to be checked by protorev given the coins swapped in the pool.
@@ -127,6 +138,61 @@ func (k Keeper) GetComparablePoolLiquidity(ctx sdk.Context, poolId uint64) (comp
 	return comparableLiquidity, nil
 }
 
+// GetPoolLiquidityInOsmo gets the liquidity of a pool in uosmo, which unlike the comparable liquidity can be compared across
+// pools of different denoms. Each coin of the pool is converted to uosmo through the highest liquidity pool of its denom with
+// uosmo, as the profits are in ConvertProfits, and is worth at most the uosmo in that pool, so that a pool with a large supply
+// of a denom that has little uosmo liquidity does not outrank deeper pools. Coins of denoms without a pool with uosmo are worth nothing.
+func (k Keeper) GetPoolLiquidityInOsmo(ctx sdk.Context, poolId uint64) (liquidity osmomath.Int, err error) {
+	coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// Recover from overflow panic
+	defer func() {
+		if r := recover(); r != nil {
+			liquidity = osmomath.Int{}
+			err = errors.New("Int overflow in GetPoolLiquidityInOsmo")
+		}
+	}()
+
+	liquidity = osmomath.ZeroInt()
+	for _, coin := range coins {
+		liquidity = liquidity.Add(k.convertToOsmo(ctx, coin))
+	}
+
+	return liquidity, nil
+}
+
+// convertToOsmo converts the coin to uosmo at the spot price of the highest liquidity pool of its denom with uosmo, capped by
+// the uosmo in that pool. Zero is returned if the denom has no pool with uosmo.
+func (k Keeper) convertToOsmo(ctx sdk.Context, coin sdk.Coin) osmomath.Int {
+	if coin.Denom == types.OsmosisDenomination {
+		return coin.Amount
+	}
+
+	conversionPoolID, err := k.GetPoolForDenomPair(ctx, types.OsmosisDenomination, coin.Denom)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, conversionPoolID)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, conversionPoolID, types.OsmosisDenomination, coin.Denom)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+	conversionPoolCoins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, conversionPoolID)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+
+	value := spotPrice.Dec().MulInt(coin.Amount).TruncateInt()
+	return osmomath.MinInt(value, conversionPoolCoins.AmountOf(types.OsmosisDenomination))
+}
+
 // StoreJoinExitPoolSwaps stores the swaps associated with GAMM join/exit pool messages in the store, depending on if it is a join or exit.
 func (k Keeper) StoreJoinExitPoolSwaps(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, denom string, isJoin bool) {
 	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
@@ -221,3 +287,57 @@ func (k Keeper) CompareAndStorePool(ctx sdk.Context, poolId uint64, baseDenom, o
 		k.SetPoolForDenomPair(ctx, baseDenom, otherDenom, poolId)
 	}
 }
+
+// UpdatePoolForDenoms indexes the pool as paired with each of its denoms, with its current liquidity in uosmo, so that it
+// can be used to discover cyclic arbitrage routes. Pools that are inactive or have no liquidity are removed from the index.
+// Like the highest liquidity pools, only pools with exactly two denoms are indexed.
+func (k Keeper) UpdatePoolForDenoms(ctx sdk.Context, poolId uint64) {
+	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error("Protorev error getting pool denoms in UpdatePoolForDenoms: " + err.Error())
+		return
+	}
+	if len(denoms) != 2 {
+		return
+	}
+
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error("Protorev error getting pool in UpdatePoolForDenoms: " + err.Error())
+		return
+	}
+
+	// The keys of the pool in the index depend on its liquidity, so the entries it was previously indexed with are removed first
+	indexedLiquidity, found, err := k.GetPoolLiquidityForDenoms(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error("Protorev error getting pool liquidity in UpdatePoolForDenoms: " + err.Error())
+		return
+	}
+	if found {
+		for _, denom := range denoms {
+			if err := k.DeletePoolForDenom(ctx, denom, poolId, indexedLiquidity); err != nil {
+				ctx.Logger().Error("Protorev error deleting pool in UpdatePoolForDenoms: " + err.Error())
+				return
+			}
+		}
+		k.DeletePoolLiquidityForDenoms(ctx, poolId)
+	}
+
+	// An error means the liquidity of the pool overflows
+	liquidity, err := k.GetPoolLiquidityInOsmo(ctx, poolId)
+	if err != nil || !liquidity.IsPositive() || !pool.IsActive(ctx) {
+		return
+	}
+
+	if err := k.SetPoolForDenom(ctx, denoms[0], denoms[1], poolId, liquidity); err != nil {
+		ctx.Logger().Error("Protorev error setting pool in UpdatePoolForDenoms: " + err.Error())
+		return
+	}
+	if err := k.SetPoolForDenom(ctx, denoms[1], denoms[0], poolId, liquidity); err != nil {
+		ctx.Logger().Error("Protorev error setting pool in UpdatePoolForDenoms: " + err.Error())
+		return
+	}
+	if err := k.SetPoolLiquidityForDenoms(ctx, poolId, liquidity); err != nil {
+		ctx.Logger().Error("Protorev error setting pool liquidity in UpdatePoolForDenoms: " + err.Error())
+	}
+}
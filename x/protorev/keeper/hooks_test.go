@@ -54,7 +54,7 @@ func (s *KeeperTestSuite) TestSwapping() {
 
 					route := []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "Atom"}}
 
-					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin("akash", osmomath.NewInt(100)), osmomath.NewInt(1))
+					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin("akash", osmomath.NewInt(100)), osmomath.NewInt(1), poolmanagertypes.SwapLimits{})
 					s.Require().NoError(err)
 				},
 			},
@@ -74,7 +74,7 @@ func (s *KeeperTestSuite) TestSwapping() {
 
 					route := []poolmanagertypes.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "akash"}}
 
-					_, err := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], route, osmomath.NewInt(10000), sdk.NewCoin("Atom", osmomath.NewInt(100)))
+					_, err := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], route, osmomath.NewInt(10000), sdk.NewCoin("Atom", osmomath.NewInt(100)), poolmanagertypes.SwapLimits{})
 					s.Require().NoError(err)
 				},
 			},
@@ -99,7 +99,7 @@ func (s *KeeperTestSuite) TestSwapping() {
 
 					route := []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "Atom"}, {PoolId: 1, TokenOutDenom: "akash"}}
 
-					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin("akash", osmomath.NewInt(100)), osmomath.NewInt(1))
+					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin("akash", osmomath.NewInt(100)), osmomath.NewInt(1), poolmanagertypes.SwapLimits{})
 					s.Require().NoError(err)
 				},
 			},
@@ -119,7 +119,7 @@ func (s *KeeperTestSuite) TestSwapping() {
 
 					route := []poolmanagertypes.SwapAmountInRoute{{PoolId: 50, TokenOutDenom: "epochTwo"}}
 
-					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10)), osmomath.NewInt(1))
+					_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10)), osmomath.NewInt(1), poolmanagertypes.SwapLimits{})
 					s.Require().NoError(err)
 				},
 			},
@@ -563,6 +563,47 @@ func (s *KeeperTestSuite) TestGetComparablePoolLiquidity() {
 	}
 }
 
+// Tests that the liquidity in uosmo of a pool converts its coins through the highest liquidity pools of their denoms with uosmo,
+// so that a low value pool of a high decimal denom does not outrank a deeper pool in the pools paired with a denom.
+func (s *KeeperTestSuite) TestGetPoolLiquidityInOsmo() {
+	s.SetupTest()
+
+	// 1 juno is worth 1 uosmo
+	deepPool := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10_000_000)), sdk.NewCoin("juno", osmomath.NewInt(10_000_000)))
+	// 1 wei is worth 10^-12 uosmo
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1_000_000)), sdk.NewCoin("wei", osmomath.NewIntWithDecimal(1, 18)))
+	// The product of the amounts of the spam pool is much larger than the one of the deep pool
+	spamPool := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("juno", osmomath.NewInt(1_000)), sdk.NewCoin("wei", osmomath.NewIntWithDecimal(1, 21)))
+	// nouosmo has no pool with uosmo
+	noOsmoPool := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("juno", osmomath.NewInt(1_000)), sdk.NewCoin("nouosmo", osmomath.NewInt(1_000_000)))
+
+	deepComparableLiquidity, err := s.App.ProtoRevKeeper.GetComparablePoolLiquidity(s.Ctx, deepPool)
+	s.Require().NoError(err)
+	spamComparableLiquidity, err := s.App.ProtoRevKeeper.GetComparablePoolLiquidity(s.Ctx, spamPool)
+	s.Require().NoError(err)
+	s.Require().True(spamComparableLiquidity.GT(deepComparableLiquidity))
+
+	liquidity, err := s.App.ProtoRevKeeper.GetPoolLiquidityInOsmo(s.Ctx, deepPool)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(20_000_000), liquidity)
+
+	// The 10^21 wei are worth 10^9 uosmo at the spot price, but at most the 1_000_000 uosmo of their pool with uosmo
+	liquidity, err = s.App.ProtoRevKeeper.GetPoolLiquidityInOsmo(s.Ctx, spamPool)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_001_000), liquidity)
+
+	// Only the juno of the pool are worth uosmo
+	liquidity, err = s.App.ProtoRevKeeper.GetPoolLiquidityInOsmo(s.Ctx, noOsmoPool)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_000), liquidity)
+
+	// The deep pool outranks the spam pool in the pools paired with juno
+	pools, err := s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "juno", types.MaxCyclicRouteNeighbors)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 3)
+	s.Require().Equal([]uint64{deepPool, spamPool, noOsmoPool}, []uint64{pools[0].PoolId, pools[1].PoolId, pools[2].PoolId})
+}
+
 // Tests StoreJoinExitPoolSwaps stores the swaps associated with GAMM join/exit pool messages in the store, depending on if it is a join or exit.
 func (s *KeeperTestSuite) TestStoreJoinExitPoolSwaps() {
 	type param struct {
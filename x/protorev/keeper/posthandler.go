@@ -11,6 +11,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
 )
 
 type SwapToBackrun struct {
@@ -61,7 +63,7 @@ func (protoRevDec ProtoRevDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 
 	// Attempt to execute arbitrage trades
-	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools); err == nil {
+	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools, GetSwapper(tx)); err == nil {
 		write()
 	} else {
 		ctx.Logger().Error("ProtoRevTrade failed with error: " + err.Error())
@@ -113,8 +115,8 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 }
 
 // ProtoRevTrade wraps around the build routes, iterate routes, and execute trade functionality to execute cyclic arbitrage trades
-// if they exist. It returns an error if there was an issue executing any single trade.
-func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (err error) {
+// if they exist, rebating the swapper. It returns an error if there was an issue executing any single trade.
+func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun, swapper sdk.AccAddress) (err error) {
 	// recover from panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -138,11 +140,11 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 		routes := k.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
 
 		// Find optimal route (input coin, profit, route) for the given routes
-		maxProfitInputCoin, maxProfitAmount, optimalRoute := k.IterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+		maxProfitInputCoin, maxProfitAmount, optimalRoute, _ := k.IterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
 
 		// The error that returns here is particularly focused on the minting/burning of coins, and the execution of the MultiHopSwapExactAmountIn.
 		if maxProfitAmount.GT(osmomath.ZeroInt()) {
-			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, pool, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
+			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, pool, swapper, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
 				return err
 			}
 		}
@@ -151,6 +153,116 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 	return nil
 }
 
+// EstimateBackrun simulates a swap of tokenIn for tokenOutDenom on the given pool and runs the same pipeline as the posthandler
+// (ExtractSwappedPools -> BuildRoutes -> IterateRoutes) to estimate the backrun the module would execute after it. Everything is
+// executed in a cache context that is discarded, with the pool point budgets of the first transaction of a block, and with a gas
+// meter limited by the gas remaining in the caller's gas meter, which is charged for the gas consumed. It returns the estimate
+// of every route that was built, the route the module would execute (nil if no route is profitable) and the number of pool
+// points consumed by simulating them.
+func (k Keeper) EstimateBackrun(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (routeEstimates []types.BackrunRouteEstimate, optimalRoute *types.BackrunRouteEstimate, poolPointsConsumed uint64, err error) {
+	// The cache context is never written, and has its own event manager to not affect the caller
+	cacheCtx, _ := ctx.CacheContext()
+	estimateGasMeter := storetypes.NewGasMeter(ctx.GasMeter().GasRemaining())
+	cacheCtx = cacheCtx.WithGasMeter(estimateGasMeter).WithEventManager(sdk.NewEventManager())
+
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("protorev estimate failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("protorev estimate failed due to internal reason: %v", r)
+			}
+		}
+	}()
+	defer func() {
+		ctx.GasMeter().ConsumeGas(estimateGasMeter.GasConsumedToLimit(), "protorev backrun estimate")
+	}()
+
+	// Reset the pool point count as if the swap was the first transaction of the block
+	k.SetPointCountForBlock(cacheCtx, 0)
+	k.SetLatestBlockHeight(cacheCtx, uint64(cacheCtx.BlockHeight()))
+	k.DeleteSwapsToBackrun(cacheCtx)
+
+	// Mint the coin to swap in to the module account and make the swap, which the hooks store as a swap to backrun
+	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return nil, nil, 0, err
+	}
+	swapRoute := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	if _, err := k.poolmanagerKeeper.RouteExactAmountIn(cacheCtx, protorevModuleAddress, swapRoute, tokenIn, osmomath.OneInt(), poolmanagertypes.SwapLimits{}); err != nil {
+		return nil, nil, 0, err
+	}
+
+	remainingTxPoolPoints, remainingBlockPoolPoints, err := k.GetRemainingPoolPoints(cacheCtx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	maxTxPoolPoints := remainingTxPoolPoints
+
+	routeEstimates = make([]types.BackrunRouteEstimate, 0)
+	optimalRouteIndex, optimalRouteProfit := -1, osmomath.ZeroInt()
+	for _, pool := range k.ExtractSwappedPools(cacheCtx) {
+		routes := k.BuildRoutes(cacheCtx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
+		_, maxProfit, _, routeResults := k.IterateRoutes(cacheCtx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+
+		for _, routeResult := range routeResults {
+			routeEstimate, err := k.newBackrunRouteEstimate(cacheCtx, routeResult)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			// IterateRoutes selects the first route with the max profit, which is executed if it is the most profitable one
+			if maxProfit.GT(optimalRouteProfit) && routeResult.ProfitInUosmo.Equal(maxProfit) {
+				optimalRouteIndex, optimalRouteProfit = len(routeEstimates), maxProfit
+			}
+			routeEstimates = append(routeEstimates, routeEstimate)
+		}
+	}
+
+	if optimalRouteIndex >= 0 {
+		optimalRoute = &routeEstimates[optimalRouteIndex]
+	}
+
+	return routeEstimates, optimalRoute, maxTxPoolPoints - remainingTxPoolPoints, nil
+}
+
+// newBackrunRouteEstimate converts the result of a route in IterateRoutes to its estimate, with the share of the profit rebated
+// to the swapper, given that the swapper is allowed to receive funds, and the profit kept by the module converted to uosmo.
+func (k Keeper) newBackrunRouteEstimate(ctx sdk.Context, routeResult RouteResult) (types.BackrunRouteEstimate, error) {
+	swapperRebate := k.GetSwapperRebate(ctx, sdk.NewCoin(routeResult.InputCoin.Denom, routeResult.Profit))
+	profitInUosmo := routeResult.ProfitInUosmo
+	if swapperRebate.IsPositive() {
+		var err error
+		profitInUosmo, err = k.ConvertProfits(ctx, routeResult.InputCoin, routeResult.Profit.Sub(swapperRebate.Amount))
+		if err != nil {
+			return types.BackrunRouteEstimate{}, err
+		}
+	}
+
+	return types.BackrunRouteEstimate{
+		Route:         newRoute(routeResult.Route),
+		PoolPoints:    routeResult.Route.PoolPoints,
+		Simulated:     routeResult.Simulated,
+		OptimalInput:  routeResult.InputCoin,
+		Profit:        routeResult.Profit,
+		ProfitInUosmo: profitInUosmo,
+		SwapperRebate: swapperRebate,
+	}, nil
+}
+
+// newRoute converts the route to the trades it is made of, with the step size used for the binary search.
+func newRoute(route RouteMetaData) types.Route {
+	trades := make([]types.Trade, 0, len(route.Route))
+	tokenIn := route.Route[route.Route.Length()-1].TokenOutDenom
+	for _, hop := range route.Route {
+		trades = append(trades, types.Trade{Pool: hop.PoolId, TokenIn: tokenIn, TokenOut: hop.TokenOutDenom})
+		tokenIn = hop.TokenOutDenom
+	}
+
+	return types.Route{Trades: trades, StepSize: route.StepSize}
+}
+
 // ExtractSwappedPools checks if there were any swaps made on pools and if so returns a list of all the pools that were
 // swapped on and metadata about the swap
 func (k Keeper) ExtractSwappedPools(ctx sdk.Context) []SwapToBackrun {
@@ -345,7 +345,7 @@ func (s *KeeperTestSuite) TestPostHandle() {
 						Amount: osmomath.NewInt(216_132_910_493),
 					},
 				},
-				expectedPoolPoints: 49,
+				expectedPoolPoints: 57,
 			},
 			expectPass: true,
 		},
@@ -491,6 +491,69 @@ func (s *KeeperTestSuite) TestPostHandle() {
 	}
 }
 
+// TestPostHandleSwapperRebate tests that the signer of a tx is rebated a share of the profit of its backrun
+func (s *KeeperTestSuite) TestPostHandleSwapperRebate() {
+	s.SetupPoolsTest()
+	s.Ctx = s.Ctx.WithIsCheckTx(false)
+	s.App.ProtoRevKeeper.SetParam(s.Ctx, types.ParamStoreKeySwapperRebateShare, osmomath.NewDecWithPrec(5, 1))
+
+	// Build a tx signed by the swapper
+	priv0, _, addr0 := testdata.KeyTestPubAddr()
+	acc1 := s.App.AccountKeeper.NewAccountWithAddress(s.Ctx, addr0)
+	s.App.AccountKeeper.SetAccount(s.Ctx, acc1)
+
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	signerData := authsigning.SignerData{
+		ChainID:       s.Ctx.ChainID(),
+		AccountNumber: 0,
+		Sequence:      0,
+	}
+	sigV2, _ := clienttx.SignWithPrivKey(s.Ctx, 1, signerData, txBuilder, priv0, s.clientCtx.TxConfig, 0)
+	txFee := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10000)))
+	tx := s.BuildTx(txBuilder, []sdk.Msg{testdata.NewTestMsg(addr0)}, sigV2, "", txFee, 500000)
+	s.Require().Equal(addr0, keeper.GetSwapper(tx))
+
+	// Same swap as the "Mainnet Arb (Block: 5905150)" case of TestPostHandle, which has a profit of 24848 uosmo
+	s.App.ProtoRevKeeper.AddSwapsToSwapsToBackrun(s.Ctx, []types.Trade{
+		{
+			Pool:     23,
+			TokenOut: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+			TokenIn:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		},
+	})
+
+	posthandlerProtoRev := sdk.ChainPostDecorators(keeper.NewProtoRevDecorator(*s.App.ProtoRevKeeper))
+	_, err := posthandlerProtoRev(s.Ctx, tx, false, true)
+	s.Require().NoError(err)
+
+	rebate := sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(12424))
+
+	// The swapper receives half of the profit, and the module keeps the other half
+	s.Require().Equal(rebate, s.App.BankKeeper.GetBalance(s.Ctx, addr0, types.OsmosisDenomination))
+	s.Require().Equal([]sdk.Coin{rebate}, s.App.ProtoRevKeeper.GetRebatesByAddress(s.Ctx, addr0))
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(24848).Sub(rebate.Amount))}, s.App.ProtoRevKeeper.GetAllProfits(s.Ctx))
+
+	// The rebate event is emitted
+	found := false
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtSwapperRebate {
+			continue
+		}
+		found = true
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeySwapper:
+				s.Require().Equal(addr0.String(), attr.Value)
+			case types.AttributeKeyRebate:
+				s.Require().Equal(rebate.Amount.String(), attr.Value)
+			case types.AttributeKeyProtorevArbDenom:
+				s.Require().Equal(rebate.Denom, attr.Value)
+			}
+		}
+	}
+	s.Require().True(found)
+}
+
 func (s *KeeperTestSuite) TestExtractSwappedPools() {
 	type param struct {
 		expectedSwappedPools []keeper.SwapToBackrun
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/store/prefix"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 
 	storetypes "cosmossdk.io/store/types"
@@ -198,6 +199,103 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// PairedPool is a pool paired with a denom in the pools by denom index, which is used to discover cyclic arbitrage routes
+type PairedPool struct {
+	PoolId uint64
+	// The other denom of the pool
+	Denom string
+	// The liquidity of the pool in uosmo when it was last indexed
+	Liquidity osmomath.Int
+}
+
+// GetPoolsForDenom returns up to limit pools paired with the given denom, ordered by descending liquidity and then by pool id
+func (k Keeper) GetPoolsForDenom(ctx sdk.Context, denom string, limit int) ([]PairedPool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolsByDenom(denom))
+	iterator := store.Iterator(nil, nil)
+
+	pools := make([]PairedPool, 0, limit)
+	defer iterator.Close()
+	for ; iterator.Valid() && len(pools) < limit; iterator.Next() {
+		liquidity, poolId, err := types.ParseLiquidityAndPoolFromKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, PairedPool{PoolId: poolId, Denom: string(iterator.Value()), Liquidity: liquidity})
+	}
+
+	return pools, nil
+}
+
+// GetPoolIdsForDenom returns the ids of up to limit pools paired with the given denom, ordered by descending liquidity
+// and then by pool id
+func (k Keeper) GetPoolIdsForDenom(ctx sdk.Context, denom string, limit int) ([]uint64, error) {
+	pools, err := k.GetPoolsForDenom(ctx, denom, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := make([]uint64, len(pools))
+	for i, pool := range pools {
+		poolIds[i] = pool.PoolId
+	}
+	return poolIds, nil
+}
+
+// SetPoolForDenom indexes the pool as paired with the denom, with the given liquidity in uosmo
+func (k Keeper) SetPoolForDenom(ctx sdk.Context, denom, pairedDenom string, poolId uint64, liquidity osmomath.Int) error {
+	key, err := types.GetKeyPoolsByDenom(denom, liquidity, poolId)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, []byte(pairedDenom))
+	return nil
+}
+
+// DeletePoolForDenom removes the pool, indexed with the given liquidity in uosmo, from the pools paired with the denom
+func (k Keeper) DeletePoolForDenom(ctx sdk.Context, denom string, poolId uint64, liquidity osmomath.Int) error {
+	key, err := types.GetKeyPoolsByDenom(denom, liquidity, poolId)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(key)
+	return nil
+}
+
+// GetPoolLiquidityForDenoms returns the liquidity in uosmo the pool is indexed with in the pools paired with its denoms,
+// and false if the pool is not indexed
+func (k Keeper) GetPoolLiquidityForDenoms(ctx sdk.Context, poolId uint64) (osmomath.Int, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetKeyPoolLiquidityForDenoms(poolId))
+	if bz == nil {
+		return osmomath.Int{}, false, nil
+	}
+
+	liquidity := osmomath.Int{}
+	if err := liquidity.Unmarshal(bz); err != nil {
+		return osmomath.Int{}, false, err
+	}
+
+	return liquidity, true, nil
+}
+
+// SetPoolLiquidityForDenoms sets the liquidity in uosmo the pool is indexed with in the pools paired with its denoms
+func (k Keeper) SetPoolLiquidityForDenoms(ctx sdk.Context, poolId uint64, liquidity osmomath.Int) error {
+	bz, err := liquidity.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetKeyPoolLiquidityForDenoms(poolId), bz)
+	return nil
+}
+
+// DeletePoolLiquidityForDenoms deletes the liquidity in uosmo the pool is indexed with in the pools paired with its denoms
+func (k Keeper) DeletePoolLiquidityForDenoms(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyPoolLiquidityForDenoms(poolId))
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixSwapsToBackrun)
@@ -359,6 +457,13 @@ func (k Keeper) SetProtoRevEnabled(ctx sdk.Context, enabled bool) {
 	k.SetParams(ctx, params)
 }
 
+// GetSwapperRebateShare returns the share of the profit of every backrun that is rebated to the swapper
+func (k Keeper) GetSwapperRebateShare(ctx sdk.Context) osmomath.Dec {
+	var swapperRebateShare osmomath.Dec
+	k.paramstore.Get(ctx, types.ParamStoreKeySwapperRebateShare, &swapperRebateShare)
+	return swapperRebateShare
+}
+
 // GetPointCountForBlock returns the number of pool points that have been consumed in the current block
 func (k Keeper) GetPointCountForBlock(ctx sdk.Context) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPointCountForBlock)
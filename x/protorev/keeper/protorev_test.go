@@ -5,6 +5,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v25/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
 )
 
@@ -113,6 +114,90 @@ func (s *KeeperTestSuite) TestGetPoolForDenomPair() {
 	s.Require().Equal(uint64(3000), pool)
 }
 
+// TestGetPoolsForDenom tests the GetPoolsForDenom, SetPoolForDenom, and DeletePoolForDenom functions.
+func (s *KeeperTestSuite) TestGetPoolsForDenom() {
+	// Should be able to set pools for a denom
+	err := s.App.ProtoRevKeeper.SetPoolForDenom(s.Ctx, "Atom", types.OsmosisDenomination, 1000, osmomath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.SetPoolForDenom(s.Ctx, "Atom", "weth", 2000, osmomath.NewInt(200))
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.SetPoolForDenom(s.Ctx, "Atom", types.OsmosisDenomination, 3000, osmomath.NewInt(300))
+	s.Require().NoError(err)
+
+	// Denoms that are prefixes of each other should not be mixed up
+	err = s.App.ProtoRevKeeper.SetPoolForDenom(s.Ctx, "Ato", "weth", 4000, osmomath.NewInt(400))
+	s.Require().NoError(err)
+
+	// Pools should be ordered by descending liquidity
+	pools, err := s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "Atom", types.MaxCyclicRouteNeighbors)
+	s.Require().NoError(err)
+	s.Require().Equal([]keeper.PairedPool{
+		{PoolId: 3000, Denom: types.OsmosisDenomination, Liquidity: osmomath.NewInt(300)},
+		{PoolId: 2000, Denom: "weth", Liquidity: osmomath.NewInt(200)},
+		{PoolId: 1000, Denom: types.OsmosisDenomination, Liquidity: osmomath.NewInt(100)},
+	}, pools)
+
+	// Only the most liquid pools should be returned up to the limit
+	pools, err = s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "Atom", 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{3000, 2000}, []uint64{pools[0].PoolId, pools[1].PoolId})
+
+	// Should be able to delete a pool for a denom
+	err = s.App.ProtoRevKeeper.DeletePoolForDenom(s.Ctx, "Atom", 1000, osmomath.NewInt(100))
+	s.Require().NoError(err)
+	pools, err = s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "Atom", types.MaxCyclicRouteNeighbors)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 2)
+
+	// Negative liquidity cannot be indexed
+	err = s.App.ProtoRevKeeper.SetPoolForDenom(s.Ctx, "Atom", "weth", 5000, osmomath.NewInt(-1))
+	s.Require().Error(err)
+
+	// Creating a pool should index it for both of its denoms
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1_000_000)), sdk.NewCoin("newdenom", osmomath.NewInt(1_000_000)))
+	pools, err = s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "newdenom", types.MaxCyclicRouteNeighbors)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 1)
+	s.Require().Equal(poolId, pools[0].PoolId)
+	s.Require().Equal(types.OsmosisDenomination, pools[0].Denom)
+	s.Require().True(pools[0].Liquidity.IsPositive())
+
+	// Reindexing the pool with the same liquidity should not duplicate it
+	s.App.ProtoRevKeeper.UpdatePoolForDenoms(s.Ctx, poolId)
+	pools, err = s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, "newdenom", types.MaxCyclicRouteNeighbors)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 1)
+
+	// Joining the pool should replace its entries with its new liquidity
+	createdLiquidity := pools[0].Liquidity
+	totalShares, err := s.App.GAMMKeeper.GetTotalPoolShares(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1_000_000)), sdk.NewCoin("newdenom", osmomath.NewInt(1_000_000))))
+	_, _, err = s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, s.TestAccs[0], poolId, totalShares.QuoRaw(2), sdk.Coins{})
+	s.Require().NoError(err)
+	joinedLiquidity, err := s.App.ProtoRevKeeper.GetPoolLiquidityInOsmo(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().True(joinedLiquidity.GT(createdLiquidity))
+	for _, denom := range []string{types.OsmosisDenomination, "newdenom"} {
+		pools, err = s.App.ProtoRevKeeper.GetPoolsForDenom(s.Ctx, denom, types.MaxCyclicRouteNeighbors)
+		s.Require().NoError(err)
+		s.Require().Equal(1, countPairedPool(pools, poolId))
+		s.Require().Equal(poolId, pools[0].PoolId)
+		s.Require().Equal(joinedLiquidity, pools[0].Liquidity)
+	}
+}
+
+// countPairedPool returns the number of times the pool appears in the paired pools
+func countPairedPool(pools []keeper.PairedPool, poolId uint64) int {
+	count := 0
+	for _, pool := range pools {
+		if pool.PoolId == poolId {
+			count++
+		}
+	}
+	return count
+}
+
 // TestGetDaysSinceModuleGenesis tests the GetDaysSinceModuleGenesis and SetDaysSinceModuleGenesis functions.
 func (s *KeeperTestSuite) TestGetDaysSinceModuleGenesis() {
 	// Should be initialized to 0 on genesis
@@ -10,45 +10,73 @@ import (
 
 var zeroInt = osmomath.ZeroInt()
 
+// RouteResult is the outcome of checking the profitability of a single route in IterateRoutes
+type RouteResult struct {
+	Route RouteMetaData
+	// Whether there were enough pool points remaining to simulate the route
+	Simulated bool
+	// The optimal input of the route, zero if the route is not profitable
+	InputCoin sdk.Coin
+	// The profit of the route in the denom of the input
+	Profit osmomath.Int
+	// The profit of the route converted to uosmo, which is used to compare routes with different input denoms
+	ProfitInUosmo osmomath.Int
+}
+
 // IterateRoutes checks the profitability of every single route that is passed in
-// and returns the optimal route if there is one
-func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes) {
+// and returns the optimal route if there is one, along with the result of every route
+func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes, []RouteResult) {
 	var optimalRoute poolmanagertypes.SwapAmountInRoutes
 	var maxProfitInputCoin sdk.Coin
 	maxProfit := osmomath.ZeroInt()
 
 	// Iterate through the routes and find the optimal route for the given swap
-	for index := 0; index < len(routes) && *remainingTxPoolPoints > 0; index++ {
+	routeResults := make([]RouteResult, 0, len(routes))
+	for _, route := range routes {
+		routeResult := RouteResult{
+			Route:         route,
+			InputCoin:     sdk.NewCoin(route.Route[route.Route.Length()-1].TokenOutDenom, osmomath.ZeroInt()),
+			Profit:        osmomath.ZeroInt(),
+			ProfitInUosmo: osmomath.ZeroInt(),
+		}
+
 		// If the route consumes more pool points than we have remaining then we skip it
-		if routes[index].PoolPoints > *remainingTxPoolPoints {
+		if *remainingTxPoolPoints == 0 || route.PoolPoints > *remainingTxPoolPoints {
+			routeResults = append(routeResults, routeResult)
 			continue
 		}
+		routeResult.Simulated = true
 
 		// Find the max profit for the route if it exists
-		inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, routes[index], remainingTxPoolPoints, remainingBlockPoolPoints)
+		inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, route, remainingTxPoolPoints, remainingBlockPoolPoints)
 		if err != nil {
 			k.Logger(ctx).Debug("Error finding max profit for route: " + err.Error())
+			routeResults = append(routeResults, routeResult)
 			continue
 		}
 
 		// If the profit is greater than zero, then we convert the profits to uosmo and compare profits in terms of uosmo
 		if profit.GT(zeroInt) {
-			profit, err := k.ConvertProfits(ctx, inputCoin, profit)
+			profitInUosmo, err := k.ConvertProfits(ctx, inputCoin, profit)
 			if err != nil {
 				k.Logger(ctx).Error("Error converting profits: " + err.Error())
+				routeResults = append(routeResults, routeResult)
 				continue
 			}
+			routeResult.InputCoin, routeResult.Profit, routeResult.ProfitInUosmo = inputCoin, profit, profitInUosmo
 
 			// Select the optimal route King of the Hill style (route with the highest profit will be executed)
-			if profit.GT(maxProfit) {
-				optimalRoute = routes[index].Route
-				maxProfit = profit
+			if profitInUosmo.GT(maxProfit) {
+				optimalRoute = route.Route
+				maxProfit = profitInUosmo
 				maxProfitInputCoin = inputCoin
 			}
 		}
+
+		routeResults = append(routeResults, routeResult)
 	}
 
-	return maxProfitInputCoin, maxProfit, optimalRoute
+	return maxProfitInputCoin, maxProfit, optimalRoute, routeResults
 }
 
 // ConvertProfits converts the profit denom to uosmo to allow for a fair comparison of profits
@@ -108,13 +136,9 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 
 var oneInt, twoInt = osmomath.OneInt(), osmomath.NewInt(2)
 
-// FindMaxProfitRoute runs a binary search to find the max profit for a given route
+// FindMaxProfitRoute finds the max profit for a given route with the search strategy set in the params
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, error) {
-	// Track the tokenIn amount/denom and the profit
-	tokenIn := sdk.Coin{}
-	profit := osmomath.ZeroInt()
-
-	// Track the left and right bounds of the binary search
+	// Track the left and right bounds of the search
 	curLeft := osmomath.OneInt()
 	curRight := types.MaxInputAmount
 
@@ -123,7 +147,7 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
-	// If there is no profit, then we can return early and not run the binary search.
+	// If there is no profit, then we can return early and not run the search.
 	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
@@ -146,36 +170,7 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
 
-	// Binary search to find the max profit
-	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
-		curMid := (curLeft.Add(curRight)).Quo(twoInt)
-		curMidPlusOne := curMid.Add(oneInt)
-
-		// Short circuit profit searching if there is an error in the GAMM module
-		tokenInMid, profitMid, err := k.EstimateMultihopProfit(ctx, inputDenom, curMid.Mul(route.StepSize), route.Route)
-		if err != nil {
-			return sdk.Coin{}, osmomath.ZeroInt(), err
-		}
-
-		// Short circuit profit searching if there is an error in the GAMM module
-		tokenInMidPlusOne, profitMidPlusOne, err := k.EstimateMultihopProfit(ctx, inputDenom, curMidPlusOne.Mul(route.StepSize), route.Route)
-		if err != nil {
-			return sdk.Coin{}, osmomath.ZeroInt(), err
-		}
-
-		// Reduce subspace to search for max profit
-		if profitMid.LTE(profitMidPlusOne) {
-			curLeft = curMidPlusOne
-			tokenIn = tokenInMidPlusOne
-			profit = profitMidPlusOne
-		} else {
-			curRight = curMid
-			tokenIn = tokenInMid
-			profit = profitMid
-		}
-	}
-
-	return tokenIn, profit, nil
+	return k.searchMaxProfit(ctx, route, inputDenom, curLeft, curRight)
 }
 
 // UpdateSearchRangeIfNeeded updates the search range for the binary search. First, we check if there are any
@@ -349,8 +344,9 @@ func (k Keeper) ExtendSearchRangeIfNeeded(
 	return curLeft, curRight, nil
 }
 
-// ExecuteTrade inputs a route, amount in, and rebalances the pool
-func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
+// ExecuteTrade inputs a route, amount in, and rebalances the pool. The swapper rebate share of the profit is sent to the swapper,
+// and the rest is kept by the module.
+func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, swapper sdk.AccAddress, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
 	// Get the module address which will execute the trade
 	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
@@ -360,7 +356,7 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	}
 
 	// Use the inputCoin.Amount as the min amount out to ensure profitability
-	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, protorevModuleAddress, route, inputCoin, inputCoin.Amount)
+	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, protorevModuleAddress, route, inputCoin, inputCoin.Amount, poolmanagertypes.SwapLimits{})
 	if err != nil {
 		return err
 	}
@@ -373,8 +369,14 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	// Profit from the trade
 	profit := tokenOutAmount.Sub(inputCoin.Amount)
 
-	// Update the module statistics stores
-	if err = k.UpdateStatistics(ctx, route, inputCoin.Denom, profit); err != nil {
+	// Rebate the swapper whose swaps created the arbitrage opportunity
+	rebate, err := k.RebateSwapper(ctx, swapper, sdk.NewCoin(inputCoin.Denom, profit))
+	if err != nil {
+		return err
+	}
+
+	// Update the module statistics stores with the profit kept by the module
+	if err = k.UpdateStatistics(ctx, route, inputCoin.Denom, profit.Sub(rebate.Amount)); err != nil {
 		return err
 	}
 
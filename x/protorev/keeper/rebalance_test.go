@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -419,6 +423,92 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 	}
 }
 
+// searchStrategyTestRoutes are the profitable routes used to compare the search strategies, along with whether
+// they are only made of balancer and stableswap pools
+var searchStrategyTestRoutes = []struct {
+	name         string
+	route        poolmanagertypes.SwapAmountInRoutes
+	constProduct bool
+}{
+	{"2 Asset, Same Weights", routeTwoAssetSameWeight, true},
+	{"Multi Asset, Same Weights", routeMultiAssetSameWeight, true},
+	{"Multi Asset, Same Weights - Pool 22 instead of 26", routeMostProfitable, true},
+	{"Multi Asset, Different Weights", routeDiffDenom, true},
+	{"StableSwap", routeStableSwap, true},
+	{"Four Pool", fourPoolRoute, true},
+	{"Two Pool", twoPoolRoute, true},
+	{"Extended Range", extendedRangeRoute, true},
+	{"CL (extended range)", clPoolRouteExtended, false},
+	{"CL", clPoolRoute, false},
+	{"CL Multi", clPoolRouteMulti, false},
+	{"CW Pool", cwPoolRoute, false},
+}
+
+// findMaxProfitWithSearchStrategy finds the max profit of the route with the given search strategy in a cache context,
+// returning the amount in, the profit and the gas consumed.
+func (s *KeeperTestSuite) findMaxProfitWithSearchStrategy(route poolmanagertypes.SwapAmountInRoutes, searchStrategy string) (osmomath.Int, osmomath.Int, uint64) {
+	ctx, _ := s.Ctx.CacheContext()
+	s.App.ProtoRevKeeper.SetParam(ctx, types.ParamStoreKeySearchStrategy, searchStrategy)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	remainingPoolPoints, remainingBlockPoolPoints := uint64(1000), uint64(1000)
+	routeMetaData := protorevtypes.RouteMetaData{
+		Route:      route,
+		PoolPoints: 6,
+		StepSize:   osmomath.NewInt(1_000_000),
+	}
+	amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(ctx, routeMetaData, &remainingPoolPoints, &remainingBlockPoolPoints)
+	s.Require().NoError(err)
+
+	return amtIn.Amount, profit, ctx.GasMeter().GasConsumed()
+}
+
+// TestFindMaxProfitRouteSearchStrategies tests that the golden-section and closed form search strategies find at least
+// the profit of the binary search, while consuming less gas.
+func (s *KeeperTestSuite) TestFindMaxProfitRouteSearchStrategies() {
+	s.SetupPoolsTest()
+
+	for _, test := range searchStrategyTestRoutes {
+		s.Run(test.name, func() {
+			_, binaryProfit, binaryGas := s.findMaxProfitWithSearchStrategy(test.route, types.SearchStrategyBinary)
+			s.Require().True(binaryProfit.IsPositive())
+
+			_, goldenSectionProfit, goldenSectionGas := s.findMaxProfitWithSearchStrategy(test.route, types.SearchStrategyGoldenSection)
+			s.Require().True(goldenSectionProfit.GTE(binaryProfit), "golden-section profit %s, binary profit %s", goldenSectionProfit, binaryProfit)
+			s.Require().Less(goldenSectionGas, binaryGas)
+
+			_, closedFormProfit, closedFormGas := s.findMaxProfitWithSearchStrategy(test.route, types.SearchStrategyClosedForm)
+			s.Require().True(closedFormProfit.GTE(binaryProfit), "closed form profit %s, binary profit %s", closedFormProfit, binaryProfit)
+			if test.constProduct {
+				// The closed form only simulates a couple of amounts in, instead of searching for them
+				s.Require().Less(closedFormGas, binaryGas/2)
+			}
+		})
+	}
+}
+
+// BenchmarkFindMaxProfitForRoute benchmarks every search strategy on every route, reporting the gas consumed and the profit found.
+func BenchmarkFindMaxProfitForRoute(b *testing.B) {
+	s := new(KeeperTestSuite)
+	s.SetT(&testing.T{})
+	s.SetupPoolsTest()
+
+	for _, searchStrategy := range []string{types.SearchStrategyBinary, types.SearchStrategyGoldenSection, types.SearchStrategyClosedForm} {
+		for _, test := range searchStrategyTestRoutes {
+			b.Run(searchStrategy+"/"+test.name, func(b *testing.B) {
+				b.ReportAllocs()
+				var profit osmomath.Int
+				var gas uint64
+				for i := 0; i < b.N; i++ {
+					_, profit, gas = s.findMaxProfitWithSearchStrategy(test.route, searchStrategy)
+				}
+				b.ReportMetric(float64(gas), "gas/op")
+				b.ReportMetric(float64(profit.Int64()), "profit")
+			})
+		}
+	}
+}
+
 func (s *KeeperTestSuite) TestExecuteTrade() {
 	s.SetupPoolsTest()
 	type param struct {
@@ -510,6 +600,7 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			test.param.route,
 			test.param.inputCoin,
 			pool,
+			s.TestAccs[0],
 			txPoolPointsRemaining,
 			blockPoolPointsRemaining,
 		)
@@ -680,7 +771,7 @@ func (s *KeeperTestSuite) TestIterateRoutes() {
 			remainingPoolPoints := uint64(40)
 			remainingBlockPoolPoints := uint64(40)
 
-			maxProfitInputCoin, maxProfitAmount, optimalRoute := s.App.ProtoRevKeeper.IterateRoutes(s.Ctx, routes, &remainingPoolPoints, &remainingBlockPoolPoints)
+			maxProfitInputCoin, maxProfitAmount, optimalRoute, _ := s.App.ProtoRevKeeper.IterateRoutes(s.Ctx, routes, &remainingPoolPoints, &remainingBlockPoolPoints)
 			if test.expectPass {
 				s.Require().Equal(test.params.expectedMaxProfitAmount, maxProfitAmount)
 				s.Require().Equal(test.params.expectedMaxProfitInputCoin, maxProfitInputCoin)
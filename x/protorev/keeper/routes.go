@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -34,6 +35,19 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append the cyclic routes that were discovered and not already built by the other methods
+	if cyclicRoutes, err := k.BuildCyclicRoutes(ctx, tokenIn, tokenOut, poolId); err == nil {
+		builtRoutes := make(map[string]bool, len(routes))
+		for _, route := range routes {
+			builtRoutes[fmt.Sprint(route.Route)] = true
+		}
+		for _, route := range cyclicRoutes {
+			if !builtRoutes[fmt.Sprint(route.Route)] {
+				routes = append(routes, route)
+			}
+		}
+	}
+
 	return routes
 }
 
@@ -219,6 +233,151 @@ func (k Keeper) BuildTwoPoolRoute(
 	}, nil
 }
 
+// cyclicRoute is a cycle of pools that goes through the swapped pool, starting with the swap on the swapped pool
+type cyclicRoute struct {
+	hops poolmanagertypes.SwapAmountInRoutes
+	// The liquidity in uosmo of the least liquid pool of the cycle, other than the swapped pool
+	minLiquidity osmomath.Int
+}
+
+// BuildCyclicRoutes builds cyclic arbitrage routes of up to MaxCyclicRouteHops pools that go through the swapped pool, which are
+// discovered from the pools paired with every denom. The cycles are ranked by the liquidity in uosmo of their least liquid pool, and every
+// cycle is built into a route for each base denom it goes through, so that the route starts and ends with the base denom.
+func (k Keeper) BuildCyclicRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	cycles, err := k.findCycles(ctx, tokenIn, tokenOut, poolId)
+	if err != nil {
+		return routes, err
+	}
+	sort.SliceStable(cycles, func(i, j int) bool {
+		return cycles[i].minLiquidity.GT(cycles[j].minLiquidity)
+	})
+
+	for _, cycle := range cycles {
+		// Base denoms that are closer to the beginning of the list have priority, as with the highest liquidity routes
+		for _, baseDenom := range baseDenoms {
+			newRoute, ok := rotateCycle(cycle.hops, tokenOut, baseDenom.Denom)
+			if !ok {
+				continue
+			}
+
+			// Check that the route is valid and update the number of pool points that this route will consume when simulating and executing trades
+			routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, newRoute)
+			if err != nil {
+				continue
+			}
+
+			routes = append(routes, RouteMetaData{
+				Route:      newRoute,
+				PoolPoints: routePoolPoints,
+				StepSize:   baseDenom.StepSize,
+			})
+			if len(routes) == types.MaxCyclicRoutes {
+				return routes, nil
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// findCycles finds all of the cycles of up to MaxCyclicRouteHops pools that go through the swapped pool, without going through
+// a pool or a denom twice. The swap made tokenIn cheaper on the swapped pool, so every cycle first swaps tokenOut for tokenIn on
+// the swapped pool, and then goes back from tokenIn to tokenOut through the MaxCyclicRouteNeighbors most liquid pools of every denom.
+func (k Keeper) findCycles(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) ([]cyclicRoute, error) {
+	pairedPoolsByDenom := make(map[string][]PairedPool)
+	getPairedPools := func(denom string) ([]PairedPool, error) {
+		if pairedPools, ok := pairedPoolsByDenom[denom]; ok {
+			return pairedPools, nil
+		}
+
+		// The index is ordered by liquidity, so only the most liquid pools of the denom are read
+		pairedPools, err := k.GetPoolsForDenom(ctx, denom, types.MaxCyclicRouteNeighbors)
+		if err != nil {
+			return nil, err
+		}
+
+		pairedPoolsByDenom[denom] = pairedPools
+		return pairedPools, nil
+	}
+
+	cycles := make([]cyclicRoute, 0)
+	visitedPools := map[uint64]bool{poolId: true}
+	visitedDenoms := map[string]bool{tokenIn: true, tokenOut: true}
+
+	var explore func(denom string, hops poolmanagertypes.SwapAmountInRoutes, minLiquidity osmomath.Int) error
+	explore = func(denom string, hops poolmanagertypes.SwapAmountInRoutes, minLiquidity osmomath.Int) error {
+		pairedPools, err := getPairedPools(denom)
+		if err != nil {
+			return err
+		}
+
+		for _, pairedPool := range pairedPools {
+			if visitedPools[pairedPool.PoolId] {
+				continue
+			}
+
+			newHops := make(poolmanagertypes.SwapAmountInRoutes, len(hops), len(hops)+1)
+			copy(newHops, hops)
+			newHops = append(newHops, poolmanagertypes.SwapAmountInRoute{
+				PoolId:        pairedPool.PoolId,
+				TokenOutDenom: pairedPool.Denom,
+			})
+			newMinLiquidity := pairedPool.Liquidity
+			if !minLiquidity.IsNil() {
+				newMinLiquidity = osmomath.MinInt(minLiquidity, pairedPool.Liquidity)
+			}
+
+			if pairedPool.Denom == tokenOut {
+				cycles = append(cycles, cyclicRoute{hops: newHops, minLiquidity: newMinLiquidity})
+				continue
+			}
+
+			// The cycle must still be closed with one more hop back to tokenOut
+			if len(newHops)+1 > types.MaxCyclicRouteHops || visitedDenoms[pairedPool.Denom] {
+				continue
+			}
+
+			visitedPools[pairedPool.PoolId], visitedDenoms[pairedPool.Denom] = true, true
+			if err := explore(pairedPool.Denom, newHops, newMinLiquidity); err != nil {
+				return err
+			}
+			delete(visitedPools, pairedPool.PoolId)
+			delete(visitedDenoms, pairedPool.Denom)
+		}
+
+		return nil
+	}
+
+	swappedPoolHop := poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: tokenIn}
+	if err := explore(tokenIn, poolmanagertypes.SwapAmountInRoutes{swappedPoolHop}, osmomath.Int{}); err != nil {
+		return nil, err
+	}
+
+	return cycles, nil
+}
+
+// rotateCycle returns the hops of the cycle, which starts with startDenom, rotated to start and end with the given denom.
+// It returns false if the cycle does not go through the denom.
+func rotateCycle(hops poolmanagertypes.SwapAmountInRoutes, startDenom, denom string) (poolmanagertypes.SwapAmountInRoutes, bool) {
+	hopInputDenom := startDenom
+	for i, hop := range hops {
+		if hopInputDenom == denom {
+			rotatedHops := make(poolmanagertypes.SwapAmountInRoutes, 0, len(hops))
+			rotatedHops = append(rotatedHops, hops[i:]...)
+			return append(rotatedHops, hops[:i]...), true
+		}
+		hopInputDenom = hop.TokenOutDenom
+	}
+
+	return nil, false
+}
+
 // CalculateRoutePoolPoints calculates the number of pool points that will be consumed by a route when simulating and executing trades. This
 // is only added to the global pool point counter if the route simulated is minimally profitable i.e. it will make a profit.
 func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (uint64, error) {
@@ -116,9 +116,11 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 	for _, tc := range cases {
 		s.Run(tc.description, func() {
 			routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID)
-			s.Require().Equal(len(tc.expectedRoutes), len(routes))
+			// The hot and highest liquidity routes come first, followed by at most MaxCyclicRoutes cyclic routes
+			s.Require().GreaterOrEqual(len(routes), len(tc.expectedRoutes))
+			s.Require().LessOrEqual(len(routes), len(tc.expectedRoutes)+types.MaxCyclicRoutes)
 
-			for routeIndex, route := range routes {
+			for routeIndex, route := range routes[:len(tc.expectedRoutes)] {
 				for tradeIndex, poolID := range route.Route.PoolIds() {
 					s.Require().Equal(tc.expectedRoutes[routeIndex][tradeIndex].PoolId, poolID)
 					s.Require().Equal(tc.expectedRoutes[routeIndex][tradeIndex].OutputDenom, route.Route[tradeIndex].TokenOutDenom)
@@ -418,6 +420,93 @@ func (s *KeeperTestSuite) TestBuildHotRoutes() {
 	}
 }
 
+// TestBuildCyclicRoutes tests the BuildCyclicRoutes function
+func (s *KeeperTestSuite) TestBuildCyclicRoutes() {
+	s.SetupPoolsTest()
+	cases := []struct {
+		description    string
+		swapIn         string
+		swapOut        string
+		poolId         uint64
+		expectedRoutes [][]TestRoute
+		expectedCount  int
+	}{
+		{
+			description: "Two pool cycle through a stable pool",
+			swapIn:      "usdc",
+			swapOut:     types.OsmosisDenomination,
+			poolId:      29,
+			expectedRoutes: [][]TestRoute{
+				{
+					{29, types.OsmosisDenomination, "usdc"},
+					{53, "usdc", types.OsmosisDenomination},
+				},
+			},
+			expectedCount: 1,
+		},
+		{
+			description: "Cycles of up to four pools are capped at MaxCyclicRoutes",
+			swapIn:      "akash",
+			swapOut:     "Atom",
+			poolId:      1,
+			// Pools with the same liquidity are explored in the order of their ids
+			expectedRoutes: [][]TestRoute{
+				{
+					{25, types.OsmosisDenomination, "Atom"},
+					{1, "Atom", "akash"},
+					{7, "akash", types.OsmosisDenomination},
+				},
+				{
+					{1, "Atom", "akash"},
+					{7, "akash", types.OsmosisDenomination},
+					{25, types.OsmosisDenomination, "Atom"},
+				},
+			},
+			expectedCount: types.MaxCyclicRoutes,
+		},
+		{
+			description:    "No cycles for a pool that is not indexed",
+			swapIn:         "akash",
+			swapOut:        "Atom",
+			poolId:         1000,
+			expectedRoutes: [][]TestRoute{},
+			expectedCount:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			routes, err := s.App.ProtoRevKeeper.BuildCyclicRoutes(s.Ctx, tc.swapIn, tc.swapOut, tc.poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedCount, len(routes))
+
+			for routeIndex, expectedRoute := range tc.expectedRoutes {
+				s.Require().Equal(len(expectedRoute), len(routes[routeIndex].Route))
+				for tradeIndex, trade := range expectedRoute {
+					s.Require().Equal(trade.PoolId, routes[routeIndex].Route[tradeIndex].PoolId)
+					s.Require().Equal(trade.OutputDenom, routes[routeIndex].Route[tradeIndex].TokenOutDenom)
+				}
+			}
+
+			// Every route must be a cycle through the swapped pool that does not go through a pool twice
+			for _, routeMetaData := range routes {
+				route := routeMetaData.Route
+				s.Require().LessOrEqual(len(route), types.MaxCyclicRouteHops)
+
+				visitedPools := make(map[uint64]bool)
+				for _, hop := range route {
+					s.Require().False(visitedPools[hop.PoolId])
+					visitedPools[hop.PoolId] = true
+					if hop.PoolId == tc.poolId {
+						s.Require().Equal(tc.swapIn, hop.TokenOutDenom)
+					}
+				}
+				s.Require().True(visitedPools[tc.poolId])
+			}
+		})
+	}
+}
+
 // TestCalculateRoutePoolPoints tests the CalculateRoutePoolPoints function
 func (s *KeeperTestSuite) TestCalculateRoutePoolPoints() {
 	s.SetupPoolsTest()
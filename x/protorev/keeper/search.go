@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v25/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
+)
+
+// searchFunc finds the amount in that maximizes the profit of a route, searching between curLeft and curRight
+// (in multiples of the step size of the route).
+type searchFunc func(k Keeper, ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error)
+
+// searchStrategies maps every search strategy that can be set in the params to its implementation.
+var searchStrategies = map[string]searchFunc{
+	types.SearchStrategyBinary:        Keeper.binarySearchMaxProfit,
+	types.SearchStrategyGoldenSection: Keeper.goldenSectionSearchMaxProfit,
+	types.SearchStrategyClosedForm:    Keeper.closedFormMaxProfit,
+}
+
+// GetSearchStrategy returns the strategy used to find the amount in that maximizes the profit of a route.
+func (k Keeper) GetSearchStrategy(ctx sdk.Context) string {
+	var searchStrategy string
+	k.paramstore.Get(ctx, types.ParamStoreKeySearchStrategy, &searchStrategy)
+	return searchStrategy
+}
+
+// searchMaxProfit finds the amount in that maximizes the profit of a route with the search strategy set in the params.
+func (k Keeper) searchMaxProfit(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	search, ok := searchStrategies[k.GetSearchStrategy(ctx)]
+	if !ok {
+		search = searchStrategies[types.DefaultSearchStrategy]
+	}
+
+	return search(k, ctx, route, inputDenom, curLeft, curRight)
+}
+
+// binarySearchMaxProfit runs a binary search that compares the profits of two adjacent amounts in at every iteration.
+func (k Keeper) binarySearchMaxProfit(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	// Track the tokenIn amount/denom and the profit
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+
+	// Binary search to find the max profit
+	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
+		curMid := (curLeft.Add(curRight)).Quo(twoInt)
+		curMidPlusOne := curMid.Add(oneInt)
+
+		// Short circuit profit searching if there is an error in the GAMM module
+		tokenInMid, profitMid, err := k.EstimateMultihopProfit(ctx, inputDenom, curMid.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		// Short circuit profit searching if there is an error in the GAMM module
+		tokenInMidPlusOne, profitMidPlusOne, err := k.EstimateMultihopProfit(ctx, inputDenom, curMidPlusOne.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return sdk.Coin{}, osmomath.ZeroInt(), err
+		}
+
+		// Reduce subspace to search for max profit
+		if profitMid.LTE(profitMidPlusOne) {
+			curLeft = curMidPlusOne
+			tokenIn = tokenInMidPlusOne
+			profit = profitMidPlusOne
+		} else {
+			curRight = curMid
+			tokenIn = tokenInMid
+			profit = profitMid
+		}
+	}
+
+	return tokenIn, profit, nil
+}
+
+// goldenSectionSearchMaxProfit runs a golden-section search, which keeps two amounts in inside of the search range and
+// discards the part of the range beyond the one with the lower profit. The remaining amount in splits the new range in the
+// golden ratio, so only a single amount in is simulated per iteration instead of the two of the binary search.
+func (k Keeper) goldenSectionSearchMaxProfit(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+
+	// Simulates the amount in (in multiples of the step size) and keeps track of the most profitable one
+	estimate := func(amount osmomath.Int) (osmomath.Int, error) {
+		tokenInAmount, profitAmount, err := k.EstimateMultihopProfit(ctx, inputDenom, amount.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return osmomath.ZeroInt(), err
+		}
+		if tokenIn.IsNil() || profitAmount.GT(profit) {
+			tokenIn, profit = tokenInAmount, profitAmount
+		}
+		return profitAmount, nil
+	}
+	goldenSection := func(left, right osmomath.Int) osmomath.Int {
+		return right.Sub(left).Mul(goldenRatioConjugateNumerator).Quo(goldenRatioConjugateDenominator)
+	}
+
+	if curLeft.GTE(curRight) {
+		return tokenIn, profit, nil
+	}
+
+	innerLeft, innerRight := curRight.Sub(goldenSection(curLeft, curRight)), curLeft.Add(goldenSection(curLeft, curRight))
+	profitInnerLeft, err := estimate(innerLeft)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+	profitInnerRight, err := estimate(innerRight)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+
+	for iteration := 0; innerLeft.LT(innerRight) && iteration < types.MaxGoldenSectionIterations; iteration++ {
+		if profitInnerLeft.LT(profitInnerRight) {
+			// The max profit is to the right of the inner left amount in
+			curLeft, innerLeft, profitInnerLeft = innerLeft, innerRight, profitInnerRight
+			innerRight = curLeft.Add(goldenSection(curLeft, curRight))
+			if innerRight.LTE(innerLeft) {
+				innerRight = innerLeft.Add(oneInt)
+			}
+			if innerRight.GT(curRight) {
+				break
+			}
+			if profitInnerRight, err = estimate(innerRight); err != nil {
+				return sdk.Coin{}, osmomath.ZeroInt(), err
+			}
+		} else {
+			// The max profit is to the left of the inner right amount in
+			curRight, innerRight, profitInnerRight = innerRight, innerLeft, profitInnerLeft
+			innerLeft = curRight.Sub(goldenSection(curLeft, curRight))
+			if innerLeft.GTE(innerRight) {
+				innerLeft = innerRight.Sub(oneInt)
+			}
+			if innerLeft.LT(curLeft) {
+				break
+			}
+			if profitInnerLeft, err = estimate(innerLeft); err != nil {
+				return sdk.Coin{}, osmomath.ZeroInt(), err
+			}
+		}
+	}
+
+	return tokenIn, profit, nil
+}
+
+// goldenRatioConjugateNumerator / goldenRatioConjugateDenominator approximates 1 / phi, the fraction of the search range
+// between an end of the range and the inner amount in furthest from it.
+var goldenRatioConjugateNumerator, goldenRatioConjugateDenominator = osmomath.NewInt(618_034), osmomath.NewInt(1_000_000)
+
+// closedFormMaxProfit finds the amount in that maximizes the profit of a route made only of balancer and stableswap pools in
+// closed form. Every pool of the route is approximated by a constant product pool, which is exact for balancer pools with equal
+// weights, and the pools are composed into a single one with reserves (a, b). The profit b * x / (a + x) - x of the composed pool
+// is maximized at x = sqrt(a * b) - a, which is then simulated on the actual pools. Routes with any other pool type, or for which
+// the amount in found is not profitable, fall back to the golden-section search.
+func (k Keeper) closedFormMaxProfit(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	amountIn, err := k.closedFormAmountIn(ctx, route, inputDenom)
+	if err != nil {
+		k.Logger(ctx).Debug("Falling back to golden-section search: " + err.Error())
+		return k.goldenSectionSearchMaxProfit(ctx, route, inputDenom, curLeft, curRight)
+	}
+
+	// Simulate the multiples of the step size of the route on both sides of the amount in, within the search range
+	tokenIn, profit := sdk.Coin{}, osmomath.ZeroInt()
+	lowerAmount := amountIn.Quo(route.StepSize)
+	for _, amount := range []osmomath.Int{lowerAmount, lowerAmount.Add(oneInt)} {
+		amount = osmomath.MinInt(osmomath.MaxInt(amount, curLeft), curRight)
+		tokenInAmount, profitAmount, err := k.EstimateMultihopProfit(ctx, inputDenom, amount.Mul(route.StepSize), route.Route)
+		if err != nil {
+			return k.goldenSectionSearchMaxProfit(ctx, route, inputDenom, curLeft, curRight)
+		}
+		if profitAmount.GT(profit) {
+			tokenIn, profit = tokenInAmount, profitAmount
+		}
+	}
+	if !profit.IsPositive() {
+		return k.goldenSectionSearchMaxProfit(ctx, route, inputDenom, curLeft, curRight)
+	}
+
+	return tokenIn, profit, nil
+}
+
+// closedFormAmountIn returns the amount in that maximizes the profit of the route once every pool is approximated by a constant
+// product pool. Swapping x through a constant product pool with reserves (a, b) once the spread factor is taken out of the
+// reserve in returns b * x / (a + x), and swapping that through (c, d) returns (b * d / (c + b)) * x / (a * c / (c + b) + x).
+func (k Keeper) closedFormAmountIn(ctx sdk.Context, route RouteMetaData, inputDenom string) (osmomath.Int, error) {
+	var reserveIn, reserveOut osmomath.BigDec
+	tokenInDenom := inputDenom
+	for index, hop := range route.Route {
+		hopReserveIn, hopReserveOut, err := k.constantProductReserves(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		tokenInDenom = hop.TokenOutDenom
+
+		if index == 0 {
+			reserveIn, reserveOut = hopReserveIn, hopReserveOut
+			continue
+		}
+
+		denominator := hopReserveIn.Add(reserveOut)
+		reserveIn = reserveIn.Mul(hopReserveIn).Quo(denominator)
+		reserveOut = reserveOut.Mul(hopReserveOut).Quo(denominator)
+	}
+
+	// The route is only profitable if the marginal amount out of the first unit in is greater than one
+	if reserveOut.LTE(reserveIn) {
+		return osmomath.Int{}, errors.New("route is not profitable once approximated by constant product pools")
+	}
+
+	sqrtReserves, err := osmomath.MonotonicSqrtBigDec(reserveIn.Mul(reserveOut))
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return sqrtReserves.Sub(reserveIn).Dec().TruncateInt(), nil
+}
+
+// constantProductReserves returns the reserves of the constant product pool with the same spot price as the pool, and the same
+// amount out for a swap of 1% of the reserve in. The spread factor is taken out of the reserve in, such that swapping x returns
+// reserveOut * x / (reserveIn + x).
+func (k Keeper) constantProductReserves(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (osmomath.BigDec, osmomath.BigDec, error) {
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+	if pool.GetType() != poolmanagertypes.Balancer && pool.GetType() != poolmanagertypes.Stableswap {
+		return osmomath.BigDec{}, osmomath.BigDec{}, fmt.Errorf("pool %d is not a balancer or stableswap pool", poolId)
+	}
+
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+
+	// The spot price is the amount out of the first unit in, before the spread factor
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, poolId, tokenOutDenom, tokenInDenom)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+
+	liquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+	probeIn := liquidity.AmountOf(tokenInDenom).Quo(osmomath.NewInt(100))
+	if !probeIn.IsPositive() {
+		return osmomath.BigDec{}, osmomath.BigDec{}, fmt.Errorf("pool %d does not have enough %s liquidity", poolId, tokenInDenom)
+	}
+
+	spreadFactor := pool.GetSpreadFactor(ctx)
+	probeOut, err := swapModule.CalcOutAmtGivenIn(ctx, pool, sdk.NewCoin(tokenInDenom, probeIn), tokenOutDenom, spreadFactor)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+
+	// Solving spotPrice * reserve * x / (reserve + x) = probeOut, with x the probe amount in after the spread factor
+	feeMultiplier := osmomath.OneBigDec().Sub(osmomath.BigDecFromDec(spreadFactor))
+	probeInAfterFee := osmomath.BigDecFromSDKInt(probeIn).Mul(feeMultiplier)
+	amountOut := osmomath.BigDecFromSDKInt(probeOut.Amount)
+	denominator := spotPrice.Mul(probeInAfterFee).Sub(amountOut)
+	if !denominator.IsPositive() || !amountOut.IsPositive() {
+		return osmomath.BigDec{}, osmomath.BigDec{}, fmt.Errorf("pool %d cannot be approximated by a constant product pool", poolId)
+	}
+	reserve := amountOut.Mul(probeInAfterFee).Quo(denominator)
+
+	return reserve.Quo(feeMultiplier), spotPrice.Mul(reserve), nil
+}
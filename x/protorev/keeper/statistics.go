@@ -256,6 +256,78 @@ func (k Keeper) UpdateProfitsByRoute(ctx sdk.Context, route []uint64, denom stri
 	return nil
 }
 
+// GetRebatesByAddress returns all of the backrun profits that have been rebated to the swapper
+func (k Keeper) GetRebatesByAddress(ctx sdk.Context, swapper sdk.AccAddress) []sdk.Coin {
+	rebates := make([]sdk.Coin, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetKeyPrefixRebatesByAddress(swapper))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		rebate := sdk.Coin{}
+		if err := rebate.Unmarshal(bz); err == nil {
+			rebates = append(rebates, rebate)
+		}
+	}
+
+	return rebates
+}
+
+// GetAllSwapperRebates returns the backrun profits that have been rebated to every swapper
+func (k Keeper) GetAllSwapperRebates(ctx sdk.Context) ([]types.SwapperRebates, error) {
+	swapperRebates := make([]types.SwapperRebates, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRebatesByAddress)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// ignore the portion of the key that is the prefix
+		swapper, err := types.ParseAddressFromRebatesKey(iterator.Key()[len(types.KeyPrefixRebatesByAddress):])
+		if err != nil {
+			return nil, err
+		}
+
+		rebate := sdk.Coin{}
+		if err := rebate.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		// the rebates of a swapper are stored contiguously, so they are grouped with the last swapper
+		if len(swapperRebates) == 0 || swapperRebates[len(swapperRebates)-1].Address != swapper.String() {
+			swapperRebates = append(swapperRebates, types.SwapperRebates{Address: swapper.String()})
+		}
+		last := &swapperRebates[len(swapperRebates)-1]
+		last.Rebates = append(last.Rebates, rebate)
+	}
+
+	return swapperRebates, nil
+}
+
+// UpdateRebatesByAddress adds the rebate to the backrun profits that have been rebated to the swapper
+func (k Keeper) UpdateRebatesByAddress(ctx sdk.Context, swapper sdk.AccAddress, rebate sdk.Coin) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyRebatesByAddress(swapper, rebate.Denom)
+
+	rebates := sdk.NewCoin(rebate.Denom, osmomath.ZeroInt())
+	if bz := store.Get(key); len(bz) != 0 {
+		if err := rebates.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	rebates.Amount = rebates.Amount.Add(rebate.Amount)
+	bz, err := rebates.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
 // UpdateStatistics updates the module statistics after each trade is executed
 func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, denom string, profit osmomath.Int) error {
 	// Increment the number of trades executed by the ProtoRev module
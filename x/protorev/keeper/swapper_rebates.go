@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
+)
+
+// GetSwapper returns the account that is rebated for the backruns of the tx, which is its first signer.
+// An empty address is returned if the signers of the tx cannot be determined.
+func GetSwapper(tx sdk.Tx) sdk.AccAddress {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(signers) == 0 {
+		return nil
+	}
+
+	return sdk.AccAddress(signers[0])
+}
+
+// GetSwapperRebate returns the swapper rebate share of the profit of a backrun
+func (k Keeper) GetSwapperRebate(ctx sdk.Context, profit sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(profit.Denom, k.GetSwapperRebateShare(ctx).MulInt(profit.Amount).TruncateInt())
+}
+
+// RebateSwapper sends the swapper rebate share of the profit of a backrun from the module account to the swapper,
+// updates the rebate statistics of the swapper and emits a rebate event. It returns the rebate that was paid, which is
+// zero if there is no swapper, rebates are disabled or the swapper is not allowed to receive funds.
+func (k Keeper) RebateSwapper(ctx sdk.Context, swapper sdk.AccAddress, profit sdk.Coin) (sdk.Coin, error) {
+	noRebate := sdk.NewCoin(profit.Denom, osmomath.ZeroInt())
+	if swapper.Empty() || k.bankKeeper.BlockedAddr(swapper) {
+		return noRebate, nil
+	}
+
+	rebate := k.GetSwapperRebate(ctx, profit)
+	if !rebate.IsPositive() {
+		return noRebate, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swapper, sdk.NewCoins(rebate)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.UpdateRebatesByAddress(ctx, swapper, rebate); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	EmitSwapperRebateEvent(ctx, swapper, rebate)
+
+	return rebate, nil
+}
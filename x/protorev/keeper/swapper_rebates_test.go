@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
+)
+
+// TestRebateSwapper tests that the swapper rebate share of a backrun profit is sent to the swapper
+func (s *KeeperTestSuite) TestRebateSwapper() {
+	swapper := apptesting.CreateRandomAccounts(1)[0]
+
+	cases := []struct {
+		description    string
+		swapper        sdk.AccAddress
+		rebateShare    osmomath.Dec
+		profit         sdk.Coin
+		expectedRebate osmomath.Int
+	}{
+		{
+			description:    "Rebates disabled",
+			swapper:        swapper,
+			rebateShare:    osmomath.ZeroDec(),
+			profit:         sdk.NewCoin(types.OsmosisDenomination, arbProfit),
+			expectedRebate: osmomath.ZeroInt(),
+		},
+		{
+			description:    "No swapper",
+			swapper:        nil,
+			rebateShare:    osmomath.NewDecWithPrec(5, 1),
+			profit:         sdk.NewCoin(types.OsmosisDenomination, arbProfit),
+			expectedRebate: osmomath.ZeroInt(),
+		},
+		{
+			description:    "Swapper that is not allowed to receive funds",
+			swapper:        s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName),
+			rebateShare:    osmomath.NewDecWithPrec(5, 1),
+			profit:         sdk.NewCoin(types.OsmosisDenomination, arbProfit),
+			expectedRebate: osmomath.ZeroInt(),
+		},
+		{
+			description:    "Half of the profit is rebated",
+			swapper:        swapper,
+			rebateShare:    osmomath.NewDecWithPrec(5, 1),
+			profit:         sdk.NewCoin(types.OsmosisDenomination, arbProfit),
+			expectedRebate: osmomath.NewInt(500),
+		},
+		{
+			description:    "Rebate is rounded down",
+			swapper:        swapper,
+			rebateShare:    osmomath.NewDecWithPrec(1, 1),
+			profit:         sdk.NewCoin(usdcDenom, osmomath.NewInt(1009)),
+			expectedRebate: osmomath.NewInt(100),
+		},
+		{
+			description:    "Rebate rounded down to zero",
+			swapper:        swapper,
+			rebateShare:    osmomath.NewDecWithPrec(1, 1),
+			profit:         sdk.NewCoin(usdcDenom, osmomath.NewInt(9)),
+			expectedRebate: osmomath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			s.SetupTest()
+			s.App.ProtoRevKeeper.SetParam(s.Ctx, types.ParamStoreKeySwapperRebateShare, tc.rebateShare)
+
+			err := s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(tc.profit))
+			s.Require().NoError(err)
+			initialSwapperBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.swapper, tc.profit.Denom)
+
+			rebate, err := s.App.ProtoRevKeeper.RebateSwapper(s.Ctx, tc.swapper, tc.profit)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(tc.profit.Denom, tc.expectedRebate), rebate)
+
+			// The module account keeps the rest of the profit
+			moduleBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName), tc.profit.Denom)
+			s.Require().Equal(tc.profit.Amount.Sub(tc.expectedRebate), moduleBalance.Amount)
+
+			if tc.swapper.Empty() {
+				return
+			}
+
+			swapperBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.swapper, tc.profit.Denom)
+			s.Require().Equal(initialSwapperBalance.Amount.Add(tc.expectedRebate), swapperBalance.Amount)
+
+			rebates := s.App.ProtoRevKeeper.GetRebatesByAddress(s.Ctx, tc.swapper)
+			if tc.expectedRebate.IsZero() {
+				s.Require().Empty(rebates)
+			} else {
+				s.Require().Equal([]sdk.Coin{rebate}, rebates)
+			}
+		})
+	}
+}
+
+// TestGetAllSwapperRebates tests that the cumulative rebates of every swapper are tracked and exported
+func (s *KeeperTestSuite) TestGetAllSwapperRebates() {
+	swappers := apptesting.CreateRandomAccounts(2)
+
+	swapperRebates, err := s.App.ProtoRevKeeper.GetAllSwapperRebates(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(swapperRebates)
+
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateRebatesByAddress(s.Ctx, swappers[0], sdk.NewInt64Coin(types.OsmosisDenomination, 100)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateRebatesByAddress(s.Ctx, swappers[0], sdk.NewInt64Coin(types.OsmosisDenomination, 50)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateRebatesByAddress(s.Ctx, swappers[0], sdk.NewInt64Coin(usdcDenom, 10)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateRebatesByAddress(s.Ctx, swappers[1], sdk.NewInt64Coin("Atom", 7)))
+
+	expectedRebates := map[string][]sdk.Coin{
+		swappers[0].String(): {sdk.NewInt64Coin(types.OsmosisDenomination, 150), sdk.NewInt64Coin(usdcDenom, 10)},
+		swappers[1].String(): {sdk.NewInt64Coin("Atom", 7)},
+	}
+
+	s.Require().Equal(expectedRebates[swappers[0].String()], s.App.ProtoRevKeeper.GetRebatesByAddress(s.Ctx, swappers[0]))
+	s.Require().Equal(expectedRebates[swappers[1].String()], s.App.ProtoRevKeeper.GetRebatesByAddress(s.Ctx, swappers[1]))
+
+	swapperRebates, err = s.App.ProtoRevKeeper.GetAllSwapperRebates(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(swapperRebates, 2)
+	for _, swapperRebate := range swapperRebates {
+		s.Require().Equal(expectedRebates[swapperRebate.Address], swapperRebate.Rebates)
+	}
+}
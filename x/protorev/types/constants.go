@@ -20,6 +20,9 @@ var ExtendedMaxInputAmount = osmomath.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// Max iterations for golden-section search, which narrows the search range by a factor of 1/phi per iteration (log_phi(131_072) ~= 25)
+const MaxGoldenSectionIterations int = 25
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -31,6 +34,16 @@ const MaxPoolPointsPerBlock uint64 = 200
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
+// Max number of hops of the cyclic arbitrage routes that are discovered from the pools paired with every denom
+const MaxCyclicRouteHops int = 4
+
+// Max number of pools paired with a denom, in order of liquidity, that are explored when discovering cyclic arbitrage routes.
+// This bounds the number of routes explored to MaxCyclicRouteNeighbors ^ (MaxCyclicRouteHops - 1)
+const MaxCyclicRouteNeighbors int = 5
+
+// Max number of cyclic arbitrage routes that are discovered for a single swap
+const MaxCyclicRoutes int = 10
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
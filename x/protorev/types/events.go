@@ -1,7 +1,8 @@
 package types
 
 const (
-	TypeEvtBackrun = "protorev_backrun"
+	TypeEvtBackrun       = "protorev_backrun"
+	TypeEvtSwapperRebate = "protorev_swapper_rebate"
 
 	AttributeValueCategory               = ModuleName
 	AttributeKeyTxHash                   = "tx_hash"
@@ -14,4 +15,6 @@ const (
 	AttributeKeyProtorevAmountIn         = "amount_in"
 	AttributeKeyProtorevAmountOut        = "amount_out"
 	AttributeKeyProtorevArbDenom         = "arb_denom"
+	AttributeKeySwapper                  = "swapper"
+	AttributeKeyRebate                   = "rebate"
 )
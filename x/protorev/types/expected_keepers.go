@@ -25,6 +25,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // GAMMKeeper defines the Gamm contract that must be fulfilled when
@@ -41,7 +42,8 @@ type PoolManagerKeeper interface {
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
-		tokenOutMinAmount osmomath.Int) (tokenOutAmount osmomath.Int, err error)
+		tokenOutMinAmount osmomath.Int,
+		limits poolmanagertypes.SwapLimits) (tokenOutAmount osmomath.Int, err error)
 
 	MultihopEstimateOutGivenExactAmountInNoTakerFee(
 		ctx sdk.Context,
@@ -39,6 +39,7 @@ var (
 	DefaultMaxPoolPointsPerTx        = uint64(18)
 	DefaultPoolPointsConsumedInBlock = uint64(0)
 	DefaultProfits                   = []sdk.Coin{}
+	DefaultSwapperRebates            = []SwapperRebates{}
 	DefaultCyclicArbTracker          = CyclicArbTracker{
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
@@ -61,6 +62,7 @@ func DefaultGenesis() *GenesisState {
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
 		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		SwapperRebates:         DefaultSwapperRebates,
 	}
 }
 
@@ -103,6 +105,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the swapper rebates
+	if err := ValidateSwapperRebates(gs.SwapperRebates); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
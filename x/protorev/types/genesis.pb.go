@@ -60,6 +60,8 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// All of the backrun profits that have been rebated to swappers.
+	SwapperRebates []SwapperRebates `protobuf:"bytes,15,rep,name=swapper_rebates,json=swapperRebates,proto3" json:"swapper_rebates" yaml:"swapper_rebates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -194,6 +196,13 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetSwapperRebates() []SwapperRebates {
+	if m != nil {
+		return m.SwapperRebates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -203,57 +212,59 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0x8f, 0x69, 0x48, 0xe9, 0x6c, 0xba, 0x6a, 0x06, 0x12, 0xcd, 0xae, 0x88, 0xd7, 0x0c, 0x0d,
-	0xac, 0x50, 0x6b, 0xab, 0x01, 0x2e, 0x3d, 0x20, 0xc5, 0x41, 0x05, 0x84, 0xa8, 0x22, 0x27, 0x08,
-	0x09, 0x24, 0x86, 0xb1, 0x3d, 0xbb, 0xb1, 0x62, 0x7b, 0xac, 0x99, 0xd9, 0x74, 0xfd, 0x00, 0xdc,
-	0x79, 0x18, 0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0xa7, 0x15, 0x4a, 0x8e, 0xdc, 0xf6, 0x09, 0x90, 0x67,
-	0x66, 0x37, 0xed, 0x66, 0x4d, 0x6f, 0x99, 0xef, 0xfb, 0xfd, 0xf9, 0x7e, 0xe3, 0x6f, 0xb2, 0xe0,
-	0x13, 0x2e, 0x0b, 0x2e, 0x33, 0x19, 0x54, 0x82, 0x2b, 0x2e, 0xd8, 0x65, 0x70, 0xf9, 0x24, 0x66,
-	0x8a, 0x3e, 0x09, 0xc6, 0xac, 0x64, 0x32, 0x93, 0xbe, 0x6e, 0x40, 0x64, 0x71, 0xfe, 0x02, 0xe7,
-	0x5b, 0x5c, 0xff, 0x83, 0x31, 0x1f, 0x73, 0x5d, 0x0d, 0x9a, 0xbf, 0x0c, 0xa0, 0xff, 0x69, 0xab,
-	0xee, 0x52, 0xc0, 0x00, 0x0f, 0xda, 0x81, 0x54, 0xd0, 0xc2, 0x1a, 0xf6, 0x7b, 0x89, 0xc6, 0x11,
-	0x63, 0x64, 0x0e, 0xb6, 0xe5, 0x9a, 0x53, 0x10, 0x53, 0xc9, 0x96, 0xe4, 0x84, 0x67, 0xa5, 0xe9,
-	0xe3, 0x7f, 0x01, 0xd8, 0xfe, 0xc6, 0x84, 0x39, 0x55, 0x54, 0x31, 0xf8, 0x15, 0xd8, 0x32, 0xda,
-	0xc8, 0xf1, 0x9c, 0x61, 0xe7, 0xd0, 0xf3, 0xdb, 0xc2, 0xf9, 0x27, 0x1a, 0x17, 0x6e, 0xbe, 0x9c,
-	0x0d, 0x36, 0x22, 0xcb, 0x82, 0xbf, 0x3b, 0x60, 0x57, 0xf1, 0x0b, 0x56, 0x92, 0x8a, 0x66, 0x82,
-	0x50, 0x11, 0x13, 0xc1, 0x27, 0x8a, 0x49, 0xf4, 0x8e, 0x77, 0x67, 0xd8, 0x39, 0x7c, 0xd4, 0xae,
-	0x77, 0xd6, 0xd0, 0x4e, 0x68, 0x26, 0x8e, 0x44, 0x1c, 0x69, 0x4e, 0xf8, 0xb0, 0xd1, 0x9e, 0xcf,
-	0x06, 0x1f, 0xd6, 0xb4, 0xc8, 0x9f, 0xe2, 0xb5, 0xc2, 0x38, 0x82, 0xea, 0x16, 0x13, 0xfe, 0x06,
-	0x3a, 0x4d, 0x66, 0x92, 0xb2, 0x92, 0x17, 0x12, 0xdd, 0xd1, 0xe6, 0x1f, 0xb7, 0x9b, 0x87, 0x54,
-	0xb2, 0xaf, 0x1b, 0x6c, 0xd8, 0xb7, 0x9e, 0xd0, 0x78, 0xbe, 0xa6, 0x82, 0x23, 0x10, 0x2f, 0x60,
-	0x12, 0xd6, 0x60, 0xbb, 0xe2, 0x3c, 0x27, 0x2f, 0x58, 0x36, 0x3e, 0x57, 0x12, 0x6d, 0xea, 0xfb,
-	0x3a, 0xf8, 0x9f, 0xfb, 0xe2, 0x3c, 0xff, 0xc9, 0x80, 0xc3, 0xc0, 0x9a, 0x1c, 0x18, 0x93, 0xd7,
-	0x85, 0xf0, 0xa3, 0x94, 0x55, 0x82, 0x25, 0x54, 0xb1, 0xf4, 0x29, 0x56, 0x62, 0xc2, 0x30, 0x72,
-	0xa2, 0x4e, 0x75, 0xc3, 0x86, 0x04, 0xf4, 0x52, 0x5a, 0x4b, 0x22, 0xb3, 0x32, 0x61, 0xa4, 0xe0,
-	0xe9, 0x24, 0x67, 0xc4, 0xee, 0x24, 0x7a, 0xd7, 0x73, 0x86, 0x9b, 0xe1, 0xc3, 0xf9, 0x6c, 0xe0,
-	0x19, 0xf1, 0x56, 0x28, 0x8e, 0xf6, 0x9a, 0xde, 0x69, 0xd3, 0xfa, 0x41, 0x77, 0xec, 0x2a, 0x40,
-	0x02, 0xba, 0x29, 0xbb, 0x64, 0x39, 0xaf, 0x98, 0x20, 0x23, 0xc6, 0x24, 0xda, 0xd2, 0x17, 0xd8,
-	0xf3, 0xed, 0x76, 0x35, 0xf7, 0xb0, 0x0c, 0x76, 0xcc, 0xb3, 0x32, 0xdc, 0xb7, 0x89, 0x76, 0xad,
-	0xe9, 0x1b, 0x74, 0x1c, 0xdd, 0x5f, 0x16, 0x9e, 0x31, 0x26, 0xe1, 0x73, 0xf0, 0x7e, 0x4e, 0x15,
-	0x93, 0x8a, 0xc4, 0x39, 0x4f, 0x2e, 0xc8, 0xb9, 0x4e, 0x86, 0xee, 0xea, 0xd9, 0xdd, 0xf9, 0x6c,
-	0xd0, 0x37, 0x32, 0x6b, 0x40, 0x38, 0xda, 0x31, 0xd5, 0xb0, 0x29, 0x7e, 0xab, 0x6b, 0xf0, 0x17,
-	0xb0, 0x73, 0xe3, 0x48, 0xd3, 0x54, 0x30, 0x29, 0xd1, 0x7b, 0x9e, 0x33, 0xbc, 0x17, 0xfa, 0xf3,
-	0xd9, 0x00, 0xad, 0x0e, 0x65, 0x21, 0xf8, 0xaf, 0x3f, 0x1f, 0x77, 0x6d, 0xa4, 0x23, 0x53, 0x8a,
-	0x1e, 0x2c, 0x51, 0xb6, 0x02, 0x7f, 0x05, 0xbd, 0x82, 0x4e, 0x89, 0xfe, 0x48, 0x15, 0xcf, 0x4a,
-	0x25, 0x49, 0xa3, 0xa1, 0x87, 0x42, 0xf7, 0x56, 0xaf, 0xbb, 0x15, 0x8a, 0xa3, 0xdd, 0x82, 0x4e,
-	0x9b, 0x2d, 0x38, 0xd1, 0x9d, 0x13, 0x26, 0x74, 0x04, 0xf8, 0x23, 0xd8, 0x5b, 0x47, 0x52, 0x53,
-	0x04, 0xb4, 0xf8, 0x47, 0xf3, 0xd9, 0x60, 0xbf, 0x5d, 0x5c, 0x4d, 0x71, 0x04, 0x57, 0x95, 0xcf,
-	0xa6, 0xf0, 0x14, 0xec, 0x6a, 0x14, 0x49, 0xf8, 0xa4, 0x54, 0x64, 0xc4, 0x17, 0x23, 0x77, 0xb4,
-	0xaa, 0x77, 0xf3, 0xae, 0xd6, 0xc2, 0x70, 0x04, 0x75, 0xfd, 0xb8, 0x29, 0x3f, 0xe3, 0x76, 0xd6,
-	0xef, 0xc1, 0xdd, 0x4a, 0xf0, 0x51, 0xa6, 0x24, 0xda, 0x7e, 0xdb, 0x4a, 0xec, 0xd9, 0x95, 0xe8,
-	0x5a, 0x17, 0xc3, 0xc3, 0xd1, 0x42, 0x01, 0x4e, 0xc0, 0x4e, 0x56, 0x8e, 0x38, 0x89, 0x6b, 0x13,
-	0x4a, 0xd5, 0x15, 0x43, 0xf7, 0xf5, 0x3b, 0x1a, 0xb6, 0xbf, 0xa3, 0xef, 0xca, 0x11, 0x0f, 0xeb,
-	0x26, 0xed, 0x59, 0x5d, 0xb1, 0xd0, 0xb3, 0x2e, 0xf6, 0x1b, 0xdf, 0x12, 0xc4, 0x51, 0x37, 0x7b,
-	0x83, 0x01, 0x5f, 0x00, 0x98, 0xd4, 0x49, 0x9e, 0x25, 0xfa, 0xbf, 0x88, 0x12, 0x34, 0xb9, 0x60,
-	0x02, 0x75, 0xb5, 0xef, 0x67, 0xed, 0xbe, 0xc7, 0x9a, 0x73, 0x24, 0xe2, 0x33, 0xc3, 0x08, 0xf7,
-	0xe7, 0xb3, 0x41, 0xcf, 0xb8, 0xde, 0xd6, 0xc3, 0xd1, 0x83, 0x64, 0x95, 0xf0, 0xfc, 0xe5, 0x95,
-	0xeb, 0xbc, 0xba, 0x72, 0x9d, 0x7f, 0xae, 0x5c, 0xe7, 0x8f, 0x6b, 0x77, 0xe3, 0xd5, 0xb5, 0xbb,
-	0xf1, 0xf7, 0xb5, 0xbb, 0xf1, 0xf3, 0x17, 0xe3, 0x4c, 0x9d, 0x4f, 0x62, 0x3f, 0xe1, 0x45, 0x60,
-	0x07, 0x78, 0x9c, 0xd3, 0x58, 0x2e, 0x0e, 0xc1, 0xe5, 0xe1, 0x97, 0xc1, 0xf4, 0xe6, 0x77, 0xa0,
-	0x09, 0x26, 0xe3, 0x2d, 0x7d, 0xfe, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x93, 0x50,
-	0x1e, 0xa9, 0x06, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0x23, 0x35,
+	0x18, 0xef, 0xd0, 0xd2, 0x65, 0x9d, 0x6e, 0x76, 0x6b, 0x68, 0xe5, 0x44, 0x74, 0x32, 0x98, 0x2d,
+	0x44, 0x68, 0x37, 0xa3, 0x2d, 0x70, 0xd9, 0x03, 0x52, 0xa7, 0x68, 0x01, 0x21, 0x56, 0x95, 0x5b,
+	0x84, 0x04, 0x12, 0xc6, 0x33, 0x71, 0xd2, 0x51, 0x27, 0xe3, 0xc1, 0x76, 0xda, 0xe4, 0x01, 0xb8,
+	0xf3, 0x30, 0x3c, 0xc4, 0x1e, 0x57, 0x9c, 0x38, 0x8d, 0x50, 0xfb, 0x06, 0x79, 0x02, 0x34, 0xb6,
+	0x93, 0x6e, 0xd3, 0xcc, 0x72, 0xab, 0xbf, 0xef, 0xf7, 0xc7, 0xbf, 0x6f, 0x3e, 0x37, 0xe0, 0x13,
+	0xa1, 0x46, 0x42, 0xa5, 0x2a, 0x2c, 0xa4, 0xd0, 0x42, 0xf2, 0x8b, 0xf0, 0xe2, 0x59, 0xcc, 0x35,
+	0x7b, 0x16, 0x0e, 0x79, 0xce, 0x55, 0xaa, 0x7a, 0xa6, 0x01, 0x91, 0xc3, 0xf5, 0xe6, 0xb8, 0x9e,
+	0xc3, 0xb5, 0x3f, 0x18, 0x8a, 0xa1, 0x30, 0xd5, 0xb0, 0xfa, 0xcb, 0x02, 0xda, 0x9f, 0xd6, 0xea,
+	0x2e, 0x04, 0x2c, 0x70, 0xbf, 0x1e, 0xc8, 0x24, 0x1b, 0x39, 0xc3, 0x76, 0x2b, 0x31, 0x38, 0x6a,
+	0x8d, 0xec, 0xc1, 0xb5, 0x7c, 0x7b, 0x0a, 0x63, 0xa6, 0xf8, 0x82, 0x9c, 0x88, 0x34, 0xb7, 0x7d,
+	0x5c, 0x36, 0xc0, 0xd6, 0x37, 0x36, 0xcc, 0x89, 0x66, 0x9a, 0xc3, 0xaf, 0xc0, 0xa6, 0xd5, 0x46,
+	0x5e, 0xe0, 0x75, 0x1b, 0x07, 0x41, 0xaf, 0x2e, 0x5c, 0xef, 0xd8, 0xe0, 0xa2, 0x8d, 0x57, 0x65,
+	0x67, 0x8d, 0x38, 0x16, 0xfc, 0xc3, 0x03, 0x3b, 0x5a, 0x9c, 0xf3, 0x9c, 0x16, 0x2c, 0x95, 0x94,
+	0xc9, 0x98, 0x4a, 0x31, 0xd6, 0x5c, 0xa1, 0x77, 0x82, 0xf5, 0x6e, 0xe3, 0xe0, 0x49, 0xbd, 0xde,
+	0x69, 0x45, 0x3b, 0x66, 0xa9, 0x3c, 0x94, 0x31, 0x31, 0x9c, 0xe8, 0x71, 0xa5, 0x3d, 0x2b, 0x3b,
+	0x1f, 0x4e, 0xd9, 0x28, 0x7b, 0x8e, 0x57, 0x0a, 0x63, 0x02, 0xf5, 0x1d, 0x26, 0xfc, 0x0d, 0x34,
+	0xaa, 0xcc, 0xb4, 0xcf, 0x73, 0x31, 0x52, 0x68, 0xdd, 0x98, 0x7f, 0x5c, 0x6f, 0x1e, 0x31, 0xc5,
+	0xbf, 0xae, 0xb0, 0x51, 0xdb, 0x79, 0x42, 0xeb, 0xf9, 0x86, 0x0a, 0x26, 0x20, 0x9e, 0xc3, 0x14,
+	0x9c, 0x82, 0xad, 0x42, 0x88, 0x8c, 0x5e, 0xf2, 0x74, 0x78, 0xa6, 0x15, 0xda, 0x30, 0xf3, 0xda,
+	0x7f, 0xcb, 0xbc, 0x84, 0xc8, 0x7e, 0xb2, 0xe0, 0x28, 0x74, 0x26, 0xfb, 0xd6, 0xe4, 0x4d, 0x21,
+	0xfc, 0xa4, 0xcf, 0x0b, 0xc9, 0x13, 0xa6, 0x79, 0xff, 0x39, 0xd6, 0x72, 0xcc, 0x31, 0xf2, 0x48,
+	0xa3, 0xb8, 0x61, 0x43, 0x0a, 0x5a, 0x7d, 0x36, 0x55, 0x54, 0xa5, 0x79, 0xc2, 0xe9, 0x48, 0xf4,
+	0xc7, 0x19, 0xa7, 0x6e, 0x27, 0xd1, 0xbb, 0x81, 0xd7, 0xdd, 0x88, 0x1e, 0xcf, 0xca, 0x4e, 0x60,
+	0xc5, 0x6b, 0xa1, 0x98, 0xec, 0x56, 0xbd, 0x93, 0xaa, 0xf5, 0x83, 0xe9, 0xb8, 0x55, 0x80, 0x14,
+	0x34, 0xfb, 0xfc, 0x82, 0x67, 0xa2, 0xe0, 0x92, 0x0e, 0x38, 0x57, 0x68, 0xd3, 0x0c, 0xb0, 0xd5,
+	0x73, 0xdb, 0x55, 0xcd, 0x61, 0x11, 0xec, 0x48, 0xa4, 0x79, 0xb4, 0xe7, 0x12, 0xed, 0x38, 0xd3,
+	0x5b, 0x74, 0x4c, 0x1e, 0x2c, 0x0a, 0x2f, 0x38, 0x57, 0xf0, 0x25, 0x78, 0x3f, 0x63, 0x9a, 0x2b,
+	0x4d, 0xe3, 0x4c, 0x24, 0xe7, 0xf4, 0xcc, 0x24, 0x43, 0xf7, 0xcc, 0xdd, 0xfd, 0x59, 0xd9, 0x69,
+	0x5b, 0x99, 0x15, 0x20, 0x4c, 0xb6, 0x6d, 0x35, 0xaa, 0x8a, 0xdf, 0x9a, 0x1a, 0xfc, 0x05, 0x6c,
+	0xdf, 0x38, 0xb2, 0x7e, 0x5f, 0x72, 0xa5, 0xd0, 0x7b, 0x81, 0xd7, 0xbd, 0x1f, 0xf5, 0x66, 0x65,
+	0x07, 0x2d, 0x5f, 0xca, 0x41, 0xf0, 0xdf, 0x7f, 0x3d, 0x6d, 0xba, 0x48, 0x87, 0xb6, 0x44, 0x1e,
+	0x2d, 0x50, 0xae, 0x02, 0x7f, 0x05, 0xad, 0x11, 0x9b, 0x50, 0xf3, 0x91, 0x0a, 0x91, 0xe6, 0x5a,
+	0xd1, 0x4a, 0xc3, 0x5c, 0x0a, 0xdd, 0x5f, 0x1e, 0x77, 0x2d, 0x14, 0x93, 0x9d, 0x11, 0x9b, 0x54,
+	0x5b, 0x70, 0x6c, 0x3a, 0xc7, 0x5c, 0x9a, 0x08, 0xf0, 0x47, 0xb0, 0xbb, 0x8a, 0xa4, 0x27, 0x08,
+	0x18, 0xf1, 0x8f, 0x66, 0x65, 0x67, 0xaf, 0x5e, 0x5c, 0x4f, 0x30, 0x81, 0xcb, 0xca, 0xa7, 0x13,
+	0x78, 0x02, 0x76, 0x0c, 0x8a, 0x26, 0x62, 0x9c, 0x6b, 0x3a, 0x10, 0xf3, 0x2b, 0x37, 0x8c, 0x6a,
+	0x70, 0xf3, 0xae, 0x56, 0xc2, 0x30, 0x81, 0xa6, 0x7e, 0x54, 0x95, 0x5f, 0x08, 0x77, 0xd7, 0xef,
+	0xc1, 0xbd, 0x42, 0x8a, 0x41, 0xaa, 0x15, 0xda, 0xfa, 0xbf, 0x95, 0xd8, 0x75, 0x2b, 0xd1, 0x74,
+	0x2e, 0x96, 0x87, 0xc9, 0x5c, 0x01, 0x8e, 0xc1, 0x76, 0x9a, 0x0f, 0x04, 0x8d, 0xa7, 0x36, 0x94,
+	0x9e, 0x16, 0x1c, 0x3d, 0x30, 0xef, 0xa8, 0x5b, 0xff, 0x8e, 0xbe, 0xcb, 0x07, 0x22, 0x9a, 0x56,
+	0x69, 0x4f, 0xa7, 0x05, 0x8f, 0x02, 0xe7, 0xe2, 0xbe, 0xf1, 0x1d, 0x41, 0x4c, 0x9a, 0xe9, 0x2d,
+	0x06, 0xbc, 0x04, 0x30, 0x99, 0x26, 0x59, 0x9a, 0x98, 0xff, 0x22, 0x5a, 0xb2, 0xe4, 0x9c, 0x4b,
+	0xd4, 0x34, 0xbe, 0x9f, 0xd5, 0xfb, 0x1e, 0x19, 0xce, 0xa1, 0x8c, 0x4f, 0x2d, 0x23, 0xda, 0x9b,
+	0x95, 0x9d, 0x96, 0x75, 0xbd, 0xab, 0x87, 0xc9, 0xa3, 0x64, 0x89, 0x00, 0x7f, 0x07, 0x0f, 0xd5,
+	0x25, 0x2b, 0xaa, 0x8f, 0x26, 0x79, 0x5c, 0x2d, 0x31, 0x7a, 0x18, 0xac, 0xbf, 0x3d, 0xed, 0x89,
+	0x25, 0x10, 0x8b, 0x8f, 0x7c, 0x97, 0x76, 0xd7, 0xfa, 0x2e, 0xc9, 0x61, 0xd2, 0x54, 0xb7, 0xf1,
+	0x2f, 0x5f, 0x5d, 0xf9, 0xde, 0xeb, 0x2b, 0xdf, 0xfb, 0xf7, 0xca, 0xf7, 0xfe, 0xbc, 0xf6, 0xd7,
+	0x5e, 0x5f, 0xfb, 0x6b, 0xff, 0x5c, 0xfb, 0x6b, 0x3f, 0x7f, 0x31, 0x4c, 0xf5, 0xd9, 0x38, 0xee,
+	0x25, 0x62, 0x14, 0x3a, 0xf7, 0xa7, 0x19, 0x8b, 0xd5, 0xfc, 0x10, 0x5e, 0x1c, 0x7c, 0x19, 0x4e,
+	0x6e, 0x7e, 0x7a, 0xaa, 0x59, 0xaa, 0x78, 0xd3, 0x9c, 0x3f, 0xff, 0x6f, 0x00, 0xe7, 0xa8, 0x29,
+	0x86, 0x1c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +287,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SwapperRebates) > 0 {
+		for iNdEx := len(m.SwapperRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapperRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +504,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.SwapperRebates) > 0 {
+		for _, e := range m.SwapperRebates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -915,6 +946,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapperRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapperRebates = append(m.SwapperRebates, SwapperRebates{})
+			if err := m.SwapperRebates[len(m.SwapperRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
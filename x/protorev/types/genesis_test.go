@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v25/x/protorev/types"
 )
 
@@ -20,6 +21,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Swapper rebate share above 1",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.SwapperRebateShare = osmomath.NewDecWithPrec(11, 1)
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			description: "Valid swapper rebates",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.SwapperRebateShare = osmomath.NewDecWithPrec(5, 1)
+				genState.SwapperRebates = []types.SwapperRebates{
+					{Address: types.DefaultNullAddress.String(), Rebates: sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 100))},
+				}
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			description: "Duplicate swapper rebates",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.SwapperRebates = []types.SwapperRebates{
+					{Address: types.DefaultNullAddress.String(), Rebates: sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 100))},
+					{Address: types.DefaultNullAddress.String(), Rebates: sdk.NewCoins(sdk.NewInt64Coin("Atom", 100))},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
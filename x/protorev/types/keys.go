@@ -2,10 +2,14 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 const (
@@ -19,6 +23,10 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// poolsByDenomLiquidityLength is the number of bytes of the liquidity in the keys of the pools by denom store,
+	// which fits the 256 bits of an osmomath.Int
+	poolsByDenomLiquidityLength = 32
 )
 
 const (
@@ -41,6 +49,9 @@ const (
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
 	prefixBaseDenoms
+	prefixPoolsByDenom
+	prefixRebatesByAddress
+	prefixPoolLiquidityForDenoms
 )
 
 var (
@@ -103,6 +114,16 @@ var (
 
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
+
+	// KeyPrefixPoolsByDenom is the prefix for the store that indexes the pools paired with every denom, used to discover cyclic arbitrage routes
+	KeyPrefixPoolsByDenom = []byte{prefixPoolsByDenom}
+
+	// KeyPrefixPoolLiquidityForDenoms is the prefix for the store that keeps track of the liquidity every pool is indexed with
+	// in the pools by denom store, used to remove its previous entries when the liquidity of the pool changes
+	KeyPrefixPoolLiquidityForDenoms = []byte{prefixPoolLiquidityForDenoms}
+
+	// KeyPrefixRebatesByAddress is the prefix for the store that keeps track of the backrun profits rebated to every swapper
+	KeyPrefixRebatesByAddress = []byte{prefixRebatesByAddress}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -110,6 +131,47 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key prefix needed to fetch all of the pools paired with a given denom
+func GetKeyPrefixPoolsByDenom(denom string) []byte {
+	return append(KeyPrefixPoolsByDenom, []byte(denom+"|")...)
+}
+
+// Returns the key of a pool paired with a denom in the pools by denom store. Pools are ordered by descending liquidity
+// and then by pool id, so that the most liquid pools of a denom can be read without iterating over all of them.
+// {denom}|{invertedLiquidity}{poolId}
+func GetKeyPoolsByDenom(denom string, liquidity osmomath.Int, poolId uint64) ([]byte, error) {
+	if liquidity.IsNegative() || liquidity.BigInt().BitLen() > 8*poolsByDenomLiquidityLength {
+		return nil, fmt.Errorf("liquidity %s cannot be indexed", liquidity)
+	}
+
+	invertedLiquidity := liquidity.BigInt().FillBytes(make([]byte, poolsByDenomLiquidityLength))
+	for i := range invertedLiquidity {
+		invertedLiquidity[i] = ^invertedLiquidity[i]
+	}
+
+	key := append(GetKeyPrefixPoolsByDenom(denom), invertedLiquidity...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...), nil
+}
+
+// ParseLiquidityAndPoolFromKey returns the liquidity and pool id from a key of the pools by denom store,
+// with the {denom}| prefix removed.
+func ParseLiquidityAndPoolFromKey(key []byte) (osmomath.Int, uint64, error) {
+	if len(key) != poolsByDenomLiquidityLength+8 {
+		return osmomath.Int{}, 0, fmt.Errorf("invalid pools by denom key %x", key)
+	}
+
+	liquidity := make([]byte, poolsByDenomLiquidityLength)
+	for i := range liquidity {
+		liquidity[i] = ^key[i]
+	}
+	return osmomath.NewIntFromBigInt(new(big.Int).SetBytes(liquidity)), sdk.BigEndianToUint64(key[poolsByDenomLiquidityLength:]), nil
+}
+
+// Returns the key needed to fetch the liquidity a pool is indexed with in the pools by denom store
+func GetKeyPoolLiquidityForDenoms(poolId uint64) []byte {
+	return append(KeyPrefixPoolLiquidityForDenoms, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func DeprecatedGetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixDeprecatedBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
@@ -156,6 +218,25 @@ func CreateRouteFromKey(key []byte) ([]uint64, error) {
 	return route, nil
 }
 
+// Returns the key prefix of all of the rebates paid to a swapper
+func GetKeyPrefixRebatesByAddress(swapper sdk.AccAddress) []byte {
+	return append(KeyPrefixRebatesByAddress, address.MustLengthPrefix(swapper)...)
+}
+
+// Returns the key needed to fetch the rebates paid to a swapper by denom
+func GetKeyRebatesByAddress(swapper sdk.AccAddress, denom string) []byte {
+	return append(GetKeyPrefixRebatesByAddress(swapper), []byte(denom)...)
+}
+
+// ParseAddressFromRebatesKey returns the swapper address from a key of the rebates by address store,
+// with the prefix removed.
+func ParseAddressFromRebatesKey(key []byte) (sdk.AccAddress, error) {
+	if len(key) == 0 || len(key) < int(key[0])+1 {
+		return nil, fmt.Errorf("invalid rebates by address key %x", key)
+	}
+	return sdk.AccAddress(key[1 : int(key[0])+1]), nil
+}
+
 // Returns the key needed to fetch the developer fees by coin
 func GetKeyPrefixDeveloperFees(denom string) []byte {
 	return append(KeyPrefixDeveloperFees, []byte(denom)...)
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -19,8 +21,27 @@ var (
 	// as of V24. This address is equivalent to osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqmcn030.
 	DefaultNullAddress = sdk.AccAddress([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	// DefaultSearchStrategy is the binary search that has always been used to find the optimal amount in of a route.
+	DefaultSearchStrategy = SearchStrategyBinary
+
+	// DefaultSwapperRebateShare disables swapper rebates, so that all of the backrun profits are kept by the module.
+	DefaultSwapperRebateShare = osmomath.ZeroDec()
+
+	ParamStoreKeyEnableModule       = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount       = []byte("AdminAccount")
+	ParamStoreKeySearchStrategy     = []byte("SearchStrategy")
+	ParamStoreKeySwapperRebateShare = []byte("SwapperRebateShare")
+)
+
+// The strategies that can be used to find the amount in that maximizes the profit of a route.
+const (
+	// SearchStrategyBinary runs a binary search that compares the profits of two adjacent amounts in at every iteration.
+	SearchStrategyBinary = "binary"
+	// SearchStrategyGoldenSection runs a golden-section search, which only simulates a single amount in per iteration.
+	SearchStrategyGoldenSection = "golden_section"
+	// SearchStrategyClosedForm computes the optimal amount in of routes made only of balancer and stableswap pools
+	// in closed form, and runs a golden-section search for any other route.
+	SearchStrategyClosedForm = "closed_form"
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,16 +50,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, searchStrategy string, swapperRebateShare osmomath.Dec) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:            enable,
+		Admin:              admin,
+		SearchStrategy:     searchStrategy,
+		SwapperRebateShare: swapperRebateShare,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultSearchStrategy, DefaultSwapperRebateShare)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -46,6 +69,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeySearchStrategy, &p.SearchStrategy, ValidateSearchStrategy),
+		paramtypes.NewParamSetPair(ParamStoreKeySwapperRebateShare, &p.SwapperRebateShare, ValidateSwapperRebateShare),
 	}
 }
 
@@ -55,7 +80,11 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
-	return nil
+	if err := ValidateSearchStrategy(p.SearchStrategy); err != nil {
+		return err
+	}
+
+	return ValidateSwapperRebateShare(p.SwapperRebateShare)
 }
 
 func ValidateAccount(i interface{}) error {
@@ -78,3 +107,30 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+func ValidateSearchStrategy(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case SearchStrategyBinary, SearchStrategyGoldenSection, SearchStrategyClosedForm:
+		return nil
+	default:
+		return fmt.Errorf("invalid search strategy: %s", v)
+	}
+}
+
+func ValidateSwapperRebateShare(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("swapper rebate share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
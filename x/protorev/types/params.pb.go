@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -30,6 +31,12 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The strategy used to find the amount in that maximizes the profit of a
+	// route, one of binary, golden_section or closed_form.
+	SearchStrategy string `protobuf:"bytes,3,opt,name=search_strategy,json=searchStrategy,proto3" json:"search_strategy,omitempty" yaml:"search_strategy"`
+	// The share of the profit of every backrun that is rebated to the signer of
+	// the transaction whose swaps created the arbitrage opportunity.
+	SwapperRebateShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=swapper_rebate_share,json=swapperRebateShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swapper_rebate_share" yaml:"swapper_rebate_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +86,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetSearchStrategy() string {
+	if m != nil {
+		return m.SearchStrategy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
 }
@@ -88,22 +102,29 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x4c, 0xf5, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x5c, 0x4d, 0xbc, 0x69, 0x2a, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x93, 0xde, 0x7b, 0x7b, 0xef, 0x0d, 0x52, 0x65, 0x28, 0x12, 0x5b, 0x48, 0x4a, 0x40,
+	0xe9, 0x42, 0x33, 0xd4, 0x3f, 0x1b, 0x97, 0xd1, 0xa5, 0x88, 0xa4, 0x3b, 0x37, 0xe1, 0x24, 0x1d,
+	0x92, 0x60, 0xd3, 0x09, 0x33, 0x63, 0x35, 0x6f, 0xe1, 0xd6, 0x37, 0xea, 0xb2, 0x4b, 0x71, 0x11,
+	0xa4, 0x7d, 0x83, 0x3c, 0x81, 0x74, 0x66, 0x8a, 0x20, 0xee, 0x72, 0x7e, 0xdf, 0xef, 0x7c, 0x61,
+	0x38, 0xd6, 0x21, 0xe5, 0x05, 0xe5, 0x39, 0xc7, 0x25, 0xa3, 0x82, 0x32, 0x32, 0xc7, 0xf3, 0x51,
+	0x4c, 0x04, 0x8c, 0x70, 0x09, 0x0c, 0x0a, 0xee, 0x4b, 0x8e, 0x6c, 0xad, 0xf9, 0x5b, 0xcd, 0xd7,
+	0x5a, 0xaf, 0x9b, 0xd2, 0x94, 0x4a, 0x8a, 0x37, 0x5f, 0x4a, 0xe8, 0x1d, 0x24, 0x72, 0x21, 0x52,
+	0x81, 0x1a, 0x54, 0xe4, 0xbd, 0xb6, 0xac, 0xf6, 0x9d, 0xec, 0x46, 0xc7, 0xd6, 0x5f, 0x32, 0x83,
+	0x78, 0x4a, 0x26, 0xb6, 0x39, 0x30, 0x87, 0xff, 0x02, 0xd4, 0xd4, 0x6e, 0xa7, 0x82, 0x62, 0x7a,
+	0xe9, 0xe9, 0xc0, 0x0b, 0xb7, 0x0a, 0x3a, 0xb2, 0xfe, 0xc0, 0xa4, 0xc8, 0x67, 0x76, 0x6b, 0x60,
+	0x0e, 0xff, 0x07, 0x7b, 0x4d, 0xed, 0xee, 0x28, 0x57, 0x62, 0x2f, 0x54, 0x31, 0xba, 0xb2, 0x76,
+	0x39, 0x01, 0x96, 0x64, 0x11, 0x17, 0x0c, 0x04, 0x49, 0x2b, 0xfb, 0x97, 0xdc, 0xe8, 0x35, 0xb5,
+	0xbb, 0xaf, 0x36, 0xbe, 0x09, 0x5e, 0xd8, 0x51, 0x64, 0xac, 0x01, 0x12, 0x56, 0x97, 0x3f, 0x41,
+	0x59, 0x12, 0x16, 0x31, 0x12, 0x83, 0x20, 0x11, 0xcf, 0x80, 0x11, 0xfb, 0xb7, 0x6c, 0x0a, 0x16,
+	0xb5, 0x6b, 0xbc, 0xd7, 0x6e, 0x5f, 0xbd, 0x8c, 0x4f, 0x1e, 0xfc, 0x9c, 0xe2, 0x02, 0x44, 0xe6,
+	0xdf, 0x90, 0x14, 0x92, 0xea, 0x9a, 0x24, 0x4d, 0xed, 0xf6, 0xf5, 0xcf, 0x7e, 0x28, 0xf2, 0x42,
+	0xa4, 0x71, 0x28, 0xe9, 0x78, 0x03, 0x83, 0xdb, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7, 0xfc, 0x58,
+	0x39, 0xe6, 0xcb, 0xda, 0x31, 0x96, 0x6b, 0xc7, 0x78, 0x5b, 0x3b, 0xc6, 0xfd, 0x79, 0x9a, 0x8b,
+	0xec, 0x31, 0xf6, 0x13, 0x5a, 0x60, 0x7d, 0x8b, 0x93, 0x29, 0xc4, 0x7c, 0x3b, 0xe0, 0xf9, 0xe9,
+	0x05, 0x7e, 0xfe, 0xba, 0xa2, 0xa8, 0x4a, 0xc2, 0xe3, 0xb6, 0x9c, 0xcf, 0x3e, 0x07, 0x00, 0x30,
+	0x49, 0x90, 0x4e, 0xe6, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +147,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SwapperRebateShare.Size()
+		i -= size
+		if _, err := m.SwapperRebateShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SearchStrategy) > 0 {
+		i -= len(m.SearchStrategy)
+		copy(dAtA[i:], m.SearchStrategy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SearchStrategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -170,6 +208,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.SearchStrategy)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.SwapperRebateShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -260,6 +304,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SearchStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapperRebateShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapperRebateShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -883,6 +883,62 @@ func (m *CyclicArbTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+// SwapperRebates tracks all of the backrun profits that have been rebated to a
+// swapper.
+type SwapperRebates struct {
+	// address is the address of the swapper
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// rebates are the cumulative rebates paid to the swapper
+	Rebates []types.Coin `protobuf:"bytes,2,rep,name=rebates,proto3" json:"rebates" yaml:"rebates"`
+}
+
+func (m *SwapperRebates) Reset()         { *m = SwapperRebates{} }
+func (m *SwapperRebates) String() string { return proto.CompactTextString(m) }
+func (*SwapperRebates) ProtoMessage()    {}
+func (*SwapperRebates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
+}
+func (m *SwapperRebates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapperRebates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapperRebates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapperRebates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapperRebates.Merge(m, src)
+}
+func (m *SwapperRebates) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapperRebates) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapperRebates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapperRebates proto.InternalMessageInfo
+
+func (m *SwapperRebates) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SwapperRebates) GetRebates() []types.Coin {
+	if m != nil {
+		return m.Rebates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
@@ -899,6 +955,7 @@ func init() {
 	proto.RegisterType((*BaseDenoms)(nil), "osmosis.protorev.v1beta1.BaseDenoms")
 	proto.RegisterType((*AllProtocolRevenue)(nil), "osmosis.protorev.v1beta1.AllProtocolRevenue")
 	proto.RegisterType((*CyclicArbTracker)(nil), "osmosis.protorev.v1beta1.CyclicArbTracker")
+	proto.RegisterType((*SwapperRebates)(nil), "osmosis.protorev.v1beta1.SwapperRebates")
 }
 
 func init() {
@@ -906,84 +963,87 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x37, 0xdb, 0x6d, 0x33, 0x69, 0x37, 0xe9, 0x74, 0xdb, 0x66, 0x53, 0x88, 0x97, 0x69,
-	0x81, 0x14, 0xd1, 0x44, 0x1b, 0x40, 0x42, 0x45, 0x45, 0x5a, 0x2f, 0xaa, 0xa8, 0x10, 0x6d, 0x35,
-	0x1b, 0xa9, 0x82, 0x8b, 0x19, 0x3b, 0x93, 0xac, 0x95, 0xd8, 0x13, 0x79, 0x26, 0xdb, 0x6c, 0x91,
-	0x2a, 0x21, 0x8e, 0x5c, 0xb8, 0xf4, 0xc6, 0x81, 0x1b, 0x27, 0xfe, 0x06, 0xae, 0x3d, 0xf6, 0x58,
-	0x71, 0xb0, 0x50, 0x7b, 0x41, 0x1c, 0xf3, 0x17, 0xa0, 0xf9, 0x61, 0x27, 0xf1, 0x36, 0x6d, 0x57,
-	0x42, 0xdc, 0xec, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x7d, 0xe3, 0x99, 0x4c, 0xc0, 0xfb, 0x8c, 0x87,
-	0x8c, 0x07, 0xbc, 0x35, 0x8a, 0x99, 0x60, 0x31, 0x3d, 0x68, 0x1d, 0x6c, 0x7b, 0x54, 0x90, 0xed,
-	0x2c, 0xd0, 0x54, 0x0f, 0xb0, 0x6a, 0x0a, 0x9b, 0x59, 0xdc, 0x14, 0xd6, 0x36, 0x7d, 0x95, 0x72,
-	0x55, 0xa2, 0xa5, 0x5f, 0x74, 0x55, 0x6d, 0xa3, 0xcf, 0xfa, 0x4c, 0xc7, 0xe5, 0x93, 0x89, 0xd6,
-	0x75, 0x4d, 0xcb, 0x23, 0x9c, 0x66, 0x72, 0x3e, 0x0b, 0x22, 0x93, 0xbf, 0x96, 0xf5, 0xc4, 0xd8,
-	0x30, 0x24, 0x11, 0xe9, 0xd3, 0x38, 0xab, 0xeb, 0xd3, 0x88, 0x66, 0x6d, 0xd4, 0xae, 0xa6, 0xa5,
-	0x62, 0xd2, 0xa3, 0x94, 0xbf, 0xbc, 0x0a, 0x3d, 0xb3, 0x00, 0xec, 0xb0, 0x01, 0x8d, 0xee, 0x91,
-	0x20, 0xde, 0x89, 0x3d, 0xcc, 0xc6, 0x82, 0x72, 0xf8, 0x0d, 0x00, 0x24, 0xf6, 0xdc, 0x58, 0xbd,
-	0x55, 0xad, 0xad, 0x42, 0xa3, 0xd4, 0xb6, 0x9b, 0xcb, 0xe6, 0x6c, 0x2a, 0x94, 0xb3, 0xf9, 0x24,
-	0xb1, 0x57, 0xa6, 0x89, 0x7d, 0xee, 0x90, 0x84, 0xc3, 0x1b, 0x68, 0x46, 0x80, 0x70, 0x91, 0x64,
-	0xd4, 0x4d, 0x70, 0x5a, 0x48, 0x41, 0x37, 0x88, 0xaa, 0x27, 0xb6, 0xac, 0x46, 0xd1, 0x39, 0x3f,
-	0x4d, 0xec, 0xb2, 0xc6, 0xa4, 0x19, 0x84, 0x4f, 0xa9, 0xc7, 0xdb, 0x11, 0xdc, 0x06, 0x45, 0x1d,
-	0x65, 0x63, 0x51, 0x2d, 0x28, 0xc0, 0xc6, 0x34, 0xb1, 0x2b, 0xf3, 0x00, 0x36, 0x16, 0x08, 0x6b,
-	0xda, 0xbb, 0x63, 0x71, 0x63, 0xf5, 0xef, 0x5f, 0x6d, 0x0b, 0xfd, 0x6e, 0x81, 0x93, 0x4a, 0x13,
-	0xde, 0x01, 0x6b, 0x22, 0x26, 0xdd, 0x37, 0x99, 0xa4, 0x23, 0xeb, 0x9c, 0x0b, 0x66, 0x92, 0xb3,
-	0x46, 0x44, 0x81, 0x11, 0x36, 0x2c, 0xf0, 0x0e, 0x28, 0x72, 0x41, 0x47, 0x2e, 0x0f, 0x1e, 0x52,
-	0x33, 0xc3, 0xb6, 0x44, 0xfc, 0x99, 0xd8, 0x17, 0xf4, 0x02, 0xf2, 0xee, 0xa0, 0x19, 0xb0, 0x56,
-	0x48, 0xc4, 0x7e, 0xf3, 0x76, 0x24, 0x66, 0xfd, 0x66, 0x38, 0x84, 0x4f, 0xcb, 0xe7, 0xbd, 0xe0,
-	0x21, 0x35, 0xfd, 0x3e, 0xb6, 0xc0, 0x49, 0x25, 0x0f, 0xaf, 0x80, 0x55, 0xb9, 0xbe, 0x55, 0x6b,
-	0xcb, 0x6a, 0xac, 0x3a, 0xe5, 0x69, 0x62, 0x97, 0x34, 0x5a, 0x46, 0x11, 0x56, 0xc9, 0xff, 0xcf,
-	0xc7, 0x7f, 0x2c, 0x50, 0x56, 0x3e, 0xee, 0x09, 0x22, 0x02, 0x2e, 0x02, 0x9f, 0xc3, 0xaf, 0xc0,
-	0xa9, 0x51, 0xcc, 0x7a, 0x81, 0x48, 0x2d, 0xdd, 0x6c, 0x9a, 0xaf, 0x5b, 0x7e, 0xb9, 0x99, 0x9b,
-	0xbb, 0x2c, 0x88, 0x9c, 0x8b, 0xc6, 0xcc, 0x75, 0x33, 0x83, 0xc6, 0x21, 0x9c, 0x32, 0x40, 0x0f,
-	0x54, 0xa2, 0x71, 0xe8, 0xd1, 0xd8, 0x65, 0x3d, 0xd7, 0x2c, 0x94, 0x9e, 0xe8, 0xd3, 0xd7, 0xb9,
-	0x7a, 0x49, 0x73, 0xe6, 0xe1, 0x08, 0xaf, 0xeb, 0xd0, 0xdd, 0x5e, 0x47, 0x2f, 0xd9, 0x7b, 0xe0,
-	0xa4, 0xfa, 0x16, 0xab, 0x85, 0xad, 0x42, 0x63, 0xd5, 0xa9, 0x4c, 0x13, 0xfb, 0x8c, 0xc6, 0xaa,
-	0x30, 0xc2, 0x3a, 0x8d, 0x7e, 0x3b, 0x01, 0x4a, 0xf7, 0x18, 0x1b, 0xde, 0xa7, 0x41, 0x7f, 0x5f,
-	0x70, 0x78, 0x13, 0x9c, 0xe5, 0x82, 0x78, 0x43, 0xea, 0x3e, 0x50, 0x11, 0xb3, 0x26, 0xd5, 0x69,
-	0x62, 0x6f, 0xa4, 0x2b, 0x3a, 0x97, 0x46, 0xf8, 0x8c, 0x7e, 0xd7, 0x78, 0xb8, 0x0b, 0xca, 0x1e,
-	0x19, 0x92, 0xc8, 0xa7, 0x71, 0x4a, 0x70, 0x42, 0x11, 0xd4, 0xa6, 0x89, 0x7d, 0x51, 0x13, 0xe4,
-	0x0a, 0x10, 0x5e, 0x4f, 0x23, 0x86, 0xe4, 0x2e, 0x38, 0xef, 0xb3, 0xc8, 0xa7, 0x91, 0x88, 0x89,
-	0xa0, 0xdd, 0x94, 0xa8, 0xa0, 0x88, 0xea, 0xd3, 0xc4, 0xae, 0x69, 0xa2, 0x97, 0x14, 0x21, 0x0c,
-	0xe7, 0xa3, 0xb3, 0xae, 0xa4, 0xa1, 0x0f, 0x08, 0x0f, 0x53, 0xb2, 0xd5, 0x7c, 0x57, 0xb9, 0x02,
-	0x84, 0xd7, 0xd3, 0x88, 0x26, 0x41, 0xbf, 0x14, 0xc0, 0xfa, 0xed, 0xa8, 0xc7, 0x9c, 0x43, 0xe9,
-	0x57, 0xe7, 0x70, 0x44, 0xe1, 0x7d, 0xb0, 0xa6, 0xa7, 0x57, 0x2e, 0x95, 0xda, 0x8d, 0xe5, 0xfb,
-	0x6c, 0x4f, 0xd5, 0x49, 0xa4, 0xe2, 0xc8, 0x6d, 0x38, 0xcd, 0x82, 0xb0, 0xa1, 0x83, 0x2e, 0x38,
-	0x9d, 0x7a, 0xa2, 0xfc, 0x2b, 0xb5, 0x3f, 0x58, 0x4e, 0xed, 0x98, 0xca, 0x8c, 0xfc, 0x92, 0x21,
-	0x2f, 0x2f, 0xfa, 0x8d, 0x70, 0x46, 0x0a, 0x19, 0x38, 0x33, 0xef, 0x93, 0xf2, 0xb6, 0xd4, 0x6e,
-	0x2e, 0x17, 0xd9, 0x9d, 0xab, 0xce, 0x84, 0x2e, 0x1b, 0xa1, 0xf3, 0x47, 0xd7, 0x03, 0xe1, 0x05,
-	0x01, 0x39, 0x51, 0xea, 0xa7, 0xf2, 0xfe, 0x95, 0x13, 0xed, 0x9a, 0xca, 0x65, 0x13, 0xa5, 0x4c,
-	0x08, 0x67, 0xa4, 0xe8, 0x33, 0xb0, 0xbe, 0xe8, 0x31, 0xbc, 0x06, 0xd6, 0x16, 0xbe, 0xe1, 0x73,
-	0x33, 0xbf, 0xd3, 0x35, 0x36, 0x05, 0xe8, 0x26, 0xa8, 0xe4, 0x5d, 0x3c, 0x0e, 0xfc, 0x27, 0x0b,
-	0x6c, 0xbc, 0xcc, 0xa0, 0x63, 0x70, 0xc0, 0x2f, 0xc1, 0xb9, 0x90, 0x4c, 0x5c, 0x11, 0xf8, 0x03,
-	0xee, 0xfa, 0x31, 0xe3, 0x9c, 0x76, 0xcd, 0xde, 0x79, 0x6b, 0x9a, 0xd8, 0x55, 0x8d, 0x3a, 0x52,
-	0x82, 0x70, 0x39, 0x24, 0x93, 0x8e, 0x0c, 0xed, 0x9a, 0x88, 0x00, 0x95, 0xbc, 0x81, 0xf0, 0x3b,
-	0x50, 0xd2, 0x3a, 0x6e, 0x48, 0x46, 0xe9, 0x19, 0x76, 0x65, 0xf9, 0x0a, 0xe8, 0x6f, 0xfe, 0x6b,
-	0x32, 0x72, 0x6a, 0xc6, 0x7a, 0x38, 0xdf, 0xb6, 0x62, 0x41, 0x18, 0x3c, 0x48, 0xcb, 0x38, 0x7a,
-	0x04, 0x8a, 0x19, 0xe8, 0x38, 0x73, 0xdf, 0x02, 0x15, 0x9f, 0x49, 0xdf, 0x7c, 0xe1, 0x92, 0x6e,
-	0x37, 0xa6, 0x3c, 0x3d, 0x0c, 0x2f, 0xcf, 0xce, 0xbb, 0x7c, 0x05, 0xc2, 0xe5, 0x34, 0xb4, 0x63,
-	0x22, 0x3f, 0x5a, 0xa0, 0xe8, 0x10, 0x4e, 0xbf, 0xa0, 0x11, 0x0b, 0xe5, 0xf1, 0xd7, 0x95, 0x0f,
-	0x4a, 0xbf, 0x38, 0x7f, 0xfc, 0xa9, 0x30, 0xc2, 0x3a, 0xfd, 0x5f, 0xff, 0xb2, 0xa1, 0x08, 0x80,
-	0xac, 0x09, 0x2e, 0x5d, 0x97, 0x3f, 0x0f, 0xae, 0xd2, 0x7a, 0x03, 0xd7, 0x33, 0x68, 0xde, 0xf5,
-	0x39, 0x16, 0x84, 0x81, 0x97, 0x29, 0xa0, 0xc7, 0x05, 0x00, 0x77, 0x86, 0xc3, 0x7b, 0x92, 0xc9,
-	0x67, 0x43, 0x4c, 0x0f, 0x68, 0x34, 0xa6, 0xf0, 0x11, 0x80, 0x82, 0x0c, 0x68, 0xec, 0xca, 0x9b,
-	0x90, 0xfc, 0x8d, 0xf0, 0x07, 0x34, 0x36, 0x87, 0xd4, 0xf5, 0x99, 0xfe, 0xec, 0x4e, 0x35, 0xbb,
-	0x0f, 0x48, 0xd8, 0x2d, 0x4a, 0x79, 0x47, 0x83, 0x9c, 0x77, 0x4c, 0x27, 0x9b, 0xe6, 0x77, 0xf3,
-	0x08, 0x2d, 0xc2, 0x15, 0x91, 0x03, 0xc1, 0x1f, 0x2c, 0x50, 0x16, 0x93, 0x45, 0x75, 0x7d, 0x8e,
-	0xbd, 0x9b, 0xa9, 0xeb, 0x6b, 0xda, 0x4c, 0x78, 0x32, 0xaf, 0xda, 0x36, 0xaa, 0x0d, 0xa3, 0xba,
-	0xc8, 0x85, 0x3e, 0xec, 0xd2, 0x51, 0x4c, 0x7d, 0xb9, 0xd7, 0xe4, 0x6d, 0x65, 0x4c, 0x51, 0xd5,
-	0xc2, 0x67, 0xc5, 0x3c, 0x05, 0xfc, 0x1e, 0x40, 0xff, 0xd0, 0x1f, 0x06, 0xbe, 0x2b, 0x2f, 0x66,
-	0x69, 0x17, 0x85, 0xd7, 0x9e, 0x3d, 0x0a, 0xb3, 0x13, 0x7b, 0x4b, 0x0c, 0x38, 0xca, 0x89, 0x70,
-	0xc5, 0xcf, 0x81, 0xd0, 0x1f, 0x16, 0xa8, 0xe4, 0x99, 0xe0, 0xe7, 0x00, 0xcc, 0xd0, 0xaf, 0xbf,
-	0x47, 0xac, 0x4a, 0x61, 0x5c, 0xcc, 0xb8, 0xe1, 0x00, 0xbc, 0xbd, 0xaf, 0xb7, 0x1f, 0xf1, 0x7d,
-	0x36, 0x8e, 0x44, 0x10, 0xf5, 0x5d, 0x2e, 0x48, 0x2c, 0xb8, 0xdb, 0x8b, 0x59, 0xa8, 0x2c, 0x2e,
-	0x38, 0x8d, 0x69, 0x62, 0x5f, 0xd5, 0xcd, 0xbe, 0xb2, 0x1c, 0xe1, 0x9a, 0xce, 0xef, 0x64, 0xe9,
-	0x3d, 0x95, 0xbd, 0x15, 0xb3, 0xd0, 0xb9, 0xf3, 0xe4, 0x79, 0xdd, 0x7a, 0xfa, 0xbc, 0x6e, 0xfd,
-	0xf5, 0xbc, 0x6e, 0xfd, 0xfc, 0xa2, 0xbe, 0xf2, 0xf4, 0x45, 0x7d, 0xe5, 0xd9, 0x8b, 0xfa, 0xca,
-	0xb7, 0x1f, 0xf7, 0x03, 0xb1, 0x3f, 0xf6, 0x9a, 0x3e, 0x0b, 0x5b, 0xc6, 0xc6, 0xeb, 0x43, 0xe2,
-	0xf1, 0xf4, 0xa5, 0x75, 0xd0, 0xfe, 0xa4, 0x35, 0x99, 0xfd, 0x8b, 0x10, 0x87, 0x23, 0xca, 0xbd,
-	0x35, 0xf5, 0xfe, 0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x9c, 0xfc, 0x3d, 0x66, 0x0c,
-	0x00, 0x00,
+	// 1275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x6e, 0x5a, 0x8f, 0x5b, 0xdb, 0x9d, 0xa6, 0xad, 0xe3, 0x82, 0x37, 0x4c, 0x0b,
+	0xb8, 0x15, 0xb5, 0x95, 0x00, 0x12, 0x2a, 0x2a, 0x52, 0x36, 0x55, 0x45, 0x85, 0x68, 0xab, 0x49,
+	0xa4, 0x0a, 0x2e, 0xcb, 0xec, 0x7a, 0xe2, 0xac, 0x62, 0xef, 0x58, 0x3b, 0xe3, 0xfc, 0x28, 0x52,
+	0x25, 0xc4, 0x91, 0x0b, 0x97, 0xde, 0x38, 0x20, 0x71, 0xe0, 0xc4, 0x8d, 0x3b, 0xd7, 0x1e, 0x2b,
+	0x4e, 0x15, 0x87, 0x15, 0x6a, 0x2f, 0x88, 0xa3, 0xff, 0x02, 0x34, 0x3f, 0x76, 0xd7, 0xde, 0xc4,
+	0xa4, 0x91, 0x10, 0xb7, 0xdd, 0xf7, 0xde, 0xf7, 0x7d, 0xf3, 0xbe, 0xd9, 0x19, 0x3f, 0x83, 0x77,
+	0x19, 0x1f, 0x30, 0x1e, 0xf0, 0xce, 0x30, 0x62, 0x82, 0x45, 0x74, 0xb7, 0xb3, 0xbb, 0xe2, 0x51,
+	0x41, 0x56, 0xd2, 0x40, 0x5b, 0x3d, 0xc0, 0xba, 0x29, 0x6c, 0xa7, 0x71, 0x53, 0xd8, 0x58, 0xf2,
+	0x55, 0xca, 0x55, 0x89, 0x8e, 0x7e, 0xd1, 0x55, 0x8d, 0xc5, 0x1e, 0xeb, 0x31, 0x1d, 0x97, 0x4f,
+	0x26, 0xda, 0xd4, 0x35, 0x1d, 0x8f, 0x70, 0x9a, 0xca, 0xf9, 0x2c, 0x08, 0x4d, 0xfe, 0x7a, 0xba,
+	0x26, 0xc6, 0xfa, 0x03, 0x12, 0x92, 0x1e, 0x8d, 0xd2, 0xba, 0x1e, 0x0d, 0x69, 0xba, 0x8c, 0xc6,
+	0xb5, 0xa4, 0x54, 0xec, 0x6f, 0x51, 0xca, 0x8f, 0xae, 0x42, 0x2f, 0x2c, 0x00, 0x37, 0xd9, 0x0e,
+	0x0d, 0x1f, 0x92, 0x20, 0x5a, 0x8b, 0x3c, 0xcc, 0x46, 0x82, 0x72, 0xf8, 0x05, 0x00, 0x24, 0xf2,
+	0xdc, 0x48, 0xbd, 0xd5, 0xad, 0xe5, 0x42, 0xab, 0xbc, 0x6a, 0xb7, 0x67, 0xf5, 0xd9, 0x56, 0x28,
+	0x67, 0xe9, 0x59, 0x6c, 0xcf, 0x8d, 0x63, 0xfb, 0xfc, 0x01, 0x19, 0xf4, 0x6f, 0xa1, 0x8c, 0x00,
+	0xe1, 0x12, 0x49, 0xa9, 0xdb, 0xe0, 0x8c, 0x90, 0x82, 0x6e, 0x10, 0xd6, 0xe7, 0x97, 0xad, 0x56,
+	0xc9, 0xb9, 0x30, 0x8e, 0xed, 0xaa, 0xc6, 0x24, 0x19, 0x84, 0x4f, 0xab, 0xc7, 0x7b, 0x21, 0x5c,
+	0x01, 0x25, 0x1d, 0x65, 0x23, 0x51, 0x2f, 0x28, 0xc0, 0xe2, 0x38, 0xb6, 0x6b, 0x93, 0x00, 0x36,
+	0x12, 0x08, 0x6b, 0xda, 0x07, 0x23, 0x71, 0xab, 0xf8, 0xd7, 0x8f, 0xb6, 0x85, 0x7e, 0xb1, 0xc0,
+	0x29, 0xa5, 0x09, 0xef, 0x83, 0x05, 0x11, 0x91, 0xee, 0xeb, 0x74, 0xb2, 0x29, 0xeb, 0x9c, 0x8b,
+	0xa6, 0x93, 0x73, 0x46, 0x44, 0x81, 0x11, 0x36, 0x2c, 0xf0, 0x3e, 0x28, 0x71, 0x41, 0x87, 0x2e,
+	0x0f, 0x1e, 0x53, 0xd3, 0xc3, 0x8a, 0x44, 0xfc, 0x11, 0xdb, 0x17, 0xf5, 0x06, 0xf2, 0xee, 0x4e,
+	0x3b, 0x60, 0x9d, 0x01, 0x11, 0xdb, 0xed, 0x7b, 0xa1, 0xc8, 0xd6, 0x9b, 0xe2, 0x10, 0x3e, 0x23,
+	0x9f, 0x37, 0x82, 0xc7, 0xd4, 0xac, 0xf7, 0xa9, 0x05, 0x4e, 0x29, 0x79, 0x78, 0x15, 0x14, 0xe5,
+	0xfe, 0xd6, 0xad, 0x65, 0xab, 0x55, 0x74, 0xaa, 0xe3, 0xd8, 0x2e, 0x6b, 0xb4, 0x8c, 0x22, 0xac,
+	0x92, 0xff, 0x9f, 0x8f, 0x7f, 0x5b, 0xa0, 0xaa, 0x7c, 0xdc, 0x10, 0x44, 0x04, 0x5c, 0x04, 0x3e,
+	0x87, 0x9f, 0x81, 0xd3, 0xc3, 0x88, 0x6d, 0x05, 0x22, 0xb1, 0x74, 0xa9, 0x6d, 0xbe, 0x6e, 0xf9,
+	0xe5, 0xa6, 0x6e, 0xae, 0xb3, 0x20, 0x74, 0x2e, 0x19, 0x33, 0x2b, 0xa6, 0x07, 0x8d, 0x43, 0x38,
+	0x61, 0x80, 0x1e, 0xa8, 0x85, 0xa3, 0x81, 0x47, 0x23, 0x97, 0x6d, 0xb9, 0x66, 0xa3, 0x74, 0x47,
+	0x1f, 0x1d, 0xe7, 0xea, 0x65, 0xcd, 0x99, 0x87, 0x23, 0x5c, 0xd1, 0xa1, 0x07, 0x5b, 0x9b, 0x7a,
+	0xcb, 0xde, 0x01, 0xa7, 0xd4, 0xb7, 0x58, 0x2f, 0x2c, 0x17, 0x5a, 0x45, 0xa7, 0x36, 0x8e, 0xed,
+	0xb3, 0x1a, 0xab, 0xc2, 0x08, 0xeb, 0x34, 0xfa, 0x79, 0x1e, 0x94, 0x1f, 0x32, 0xd6, 0x7f, 0x44,
+	0x83, 0xde, 0xb6, 0xe0, 0xf0, 0x36, 0x38, 0xc7, 0x05, 0xf1, 0xfa, 0xd4, 0xdd, 0x53, 0x11, 0xb3,
+	0x27, 0xf5, 0x71, 0x6c, 0x2f, 0x26, 0x3b, 0x3a, 0x91, 0x46, 0xf8, 0xac, 0x7e, 0xd7, 0x78, 0xb8,
+	0x0e, 0xaa, 0x1e, 0xe9, 0x93, 0xd0, 0xa7, 0x51, 0x42, 0x30, 0xaf, 0x08, 0x1a, 0xe3, 0xd8, 0xbe,
+	0xa4, 0x09, 0x72, 0x05, 0x08, 0x57, 0x92, 0x88, 0x21, 0x79, 0x00, 0x2e, 0xf8, 0x2c, 0xf4, 0x69,
+	0x28, 0x22, 0x22, 0x68, 0x37, 0x21, 0x2a, 0x28, 0xa2, 0xe6, 0x38, 0xb6, 0x1b, 0x9a, 0xe8, 0x88,
+	0x22, 0x84, 0xe1, 0x64, 0x34, 0x5b, 0x95, 0x34, 0x74, 0x8f, 0xf0, 0x41, 0x42, 0x56, 0xcc, 0xaf,
+	0x2a, 0x57, 0x80, 0x70, 0x25, 0x89, 0x68, 0x12, 0xf4, 0x43, 0x01, 0x54, 0xee, 0x85, 0x5b, 0xcc,
+	0x39, 0x90, 0x7e, 0x6d, 0x1e, 0x0c, 0x29, 0x7c, 0x04, 0x16, 0x74, 0xf7, 0xca, 0xa5, 0xf2, 0x6a,
+	0x6b, 0xf6, 0x39, 0xdb, 0x50, 0x75, 0x12, 0xa9, 0x38, 0x72, 0x07, 0x4e, 0xb3, 0x20, 0x6c, 0xe8,
+	0xa0, 0x0b, 0xce, 0x24, 0x9e, 0x28, 0xff, 0xca, 0xab, 0x37, 0x66, 0x53, 0x3b, 0xa6, 0x32, 0x25,
+	0xbf, 0x6c, 0xc8, 0xab, 0xd3, 0x7e, 0x23, 0x9c, 0x92, 0x42, 0x06, 0xce, 0x4e, 0xfa, 0xa4, 0xbc,
+	0x2d, 0xaf, 0xb6, 0x67, 0x8b, 0xac, 0x4f, 0x54, 0xa7, 0x42, 0x57, 0x8c, 0xd0, 0x85, 0xc3, 0xfb,
+	0x81, 0xf0, 0x94, 0x80, 0xec, 0x28, 0xf1, 0xb3, 0x5e, 0x3c, 0xae, 0xa3, 0x75, 0x53, 0x39, 0xab,
+	0xa3, 0x84, 0x09, 0xe1, 0x94, 0x14, 0x7d, 0x0c, 0x2a, 0xd3, 0x1e, 0xc3, 0xeb, 0x60, 0x61, 0xea,
+	0x1b, 0x3e, 0x9f, 0xf9, 0x9d, 0xec, 0xb1, 0x29, 0x40, 0xb7, 0x41, 0x2d, 0xef, 0xe2, 0x49, 0xe0,
+	0xdf, 0x59, 0x60, 0xf1, 0x28, 0x83, 0x4e, 0xc0, 0x01, 0x3f, 0x05, 0xe7, 0x07, 0x64, 0xdf, 0x15,
+	0x81, 0xbf, 0xc3, 0x5d, 0x3f, 0x62, 0x9c, 0xd3, 0xae, 0x39, 0x3b, 0x6f, 0x8c, 0x63, 0xbb, 0xae,
+	0x51, 0x87, 0x4a, 0x10, 0xae, 0x0e, 0xc8, 0xfe, 0xa6, 0x0c, 0xad, 0x9b, 0x88, 0x00, 0xb5, 0xbc,
+	0x81, 0xf0, 0x2b, 0x50, 0xd6, 0x3a, 0xee, 0x80, 0x0c, 0x93, 0x3b, 0xec, 0xea, 0xec, 0x1d, 0xd0,
+	0xdf, 0xfc, 0xe7, 0x64, 0xe8, 0x34, 0x8c, 0xf5, 0x70, 0x72, 0xd9, 0x8a, 0x05, 0x61, 0xb0, 0x97,
+	0x94, 0x71, 0xf4, 0x04, 0x94, 0x52, 0xd0, 0x49, 0xfa, 0xbe, 0x0b, 0x6a, 0x3e, 0x93, 0xbe, 0xf9,
+	0xc2, 0x25, 0xdd, 0x6e, 0x44, 0x79, 0x72, 0x19, 0x5e, 0xc9, 0xee, 0xbb, 0x7c, 0x05, 0xc2, 0xd5,
+	0x24, 0xb4, 0x66, 0x22, 0xdf, 0x5a, 0xa0, 0xe4, 0x10, 0x4e, 0xef, 0xd0, 0x90, 0x0d, 0xe4, 0xf5,
+	0xd7, 0x95, 0x0f, 0x4a, 0xbf, 0x34, 0x79, 0xfd, 0xa9, 0x30, 0xc2, 0x3a, 0xfd, 0x5f, 0xff, 0xb2,
+	0xa1, 0x10, 0x80, 0x74, 0x11, 0x5c, 0xba, 0x2e, 0x7f, 0x1e, 0x5c, 0xa5, 0xf5, 0x1a, 0xae, 0xa7,
+	0xd0, 0xbc, 0xeb, 0x13, 0x2c, 0x08, 0x03, 0x2f, 0x55, 0x40, 0x4f, 0x0b, 0x00, 0xae, 0xf5, 0xfb,
+	0x0f, 0x25, 0x93, 0xcf, 0xfa, 0x98, 0xee, 0xd2, 0x70, 0x44, 0xe1, 0x13, 0x00, 0x05, 0xd9, 0xa1,
+	0x91, 0x2b, 0x27, 0x21, 0xf9, 0x1b, 0xe1, 0xef, 0xd0, 0xc8, 0x5c, 0x52, 0x37, 0x33, 0xfd, 0x6c,
+	0xa6, 0xca, 0xe6, 0x01, 0x09, 0xbb, 0x4b, 0x29, 0xdf, 0xd4, 0x20, 0xe7, 0x2d, 0xb3, 0x92, 0x25,
+	0xf3, 0xbb, 0x79, 0x88, 0x16, 0xe1, 0x9a, 0xc8, 0x81, 0xe0, 0x37, 0x16, 0xa8, 0x8a, 0xfd, 0x69,
+	0x75, 0x7d, 0x8f, 0xbd, 0x9d, 0xaa, 0xeb, 0x31, 0x2d, 0x13, 0xde, 0x9f, 0x54, 0x5d, 0x35, 0xaa,
+	0x2d, 0xa3, 0x3a, 0xcd, 0x85, 0xde, 0xeb, 0xd2, 0x61, 0x44, 0x7d, 0x79, 0xd6, 0xe4, 0xb4, 0x32,
+	0xa2, 0xa8, 0x6e, 0xe1, 0x73, 0x62, 0x92, 0x02, 0x7e, 0x0d, 0xa0, 0x7f, 0xe0, 0xf7, 0x03, 0xdf,
+	0x95, 0x83, 0x59, 0xb2, 0x8a, 0xc2, 0xb1, 0x77, 0x8f, 0xc2, 0xac, 0x45, 0xde, 0x0c, 0x03, 0x0e,
+	0x73, 0x22, 0x5c, 0xf3, 0x73, 0x20, 0xf4, 0x9b, 0x05, 0x6a, 0x79, 0x26, 0xf8, 0x09, 0x00, 0x19,
+	0xfa, 0xf8, 0x39, 0xa2, 0x28, 0x85, 0x71, 0x29, 0xe5, 0x86, 0x3b, 0xe0, 0xcd, 0x6d, 0x7d, 0xfc,
+	0x88, 0xef, 0xb3, 0x51, 0x28, 0x82, 0xb0, 0xe7, 0x72, 0x41, 0x22, 0xc1, 0xdd, 0xad, 0x88, 0x0d,
+	0x94, 0xc5, 0x05, 0xa7, 0x35, 0x8e, 0xed, 0x6b, 0x7a, 0xb1, 0xff, 0x5a, 0x8e, 0x70, 0x43, 0xe7,
+	0xd7, 0xd2, 0xf4, 0x86, 0xca, 0xde, 0x95, 0xc9, 0x9f, 0x2c, 0x50, 0xd9, 0xd8, 0x23, 0xc3, 0x21,
+	0x8d, 0x30, 0xf5, 0x88, 0x9c, 0x64, 0xef, 0x80, 0xd3, 0xc9, 0x09, 0xd5, 0xc7, 0xea, 0x46, 0x36,
+	0xe5, 0x98, 0x04, 0xfa, 0xfd, 0xd7, 0x9b, 0x8b, 0xa6, 0x23, 0x73, 0x32, 0x37, 0x44, 0x14, 0x84,
+	0x3d, 0x9c, 0x40, 0xe5, 0x28, 0x15, 0x69, 0xc2, 0xfa, 0xfc, 0x09, 0x47, 0x29, 0x83, 0x43, 0x38,
+	0x61, 0x70, 0xee, 0x3f, 0x7b, 0xd9, 0xb4, 0x9e, 0xbf, 0x6c, 0x5a, 0x7f, 0xbe, 0x6c, 0x5a, 0xdf,
+	0xbf, 0x6a, 0xce, 0x3d, 0x7f, 0xd5, 0x9c, 0x7b, 0xf1, 0xaa, 0x39, 0xf7, 0xe5, 0x07, 0xbd, 0x40,
+	0x6c, 0x8f, 0xbc, 0xb6, 0xcf, 0x06, 0x1d, 0xb3, 0xd9, 0x37, 0xfb, 0xc4, 0xe3, 0xc9, 0x4b, 0x67,
+	0x77, 0xf5, 0xc3, 0xce, 0x7e, 0xf6, 0x5f, 0x47, 0x1c, 0x0c, 0x29, 0xf7, 0x16, 0xd4, 0xfb, 0xfb,
+	0xff, 0x0c, 0x00, 0x61, 0x46, 0xf9, 0x0b, 0x0c, 0x0d, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -1727,6 +1787,50 @@ func (m *CyclicArbTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapperRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapperRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapperRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProtorev(v)
 	base := offset
@@ -1993,6 +2097,25 @@ func (m *CyclicArbTracker) Size() (n int) {
 	return n
 }
 
+func (m *SwapperRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProtorev(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3763,6 +3886,122 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapperRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapperRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapperRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProtorev(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
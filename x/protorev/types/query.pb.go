@@ -1469,6 +1469,329 @@ func (m *QueryGetAllProtocolRevenueResponse) GetAllProtocolRevenue() AllProtocol
 	return AllProtocolRevenue{}
 }
 
+// QueryEstimateProtoRevBackrunRequest is request type for the
+// Query/EstimateProtoRevBackrun RPC method.
+type QueryEstimateProtoRevBackrunRequest struct {
+	// pool_id is the id of the pool the hypothetical swap is made on
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the coin swapped in by the hypothetical swap
+	TokenIn types.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// token_out_denom is the denom swapped out by the hypothetical swap
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *QueryEstimateProtoRevBackrunRequest) Reset()         { *m = QueryEstimateProtoRevBackrunRequest{} }
+func (m *QueryEstimateProtoRevBackrunRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateProtoRevBackrunRequest) ProtoMessage()    {}
+func (*QueryEstimateProtoRevBackrunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryEstimateProtoRevBackrunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateProtoRevBackrunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateProtoRevBackrunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateProtoRevBackrunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateProtoRevBackrunRequest.Merge(m, src)
+}
+func (m *QueryEstimateProtoRevBackrunRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateProtoRevBackrunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateProtoRevBackrunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateProtoRevBackrunRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateProtoRevBackrunRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryEstimateProtoRevBackrunRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimateProtoRevBackrunRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// BackrunRouteEstimate is the simulated outcome of backrunning a swap with a
+// single route
+type BackrunRouteEstimate struct {
+	// route is the cyclic arbitrage route that was built for the swap
+	Route Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route" yaml:"route"`
+	// pool_points is the number of pool points the route consumes if it is
+	// profitable
+	PoolPoints uint64 `protobuf:"varint,2,opt,name=pool_points,json=poolPoints,proto3" json:"pool_points,omitempty" yaml:"pool_points"`
+	// simulated is whether there were enough pool points left to simulate the
+	// route
+	Simulated bool `protobuf:"varint,3,opt,name=simulated,proto3" json:"simulated,omitempty" yaml:"simulated"`
+	// optimal_input is the input found by the binary search, zero if the route
+	// is not profitable
+	OptimalInput types.Coin `protobuf:"bytes,4,opt,name=optimal_input,json=optimalInput,proto3" json:"optimal_input" yaml:"optimal_input"`
+	// profit is the profit of the route in the denom of the optimal input
+	Profit cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=profit,proto3,customtype=cosmossdk.io/math.Int" json:"profit" yaml:"profit"`
+	// profit_in_uosmo is the profit of the route kept by the module, after the
+	// swapper rebate, converted to uosmo
+	ProfitInUosmo cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=profit_in_uosmo,json=profitInUosmo,proto3,customtype=cosmossdk.io/math.Int" json:"profit_in_uosmo" yaml:"profit_in_uosmo"`
+	// swapper_rebate is the share of the profit that is rebated to the swapper,
+	// given that the swapper is allowed to receive funds
+	SwapperRebate types.Coin `protobuf:"bytes,7,opt,name=swapper_rebate,json=swapperRebate,proto3" json:"swapper_rebate" yaml:"swapper_rebate"`
+}
+
+func (m *BackrunRouteEstimate) Reset()         { *m = BackrunRouteEstimate{} }
+func (m *BackrunRouteEstimate) String() string { return proto.CompactTextString(m) }
+func (*BackrunRouteEstimate) ProtoMessage()    {}
+func (*BackrunRouteEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *BackrunRouteEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackrunRouteEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackrunRouteEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackrunRouteEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackrunRouteEstimate.Merge(m, src)
+}
+func (m *BackrunRouteEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackrunRouteEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackrunRouteEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackrunRouteEstimate proto.InternalMessageInfo
+
+func (m *BackrunRouteEstimate) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+func (m *BackrunRouteEstimate) GetPoolPoints() uint64 {
+	if m != nil {
+		return m.PoolPoints
+	}
+	return 0
+}
+
+func (m *BackrunRouteEstimate) GetSimulated() bool {
+	if m != nil {
+		return m.Simulated
+	}
+	return false
+}
+
+func (m *BackrunRouteEstimate) GetOptimalInput() types.Coin {
+	if m != nil {
+		return m.OptimalInput
+	}
+	return types.Coin{}
+}
+
+func (m *BackrunRouteEstimate) GetSwapperRebate() types.Coin {
+	if m != nil {
+		return m.SwapperRebate
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateProtoRevBackrunResponse is response type for the
+// Query/EstimateProtoRevBackrun RPC method.
+type QueryEstimateProtoRevBackrunResponse struct {
+	// routes are all of the routes built for the swap, in the order they are
+	// simulated
+	Routes []BackrunRouteEstimate `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// optimal_route is the route that the module would execute, unset if no
+	// route is profitable
+	OptimalRoute *BackrunRouteEstimate `protobuf:"bytes,2,opt,name=optimal_route,json=optimalRoute,proto3" json:"optimal_route,omitempty" yaml:"optimal_route"`
+	// pool_points_consumed is the number of pool points consumed by simulating
+	// the routes
+	PoolPointsConsumed uint64 `protobuf:"varint,3,opt,name=pool_points_consumed,json=poolPointsConsumed,proto3" json:"pool_points_consumed,omitempty" yaml:"pool_points_consumed"`
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) Reset()         { *m = QueryEstimateProtoRevBackrunResponse{} }
+func (m *QueryEstimateProtoRevBackrunResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateProtoRevBackrunResponse) ProtoMessage()    {}
+func (*QueryEstimateProtoRevBackrunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryEstimateProtoRevBackrunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateProtoRevBackrunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateProtoRevBackrunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateProtoRevBackrunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateProtoRevBackrunResponse.Merge(m, src)
+}
+func (m *QueryEstimateProtoRevBackrunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateProtoRevBackrunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateProtoRevBackrunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateProtoRevBackrunResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateProtoRevBackrunResponse) GetRoutes() []BackrunRouteEstimate {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) GetOptimalRoute() *BackrunRouteEstimate {
+	if m != nil {
+		return m.OptimalRoute
+	}
+	return nil
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) GetPoolPointsConsumed() uint64 {
+	if m != nil {
+		return m.PoolPointsConsumed
+	}
+	return 0
+}
+
+// QueryGetProtoRevRebatesByAddressRequest is request type for the
+// Query/GetProtoRevRebatesByAddress RPC method.
+type QueryGetProtoRevRebatesByAddressRequest struct {
+	// address is the address of the swapper to query rebates by
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) Reset() {
+	*m = QueryGetProtoRevRebatesByAddressRequest{}
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRebatesByAddressRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevRebatesByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRebatesByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRebatesByAddressRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRebatesByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRebatesByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetProtoRevRebatesByAddressResponse is response type for the
+// Query/GetProtoRevRebatesByAddress RPC method.
+type QueryGetProtoRevRebatesByAddressResponse struct {
+	// rebates are the cumulative backrun profits rebated to the address
+	Rebates []types.Coin `protobuf:"bytes,1,rep,name=rebates,proto3" json:"rebates" yaml:"rebates"`
+}
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) Reset() {
+	*m = QueryGetProtoRevRebatesByAddressResponse{}
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRebatesByAddressResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevRebatesByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRebatesByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRebatesByAddressResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRebatesByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRebatesByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) GetRebates() []types.Coin {
+	if m != nil {
+		return m.Rebates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
@@ -1502,6 +1825,11 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
 	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
 	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+	proto.RegisterType((*QueryEstimateProtoRevBackrunRequest)(nil), "osmosis.protorev.v1beta1.QueryEstimateProtoRevBackrunRequest")
+	proto.RegisterType((*BackrunRouteEstimate)(nil), "osmosis.protorev.v1beta1.BackrunRouteEstimate")
+	proto.RegisterType((*QueryEstimateProtoRevBackrunResponse)(nil), "osmosis.protorev.v1beta1.QueryEstimateProtoRevBackrunResponse")
+	proto.RegisterType((*QueryGetProtoRevRebatesByAddressRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRebatesByAddressRequest")
+	proto.RegisterType((*QueryGetProtoRevRebatesByAddressResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRebatesByAddressResponse")
 }
 
 func init() {
@@ -1509,108 +1837,135 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
-	0x1b, 0xce, 0xf4, 0x92, 0x7c, 0x3d, 0xbd, 0x7c, 0xcd, 0xf9, 0x92, 0xd4, 0x99, 0xa4, 0xb6, 0x73,
-	0x92, 0x34, 0xb7, 0xc6, 0xfe, 0x7a, 0x83, 0x72, 0x29, 0x90, 0x69, 0xa0, 0x8a, 0x2a, 0x1a, 0x33,
-	0x84, 0x0d, 0x48, 0x98, 0xb1, 0x3d, 0x49, 0x47, 0x1d, 0xcf, 0x99, 0xce, 0x8c, 0xa3, 0x78, 0x4b,
-	0x25, 0x10, 0x12, 0x12, 0xb7, 0x1f, 0x00, 0x6b, 0xc4, 0x1f, 0x60, 0x09, 0xab, 0x0a, 0x36, 0x45,
-	0x48, 0x08, 0x15, 0x64, 0xa1, 0x96, 0x05, 0x6b, 0xff, 0x00, 0x84, 0xe6, 0x9c, 0x77, 0xec, 0xf1,
-	0x9c, 0x19, 0xc7, 0x93, 0x48, 0xec, 0xec, 0x39, 0xef, 0xfb, 0xbc, 0xcf, 0x73, 0xae, 0xcf, 0x8b,
-	0xe6, 0xa8, 0x5b, 0xa7, 0xae, 0xe1, 0x16, 0x6d, 0x87, 0x7a, 0xd4, 0xd1, 0x77, 0x8b, 0xbb, 0x97,
-	0x2a, 0xba, 0xa7, 0x5d, 0x2a, 0xde, 0x6f, 0xe8, 0x4e, 0xb3, 0xc0, 0x3e, 0xe3, 0x0c, 0x44, 0x15,
-	0x82, 0xa8, 0x02, 0x44, 0xc9, 0x63, 0x3b, 0x74, 0x87, 0xb2, 0xaf, 0x45, 0xff, 0x17, 0x0f, 0x90,
-	0xa7, 0x77, 0x28, 0xdd, 0x31, 0xf5, 0xa2, 0x66, 0x1b, 0x45, 0xcd, 0xb2, 0xa8, 0xa7, 0x79, 0x06,
-	0xb5, 0x20, 0x5d, 0x5e, 0xae, 0x32, 0xb8, 0x62, 0x45, 0x73, 0x75, 0x5e, 0xa6, 0x53, 0xd4, 0xd6,
-	0x76, 0x0c, 0x8b, 0x05, 0x43, 0xec, 0x7c, 0x22, 0x3f, 0x5b, 0x73, 0xb4, 0x7a, 0x00, 0xb9, 0x90,
-	0x1c, 0x16, 0x30, 0xe6, 0x81, 0xd9, 0x70, 0xed, 0x20, 0xa6, 0x4a, 0x0d, 0xa8, 0x47, 0xc6, 0x10,
-	0x7e, 0xc3, 0x67, 0x54, 0x62, 0xe8, 0xaa, 0x7e, 0xbf, 0xa1, 0xbb, 0x1e, 0xd9, 0x46, 0xff, 0xeb,
-	0xf9, 0xea, 0xda, 0xd4, 0x72, 0x75, 0xbc, 0x89, 0x86, 0x39, 0x8b, 0x8c, 0x94, 0x97, 0x16, 0x4f,
-	0x5e, 0xce, 0x17, 0x92, 0xe6, 0xa9, 0xc0, 0x33, 0x95, 0xf1, 0x87, 0xad, 0xdc, 0x50, 0xbb, 0x95,
-	0x3b, 0xdd, 0xd4, 0xea, 0xe6, 0xf3, 0x84, 0x67, 0x13, 0x15, 0x60, 0xc8, 0x02, 0x9a, 0x67, 0x75,
-	0x6e, 0xe9, 0x5e, 0xc9, 0x47, 0x50, 0xf5, 0xdd, 0x3b, 0x8d, 0x7a, 0x45, 0x77, 0x36, 0xb7, 0xb7,
-	0x1c, 0xad, 0xa6, 0x77, 0x08, 0x7d, 0x2c, 0xa1, 0x0b, 0xfb, 0x45, 0x02, 0xc9, 0x0a, 0x3a, 0x6b,
-	0xb1, 0x91, 0x32, 0xdd, 0x2e, 0x7b, 0x6c, 0x8c, 0xd1, 0x3d, 0xa1, 0x5c, 0xf7, 0xc9, 0x3c, 0x6e,
-	0xe5, 0xc6, 0xf9, 0x9c, 0xb8, 0xb5, 0x7b, 0x05, 0x83, 0x16, 0xeb, 0x9a, 0x77, 0xb7, 0xb0, 0x61,
-	0x79, 0xed, 0x56, 0xee, 0x1c, 0x67, 0x19, 0x4d, 0x27, 0xea, 0x19, 0xab, 0xa7, 0x16, 0xd9, 0x14,
-	0x79, 0x97, 0x1c, 0xba, 0x6d, 0x78, 0xae, 0xd2, 0x5c, 0xd7, 0x2d, 0x5a, 0x07, 0xde, 0xf8, 0x02,
-	0x3a, 0x5e, 0xf3, 0xff, 0x03, 0x83, 0xb3, 0xed, 0x56, 0xee, 0x14, 0x2f, 0xc2, 0x3e, 0x13, 0x95,
-	0x0f, 0x13, 0x4b, 0x94, 0x17, 0x05, 0x04, 0x79, 0xeb, 0x68, 0xd8, 0x66, 0x23, 0xb0, 0x06, 0x93,
-	0x05, 0xae, 0xa6, 0xe0, 0xaf, 0x70, 0x67, 0xfa, 0x6f, 0x52, 0xc3, 0x52, 0x46, 0x43, 0x13, 0xcf,
-	0x52, 0xfc, 0x89, 0xe7, 0x3f, 0x66, 0xd1, 0x4c, 0xb4, 0xde, 0x9a, 0x69, 0x42, 0xc9, 0x60, 0xd2,
-	0xef, 0x23, 0xd2, 0x2f, 0x08, 0x08, 0xdd, 0x46, 0x23, 0x1c, 0xd4, 0x9f, 0xe6, 0xa3, 0xfd, 0x19,
-	0x4d, 0xc0, 0x76, 0x38, 0x13, 0x66, 0xe5, 0x12, 0x75, 0xa4, 0xf3, 0x0b, 0x2d, 0x46, 0x4b, 0xbe,
-	0xe9, 0x1f, 0x26, 0xd7, 0x33, 0xaa, 0xae, 0xd2, 0x54, 0x69, 0xc3, 0xd3, 0x43, 0x73, 0xeb, 0xf8,
-	0xff, 0x59, 0xd9, 0x63, 0xe1, 0xb9, 0x65, 0x9f, 0x89, 0xca, 0x87, 0xc9, 0x67, 0x12, 0x5a, 0x1a,
-	0x00, 0x14, 0xe4, 0xd4, 0x10, 0x72, 0x3b, 0x83, 0x30, 0xc7, 0x4b, 0xc9, 0xfb, 0x9c, 0x25, 0x87,
-	0xd0, 0x26, 0x41, 0xe1, 0x28, 0x67, 0xd2, 0x85, 0x22, 0x6a, 0x08, 0x97, 0xac, 0x88, 0x94, 0xd6,
-	0x4c, 0x33, 0x02, 0x16, 0xac, 0xc3, 0xe7, 0x12, 0x5a, 0x1e, 0x24, 0x3a, 0x41, 0xc1, 0xd1, 0x7f,
-	0x4b, 0xc1, 0x16, 0xbd, 0xa7, 0x5b, 0x25, 0xcd, 0x70, 0xd6, 0x9c, 0x0a, 0x43, 0xed, 0x28, 0xf8,
-	0x28, 0x46, 0x41, 0x5c, 0x34, 0x28, 0x78, 0x07, 0x0d, 0xb3, 0xa5, 0x0b, 0xd8, 0x5f, 0x4c, 0x66,
-	0x2f, 0xa2, 0x44, 0xef, 0x1c, 0x8e, 0x44, 0x54, 0x80, 0x24, 0xf3, 0x68, 0x56, 0x98, 0xcc, 0x5a,
-	0xdd, 0xb0, 0xd6, 0xaa, 0x55, 0xda, 0xb0, 0xbc, 0x80, 0xb2, 0x8e, 0xe6, 0xfa, 0x87, 0x01, 0xd7,
-	0x1b, 0xe8, 0xb4, 0xe6, 0x7f, 0x2f, 0x6b, 0x7c, 0x00, 0x4e, 0x7a, 0xa6, 0xdd, 0xca, 0x8d, 0x71,
-	0x02, 0x3d, 0xc3, 0x44, 0x3d, 0xa5, 0x85, 0x60, 0xc8, 0x12, 0x5a, 0x88, 0x96, 0x59, 0xd7, 0x77,
-	0x75, 0x93, 0xda, 0xba, 0x13, 0x61, 0xd4, 0x10, 0xcf, 0x86, 0x18, 0x0a, 0xac, 0x36, 0xd0, 0x68,
-	0x2d, 0x18, 0x8b, 0x30, 0x9b, 0x6e, 0xb7, 0x72, 0x99, 0xe0, 0x0e, 0x8a, 0x84, 0x10, 0xf5, 0x6c,
-	0x2d, 0x02, 0x19, 0x77, 0x47, 0x6f, 0x58, 0xdb, 0x54, 0x69, 0x96, 0x28, 0x35, 0xb7, 0x9a, 0x76,
-	0x70, 0x1e, 0xc9, 0x97, 0x31, 0x77, 0x74, 0x34, 0x12, 0xe8, 0x35, 0xd0, 0xa8, 0x61, 0x6d, 0xd3,
-	0x72, 0xa5, 0x59, 0xb6, 0x29, 0x35, 0xcb, 0x5e, 0xd3, 0xd6, 0xe1, 0xac, 0x2d, 0x26, 0xaf, 0x75,
-	0x2f, 0x98, 0x92, 0x87, 0x75, 0x06, 0x31, 0x02, 0x20, 0x51, 0xcf, 0x18, 0x3d, 0x19, 0xa4, 0x80,
-	0x2e, 0x46, 0x09, 0xbe, 0xae, 0xed, 0xf9, 0xc3, 0x25, 0x6a, 0x58, 0x9e, 0x5b, 0xd2, 0x1d, 0xc5,
-	0xa4, 0xd5, 0x7b, 0x81, 0xa2, 0x4f, 0x24, 0xb4, 0x3a, 0x60, 0x02, 0x08, 0x7b, 0x17, 0x4d, 0xd6,
-	0xb5, 0x3d, 0xce, 0xc1, 0x66, 0x21, 0x65, 0x7f, 0x7a, 0x2b, 0x7e, 0x10, 0x13, 0x78, 0x4c, 0x99,
-	0x6b, 0xb7, 0x72, 0x79, 0x4e, 0x39, 0x31, 0x94, 0xa8, 0xe3, 0xf5, 0xb8, 0x3a, 0x71, 0xa7, 0x2e,
-	0x4a, 0x68, 0x6b, 0x2f, 0xa0, 0xff, 0x20, 0xe6, 0xd4, 0xc5, 0x45, 0x03, 0xf7, 0xb7, 0xd0, 0x44,
-	0x1c, 0x21, 0x6f, 0x0f, 0x88, 0xcf, 0xb4, 0x5b, 0xb9, 0xf3, 0xc9, 0xc4, 0xbd, 0x3d, 0xa2, 0xe2,
-	0xba, 0x00, 0x1f, 0xf7, 0xd4, 0x28, 0x9a, 0xab, 0xb3, 0x57, 0xad, 0x73, 0x41, 0x7c, 0x20, 0x89,
-	0x6f, 0x4d, 0x38, 0x0a, 0x28, 0xbe, 0x87, 0x4e, 0xfa, 0x8f, 0x4a, 0x99, 0x3d, 0x9a, 0xc1, 0xed,
-	0x30, 0x9b, 0xbc, 0x63, 0x3a, 0x10, 0x8a, 0x0c, 0x9b, 0x05, 0x73, 0x01, 0x21, 0x14, 0xa2, 0xa2,
-	0x4a, 0xa7, 0x12, 0xc9, 0xa3, 0x6c, 0x94, 0xc7, 0xab, 0x96, 0x56, 0x31, 0xf5, 0x5a, 0x40, 0x75,
-	0x13, 0xe5, 0x12, 0x23, 0x80, 0xe6, 0x45, 0x34, 0xa2, 0xf3, 0x4f, 0x6c, 0xea, 0xfe, 0xa3, 0xe0,
-	0xee, 0x9b, 0x07, 0x03, 0x44, 0x0d, 0x42, 0x7c, 0x6f, 0x33, 0x25, 0x3c, 0xfe, 0x94, 0x9a, 0xc1,
-	0x3b, 0x77, 0x15, 0xa1, 0x2e, 0x5d, 0x38, 0xc4, 0xe3, 0xdd, 0x0b, 0xba, 0x3b, 0x46, 0xd4, 0x13,
-	0x1d, 0x25, 0xf8, 0x59, 0x74, 0x92, 0x7a, 0x77, 0x75, 0x07, 0xd2, 0x8e, 0xb0, 0xb4, 0x89, 0xee,
-	0x0c, 0x84, 0x06, 0x89, 0x8a, 0xd8, 0x3f, 0x96, 0x48, 0x6e, 0xa3, 0xe9, 0x78, 0x36, 0x20, 0x6e,
-	0x05, 0x8d, 0xb0, 0xa5, 0x37, 0x6a, 0xb0, 0x2f, 0x42, 0xe2, 0x60, 0xc0, 0xf7, 0x19, 0x94, 0x9a,
-	0x1b, 0xb5, 0xf0, 0xe2, 0x73, 0xeb, 0xe0, 0xd1, 0xaa, 0x8f, 0xb5, 0xab, 0x5b, 0x8d, 0xce, 0xc5,
-	0xf1, 0x75, 0x68, 0xf1, 0xe3, 0xa2, 0xa0, 0xf0, 0x03, 0x09, 0x8d, 0x69, 0xa6, 0x59, 0xb6, 0x61,
-	0xbc, 0xec, 0xf0, 0x00, 0xb8, 0x38, 0xfa, 0x3c, 0x12, 0x22, 0xa8, 0x32, 0x0b, 0xfb, 0x61, 0x0a,
-	0xee, 0xe8, 0x18, 0x5c, 0xa2, 0x62, 0x4d, 0x48, 0xbc, 0xfc, 0x77, 0x06, 0x1d, 0x67, 0x64, 0xf1,
-	0x87, 0x12, 0x1a, 0xe6, 0x36, 0x17, 0xf7, 0xa9, 0x2d, 0xba, 0x6b, 0x79, 0x75, 0xc0, 0x68, 0xae,
-	0x9b, 0xe4, 0xdf, 0xff, 0xf9, 0xcf, 0x2f, 0x8e, 0xc8, 0x38, 0x53, 0x14, 0x4c, 0x3f, 0xb7, 0xd1,
-	0xf8, 0x07, 0x09, 0x4d, 0x26, 0x1a, 0x63, 0xfc, 0xf2, 0x3e, 0xe5, 0xf6, 0x33, 0xdf, 0xf2, 0x2b,
-	0x07, 0x07, 0x00, 0x09, 0xcb, 0x4c, 0xc2, 0x1c, 0x26, 0xa2, 0x84, 0xa8, 0xd9, 0x8e, 0x8a, 0xe9,
-	0xb5, 0xc1, 0x69, 0xc4, 0xc4, 0x3a, 0xf2, 0x34, 0x62, 0xe2, 0x1d, 0x78, 0x3f, 0x31, 0x60, 0x63,
-	0xfd, 0x67, 0x88, 0x9d, 0x2c, 0xfc, 0xad, 0x84, 0xc6, 0x63, 0xed, 0x33, 0x7e, 0x61, 0x70, 0x1e,
-	0x82, 0x33, 0x97, 0x5f, 0x3c, 0x58, 0x32, 0x08, 0x98, 0x67, 0x02, 0x72, 0xf8, 0xbc, 0x28, 0x00,
-	0xce, 0x01, 0x63, 0xf8, 0x8b, 0x84, 0xa6, 0xfb, 0x59, 0x66, 0xac, 0x0c, 0xce, 0x22, 0xc9, 0xc4,
-	0xcb, 0x37, 0x0f, 0x85, 0x01, 0x82, 0x56, 0x99, 0xa0, 0x05, 0x3c, 0x2f, 0x0a, 0xea, 0x3a, 0x56,
-	0x7f, 0x51, 0x98, 0x05, 0xc4, 0x8f, 0x25, 0x74, 0xbe, 0xaf, 0x95, 0xc6, 0x37, 0x53, 0xcd, 0x6f,
-	0xbc, 0x6d, 0x97, 0xd7, 0x0f, 0x07, 0x02, 0xda, 0x0a, 0x4c, 0xdb, 0x22, 0xbe, 0x10, 0xbf, 0x58,
-	0x4c, 0x51, 0xb9, 0xab, 0x12, 0xff, 0xd6, 0x2b, 0x4e, 0xf4, 0xc7, 0x69, 0xc4, 0x25, 0x3a, 0xfa,
-	0x34, 0xe2, 0x92, 0x8d, 0x3e, 0x29, 0x32, 0x71, 0x4b, 0x78, 0x41, 0x14, 0xe7, 0xf9, 0x59, 0x65,
-	0x5b, 0x33, 0x9c, 0xb2, 0xe6, 0x54, 0xb8, 0x4e, 0x17, 0x7f, 0x27, 0xa1, 0x73, 0x09, 0x8e, 0x1c,
-	0xdf, 0x48, 0x31, 0xdf, 0xa2, 0xe1, 0x97, 0x5f, 0x3a, 0x68, 0x3a, 0x68, 0x59, 0x60, 0x5a, 0x66,
-	0x70, 0x2e, 0x66, 0xa1, 0xc2, 0x1d, 0x00, 0xfe, 0x49, 0x42, 0x53, 0x7d, 0x3c, 0x3c, 0x5e, 0x1b,
-	0x9c, 0x48, 0x42, 0xab, 0x20, 0x2b, 0x87, 0x81, 0x00, 0x3d, 0x2b, 0x4c, 0xcf, 0x3c, 0x9e, 0x15,
-	0xf5, 0x08, 0x7d, 0x03, 0xfe, 0xb1, 0xf7, 0xd2, 0xee, 0x75, 0xea, 0x69, 0x2e, 0xed, 0xd8, 0xd6,
-	0x22, 0xcd, 0xa5, 0x1d, 0xdf, 0x71, 0xf4, 0x53, 0x23, 0x34, 0x0e, 0xf8, 0xf7, 0xde, 0x33, 0x24,
-	0x7a, 0xe6, 0x34, 0x67, 0x28, 0xd1, 0x9f, 0xa7, 0x39, 0x43, 0xc9, 0xb6, 0x9d, 0xfc, 0x9f, 0x29,
-	0x5b, 0xc6, 0x8b, 0xa2, 0xb2, 0x78, 0x9b, 0x8e, 0xff, 0x92, 0x50, 0x7e, 0xbf, 0x8e, 0x06, 0xbf,
-	0x76, 0x70, 0x72, 0xe1, 0x1e, 0x4a, 0xbe, 0x75, 0x68, 0x1c, 0xd0, 0x79, 0x85, 0xe9, 0x5c, 0xc5,
-	0x2b, 0x83, 0xe9, 0x64, 0x7d, 0x54, 0xf4, 0xfd, 0xed, 0xb6, 0x14, 0x69, 0xde, 0x5f, 0xa1, 0x5d,
-	0x49, 0xf3, 0xfe, 0x8a, 0x5d, 0x4c, 0xbf, 0xf7, 0x37, 0xd4, 0x97, 0xe0, 0x6f, 0x24, 0x84, 0xc5,
-	0x26, 0x03, 0x5f, 0x1f, 0xbc, 0x76, 0x6f, 0xe7, 0x22, 0x3f, 0x77, 0x80, 0x4c, 0xa0, 0x3c, 0xc3,
-	0x28, 0x4f, 0xe1, 0x49, 0x91, 0x32, 0xb4, 0x31, 0xf8, 0x2b, 0x09, 0xfd, 0x37, 0xd2, 0x33, 0xe0,
-	0x6b, 0x29, 0xcc, 0x56, 0xb7, 0xe3, 0x91, 0x9f, 0x49, 0x9b, 0x06, 0x2c, 0xb3, 0x8c, 0x65, 0x06,
-	0x4f, 0xc4, 0x38, 0x33, 0x9f, 0xce, 0xf7, 0x7c, 0x37, 0x88, 0xed, 0xc0, 0x20, 0xbb, 0x21, 0xb1,
-	0x7f, 0x19, 0x64, 0x37, 0x24, 0xb7, 0x35, 0xfb, 0x3d, 0xf0, 0xd1, 0xae, 0x44, 0xb9, 0xf3, 0xf0,
-	0x49, 0x56, 0x7a, 0xf4, 0x24, 0x2b, 0xfd, 0xf1, 0x24, 0x2b, 0x7d, 0xfa, 0x34, 0x3b, 0xf4, 0xe8,
-	0x69, 0x76, 0xe8, 0xd7, 0xa7, 0xd9, 0xa1, 0xb7, 0xaf, 0xee, 0x18, 0xde, 0xdd, 0x46, 0xa5, 0x50,
-	0xa5, 0xf5, 0x00, 0x6b, 0xd5, 0xd4, 0x2a, 0x6e, 0x07, 0x78, 0xf7, 0xf2, 0xb5, 0xe2, 0x5e, 0xe8,
-	0x89, 0x6d, 0xda, 0xba, 0x5b, 0x19, 0x66, 0xff, 0xaf, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x62,
-	0x45, 0x25, 0x70, 0x1a, 0x19, 0x00, 0x00,
+	// 2046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0x27, 0x8e, 0x9d, 0x54, 0xe2, 0x5c, 0x0a, 0xdb, 0x19, 0x77, 0x9c, 0x19, 0xa7, 0x7c,
+	0xcd, 0xc5, 0x33, 0x6c, 0x76, 0x97, 0x5d, 0x20, 0xbb, 0xc4, 0x1d, 0xef, 0xae, 0xac, 0x68, 0xd7,
+	0xde, 0x26, 0x2b, 0x24, 0x6e, 0x4d, 0xcf, 0x4c, 0xd9, 0x69, 0xa5, 0xa7, 0xab, 0xd3, 0x17, 0xaf,
+	0xe7, 0x95, 0x95, 0x40, 0x48, 0x48, 0xdc, 0xde, 0x78, 0x81, 0x67, 0xc4, 0x0f, 0x80, 0x47, 0x10,
+	0x12, 0x2b, 0x78, 0x59, 0x40, 0x42, 0x68, 0x41, 0x23, 0x94, 0x80, 0xc4, 0xf3, 0xfc, 0x02, 0xd4,
+	0x55, 0xa7, 0x2f, 0xd3, 0x97, 0xe9, 0x99, 0xb1, 0xb4, 0x6f, 0xdd, 0x55, 0xe7, 0x7c, 0xf5, 0x7d,
+	0x55, 0xd5, 0x75, 0xaa, 0x3f, 0xb4, 0xca, 0xdc, 0x0e, 0x73, 0x0d, 0xb7, 0x61, 0x3b, 0xcc, 0x63,
+	0x0e, 0x3d, 0x6a, 0x1c, 0xbd, 0xd4, 0xa4, 0x9e, 0xfe, 0x52, 0xe3, 0x99, 0x4f, 0x9d, 0x6e, 0x9d,
+	0x37, 0xe3, 0x0a, 0x44, 0xd5, 0xc3, 0xa8, 0x3a, 0x44, 0xc9, 0x73, 0x87, 0xec, 0x90, 0xf1, 0xd6,
+	0x46, 0xf0, 0x24, 0x02, 0xe4, 0xa5, 0x43, 0xc6, 0x0e, 0x4d, 0xda, 0xd0, 0x6d, 0xa3, 0xa1, 0x5b,
+	0x16, 0xf3, 0x74, 0xcf, 0x60, 0x16, 0xa4, 0xcb, 0xb7, 0x5b, 0x1c, 0xae, 0xd1, 0xd4, 0x5d, 0x2a,
+	0x86, 0x89, 0x06, 0xb5, 0xf5, 0x43, 0xc3, 0xe2, 0xc1, 0x10, 0xbb, 0x56, 0xc8, 0xcf, 0xd6, 0x1d,
+	0xbd, 0x13, 0x42, 0x6e, 0x14, 0x87, 0x85, 0x8c, 0x45, 0x60, 0x35, 0x39, 0x76, 0x18, 0xd3, 0x62,
+	0x06, 0x8c, 0x47, 0xe6, 0x10, 0x7e, 0x3f, 0x60, 0xb4, 0xcf, 0xd1, 0x55, 0xfa, 0xcc, 0xa7, 0xae,
+	0x47, 0x0e, 0xd0, 0xe7, 0x06, 0x5a, 0x5d, 0x9b, 0x59, 0x2e, 0xc5, 0x7b, 0x68, 0x5a, 0xb0, 0xa8,
+	0x48, 0xcb, 0xd2, 0xe6, 0x85, 0x7b, 0xcb, 0xf5, 0xa2, 0x79, 0xaa, 0x8b, 0x4c, 0x65, 0xfe, 0xe3,
+	0x5e, 0xed, 0x54, 0xbf, 0x57, 0x9b, 0xed, 0xea, 0x1d, 0xf3, 0x4b, 0x44, 0x64, 0x13, 0x15, 0x60,
+	0xc8, 0x06, 0x5a, 0xe3, 0xe3, 0xbc, 0x43, 0xbd, 0xfd, 0x00, 0x41, 0xa5, 0x47, 0xef, 0xf9, 0x9d,
+	0x26, 0x75, 0xf6, 0x0e, 0x1e, 0x3b, 0x7a, 0x9b, 0x46, 0x84, 0x7e, 0x28, 0xa1, 0xf5, 0xb2, 0x48,
+	0x20, 0xd9, 0x44, 0x57, 0x2c, 0xde, 0xa3, 0xb1, 0x03, 0xcd, 0xe3, 0x7d, 0x9c, 0xee, 0x79, 0xe5,
+	0xf5, 0x80, 0xcc, 0xa7, 0xbd, 0xda, 0xbc, 0x98, 0x13, 0xb7, 0xfd, 0xb4, 0x6e, 0xb0, 0x46, 0x47,
+	0xf7, 0x9e, 0xd4, 0x77, 0x2d, 0xaf, 0xdf, 0xab, 0x5d, 0x13, 0x2c, 0xd3, 0xe9, 0x44, 0xbd, 0x64,
+	0x0d, 0x8c, 0x45, 0xf6, 0xb2, 0xbc, 0xf7, 0x1d, 0x76, 0x60, 0x78, 0xae, 0xd2, 0xdd, 0xa1, 0x16,
+	0xeb, 0x00, 0x6f, 0xbc, 0x8e, 0xce, 0xb6, 0x83, 0x77, 0x60, 0x70, 0xa5, 0xdf, 0xab, 0x5d, 0x14,
+	0x83, 0xf0, 0x66, 0xa2, 0x8a, 0x6e, 0x62, 0xa1, 0xf5, 0x32, 0x40, 0x90, 0xb7, 0x83, 0xa6, 0x6d,
+	0xde, 0x03, 0x6b, 0xb0, 0x58, 0x17, 0x6a, 0xea, 0xc1, 0x0a, 0x47, 0xd3, 0xff, 0x90, 0x19, 0x96,
+	0x72, 0x35, 0x31, 0xf1, 0x3c, 0x25, 0x98, 0x78, 0xf1, 0xb0, 0x82, 0x6e, 0xa6, 0xc7, 0xdb, 0x36,
+	0x4d, 0x18, 0x32, 0x9c, 0xf4, 0x67, 0x88, 0x0c, 0x0b, 0x02, 0x42, 0x8f, 0xd0, 0x8c, 0x00, 0x0d,
+	0xa6, 0xf9, 0xcc, 0x70, 0x46, 0x0b, 0xb0, 0x1d, 0x2e, 0x25, 0x59, 0xb9, 0x44, 0x9d, 0x89, 0x9e,
+	0xd0, 0x66, 0x7a, 0xc8, 0xaf, 0x06, 0x1f, 0x93, 0xeb, 0x19, 0x2d, 0x57, 0xe9, 0xaa, 0xcc, 0xf7,
+	0x68, 0x62, 0x6e, 0x9d, 0xe0, 0x9d, 0x0f, 0x3b, 0x95, 0x9c, 0x5b, 0xde, 0x4c, 0x54, 0xd1, 0x4d,
+	0x7e, 0x22, 0xa1, 0x5b, 0x23, 0x80, 0x82, 0x9c, 0x36, 0x42, 0x6e, 0xd4, 0x09, 0x73, 0x7c, 0xab,
+	0x78, 0x9f, 0xf3, 0xe4, 0x04, 0xda, 0x22, 0x28, 0xbc, 0x2a, 0x98, 0xc4, 0x50, 0x44, 0x4d, 0xe0,
+	0x92, 0x3b, 0x59, 0x4a, 0xdb, 0xa6, 0x99, 0x02, 0x0b, 0xd7, 0xe1, 0xa7, 0x12, 0xba, 0x3d, 0x4a,
+	0x74, 0x81, 0x82, 0x33, 0x9f, 0x95, 0x82, 0xc7, 0xec, 0x29, 0xb5, 0xf6, 0x75, 0xc3, 0xd9, 0x76,
+	0x9a, 0x1c, 0x35, 0x52, 0xf0, 0x83, 0x1c, 0x05, 0x79, 0xd1, 0xa0, 0xe0, 0x1b, 0x68, 0x9a, 0x2f,
+	0x5d, 0xc8, 0xfe, 0x6e, 0x31, 0xfb, 0x2c, 0x4a, 0xfa, 0xcc, 0x11, 0x48, 0x44, 0x05, 0x48, 0xb2,
+	0x86, 0x56, 0x32, 0x93, 0xd9, 0xee, 0x18, 0xd6, 0x76, 0xab, 0xc5, 0x7c, 0xcb, 0x0b, 0x29, 0x53,
+	0xb4, 0x3a, 0x3c, 0x0c, 0xb8, 0xbe, 0x81, 0x66, 0xf5, 0xa0, 0x5d, 0xd3, 0x45, 0x07, 0x7c, 0xe9,
+	0x95, 0x7e, 0xaf, 0x36, 0x27, 0x08, 0x0c, 0x74, 0x13, 0xf5, 0xa2, 0x9e, 0x80, 0x21, 0xb7, 0xd0,
+	0x46, 0x7a, 0x98, 0x1d, 0x7a, 0x44, 0x4d, 0x66, 0x53, 0x27, 0xc5, 0xc8, 0x47, 0x9b, 0xe5, 0xa1,
+	0xc0, 0x6a, 0x17, 0x5d, 0x6d, 0x87, 0x7d, 0x29, 0x66, 0x4b, 0xfd, 0x5e, 0xad, 0x12, 0x9e, 0x41,
+	0xa9, 0x10, 0xa2, 0x5e, 0x69, 0xa7, 0x20, 0xf3, 0xce, 0xe8, 0x5d, 0xeb, 0x80, 0x29, 0xdd, 0x7d,
+	0xc6, 0xcc, 0xc7, 0x5d, 0x3b, 0xfc, 0x1e, 0xc9, 0x2f, 0x72, 0xce, 0xe8, 0x74, 0x24, 0xd0, 0xf3,
+	0xd1, 0x55, 0xc3, 0x3a, 0x60, 0x5a, 0xb3, 0xab, 0xd9, 0x8c, 0x99, 0x9a, 0xd7, 0xb5, 0x29, 0x7c,
+	0x6b, 0x9b, 0xc5, 0x6b, 0x3d, 0x08, 0xa6, 0x2c, 0xc3, 0x3a, 0x83, 0x98, 0x0c, 0x20, 0x51, 0x2f,
+	0x19, 0x03, 0x19, 0xa4, 0x8e, 0xee, 0xa6, 0x09, 0xbe, 0xab, 0x1f, 0x07, 0xdd, 0xfb, 0xcc, 0xb0,
+	0x3c, 0x77, 0x9f, 0x3a, 0x8a, 0xc9, 0x5a, 0x4f, 0x43, 0x45, 0x3f, 0x92, 0xd0, 0xd6, 0x88, 0x09,
+	0x20, 0xec, 0xdb, 0x68, 0xb1, 0xa3, 0x1f, 0x0b, 0x0e, 0x36, 0x0f, 0xd1, 0x82, 0xe9, 0x6d, 0x06,
+	0x41, 0x5c, 0xe0, 0x94, 0xb2, 0xda, 0xef, 0xd5, 0x96, 0x05, 0xe5, 0xc2, 0x50, 0xa2, 0xce, 0x77,
+	0xf2, 0xc6, 0xc9, 0xfb, 0xea, 0xd2, 0x84, 0x1e, 0x1f, 0x87, 0xf4, 0x3f, 0xca, 0xf9, 0xea, 0xf2,
+	0xa2, 0x81, 0xfb, 0x07, 0x68, 0x21, 0x8f, 0x90, 0x77, 0x0c, 0xc4, 0x6f, 0xf6, 0x7b, 0xb5, 0x1b,
+	0xc5, 0xc4, 0xbd, 0x63, 0xa2, 0xe2, 0x4e, 0x06, 0x3e, 0xaf, 0xd4, 0x28, 0xba, 0x4b, 0x79, 0x55,
+	0x8b, 0x0e, 0x88, 0xef, 0x49, 0x88, 0x0c, 0x8b, 0x02, 0x8a, 0xdf, 0x41, 0x17, 0x82, 0xa2, 0xa2,
+	0xf1, 0xa2, 0x19, 0x9e, 0x0e, 0x2b, 0xc5, 0x3b, 0x26, 0x82, 0x50, 0x64, 0xd8, 0x2c, 0x58, 0x08,
+	0x48, 0xa0, 0x10, 0x15, 0x35, 0xa3, 0x91, 0xc8, 0x32, 0xaa, 0xa6, 0x79, 0xbc, 0x65, 0xe9, 0x4d,
+	0x93, 0xb6, 0x43, 0xaa, 0x7b, 0xa8, 0x56, 0x18, 0x01, 0x34, 0xef, 0xa2, 0x19, 0x2a, 0x9a, 0xf8,
+	0xd4, 0x9d, 0x53, 0x70, 0x5c, 0xf3, 0xa0, 0x83, 0xa8, 0x61, 0x48, 0x70, 0xb7, 0xb9, 0x9e, 0x29,
+	0xfe, 0x8c, 0x99, 0x61, 0x9d, 0x7b, 0x05, 0xa1, 0x98, 0x2e, 0x7c, 0xc4, 0xf3, 0xf1, 0x01, 0x1d,
+	0xf7, 0x11, 0xf5, 0x7c, 0xa4, 0x04, 0xbf, 0x86, 0x2e, 0x30, 0xef, 0x09, 0x75, 0x20, 0xed, 0x34,
+	0x4f, 0x5b, 0x88, 0x67, 0x20, 0xd1, 0x49, 0x54, 0xc4, 0xdf, 0x78, 0x22, 0x79, 0x84, 0x96, 0xf2,
+	0xd9, 0x80, 0xb8, 0x3b, 0x68, 0x86, 0x2f, 0xbd, 0xd1, 0x86, 0x7d, 0x91, 0x10, 0x07, 0x1d, 0xc1,
+	0x3d, 0x83, 0x31, 0x73, 0xb7, 0x9d, 0x5c, 0x7c, 0x71, 0x75, 0xf0, 0x58, 0x2b, 0xc0, 0x3a, 0xa2,
+	0x96, 0x1f, 0x1d, 0x1c, 0xbf, 0x4a, 0x2c, 0x7e, 0x5e, 0x14, 0x0c, 0xfc, 0x91, 0x84, 0xe6, 0x74,
+	0xd3, 0xd4, 0x6c, 0xe8, 0xd7, 0x1c, 0x11, 0x00, 0x07, 0xc7, 0x90, 0x22, 0x91, 0x05, 0x55, 0x56,
+	0x60, 0x3f, 0x5c, 0x87, 0x33, 0x3a, 0x07, 0x97, 0xa8, 0x58, 0xcf, 0x24, 0x92, 0xff, 0x4a, 0x50,
+	0x3f, 0xde, 0x72, 0x3d, 0xa3, 0xa3, 0x7b, 0x34, 0xde, 0xae, 0xad, 0xa7, 0x8e, 0x6f, 0x85, 0xab,
+	0x36, 0xce, 0x34, 0xe1, 0x77, 0xd1, 0x39, 0x2f, 0x28, 0x64, 0x9a, 0x61, 0xf1, 0x95, 0x1a, 0x7a,
+	0x89, 0xba, 0x06, 0xd4, 0x2f, 0x0b, 0xb0, 0x30, 0x91, 0xa8, 0x33, 0xfc, 0x71, 0xd7, 0xc2, 0x0a,
+	0xba, 0x2c, 0x5a, 0x99, 0xef, 0xc1, 0xfa, 0x9f, 0xe1, 0xeb, 0x2f, 0xf7, 0x7b, 0xb5, 0x85, 0x64,
+	0x5a, 0x14, 0x40, 0xd4, 0x59, 0xde, 0xb2, 0xe7, 0x7b, 0x62, 0x1b, 0xfc, 0x66, 0x0a, 0xcd, 0x85,
+	0x92, 0x98, 0xef, 0xd1, 0x50, 0x2e, 0x7e, 0x14, 0x5f, 0xbb, 0x02, 0xa2, 0xb5, 0x92, 0x9b, 0x85,
+	0x32, 0x07, 0x74, 0xf3, 0xee, 0x66, 0xc1, 0x2e, 0x4d, 0x9c, 0x23, 0x5c, 0xfb, 0x54, 0x72, 0x97,
+	0x26, 0x3a, 0x89, 0x8a, 0xec, 0xe8, 0x68, 0xc1, 0xf7, 0xd0, 0x79, 0xd7, 0xe8, 0xf8, 0xa6, 0xee,
+	0xd1, 0x36, 0x17, 0x77, 0x4e, 0x99, 0xeb, 0xf7, 0x6a, 0x57, 0x44, 0x5a, 0xd4, 0x45, 0xd4, 0x38,
+	0x0c, 0x7f, 0x13, 0xcd, 0x32, 0x3b, 0x50, 0x61, 0x6a, 0x86, 0x65, 0xfb, 0x5e, 0x65, 0xaa, 0x6c,
+	0xaa, 0x97, 0x80, 0x3b, 0x54, 0xf2, 0x81, 0x6c, 0xa2, 0x5e, 0x84, 0xf7, 0xdd, 0xe0, 0x15, 0xbf,
+	0x1d, 0x5d, 0xcc, 0xcf, 0xf2, 0xb9, 0xae, 0x97, 0xfd, 0x6d, 0xe4, 0x5f, 0xcd, 0xb1, 0x86, 0x2e,
+	0x8b, 0x27, 0xcd, 0xb0, 0x34, 0x3f, 0xc8, 0xad, 0x4c, 0x73, 0xc0, 0xd7, 0xca, 0x00, 0x17, 0x92,
+	0x80, 0x51, 0x36, 0x51, 0x67, 0x45, 0xcb, 0xae, 0xf5, 0x41, 0xf0, 0x8e, 0x35, 0x74, 0xc9, 0xfd,
+	0x50, 0xb7, 0x83, 0x33, 0xdb, 0xa1, 0x4d, 0xdd, 0xa3, 0x95, 0x99, 0xb2, 0x79, 0xb8, 0x01, 0xf3,
+	0x30, 0x0f, 0xd3, 0x3b, 0x90, 0x4e, 0xd4, 0x59, 0x68, 0x50, 0xc5, 0xfb, 0x1f, 0x4f, 0xc3, 0xdd,
+	0xa9, 0xf0, 0x13, 0x81, 0x2f, 0xfa, 0x5b, 0xa9, 0x7b, 0x5e, 0x7d, 0xd8, 0x49, 0x9e, 0xdd, 0x8a,
+	0x25, 0x37, 0x3d, 0xdc, 0x89, 0xd7, 0x5b, 0xec, 0x58, 0xf1, 0x69, 0x8d, 0x3b, 0x4a, 0x25, 0xbb,
+	0x01, 0x60, 0x13, 0x87, 0x1b, 0x80, 0xc7, 0xe3, 0xf7, 0xd1, 0x5c, 0xb2, 0x26, 0xb6, 0x98, 0xe5,
+	0xfa, 0x1d, 0xd8, 0x9d, 0x53, 0x4a, 0x2d, 0x3e, 0x6c, 0xf2, 0xa2, 0x88, 0x8a, 0xe3, 0xdd, 0xfd,
+	0x30, 0x6c, 0xfc, 0x5a, 0xf6, 0x76, 0x28, 0xe6, 0xd8, 0x55, 0xba, 0xdb, 0xed, 0xb6, 0x43, 0xdd,
+	0xb0, 0x82, 0x06, 0x35, 0x47, 0x17, 0x2d, 0x50, 0x22, 0x12, 0xe7, 0x0d, 0x74, 0x10, 0x35, 0x0c,
+	0x21, 0x1f, 0xa2, 0xcd, 0x72, 0xe0, 0xf8, 0x07, 0x4f, 0x2c, 0xf4, 0xf8, 0x3f, 0x78, 0x90, 0x47,
+	0xd4, 0x10, 0xe1, 0xde, 0xcf, 0x97, 0xd0, 0x59, 0x3e, 0x32, 0xfe, 0xbe, 0x84, 0xa6, 0x85, 0x4b,
+	0x80, 0x87, 0x1c, 0xdd, 0x59, 0x73, 0x42, 0xde, 0x1a, 0x31, 0x5a, 0xd0, 0x27, 0xcb, 0xdf, 0xfd,
+	0xdb, 0x7f, 0x7e, 0x76, 0x5a, 0xc6, 0x95, 0x46, 0xc6, 0x33, 0x11, 0x2e, 0x04, 0xfe, 0x93, 0x84,
+	0x16, 0x0b, 0x7d, 0x05, 0xfc, 0x95, 0x92, 0xe1, 0xca, 0xbc, 0x0b, 0xf9, 0xc1, 0xe4, 0x00, 0x20,
+	0xe1, 0x36, 0x97, 0xb0, 0x8a, 0x49, 0x56, 0x42, 0xda, 0xab, 0x48, 0x8b, 0x19, 0x74, 0x11, 0xc6,
+	0x11, 0x93, 0x6b, 0x68, 0xc8, 0x0f, 0x26, 0x07, 0x28, 0x17, 0x03, 0x2e, 0x40, 0x70, 0x8b, 0xe7,
+	0x45, 0x09, 0xff, 0x56, 0x42, 0xf3, 0xb9, 0xee, 0x03, 0xfe, 0xf2, 0xe8, 0x3c, 0x32, 0xc6, 0x86,
+	0x7c, 0x7f, 0xb2, 0x64, 0x10, 0xb0, 0xc6, 0x05, 0xd4, 0xf0, 0x8d, 0xac, 0x00, 0xb8, 0x46, 0x70,
+	0x86, 0x7f, 0x97, 0xd0, 0xd2, 0x30, 0xc7, 0x01, 0x2b, 0xa3, 0xb3, 0x28, 0xf2, 0x40, 0xe4, 0x87,
+	0x27, 0xc2, 0x00, 0x41, 0x5b, 0x5c, 0xd0, 0x06, 0x5e, 0xcb, 0x0a, 0x8a, 0x7f, 0xf8, 0x83, 0x45,
+	0x11, 0x35, 0xfb, 0x53, 0x09, 0xdd, 0x18, 0xea, 0x44, 0xe0, 0x87, 0x63, 0xcd, 0x6f, 0xbe, 0xeb,
+	0x21, 0xef, 0x9c, 0x0c, 0x04, 0xb4, 0xd5, 0xb9, 0xb6, 0x4d, 0xbc, 0x9e, 0xbf, 0x58, 0x5c, 0x91,
+	0x16, 0xab, 0xc4, 0xff, 0x1c, 0x14, 0x97, 0xb5, 0x17, 0xc6, 0x11, 0x57, 0x68, 0x88, 0xc8, 0x3b,
+	0x27, 0x03, 0x01, 0x71, 0x0d, 0x2e, 0xee, 0x16, 0xde, 0xc8, 0x8a, 0x13, 0xd7, 0x3b, 0x5b, 0x37,
+	0x1c, 0x4d, 0x77, 0x9a, 0x1a, 0x54, 0xc4, 0xdf, 0x49, 0xe8, 0x5a, 0x81, 0xa1, 0x81, 0xdf, 0x18,
+	0x63, 0xbe, 0xb3, 0x7e, 0x89, 0xfc, 0xe6, 0xa4, 0xe9, 0xa0, 0x65, 0x83, 0x6b, 0xb9, 0x89, 0x6b,
+	0x39, 0x0b, 0x95, 0x34, 0x50, 0xf0, 0x5f, 0x24, 0x74, 0x7d, 0x88, 0x05, 0x82, 0xb7, 0x47, 0x27,
+	0x52, 0xe0, 0xb4, 0xc8, 0xca, 0x49, 0x20, 0x40, 0xcf, 0x1d, 0xae, 0x67, 0x0d, 0xaf, 0x64, 0xf5,
+	0x64, 0x6c, 0x17, 0xfc, 0xe7, 0xc1, 0x43, 0x7b, 0xd0, 0xe8, 0x18, 0xe7, 0xd0, 0xce, 0x75, 0x66,
+	0xe4, 0x07, 0x93, 0x03, 0x94, 0xab, 0xc9, 0xf8, 0x2e, 0xf8, 0x5f, 0x83, 0xdf, 0x50, 0xd6, 0x72,
+	0x18, 0xe7, 0x1b, 0x2a, 0xb4, 0x37, 0xe4, 0x9d, 0x93, 0x81, 0x80, 0xb2, 0xcf, 0x73, 0x65, 0xb7,
+	0xf1, 0x66, 0x56, 0x59, 0xbe, 0xcb, 0x81, 0xff, 0x27, 0xa1, 0xe5, 0x32, 0x43, 0x08, 0xbf, 0x3d,
+	0x39, 0xb9, 0xa4, 0x05, 0x25, 0xbf, 0x73, 0x62, 0x1c, 0xd0, 0xf9, 0x32, 0xd7, 0xb9, 0x85, 0xef,
+	0x8c, 0xa6, 0x93, 0xdb, 0x50, 0xe9, 0xfa, 0x1b, 0x3b, 0x32, 0xe3, 0xd4, 0xdf, 0x8c, 0xdb, 0x23,
+	0xdf, 0x9f, 0x2c, 0xb9, 0xbc, 0xfe, 0x26, 0x6c, 0x1d, 0xfc, 0x6b, 0x09, 0xe1, 0xac, 0x47, 0x83,
+	0x5f, 0x1f, 0x7d, 0xec, 0x41, 0xe3, 0x47, 0xfe, 0xe2, 0x04, 0x99, 0x40, 0xf9, 0x26, 0xa7, 0x7c,
+	0x1d, 0x2f, 0x66, 0x29, 0x83, 0x0b, 0x84, 0x7f, 0x29, 0xa1, 0xcb, 0x29, 0xcb, 0x05, 0xbf, 0x3a,
+	0xc6, 0x65, 0x2b, 0x36, 0x8c, 0xe4, 0x2f, 0x8c, 0x9b, 0x06, 0x2c, 0xab, 0x9c, 0x65, 0x05, 0x2f,
+	0x64, 0x59, 0x06, 0xdb, 0x03, 0xff, 0x5e, 0xec, 0x86, 0xac, 0x9b, 0x32, 0xca, 0x6e, 0x28, 0xb4,
+	0x7f, 0xe4, 0xfb, 0x93, 0x25, 0x8f, 0x56, 0xe0, 0xd3, 0xa6, 0x0e, 0xfe, 0x83, 0x84, 0xae, 0x15,
+	0xfc, 0x97, 0x96, 0x96, 0xc0, 0xe1, 0x96, 0x8f, 0xfc, 0xe6, 0xa4, 0xe9, 0xe5, 0x37, 0x63, 0x0a,
+	0xa9, 0x5a, 0x13, 0xa8, 0xfe, 0x75, 0xb0, 0x0a, 0xa6, 0x7f, 0xde, 0xc6, 0xa9, 0x82, 0x05, 0x7f,
+	0x94, 0xb2, 0x72, 0x12, 0x08, 0x90, 0x74, 0x97, 0x4b, 0x5a, 0xc7, 0xab, 0x59, 0x49, 0xf0, 0x47,
+	0x18, 0x94, 0x0e, 0xf8, 0x2b, 0x55, 0xde, 0xfb, 0xf8, 0x79, 0x55, 0xfa, 0xe4, 0x79, 0x55, 0xfa,
+	0xf7, 0xf3, 0xaa, 0xf4, 0xe3, 0x17, 0xd5, 0x53, 0x9f, 0xbc, 0xa8, 0x9e, 0xfa, 0xc7, 0x8b, 0xea,
+	0xa9, 0xaf, 0xbf, 0x72, 0x68, 0x78, 0x4f, 0xfc, 0x66, 0xbd, 0xc5, 0x3a, 0x21, 0xd2, 0x96, 0xa9,
+	0x37, 0xdd, 0x08, 0xf6, 0xe8, 0xde, 0xab, 0x8d, 0xe3, 0x18, 0x3c, 0xa8, 0x43, 0x6e, 0x73, 0x9a,
+	0xbf, 0xbf, 0xfc, 0xff, 0x01, 0x00, 0x00, 0x89, 0x4a, 0xdf, 0xf5, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1668,6 +2023,12 @@ type QueryClient interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+	// EstimateProtoRevBackrun simulates a swap and estimates the backrun that the
+	// module would execute after it, without committing any state
+	EstimateProtoRevBackrun(ctx context.Context, in *QueryEstimateProtoRevBackrunRequest, opts ...grpc.CallOption) (*QueryEstimateProtoRevBackrunResponse, error)
+	// GetProtoRevRebatesByAddress queries the backrun profits that have been
+	// rebated to a swapper
+	GetProtoRevRebatesByAddress(ctx context.Context, in *QueryGetProtoRevRebatesByAddressRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRebatesByAddressResponse, error)
 }
 
 type queryClient struct {
@@ -1822,6 +2183,24 @@ func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAll
 	return out, nil
 }
 
+func (c *queryClient) EstimateProtoRevBackrun(ctx context.Context, in *QueryEstimateProtoRevBackrunRequest, opts ...grpc.CallOption) (*QueryEstimateProtoRevBackrunResponse, error) {
+	out := new(QueryEstimateProtoRevBackrunResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/EstimateProtoRevBackrun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevRebatesByAddress(ctx context.Context, in *QueryGetProtoRevRebatesByAddressRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRebatesByAddressResponse, error) {
+	out := new(QueryGetProtoRevRebatesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevRebatesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1867,6 +2246,12 @@ type QueryServer interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+	// EstimateProtoRevBackrun simulates a swap and estimates the backrun that the
+	// module would execute after it, without committing any state
+	EstimateProtoRevBackrun(context.Context, *QueryEstimateProtoRevBackrunRequest) (*QueryEstimateProtoRevBackrunResponse, error)
+	// GetProtoRevRebatesByAddress queries the backrun profits that have been
+	// rebated to a swapper
+	GetProtoRevRebatesByAddress(context.Context, *QueryGetProtoRevRebatesByAddressRequest) (*QueryGetProtoRevRebatesByAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1921,6 +2306,12 @@ func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
 }
+func (*UnimplementedQueryServer) EstimateProtoRevBackrun(ctx context.Context, req *QueryEstimateProtoRevBackrunRequest) (*QueryEstimateProtoRevBackrunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateProtoRevBackrun not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevRebatesByAddress(ctx context.Context, req *QueryGetProtoRevRebatesByAddressRequest) (*QueryGetProtoRevRebatesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevRebatesByAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2214,6 +2605,42 @@ func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateProtoRevBackrun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateProtoRevBackrunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateProtoRevBackrun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/EstimateProtoRevBackrun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateProtoRevBackrun(ctx, req.(*QueryEstimateProtoRevBackrunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevRebatesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevRebatesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevRebatesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevRebatesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevRebatesByAddress(ctx, req.(*QueryGetProtoRevRebatesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2282,6 +2709,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProtocolRevenue",
 			Handler:    _Query_GetAllProtocolRevenue_Handler,
 		},
+		{
+			MethodName: "EstimateProtoRevBackrun",
+			Handler:    _Query_EstimateProtoRevBackrun_Handler,
+		},
+		{
+			MethodName: "GetProtoRevRebatesByAddress",
+			Handler:    _Query_GetProtoRevRebatesByAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/query.proto",
@@ -3219,46 +3654,300 @@ func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateProtoRevBackrunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEstimateProtoRevBackrunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateProtoRevBackrunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackrunRouteEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackrunRouteEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackrunRouteEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SwapperRebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ProfitInUosmo.Size()
+		i -= size
+		if _, err := m.ProfitInUosmo.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OptimalInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Simulated {
+		i--
+		if m.Simulated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolPointsConsumed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolPointsConsumed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OptimalRoute != nil {
+		{
+			size, err := m.OptimalRoute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3595,24 +4284,117 @@ func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryEstimateProtoRevBackrunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *BackrunRouteEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PoolPoints != 0 {
+		n += 1 + sovQuery(uint64(m.PoolPoints))
+	}
+	if m.Simulated {
+		n += 2
+	}
+	l = m.OptimalInput.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProfitInUosmo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapperRebate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateProtoRevBackrunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OptimalRoute != nil {
+		l = m.OptimalRoute.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolPointsConsumed != 0 {
+		n += 1 + sovQuery(uint64(m.PoolPointsConsumed))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevRebatesByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevRebatesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -5852,6 +6634,701 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEstimateProtoRevBackrunRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateProtoRevBackrunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateProtoRevBackrunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackrunRouteEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackrunRouteEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackrunRouteEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPoints", wireType)
+			}
+			m.PoolPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Simulated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimalInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OptimalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitInUosmo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitInUosmo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapperRebate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapperRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateProtoRevBackrunResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateProtoRevBackrunResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateProtoRevBackrunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, BackrunRouteEstimate{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimalRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimalRoute == nil {
+				m.OptimalRoute = &BackrunRouteEstimate{}
+			}
+			if err := m.OptimalRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPointsConsumed", wireType)
+			}
+			m.PoolPointsConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPointsConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevRebatesByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRebatesByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRebatesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevRebatesByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRebatesByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRebatesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -152,6 +152,28 @@ func ValidateDeveloperFees(fees []sdk.Coin) error {
 	return nil
 }
 
+// ---------------------- SwapperRebates Validation ---------------------- //
+// ValidateSwapperRebates does some basic validation on the swapper rebates passed into the module genesis.
+func ValidateSwapperRebates(swapperRebates []SwapperRebates) error {
+	seenAddresses := make(map[string]bool)
+	for _, swapperRebate := range swapperRebates {
+		if _, err := sdk.AccAddressFromBech32(swapperRebate.Address); err != nil {
+			return err
+		}
+
+		// Ensure that the swapper is unique
+		if seenAddresses[swapperRebate.Address] {
+			return fmt.Errorf("duplicate swapper rebates for %s", swapperRebate.Address)
+		}
+		seenAddresses[swapperRebate.Address] = true
+
+		if err := sdk.Coins(swapperRebate.Rebates).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ---------------------- Pool Point Validation ---------------------- //
 // ValidateMaxPoolPointsPerBlock validates the max pool points per block.
 func ValidateMaxPoolPointsPerBlock(points uint64) error {
@@ -123,6 +123,10 @@ func (ad AuthenticatorDecorator) AnteHandle(
 		return ctx, err
 	}
 
+	// Unordered transactions are protected against replays by a nonce instead of the account sequence
+	unorderedNonce := ad.GetUnorderedNonce(tx)
+	var unorderedAccounts []sdk.AccAddress
+
 	// tracks are used to make sure that we only write to the store after every message is successful
 	var tracks []func() error
 
@@ -155,6 +159,11 @@ func (ad AuthenticatorDecorator) AnteHandle(
 				errorsmod.Wrapf(err, "failed to get initialized authenticator (account = %s, authenticator id = %d, msg index = %d, msg type url = %s)", account, selectedAuthenticatorId, msgIndex, sdk.MsgTypeURL(msg))
 		}
 
+		replayProtection := authenticator.SequenceMatch
+		if unorderedNonce != 0 {
+			replayProtection = ad.smartAccountKeeper.UnorderedNonceReplayProtection(ctx, account, unorderedNonce)
+		}
+
 		// Generate the authentication request data
 		authenticationRequest, err := authenticator.GenerateAuthenticationRequest(
 			ctx,
@@ -169,7 +178,7 @@ func (ad AuthenticatorDecorator) AnteHandle(
 			tx,
 			msgIndex,
 			simulate,
-			authenticator.SequenceMatch,
+			replayProtection,
 		)
 		if err != nil {
 			return sdk.Context{},
@@ -216,6 +225,10 @@ func (ad AuthenticatorDecorator) AnteHandle(
 				feesPaid = true
 			}
 
+			if unorderedNonce != 0 && !containsAccount(unorderedAccounts, account) {
+				unorderedAccounts = append(unorderedAccounts, account)
+			}
+
 			// Append the track closure to be called after every message is authenticated
 			// Note: pre-initialize type URL to avoid closure issues from passing a msg
 			// loop variable inside the closure.
@@ -231,6 +244,7 @@ func (ad AuthenticatorDecorator) AnteHandle(
 
 					return errorsmod.Wrapf(err, "track failed (account = %s, authenticator id = %s, authenticator type, %s, msg index = %d)", account, stringId, a11r.Type(), msgIndex)
 				}
+				ad.smartAccountKeeper.RecordAuthenticatorUsage(ctx, account, selectedAuthenticator.Id)
 				return nil
 			})
 		}
@@ -244,6 +258,18 @@ func (ad AuthenticatorDecorator) AnteHandle(
 		}
 	}
 
+	// If the transaction has been authenticated, the unordered nonce can't be used again by its signers
+	// until the transaction times out.
+	if unorderedNonce != 0 {
+		timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight)
+		if !ok {
+			return sdk.Context{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "unordered transactions must be a TxWithTimeoutHeight")
+		}
+		for _, account := range unorderedAccounts {
+			ad.smartAccountKeeper.UseUnorderedNonce(ctx, account, unorderedNonce, timeoutTx.GetTimeoutHeight())
+		}
+	}
+
 	// If the transaction has been authenticated, we call Track(...) on every message
 	// to notify its authenticator so that it can handle any state updates.
 	for _, track := range tracks {
@@ -321,3 +347,26 @@ func (ad AuthenticatorDecorator) GetSelectedAuthenticators(
 
 	return selectedAuthenticators, nil
 }
+
+// GetUnorderedNonce returns the unordered nonce of the transaction, or 0 if the transaction is ordered.
+func (ad AuthenticatorDecorator) GetUnorderedNonce(tx sdk.Tx) uint64 {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return 0
+	}
+	txOptions := ad.smartAccountKeeper.GetAuthenticatorExtension(extTx.GetNonCriticalExtensionOptions())
+	if txOptions == nil {
+		return 0
+	}
+	return txOptions.GetUnorderedNonce()
+}
+
+// containsAccount returns true if the account is in the list of accounts.
+func containsAccount(accounts []sdk.AccAddress, account sdk.AccAddress) bool {
+	for _, acc := range accounts {
+		if acc.Equals(account) {
+			return true
+		}
+	}
+	return false
+}
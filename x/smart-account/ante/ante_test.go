@@ -10,6 +10,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v25/x/txfees/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -33,6 +35,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v25/app"
 	"github.com/osmosis-labs/osmosis/v25/app/params"
 	"github.com/osmosis-labs/osmosis/v25/x/smart-account/ante"
+	smartaccountkeeper "github.com/osmosis-labs/osmosis/v25/x/smart-account/keeper"
 	"github.com/osmosis-labs/osmosis/v25/x/smart-account/testutils"
 )
 
@@ -185,6 +188,10 @@ func (s *AuthenticatorAnteSuite) TestSignatureVerificationWithAuthenticatorInSto
 	_, err = anteHandler(s.Ctx, tx, false)
 
 	s.Require().NoError(err)
+
+	// The usage of the authenticators is recorded
+	usage := s.OsmosisApp.SmartAccountKeeper.GetAuthenticatorUsageForAccount(s.Ctx, s.TestAccAddress[0], 1)
+	s.Require().Equal(smartaccounttypes.AuthenticatorUsage{UseCount: 1, LastUsedHeight: s.Ctx.BlockHeight()}, usage)
 }
 
 // TestSignatureVerificationOutOfGas tests that the ante handler exits early by running out of gas if the
@@ -410,6 +417,70 @@ func (s *AuthenticatorAnteSuite) TestSpecificAuthenticator() {
 	}
 }
 
+// TestUnorderedNonce tests that unordered transactions ignore the account sequence and can't be replayed
+func (s *AuthenticatorAnteSuite) TestUnorderedNonce() {
+	osmoToken := "osmo"
+	coins := sdk.Coins{sdk.NewInt64Coin(osmoToken, 2500)}
+	feeCoins := sdk.Coins{sdk.NewInt64Coin(osmoToken, 2500)}
+	ctx := s.Ctx.WithBlockHeight(100)
+
+	err := testutil.FundAccount(ctx, s.OsmosisApp.BankKeeper, s.TestAccAddress[0], feeCoins.MulInt(osmomath.NewInt(10)))
+	s.Require().NoError(err)
+
+	id, err := s.OsmosisApp.SmartAccountKeeper.AddAuthenticator(
+		ctx,
+		s.TestAccAddress[0],
+		"SignatureVerification",
+		s.TestPrivKeys[0].PubKey().Bytes(),
+	)
+	s.Require().NoError(err)
+
+	testMsg := &banktypes.MsgSend{
+		FromAddress: sdk.MustBech32ifyAddressBytes(osmoToken, s.TestAccAddress[0]),
+		ToAddress:   sdk.MustBech32ifyAddressBytes(osmoToken, s.TestAccAddress[1]),
+		Amount:      coins,
+	}
+	accNum := s.OsmosisApp.AccountKeeper.GetAccount(ctx, s.TestAccAddress[0]).GetAccountNumber()
+	genTx := func(sequence, nonce, timeoutHeight uint64) sdk.Tx {
+		tx, err := GenUnorderedTx(ctx, s.EncodingConfig.TxConfig, []sdk.Msg{testMsg}, feeCoins, 300000, "",
+			[]uint64{accNum}, []uint64{sequence},
+			[]cryptotypes.PrivKey{s.TestPrivKeys[0]}, []cryptotypes.PrivKey{s.TestPrivKeys[0]},
+			[]uint64{id}, nonce, timeoutHeight)
+		s.Require().NoError(err)
+		return tx
+	}
+
+	anteHandler := sdk.ChainAnteDecorators(s.AuthenticatorDecorator)
+
+	// ordered transactions must match the account sequence
+	_, err = anteHandler(ctx, genTx(5, 0, 0), false)
+	s.Require().Error(err)
+
+	// unordered transactions ignore it
+	_, err = anteHandler(ctx, genTx(5, 7, 110), false)
+	s.Require().NoError(err)
+	_, err = anteHandler(ctx, genTx(3, 8, 110), false)
+	s.Require().NoError(err)
+
+	// but can't reuse a nonce
+	_, err = anteHandler(ctx, genTx(5, 7, 110), false)
+	s.Require().Error(err)
+	_, err = anteHandler(ctx, genTx(6, 7, 120), false)
+	s.Require().Error(err)
+
+	// and must time out within the accepted window
+	_, err = anteHandler(ctx, genTx(5, 9, 0), false)
+	s.Require().Error(err)
+	_, err = anteHandler(ctx, genTx(5, 9, 99), false)
+	s.Require().Error(err)
+	_, err = anteHandler(ctx, genTx(5, 9, 100+smartaccountkeeper.MaxUnorderedTimeoutHeightDelta+1), false)
+	s.Require().Error(err)
+
+	nonces, err := s.OsmosisApp.SmartAccountKeeper.GetUsedUnorderedNonces(ctx, s.TestAccAddress[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]smartaccounttypes.UnorderedNonce{{Nonce: 7, TimeoutHeight: 110}, {Nonce: 8, TimeoutHeight: 110}}, nonces)
+}
+
 // GenTx generates a signed mock transaction.
 func GenTx(
 	ctx sdk.Context,
@@ -421,6 +492,23 @@ func GenTx(
 	accNums, accSeqs []uint64,
 	signers, signatures []cryptotypes.PrivKey,
 	selectedAuthenticators []uint64,
+) (sdk.Tx, error) {
+	return GenUnorderedTx(ctx, gen, msgs, feeAmt, gas, chainID, accNums, accSeqs, signers, signatures, selectedAuthenticators, 0, 0)
+}
+
+// GenUnorderedTx generates a signed mock transaction using the unordered nonce for replay protection.
+func GenUnorderedTx(
+	ctx sdk.Context,
+	gen client.TxConfig,
+	msgs []sdk.Msg,
+	feeAmt sdk.Coins,
+	gas uint64,
+	chainID string,
+	accNums, accSeqs []uint64,
+	signers, signatures []cryptotypes.PrivKey,
+	selectedAuthenticators []uint64,
+	unorderedNonce uint64,
+	timeoutHeight uint64,
 ) (sdk.Tx, error) {
 	sigs := make([]signing.SignatureV2, len(signers))
 
@@ -453,6 +541,7 @@ func GenTx(
 	if len(selectedAuthenticators) > 0 {
 		value, err := types.NewAnyWithValue(&smartaccounttypes.TxExtension{
 			SelectedAuthenticators: selectedAuthenticators,
+			UnorderedNonce:         unorderedNonce,
 		})
 		if err != nil {
 			return nil, err
@@ -471,6 +560,7 @@ func GenTx(
 	txBuilder.SetMemo(memo)
 	txBuilder.SetFeeAmount(feeAmt)
 	txBuilder.SetGasLimit(gas)
+	txBuilder.SetTimeoutHeight(timeoutHeight)
 
 	// 2nd round: once all signer infos are set, every signer can sign.
 	for i, p := range signatures {
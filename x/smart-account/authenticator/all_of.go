@@ -39,6 +39,16 @@ func (aoa AllOf) Type() string {
 	return "PartitionedAllOf"
 }
 
+// VerifiesSignature returns true if any of the sub-authenticators verifies a signature, since all of them must pass.
+func (aoa AllOf) VerifiesSignature() bool {
+	for _, auth := range aoa.SubAuthenticators {
+		if VerifiesSignature(auth) {
+			return true
+		}
+	}
+	return false
+}
+
 func (aoa AllOf) StaticGas() uint64 {
 	var totalGas uint64
 	for _, auth := range aoa.SubAuthenticators {
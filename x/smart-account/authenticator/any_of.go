@@ -49,6 +49,16 @@ func (aoa AnyOf) Type() string {
 	return "PartitionedAnyOf"
 }
 
+// VerifiesSignature returns true if all the sub-authenticators verify a signature, since any of them can pass.
+func (aoa AnyOf) VerifiesSignature() bool {
+	for _, auth := range aoa.SubAuthenticators {
+		if !VerifiesSignature(auth) {
+			return false
+		}
+	}
+	return len(aoa.SubAuthenticators) > 0
+}
+
 func (aoa AnyOf) StaticGas() uint64 {
 	var totalGas uint64
 	for _, auth := range aoa.SubAuthenticators {
@@ -47,7 +47,10 @@ func onSubAuthenticatorsAdded(ctx sdk.Context, account sdk.AccAddress, data []by
 	if err := json.Unmarshal(data, &initDatas); err != nil {
 		return errorsmod.Wrapf(err, "failed to unmarshal sub-authenticator init data")
 	}
+	return addSubAuthenticators(ctx, account, initDatas, authenticatorId, am)
+}
 
+func addSubAuthenticators(ctx sdk.Context, account sdk.AccAddress, initDatas []SubAuthenticatorInitData, authenticatorId string, am *AuthenticatorManager) error {
 	if len(initDatas) <= 1 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticators provided")
 	}
@@ -81,7 +84,10 @@ func onSubAuthenticatorsRemoved(ctx sdk.Context, account sdk.AccAddress, data []
 	if err := json.Unmarshal(data, &initDatas); err != nil {
 		return err
 	}
+	return removeSubAuthenticators(ctx, account, initDatas, authenticatorId, am)
+}
 
+func removeSubAuthenticators(ctx sdk.Context, account sdk.AccAddress, initDatas []SubAuthenticatorInitData, authenticatorId string, am *AuthenticatorManager) error {
 	baseId := authenticatorId
 	for id, initData := range initDatas {
 		authenticatorCode := am.GetAuthenticatorByType(initData.Type)
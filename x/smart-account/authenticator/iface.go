@@ -47,3 +47,15 @@ type Authenticator interface {
 	// This function is used for updating global data or preventing removal when necessary to maintain system stability.
 	OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error
 }
+
+// SignatureVerifier is implemented by the authenticators that verify a signature of the request
+// when they authenticate it. Only these authenticators add weight to a ThresholdOf.
+type SignatureVerifier interface {
+	VerifiesSignature() bool
+}
+
+// VerifiesSignature returns whether the authenticator verifies a signature of the request it authenticates.
+func VerifiesSignature(auth Authenticator) bool {
+	verifier, ok := auth.(SignatureVerifier)
+	return ok && verifier.VerifiesSignature()
+}
@@ -0,0 +1,223 @@
+package authenticator
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+var _ Authenticator = &Secp256r1SignatureVerification{}
+
+const (
+	// Secp256r1SignatureVerificationType represents an authenticator that verifies secp256r1 (P-256)
+	// signatures, either raw or wrapped in a WebAuthn assertion.
+	Secp256r1SignatureVerificationType = "Secp256r1SignatureVerification"
+
+	// secp256r1PubKeySize is the size of a compressed secp256r1 public key.
+	secp256r1PubKeySize = 33
+
+	// webAuthnClientDataType is the type of the client data of a WebAuthn assertion.
+	webAuthnClientDataType = "webauthn.get"
+
+	// webAuthnFlagUserPresent is the authenticator data flag set when the user was present.
+	webAuthnFlagUserPresent = 0x01
+
+	// webAuthnMinAuthenticatorDataSize is the size of the rpIdHash, flags and signCount of the authenticator data.
+	webAuthnMinAuthenticatorDataSize = 37
+)
+
+// Secp256r1SignatureVerificationConfig is the configuration of a Secp256r1SignatureVerification authenticator.
+// When RpId is set, signatures must be WebAuthn assertions for that relying party id, as produced by passkeys.
+// WebAuthn signatures are not required to be low-s normalized, since passkeys can't be asked to normalize them.
+// Otherwise, signatures must be raw 64 byte (R || S) signatures of the sign bytes.
+type Secp256r1SignatureVerificationConfig struct {
+	PubKey []byte `json:"pub_key"`
+	RpId   string `json:"rp_id,omitempty"`
+}
+
+// WebAuthnSignature is the signature envelope of a WebAuthn assertion. The assertion challenge
+// is the base64url encoded sha256 hash of the sign bytes, and the signature is DER encoded.
+type WebAuthnSignature struct {
+	AuthenticatorData []byte `json:"authenticator_data"`
+	ClientDataJSON    []byte `json:"client_data_json"`
+	Signature         []byte `json:"signature"`
+}
+
+// webAuthnClientData holds the fields of the WebAuthn client data that are verified.
+type webAuthnClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Secp256r1SignatureVerification verifies secp256r1 signatures of the sign bytes, allowing accounts
+// to be controlled by secure enclaves and browser passkeys.
+type Secp256r1SignatureVerification struct {
+	ak     authante.AccountKeeper
+	PubKey *ecdsa.PublicKey
+	RpId   string
+}
+
+// NewSecp256r1SignatureVerification creates a new Secp256r1SignatureVerification
+func NewSecp256r1SignatureVerification(ak authante.AccountKeeper) Secp256r1SignatureVerification {
+	return Secp256r1SignatureVerification{ak: ak}
+}
+
+func (sva Secp256r1SignatureVerification) Type() string {
+	return Secp256r1SignatureVerificationType
+}
+
+// VerifiesSignature returns true, the authenticator verifies the signature of the request.
+func (sva Secp256r1SignatureVerification) VerifiesSignature() bool {
+	return true
+}
+
+func (sva Secp256r1SignatureVerification) StaticGas() uint64 {
+	// using 0 gas here. The gas is consumed in Authenticate()
+	return 0
+}
+
+// Initialize sets up the public key and relying party id from the account-authenticator configuration
+func (sva Secp256r1SignatureVerification) Initialize(config []byte) (Authenticator, error) {
+	parsed, pubKey, err := parseSecp256r1Config(config)
+	if err != nil {
+		return nil, err
+	}
+	sva.PubKey = pubKey
+	sva.RpId = parsed.RpId
+	return sva, nil
+}
+
+// Authenticate verifies the signature of the request against the sign bytes of the transaction
+func (sva Secp256r1SignatureVerification) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	// First consume gas for verifying the signature
+	params := sva.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256r1(), "secp256r1 signature verification")
+
+	if request.Simulate || ctx.IsReCheckTx() {
+		return nil
+	}
+	if sva.PubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey not set on authenticator")
+	}
+
+	var err error
+	if sva.RpId == "" {
+		err = sva.verifyRaw(request.SignModeTxData.Direct, request.Signature)
+	} else {
+		err = sva.verifyWebAuthn(request.SignModeTxData.Direct, request.Signature)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"signature verification failed (%s); please verify account number (%d), sequence (%d) and chain-id (%s)",
+			err,
+			request.TxData.AccountNumber,
+			request.TxData.AccountSequence,
+			request.TxData.ChainID,
+		)
+	}
+	return nil
+}
+
+func (sva Secp256r1SignatureVerification) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (sva Secp256r1SignatureVerification) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (sva Secp256r1SignatureVerification) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, _, err := parseSecp256r1Config(config)
+	return err
+}
+
+func (sva Secp256r1SignatureVerification) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
+
+// verifyRaw verifies a low-s normalized R || S signature of the sign bytes, the same encoding used
+// by the sdk's secp256r1 keys.
+func (sva Secp256r1SignatureVerification) verifyRaw(signBytes, signature []byte) error {
+	if len(signature) != 64 {
+		return fmt.Errorf("invalid secp256r1 signature size, expected 64, got %d", len(signature))
+	}
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		return fmt.Errorf("secp256r1 signature is not low-s normalized")
+	}
+	hash := sha256.Sum256(signBytes)
+	if !ecdsa.Verify(sva.PubKey, hash[:], r, s) {
+		return fmt.Errorf("invalid secp256r1 signature")
+	}
+	return nil
+}
+
+// verifyWebAuthn verifies a WebAuthn assertion whose challenge is the hash of the sign bytes.
+func (sva Secp256r1SignatureVerification) verifyWebAuthn(signBytes, signature []byte) error {
+	var envelope WebAuthnSignature
+	if err := json.Unmarshal(signature, &envelope); err != nil {
+		return fmt.Errorf("invalid webauthn signature envelope: %w", err)
+	}
+
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(envelope.ClientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid webauthn client data: %w", err)
+	}
+	if clientData.Type != webAuthnClientDataType {
+		return fmt.Errorf("invalid webauthn client data type %s", clientData.Type)
+	}
+	signBytesHash := sha256.Sum256(signBytes)
+	if clientData.Challenge != base64.RawURLEncoding.EncodeToString(signBytesHash[:]) {
+		return fmt.Errorf("webauthn challenge does not match the sign bytes")
+	}
+
+	authData := envelope.AuthenticatorData
+	if len(authData) < webAuthnMinAuthenticatorDataSize {
+		return fmt.Errorf("webauthn authenticator data is too short")
+	}
+	rpIdHash := sha256.Sum256([]byte(sva.RpId))
+	if !bytes.Equal(authData[:32], rpIdHash[:]) {
+		return fmt.Errorf("webauthn relying party id does not match %s", sva.RpId)
+	}
+	if authData[32]&webAuthnFlagUserPresent == 0 {
+		return fmt.Errorf("webauthn user present flag not set")
+	}
+
+	// The assertion signs authenticatorData || sha256(clientDataJSON)
+	clientDataHash := sha256.Sum256(envelope.ClientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(sva.PubKey, digest[:], envelope.Signature) {
+		return fmt.Errorf("invalid webauthn signature")
+	}
+	return nil
+}
+
+// parseSecp256r1Config decodes the configuration and the compressed public key it contains.
+func parseSecp256r1Config(config []byte) (Secp256r1SignatureVerificationConfig, *ecdsa.PublicKey, error) {
+	var parsed Secp256r1SignatureVerificationConfig
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return Secp256r1SignatureVerificationConfig{}, nil, errorsmod.Wrap(err, "invalid secp256r1 authenticator configuration")
+	}
+	if len(parsed.PubKey) != secp256r1PubKeySize {
+		return Secp256r1SignatureVerificationConfig{}, nil, fmt.Errorf("invalid secp256r1 public key size, expected %d, got %d", secp256r1PubKeySize, len(parsed.PubKey))
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), parsed.PubKey)
+	if x == nil {
+		return Secp256r1SignatureVerificationConfig{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid secp256r1 public key")
+	}
+	return parsed, &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
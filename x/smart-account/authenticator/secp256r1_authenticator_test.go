@@ -0,0 +1,198 @@
+package authenticator_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+)
+
+type Secp256r1AuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	Secp256r1Authenticator authenticator.Secp256r1SignatureVerification
+	PrivKey                *ecdsa.PrivateKey
+	signBytes              []byte
+}
+
+func TestSecp256r1AuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(Secp256r1AuthenticatorTest))
+}
+
+func (s *Secp256r1AuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+
+	s.Secp256r1Authenticator = authenticator.NewSecp256r1SignatureVerification(s.OsmosisApp.AccountKeeper)
+
+	var err error
+	s.PrivKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.signBytes = []byte("signBytes")
+}
+
+func (s *Secp256r1AuthenticatorTest) config(rpId string) []byte {
+	bz, err := json.Marshal(authenticator.Secp256r1SignatureVerificationConfig{
+		PubKey: elliptic.MarshalCompressed(elliptic.P256(), s.PrivKey.X, s.PrivKey.Y),
+		RpId:   rpId,
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *Secp256r1AuthenticatorTest) request(signature []byte) authenticator.AuthenticationRequest {
+	return authenticator.AuthenticationRequest{
+		Account:        s.TestAccAddress[0],
+		SignModeTxData: authenticator.SignModeData{Direct: s.signBytes},
+		Signature:      signature,
+	}
+}
+
+// rawSignature signs the sign bytes and returns the low-s normalized R || S signature.
+func (s *Secp256r1AuthenticatorTest) rawSignature(signBytes []byte) []byte {
+	hash := sha256.Sum256(signBytes)
+	r, sig, err := ecdsa.Sign(rand.Reader, s.PrivKey, hash[:])
+	s.Require().NoError(err)
+	order := elliptic.P256().Params().N
+	if sig.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		sig.Sub(order, sig)
+	}
+	return append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+}
+
+// webAuthnSignature builds a WebAuthn assertion with the given rp id, flags and challenge.
+func (s *Secp256r1AuthenticatorTest) webAuthnSignature(rpId string, flags byte, clientDataType string, challenge []byte) []byte {
+	rpIdHash := sha256.Sum256([]byte(rpId))
+	authData := append(rpIdHash[:], flags, 0, 0, 0, 1)
+
+	challengeHash := sha256.Sum256(challenge)
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      clientDataType,
+		"challenge": base64.RawURLEncoding.EncodeToString(challengeHash[:]),
+		"origin":    "https://" + rpId,
+	})
+	s.Require().NoError(err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, s.PrivKey, digest[:])
+	s.Require().NoError(err)
+
+	bz, err := json.Marshal(authenticator.WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         signature,
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *Secp256r1AuthenticatorTest) TestRawSignature() {
+	auth, err := s.Secp256r1Authenticator.Initialize(s.config(""))
+	s.Require().NoError(err)
+
+	validSignature := s.rawSignature(s.signBytes)
+	r := validSignature[:32]
+	highS := new(big.Int).Sub(elliptic.P256().Params().N, new(big.Int).SetBytes(validSignature[32:]))
+
+	tests := []struct {
+		name      string
+		signature []byte
+		success   bool
+	}{
+		{"valid signature", validSignature, true},
+		{"signature of other bytes", s.rawSignature([]byte("other")), false},
+		{"high-s signature", append(append([]byte{}, r...), highS.FillBytes(make([]byte, 32))...), false},
+		{"truncated signature", validSignature[:63], false},
+		{"webauthn signature", s.webAuthnSignature("osmosis.zone", 0x01, "webauthn.get", s.signBytes), false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := auth.Authenticate(s.Ctx, s.request(tc.signature))
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *Secp256r1AuthenticatorTest) TestWebAuthnSignature() {
+	rpId := "osmosis.zone"
+	auth, err := s.Secp256r1Authenticator.Initialize(s.config(rpId))
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name      string
+		signature []byte
+		success   bool
+	}{
+		{"valid assertion", s.webAuthnSignature(rpId, 0x05, "webauthn.get", s.signBytes), true},
+		{"challenge of other bytes", s.webAuthnSignature(rpId, 0x05, "webauthn.get", []byte("other")), false},
+		{"other rp id", s.webAuthnSignature("evil.zone", 0x05, "webauthn.get", s.signBytes), false},
+		{"user not present", s.webAuthnSignature(rpId, 0x04, "webauthn.get", s.signBytes), false},
+		{"registration client data", s.webAuthnSignature(rpId, 0x05, "webauthn.create", s.signBytes), false},
+		{"raw signature", s.rawSignature(s.signBytes), false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := auth.Authenticate(s.Ctx, s.request(tc.signature))
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *Secp256r1AuthenticatorTest) TestOnAuthenticatorAdded() {
+	tests := []struct {
+		name    string
+		config  []byte
+		success bool
+	}{
+		{"raw", s.config(""), true},
+		{"webauthn", s.config("osmosis.zone"), true},
+		{"uncompressed pubkey", []byte(`{"pub_key":"` + base64.StdEncoding.EncodeToString(elliptic.Marshal(elliptic.P256(), s.PrivKey.X, s.PrivKey.Y)) + `"}`), false},
+		{"invalid pubkey prefix", []byte(`{"pub_key":"` + base64.StdEncoding.EncodeToString(append([]byte{0x05}, make([]byte, 32)...)) + `"}`), false},
+		{"invalid json", []byte(`pubkey`), false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.Secp256r1Authenticator.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *Secp256r1AuthenticatorTest) TestComposition() {
+	am := authenticator.NewAuthenticatorManager()
+	am.RegisterAuthenticator(s.Secp256r1Authenticator)
+	anyOf := authenticator.NewAnyOf(am)
+
+	config, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: authenticator.Secp256r1SignatureVerificationType, Config: s.config("")},
+		{Type: authenticator.Secp256r1SignatureVerificationType, Config: s.config("osmosis.zone")},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(anyOf.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, "1"))
+
+	auth, err := anyOf.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().NoError(auth.Authenticate(s.Ctx, s.request(s.rawSignature(s.signBytes))))
+	s.Require().NoError(auth.Authenticate(s.Ctx, s.request(s.webAuthnSignature("osmosis.zone", 0x01, "webauthn.get", s.signBytes))))
+	s.Require().Error(auth.Authenticate(s.Ctx, s.request(s.rawSignature([]byte("other")))))
+}
@@ -0,0 +1,240 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+var _ Authenticator = &Session{}
+
+const (
+	// SessionType represents an authenticator that wraps a sub-authenticator and only allows it
+	// within a time window or for a limited number of uses.
+	SessionType = "Session"
+)
+
+// SessionConfig is the configuration of a Session authenticator.
+// At least one of NotAfter and MaxUses must be set so that the session expires.
+// MaxUses counts the messages authenticated with the session.
+type SessionConfig struct {
+	NotBefore        *time.Time               `json:"not_before,omitempty"`
+	NotAfter         *time.Time               `json:"not_after,omitempty"`
+	MaxUses          uint64                   `json:"max_uses,omitempty"`
+	SubAuthenticator SubAuthenticatorInitData `json:"sub_authenticator"`
+}
+
+// Session is an authenticator that delegates to a sub-authenticator, but only between the configured
+// not_before and not_after times, and for at most max_uses messages.
+//
+// Top level sessions are removed from the account in the EndBlocker once they expire, which calls
+// OnAuthenticatorRemoved and cleans up their state. Sessions nested in composite authenticators are
+// not removed, they simply stop authenticating.
+type Session struct {
+	am       *AuthenticatorManager
+	storeKey storetypes.StoreKey
+
+	config           SessionConfig
+	SubAuthenticator Authenticator
+}
+
+// NewSession creates a new Session authenticator.
+func NewSession(am *AuthenticatorManager, storeKey storetypes.StoreKey) Session {
+	return Session{
+		am:       am,
+		storeKey: storeKey,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (s Session) Type() string {
+	return SessionType
+}
+
+// VerifiesSignature returns whether the wrapped sub-authenticator verifies a signature.
+func (s Session) VerifiesSignature() bool {
+	return s.SubAuthenticator != nil && VerifiesSignature(s.SubAuthenticator)
+}
+
+// StaticGas returns the static gas of the sub-authenticator.
+func (s Session) StaticGas() uint64 {
+	if s.SubAuthenticator == nil {
+		return 0
+	}
+	return s.SubAuthenticator.StaticGas()
+}
+
+// Initialize parses the session configuration and initializes the sub-authenticator.
+func (s Session) Initialize(config []byte) (Authenticator, error) {
+	parsed, err := parseSessionConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(parsed.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", parsed.SubAuthenticator.Type)
+	}
+	instance, err := authenticatorCode.Initialize(parsed.SubAuthenticator.Config)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", parsed.SubAuthenticator.Type)
+	}
+
+	s.config = parsed
+	s.SubAuthenticator = instance
+	return s, nil
+}
+
+// Authenticate checks that the session is active and delegates to the sub-authenticator.
+func (s Session) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if s.SubAuthenticator == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticator provided")
+	}
+
+	blockTime := ctx.BlockTime()
+	if s.config.NotBefore != nil && blockTime.Before(*s.config.NotBefore) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session is not active before %s", s.config.NotBefore)
+	}
+	if s.config.NotAfter != nil && !blockTime.Before(*s.config.NotAfter) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session expired at %s", s.config.NotAfter)
+	}
+	if s.config.MaxUses > 0 {
+		if uses := s.GetUses(ctx, request.Account, request.AuthenticatorId); uses >= s.config.MaxUses {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session has been used %d times, max uses is %d", uses, s.config.MaxUses)
+		}
+	}
+
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return s.SubAuthenticator.Authenticate(ctx, request)
+}
+
+// Track counts the use of the session and calls Track on the sub-authenticator. Once the last use is
+// consumed, top level sessions are scheduled for removal.
+func (s Session) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	if s.config.MaxUses > 0 {
+		uses := s.GetUses(ctx, request.Account, request.AuthenticatorId) + 1
+		if uses > s.config.MaxUses {
+			// A single tx can use the session for several messages, which are all authenticated before being tracked
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session max uses of %d exceeded", s.config.MaxUses)
+		}
+		osmoutils.MustSet(ctx.KVStore(s.storeKey),
+			types.KeySessionUses(request.Account, request.AuthenticatorId),
+			&gogotypes.UInt64Value{Value: uses})
+
+		if uses >= s.config.MaxUses {
+			s.scheduleRemoval(ctx, ctx.BlockTime(), request.Account, request.AuthenticatorId)
+		}
+	}
+
+	return subTrack(ctx, request, []Authenticator{s.SubAuthenticator})
+}
+
+// ConfirmExecution delegates to the sub-authenticator.
+func (s Session) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return s.SubAuthenticator.ConfirmExecution(ctx, request)
+}
+
+// OnAuthenticatorAdded validates the configuration, calls OnAuthenticatorAdded on the sub-authenticator
+// and schedules top level sessions for removal at their not_after time.
+func (s Session) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	parsed, err := parseSessionConfig(config)
+	if err != nil {
+		return err
+	}
+	if parsed.NotAfter != nil && !parsed.NotAfter.After(ctx.BlockTime()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session not_after must be in the future")
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(parsed.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", parsed.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	err = authenticatorCode.OnAuthenticatorAdded(ctx, account, parsed.SubAuthenticator.Config, subId)
+	if err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", subId)
+	}
+
+	if parsed.NotAfter != nil {
+		s.scheduleRemoval(ctx, *parsed.NotAfter, account, authenticatorId)
+	}
+	return nil
+}
+
+// OnAuthenticatorRemoved deletes the session state and calls OnAuthenticatorRemoved on the sub-authenticator.
+func (s Session) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	parsed, err := parseSessionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	store.Delete(types.KeySessionUses(account, authenticatorId))
+	if id, err := strconv.ParseUint(authenticatorId, 10, 64); err == nil && parsed.NotAfter != nil {
+		store.Delete(types.KeySessionExpiry(*parsed.NotAfter, account, id))
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(parsed.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", parsed.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	err = authenticatorCode.OnAuthenticatorRemoved(ctx, account, parsed.SubAuthenticator.Config, subId)
+	if err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// GetUses returns the number of messages the session has been used for.
+func (s Session) GetUses(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) uint64 {
+	uses := gogotypes.UInt64Value{}
+	_, err := osmoutils.Get(ctx.KVStore(s.storeKey), types.KeySessionUses(account, authenticatorId), &uses)
+	if err != nil {
+		panic(err)
+	}
+	return uses.Value
+}
+
+// scheduleRemoval adds the session to the expiry index. Sessions nested in composite authenticators
+// are not indexed since only top level authenticators can be removed from an account.
+func (s Session) scheduleRemoval(ctx sdk.Context, expiry time.Time, account sdk.AccAddress, authenticatorId string) {
+	if strings.Contains(authenticatorId, ".") {
+		return
+	}
+	id, err := strconv.ParseUint(authenticatorId, 10, 64)
+	if err != nil {
+		return
+	}
+	ctx.KVStore(s.storeKey).Set(types.KeySessionExpiry(expiry, account, id), []byte{})
+}
+
+// parseSessionConfig decodes and validates a Session configuration.
+func parseSessionConfig(config []byte) (SessionConfig, error) {
+	var parsed SessionConfig
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return SessionConfig{}, errorsmod.Wrap(err, "invalid session configuration")
+	}
+	if parsed.NotAfter == nil && parsed.MaxUses == 0 {
+		return SessionConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session must set not_after or max_uses")
+	}
+	if parsed.NotBefore != nil && parsed.NotAfter != nil && !parsed.NotBefore.Before(*parsed.NotAfter) {
+		return SessionConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session not_before must be before not_after")
+	}
+	if parsed.SubAuthenticator.Type == "" {
+		return SessionConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticator provided")
+	}
+	return parsed, nil
+}
@@ -0,0 +1,139 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+type SessionAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	Session     authenticator.Session
+	AlwaysPass  testutils.TestingAuthenticator
+	NeverPass   testutils.TestingAuthenticator
+	blockTime   time.Time
+	sessionTime time.Time
+}
+
+func TestSessionAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(SessionAuthenticatorTest))
+}
+
+func (s *SessionAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+
+	am := authenticator.NewAuthenticatorManager()
+	s.AlwaysPass = testutils.TestingAuthenticator{Approve: testutils.Always, Confirm: testutils.Always}
+	s.NeverPass = testutils.TestingAuthenticator{Approve: testutils.Never, Confirm: testutils.Always}
+	am.RegisterAuthenticator(s.AlwaysPass)
+	am.RegisterAuthenticator(s.NeverPass)
+
+	s.Session = authenticator.NewSession(am, s.OsmosisApp.GetKVStoreKey()[smartaccounttypes.StoreKey])
+	s.blockTime = time.Unix(1_000_000, 0).UTC()
+	s.Ctx = s.Ctx.WithBlockTime(s.blockTime)
+}
+
+func (s *SessionAuthenticatorTest) sessionConfig(notBefore, notAfter *time.Time, maxUses uint64, sub testutils.TestingAuthenticator) []byte {
+	bz, err := json.Marshal(authenticator.SessionConfig{
+		NotBefore:        notBefore,
+		NotAfter:         notAfter,
+		MaxUses:          maxUses,
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: sub.Type()},
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *SessionAuthenticatorTest) TestTimeWindow() {
+	notBefore := s.blockTime.Add(time.Hour)
+	notAfter := s.blockTime.Add(2 * time.Hour)
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+
+	session, err := s.Session.Initialize(s.sessionConfig(&notBefore, &notAfter, 0, s.AlwaysPass))
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		success   bool
+	}{
+		{"before not_before", s.blockTime, false},
+		{"at not_before", notBefore, true},
+		{"within window", notBefore.Add(30 * time.Minute), true},
+		{"at not_after", notAfter, false},
+		{"after not_after", notAfter.Add(time.Hour), false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := session.Authenticate(s.Ctx.WithBlockTime(tc.blockTime), request)
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+
+	// the sub-authenticator must also pass
+	session, err = s.Session.Initialize(s.sessionConfig(&notBefore, &notAfter, 0, s.NeverPass))
+	s.Require().NoError(err)
+	s.Require().Error(session.Authenticate(s.Ctx.WithBlockTime(notBefore), request))
+}
+
+func (s *SessionAuthenticatorTest) TestMaxUses() {
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+	session, err := s.Session.Initialize(s.sessionConfig(nil, nil, 2, s.AlwaysPass))
+	s.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		s.Require().NoError(session.Authenticate(s.Ctx, request))
+		s.Require().NoError(session.Track(s.Ctx, request))
+	}
+	s.Require().Equal(uint64(2), s.Session.GetUses(s.Ctx, s.TestAccAddress[0], "1"))
+	s.Require().Error(session.Authenticate(s.Ctx, request))
+	s.Require().Error(session.Track(s.Ctx, request))
+
+	// the session is scheduled for removal once its uses are consumed
+	store := s.Ctx.KVStore(s.OsmosisApp.GetKVStoreKey()[smartaccounttypes.StoreKey])
+	s.Require().True(store.Has(smartaccounttypes.KeySessionExpiry(s.blockTime, s.TestAccAddress[0], 1)))
+
+	s.Require().NoError(s.Session.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], s.sessionConfig(nil, nil, 2, s.AlwaysPass), "1"))
+	s.Require().Equal(uint64(0), s.Session.GetUses(s.Ctx, s.TestAccAddress[0], "1"))
+}
+
+func (s *SessionAuthenticatorTest) TestOnAuthenticatorAdded() {
+	past := s.blockTime.Add(-time.Hour)
+	future := s.blockTime.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		config  []byte
+		success bool
+	}{
+		{"not_after", s.sessionConfig(nil, &future, 0, s.AlwaysPass), true},
+		{"max uses", s.sessionConfig(nil, nil, 1, s.AlwaysPass), true},
+		{"window", s.sessionConfig(&past, &future, 0, s.AlwaysPass), true},
+		{"never expires", s.sessionConfig(&past, nil, 0, s.AlwaysPass), false},
+		{"not_after in the past", s.sessionConfig(nil, &past, 0, s.AlwaysPass), false},
+		{"empty window", s.sessionConfig(&future, &future, 0, s.AlwaysPass), false},
+		{"unregistered sub-authenticator", []byte(`{"max_uses":1,"sub_authenticator":{"type":"Unknown"}}`), false},
+		{"no sub-authenticator", []byte(`{"max_uses":1}`), false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.Session.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -33,6 +33,11 @@ func (sva SignatureVerification) Type() string {
 	return SignatureVerificationType
 }
 
+// VerifiesSignature returns true, the authenticator verifies the signature of the request.
+func (sva SignatureVerification) VerifiesSignature() bool {
+	return true
+}
+
 func (sva SignatureVerification) StaticGas() uint64 {
 	// using 0 gas here. The gas is consumed based on the pubkey type in Authenticate()
 	return 0
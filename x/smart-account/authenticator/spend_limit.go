@@ -0,0 +1,387 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+var _ Authenticator = &SpendLimit{}
+
+const (
+	// SpendLimitType represents a native authenticator that caps how much an account
+	// can spend per period when authenticating with it.
+	SpendLimitType = "SpendLimit"
+
+	// DefaultSpendLimitTwapWindow is the TWAP window used to price spent denoms when
+	// the configuration does not specify one.
+	DefaultSpendLimitTwapWindow = time.Hour
+
+	// SpendLimitPeriodBuckets is the number of buckets the spending of a period is summed in.
+	// The rolling window moves forward one bucket, i.e. period / SpendLimitPeriodBuckets, at a time.
+	SpendLimitPeriodBuckets = 24
+	// MinSpendLimitPeriod is the shortest period, so that each bucket spans at least a second.
+	MinSpendLimitPeriod = SpendLimitPeriodBuckets * time.Second
+)
+
+// SpendLimitConfig is the configuration of a SpendLimit authenticator.
+//
+// Limits caps the amount of each listed denom that may leave the account during a period.
+// QuoteDenom and QuoteLimit optionally cap the total value that may leave the account,
+// with every denom listed in PriceRoutes priced in QuoteDenom using the arithmetic twap
+// of the given pool. At least one of the two kinds of limits must be set.
+type SpendLimitConfig struct {
+	Limits      sdk.Coins              `json:"limits,omitempty"`
+	QuoteDenom  string                 `json:"quote_denom,omitempty"`
+	QuoteLimit  osmomath.Int           `json:"quote_limit,omitempty"`
+	PriceRoutes []SpendLimitPriceRoute `json:"price_routes,omitempty"`
+	// TwapWindow is a duration string (e.g. "1h") for the twap used to price denoms.
+	TwapWindow string `json:"twap_window,omitempty"`
+	// Period is a duration string (e.g. "24h") of the rolling window the limits apply to.
+	Period string `json:"period"`
+}
+
+// SpendLimitPriceRoute is the pool used to price Denom in the quote denom.
+type SpendLimitPriceRoute struct {
+	Denom  string `json:"denom"`
+	PoolId uint64 `json:"pool_id"`
+}
+
+// SpendLimitState is the spending of a SpendLimit authenticator in the buckets of its rolling window.
+type SpendLimitState struct {
+	Buckets []SpendLimitBucket `json:"buckets"`
+}
+
+// SpendLimitBucket is the amount spent between Start and Start + period / SpendLimitPeriodBuckets.
+type SpendLimitBucket struct {
+	Start      time.Time    `json:"start"`
+	Spent      sdk.Coins    `json:"spent"`
+	SpentValue osmomath.Int `json:"spent_value"`
+}
+
+// Spent returns the amount of each denom spent in the buckets of the state.
+func (s SpendLimitState) Spent() sdk.Coins {
+	spent := sdk.NewCoins()
+	for _, bucket := range s.Buckets {
+		spent = spent.Add(bucket.Spent...)
+	}
+	return spent
+}
+
+// SpentValue returns the value in the quote denom spent in the buckets of the state.
+func (s SpendLimitState) SpentValue() osmomath.Int {
+	value := osmomath.ZeroInt()
+	for _, bucket := range s.Buckets {
+		value = value.Add(bucket.SpentValue)
+	}
+	return value
+}
+
+// SpendLimit is an authenticator that limits how much of each denom, or how much value
+// priced in a quote denom, can be moved out of an account over a rolling period.
+//
+// The spending is summed in SpendLimitPeriodBuckets buckets per period, and a bucket is only
+// dropped once it is entirely older than the period. So the window covers between one period
+// and one period plus one bucket, and never lets more than the limits be spent in any period.
+//
+// It does not verify signatures, so it's meant to be composed with other authenticators
+// through AllOf. Spending is measured by comparing the balances recorded in Track with the
+// balances after execution in ConfirmExecution. Balance increases are not credited back.
+type SpendLimit struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	twapKeeper types.TwapKeeper
+
+	config     SpendLimitConfig
+	period     time.Duration
+	twapWindow time.Duration
+}
+
+// NewSpendLimit creates a new SpendLimit authenticator.
+func NewSpendLimit(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper, twapKeeper types.TwapKeeper) SpendLimit {
+	return SpendLimit{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		twapKeeper: twapKeeper,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (sl SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+// StaticGas returns the static gas amount for the authenticator. Gas is consumed through store and twap reads.
+func (sl SpendLimit) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize parses and validates the spend limit configuration.
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	parsed, period, twapWindow, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	sl.config = parsed
+	sl.period = period
+	sl.twapWindow = twapWindow
+	return sl, nil
+}
+
+// Authenticate always succeeds. The limits are enforced after execution in ConfirmExecution.
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track records the balances of the tracked denoms before the messages are executed.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	var balances sdk.Coins
+	for _, denom := range sl.trackedDenoms() {
+		balances = balances.Add(sl.bankKeeper.GetBalance(ctx, request.Account, denom))
+	}
+	return sl.setJSON(ctx, types.KeySpendLimitSnapshot(request.Account, request.AuthenticatorId), balances)
+}
+
+// ConfirmExecution compares the current balances against the ones recorded in Track, adds
+// the difference to the amount spent in the rolling period and fails if any limit is exceeded.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	snapshotKey := types.KeySpendLimitSnapshot(request.Account, request.AuthenticatorId)
+	store := ctx.KVStore(sl.storeKey)
+	if !store.Has(snapshotKey) {
+		// The balances of a tx are only compared once, even if the authenticator was
+		// selected for several of its messages.
+		return nil
+	}
+
+	var preBalances sdk.Coins
+	if err := sl.getJSON(ctx, snapshotKey, &preBalances); err != nil {
+		return err
+	}
+	store.Delete(snapshotKey)
+
+	spent := sdk.NewCoins()
+	for _, preBalance := range preBalances {
+		postBalance := sl.bankKeeper.GetBalance(ctx, request.Account, preBalance.Denom)
+		if preBalance.Amount.GT(postBalance.Amount) {
+			spent = spent.Add(sdk.NewCoin(preBalance.Denom, preBalance.Amount.Sub(postBalance.Amount)))
+		}
+	}
+	if spent.IsZero() {
+		return nil
+	}
+
+	state, err := sl.GetSpendLimitState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+
+	value := osmomath.ZeroInt()
+	if sl.config.QuoteDenom != "" {
+		value, err = sl.quoteValue(ctx, spent)
+		if err != nil {
+			return err
+		}
+	}
+	state = sl.addToCurrentBucket(ctx, state, spent, value)
+
+	totalSpent := state.Spent()
+	for _, limit := range sl.config.Limits {
+		if spentAmount := totalSpent.AmountOf(limit.Denom); spentAmount.GT(limit.Amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"spend limit exceeded for %s: spent %s in the last %s, limit %s", limit.Denom, spentAmount, sl.period, limit.Amount)
+		}
+	}
+	if sl.config.QuoteDenom != "" {
+		if totalValue := state.SpentValue(); totalValue.GT(sl.config.QuoteLimit) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"spend limit exceeded: spent %s%s in the last %s, limit %s%s",
+				totalValue, sl.config.QuoteDenom, sl.period, sl.config.QuoteLimit, sl.config.QuoteDenom)
+		}
+	}
+
+	return sl.setJSON(ctx, types.KeySpendLimitState(request.Account, request.AuthenticatorId), state)
+}
+
+// addToCurrentBucket adds the spending to the bucket of the block time, creating it if needed.
+func (sl SpendLimit) addToCurrentBucket(ctx sdk.Context, state SpendLimitState, spent sdk.Coins, value osmomath.Int) SpendLimitState {
+	bucketStart := ctx.BlockTime().Truncate(sl.bucketDuration())
+	last := len(state.Buckets) - 1
+	if last < 0 || !state.Buckets[last].Start.Equal(bucketStart) {
+		state.Buckets = append(state.Buckets, SpendLimitBucket{Start: bucketStart, Spent: sdk.NewCoins(), SpentValue: osmomath.ZeroInt()})
+		last++
+	}
+	state.Buckets[last].Spent = state.Buckets[last].Spent.Add(spent...)
+	state.Buckets[last].SpentValue = state.Buckets[last].SpentValue.Add(value)
+	return state
+}
+
+func (sl SpendLimit) bucketDuration() time.Duration {
+	return sl.period / SpendLimitPeriodBuckets
+}
+
+// OnAuthenticatorAdded validates the spend limit configuration.
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, _, _, err := parseSpendLimitConfig(config)
+	return err
+}
+
+// OnAuthenticatorRemoved deletes the spending tracked for the authenticator.
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	store := ctx.KVStore(sl.storeKey)
+	store.Delete(types.KeySpendLimitState(account, authenticatorId))
+	store.Delete(types.KeySpendLimitSnapshot(account, authenticatorId))
+	return nil
+}
+
+// GetSpendLimitState returns the spending of the initialized authenticator in its rolling period
+// at the block time, without the buckets that are entirely older than the period.
+func (sl SpendLimit) GetSpendLimitState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitState, error) {
+	var state SpendLimitState
+	key := types.KeySpendLimitState(account, authenticatorId)
+	if !ctx.KVStore(sl.storeKey).Has(key) {
+		return state, nil
+	}
+	if err := sl.getJSON(ctx, key, &state); err != nil {
+		return SpendLimitState{}, err
+	}
+
+	windowStart := ctx.BlockTime().Add(-sl.period)
+	buckets := state.Buckets[:0]
+	for _, bucket := range state.Buckets {
+		if bucket.Start.Add(sl.bucketDuration()).After(windowStart) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	state.Buckets = buckets
+	return state, nil
+}
+
+// trackedDenoms returns the denoms whose balances need to be compared to compute the spending.
+func (sl SpendLimit) trackedDenoms() []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	add := func(denom string) {
+		if !seen[denom] {
+			seen[denom] = true
+			denoms = append(denoms, denom)
+		}
+	}
+	for _, limit := range sl.config.Limits {
+		add(limit.Denom)
+	}
+	if sl.config.QuoteDenom != "" {
+		add(sl.config.QuoteDenom)
+		for _, route := range sl.config.PriceRoutes {
+			add(route.Denom)
+		}
+	}
+	return denoms
+}
+
+// quoteValue returns the value of coins in the quote denom. Denoms without a price route are not valued.
+func (sl SpendLimit) quoteValue(ctx sdk.Context, coins sdk.Coins) (osmomath.Int, error) {
+	value := osmomath.ZeroInt()
+	value = value.Add(coins.AmountOf(sl.config.QuoteDenom))
+	startTime := ctx.BlockTime().Add(-sl.twapWindow)
+	for _, route := range sl.config.PriceRoutes {
+		amount := coins.AmountOf(route.Denom)
+		if amount.IsZero() {
+			continue
+		}
+		price, err := sl.twapKeeper.GetArithmeticTwapToNow(ctx, route.PoolId, route.Denom, sl.config.QuoteDenom, startTime)
+		if err != nil {
+			return osmomath.Int{}, errorsmod.Wrapf(err, "failed to price %s in %s using pool %d", route.Denom, sl.config.QuoteDenom, route.PoolId)
+		}
+		// Round up so that the limit can never be bypassed through rounding.
+		value = value.Add(price.MulInt(amount).Ceil().TruncateInt())
+	}
+	return value, nil
+}
+
+func (sl SpendLimit) setJSON(ctx sdk.Context, key []byte, value interface{}) error {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal spend limit data")
+	}
+	ctx.KVStore(sl.storeKey).Set(key, bz)
+	return nil
+}
+
+func (sl SpendLimit) getJSON(ctx sdk.Context, key []byte, value interface{}) error {
+	bz := ctx.KVStore(sl.storeKey).Get(key)
+	if err := json.Unmarshal(bz, value); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal spend limit data")
+	}
+	return nil
+}
+
+// parseSpendLimitConfig decodes and validates a SpendLimit configuration.
+func parseSpendLimitConfig(config []byte) (SpendLimitConfig, time.Duration, time.Duration, error) {
+	var parsed SpendLimitConfig
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid spend limit configuration")
+	}
+
+	period, err := time.ParseDuration(parsed.Period)
+	if err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid spend limit period")
+	}
+	if period < MinSpendLimitPeriod {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "spend limit period must be at least %s", MinSpendLimitPeriod)
+	}
+
+	if err := parsed.Limits.Validate(); err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid spend limits")
+	}
+
+	twapWindow := DefaultSpendLimitTwapWindow
+	if parsed.QuoteDenom == "" {
+		if parsed.Limits.Empty() {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "spend limit must set per denom limits or a quote limit")
+		}
+		if len(parsed.PriceRoutes) > 0 {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "price routes require a quote denom")
+		}
+		return parsed, period, twapWindow, nil
+	}
+
+	if err := sdk.ValidateDenom(parsed.QuoteDenom); err != nil {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid quote denom")
+	}
+	if parsed.QuoteLimit.IsNil() || parsed.QuoteLimit.IsNegative() {
+		return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "quote limit must be set to a non-negative amount")
+	}
+	seen := make(map[string]bool)
+	for _, route := range parsed.PriceRoutes {
+		if err := sdk.ValidateDenom(route.Denom); err != nil {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid price route denom")
+		}
+		if route.Denom == parsed.QuoteDenom {
+			return SpendLimitConfig{}, 0, 0, fmt.Errorf("price route for %s is not needed, it is the quote denom", route.Denom)
+		}
+		if seen[route.Denom] {
+			return SpendLimitConfig{}, 0, 0, fmt.Errorf("duplicate price route for %s", route.Denom)
+		}
+		seen[route.Denom] = true
+	}
+	if parsed.TwapWindow != "" {
+		twapWindow, err = time.ParseDuration(parsed.TwapWindow)
+		if err != nil {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(err, "invalid twap window")
+		}
+		if twapWindow <= 0 {
+			return SpendLimitConfig{}, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "twap window must be positive")
+		}
+	}
+
+	return parsed, period, twapWindow, nil
+}
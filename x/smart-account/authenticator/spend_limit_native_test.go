@@ -0,0 +1,153 @@
+package authenticator_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+// mockTwapKeeper prices every denom at a fixed price in the quote denom.
+type mockTwapKeeper struct {
+	price osmomath.Dec
+}
+
+func (m mockTwapKeeper) GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error) {
+	return m.price, nil
+}
+
+type NativeSpendLimitTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimit authenticator.SpendLimit
+}
+
+func TestNativeSpendLimitTest(t *testing.T) {
+	suite.Run(t, new(NativeSpendLimitTest))
+}
+
+func (s *NativeSpendLimitTest) SetupTest() {
+	s.SetupKeys()
+	s.SpendLimit = authenticator.NewSpendLimit(
+		s.OsmosisApp.GetKVStoreKey()[smartaccounttypes.StoreKey],
+		s.OsmosisApp.BankKeeper,
+		mockTwapKeeper{price: osmomath.NewDec(2)},
+	)
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	s.FundAcc(s.TestAccAddress[0], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000), sdk.NewInt64Coin("uatom", 10_000)))
+}
+
+// spend runs Track, sends coins out of the account and runs ConfirmExecution.
+func (s *NativeSpendLimitTest) spend(auth authenticator.Authenticator, coins sdk.Coins) error {
+	request := authenticator.AuthenticationRequest{
+		AuthenticatorId: "1",
+		Account:         s.TestAccAddress[0],
+	}
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[1], coins))
+	return auth.ConfirmExecution(s.Ctx, request)
+}
+
+func (s *NativeSpendLimitTest) TestOnAuthenticatorAdded() {
+	tests := []struct {
+		name    string
+		config  string
+		success bool
+	}{
+		{"per denom limit", `{"limits":[{"denom":"uosmo","amount":"100"}],"period":"24h"}`, true},
+		{"quote limit", `{"quote_denom":"uusdc","quote_limit":"100","price_routes":[{"denom":"uosmo","pool_id":1}],"period":"1h"}`, true},
+		{"no limits", `{"period":"24h"}`, false},
+		{"missing period", `{"limits":[{"denom":"uosmo","amount":"100"}]}`, false},
+		{"negative period", `{"limits":[{"denom":"uosmo","amount":"100"}],"period":"-1h"}`, false},
+		{"period shorter than its buckets", `{"limits":[{"denom":"uosmo","amount":"100"}],"period":"10s"}`, false},
+		{"quote denom without limit", `{"quote_denom":"uusdc","period":"24h"}`, false},
+		{"price routes without quote denom", `{"limits":[{"denom":"uosmo","amount":"100"}],"price_routes":[{"denom":"uosmo","pool_id":1}],"period":"24h"}`, false},
+		{"duplicate price route", `{"quote_denom":"uusdc","quote_limit":"100","price_routes":[{"denom":"uosmo","pool_id":1},{"denom":"uosmo","pool_id":2}],"period":"24h"}`, false},
+		{"invalid json", `{`, false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(tc.config), "1")
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *NativeSpendLimitTest) TestPerDenomLimit() {
+	auth, err := s.SpendLimit.Initialize([]byte(`{"limits":[{"denom":"uosmo","amount":"1000"}],"period":"24h"}`))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600))))
+	// untracked denoms are not limited
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5_000))))
+	s.Require().Error(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 500))))
+
+	state, err := auth.(authenticator.SpendLimit).GetSpendLimitState(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600)), state.Spent())
+
+	// the spending is released once the period and its bucket have elapsed
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(25 * time.Hour))
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))))
+}
+
+func (s *NativeSpendLimitTest) TestRollingPeriod() {
+	auth, err := s.SpendLimit.Initialize([]byte(`{"limits":[{"denom":"uosmo","amount":"1000"}],"period":"24h"}`))
+	s.Require().NoError(err)
+	// block times are aligned on the one hour buckets of the 24h period.
+	start := time.Unix(1_000_000, 0).Truncate(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(start)
+
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600))))
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(12 * time.Hour))
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400))))
+
+	// a fixed window starting with the first spend would be reset here, the rolling one still counts the first spend.
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(24 * time.Hour))
+	s.Require().Error(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1))))
+
+	// the first spend is released once its bucket is older than the period, but not the second one.
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(25 * time.Hour))
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600))))
+	s.Require().Error(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1))))
+
+	state, err := auth.(authenticator.SpendLimit).GetSpendLimitState(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Len(state.Buckets, 2)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)), state.Spent())
+}
+
+func (s *NativeSpendLimitTest) TestQuoteLimit() {
+	auth, err := s.SpendLimit.Initialize([]byte(`{"quote_denom":"uatom","quote_limit":"1000","price_routes":[{"denom":"uosmo","pool_id":1}],"period":"1h"}`))
+	s.Require().NoError(err)
+
+	// 300uosmo are worth 600uatom
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 300))))
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uatom", 400))))
+	s.Require().Error(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))))
+
+	state, err := auth.(authenticator.SpendLimit).GetSpendLimitState(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1000), state.SpentValue())
+}
+
+func (s *NativeSpendLimitTest) TestOnAuthenticatorRemoved() {
+	auth, err := s.SpendLimit.Initialize([]byte(`{"limits":[{"denom":"uosmo","amount":"1000"}],"period":"24h"}`))
+	s.Require().NoError(err)
+	s.Require().NoError(s.spend(auth, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))))
+
+	s.Require().NoError(s.SpendLimit.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], nil, "1"))
+	state, err := auth.(authenticator.SpendLimit).GetSpendLimitState(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Empty(state.Buckets)
+}
@@ -0,0 +1,244 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WeightedSubAuthenticatorInitData is the initialization data of a ThresholdOf sub-authenticator.
+type WeightedSubAuthenticatorInitData struct {
+	Type   string `json:"type"`
+	Config []byte `json:"config"`
+	Weight uint64 `json:"weight"`
+}
+
+// ThresholdOfConfig is the configuration of a ThresholdOf authenticator.
+type ThresholdOfConfig struct {
+	Threshold         uint64                             `json:"threshold"`
+	SubAuthenticators []WeightedSubAuthenticatorInitData `json:"sub_authenticators"`
+}
+
+// ThresholdOf is a composite authenticator that passes once the weights of the sub-authenticators that
+// authenticated the request reach the configured threshold (M-of-N).
+//
+// Only the sub-authenticators that verify a signature (see SignatureVerifier) add their weight. The others,
+// like MessageFilter, must still authenticate the request if they participate, but can't make up for a
+// missing signer. Configurations whose signers' weights can't reach the threshold are rejected.
+//
+// Signatures are always partitioned, with one entry per sub-authenticator. A sub-authenticator participates
+// only if its entry is non-empty, and every participating sub-authenticator must authenticate the request.
+// This makes the set of contributing sub-authenticators derivable from the request, so that Track and
+// ConfirmExecution are only called on them.
+type ThresholdOf struct {
+	SubAuthenticators []Authenticator
+	Weights           []uint64
+	Threshold         uint64
+	am                *AuthenticatorManager
+}
+
+var _ Authenticator = &ThresholdOf{}
+
+func NewThresholdOf(am *AuthenticatorManager) ThresholdOf {
+	return ThresholdOf{
+		am:                am,
+		SubAuthenticators: []Authenticator{},
+	}
+}
+
+func (toa ThresholdOf) Type() string {
+	return "ThresholdOf"
+}
+
+// VerifiesSignature returns true, the threshold can only be reached with verified signatures.
+func (toa ThresholdOf) VerifiesSignature() bool {
+	return true
+}
+
+func (toa ThresholdOf) StaticGas() uint64 {
+	var totalGas uint64
+	for _, auth := range toa.SubAuthenticators {
+		totalGas += auth.StaticGas()
+	}
+	return totalGas
+}
+
+func (toa ThresholdOf) Initialize(config []byte) (Authenticator, error) {
+	parsed, err := parseThresholdOfConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, initData := range parsed.SubAuthenticators {
+		authenticatorCode := toa.am.GetAuthenticatorByType(initData.Type)
+		if authenticatorCode == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", initData.Type)
+		}
+		instance, err := authenticatorCode.Initialize(initData.Config)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", initData.Type)
+		}
+		toa.SubAuthenticators = append(toa.SubAuthenticators, instance)
+		toa.Weights = append(toa.Weights, initData.Weight)
+	}
+	toa.Threshold = parsed.Threshold
+
+	var signersWeight uint64
+	for i, auth := range toa.SubAuthenticators {
+		if VerifiesSignature(auth) {
+			signersWeight += toa.Weights[i]
+		}
+	}
+	if signersWeight < toa.Threshold {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"threshold %d is greater than the total weight %d of the sub-authenticators verifying signatures", toa.Threshold, signersWeight)
+	}
+
+	return toa, nil
+}
+
+func (toa ThresholdOf) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if len(toa.SubAuthenticators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticators provided")
+	}
+
+	signatures, err := splitSignatures(request.Signature, len(toa.SubAuthenticators))
+	if err != nil {
+		return err
+	}
+
+	var weight uint64
+	var participants []string
+	baseId := request.AuthenticatorId
+	for i, auth := range toa.SubAuthenticators {
+		// Sub-authenticators without a signature don't participate
+		if len(signatures[i]) == 0 {
+			continue
+		}
+
+		// update the request to include the sub-authenticator id and signature
+		request.AuthenticatorId = compositeId(baseId, i)
+		request.Signature = signatures[i]
+		if err := auth.Authenticate(ctx, request); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator failed to authenticate (sub-authenticator id = %s)", request.AuthenticatorId)
+		}
+
+		if VerifiesSignature(auth) {
+			weight += toa.Weights[i]
+			participants = append(participants, request.AuthenticatorId)
+		}
+	}
+
+	if weight < toa.Threshold {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"threshold not reached: weight %d of %d (signed by: [%s])", weight, toa.Threshold, strings.Join(participants, ", "),
+		)
+	}
+	return nil
+}
+
+// Track is only called on the sub-authenticators that contributed to the authentication.
+func (toa ThresholdOf) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	signatures, err := splitSignatures(request.Signature, len(toa.SubAuthenticators))
+	if err != nil {
+		return err
+	}
+
+	baseId := request.AuthenticatorId
+	for i, auth := range toa.SubAuthenticators {
+		if len(signatures[i]) == 0 {
+			continue
+		}
+		request.AuthenticatorId = compositeId(baseId, i)
+		request.Signature = signatures[i]
+		if err := auth.Track(ctx, request); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator track failed (sub-authenticator id = %s)", request.AuthenticatorId)
+		}
+	}
+	return nil
+}
+
+// ConfirmExecution is only called on the sub-authenticators that contributed to the authentication,
+// all of which must confirm the execution.
+func (toa ThresholdOf) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	signatures, err := splitSignatures(request.Signature, len(toa.SubAuthenticators))
+	if err != nil {
+		return err
+	}
+
+	baseId := request.AuthenticatorId
+	for i, auth := range toa.SubAuthenticators {
+		if len(signatures[i]) == 0 {
+			continue
+		}
+		request.AuthenticatorId = compositeId(baseId, i)
+		request.Signature = signatures[i]
+		if err := auth.ConfirmExecution(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (toa ThresholdOf) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	parsed, err := parseThresholdOfConfig(config)
+	if err != nil {
+		return err
+	}
+	// checks that the sub-authenticators verifying signatures can reach the threshold
+	if _, err := toa.Initialize(config); err != nil {
+		return err
+	}
+	return addSubAuthenticators(ctx, account, parsed.subAuthenticatorInitDatas(), authenticatorId, toa.am)
+}
+
+func (toa ThresholdOf) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	var parsed ThresholdOfConfig
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return err
+	}
+	return removeSubAuthenticators(ctx, account, parsed.subAuthenticatorInitDatas(), authenticatorId, toa.am)
+}
+
+func (c ThresholdOfConfig) subAuthenticatorInitDatas() []SubAuthenticatorInitData {
+	initDatas := make([]SubAuthenticatorInitData, len(c.SubAuthenticators))
+	for i, initData := range c.SubAuthenticators {
+		initDatas[i] = SubAuthenticatorInitData{Type: initData.Type, Config: initData.Config}
+	}
+	return initDatas
+}
+
+// parseThresholdOfConfig decodes the configuration and checks that the threshold can be reached.
+func parseThresholdOfConfig(config []byte) (ThresholdOfConfig, error) {
+	var parsed ThresholdOfConfig
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return ThresholdOfConfig{}, errorsmod.Wrap(err, "failed to parse thresholdOf configuration")
+	}
+
+	if len(parsed.SubAuthenticators) <= 1 {
+		return ThresholdOfConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "thresholdOf must have at least 2 sub-authenticators")
+	}
+	if parsed.Threshold == 0 {
+		return ThresholdOfConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "thresholdOf threshold must be positive")
+	}
+
+	var totalWeight uint64
+	for i, initData := range parsed.SubAuthenticators {
+		if initData.Weight == 0 {
+			return ThresholdOfConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator %d weight must be positive", i)
+		}
+		if totalWeight+initData.Weight < totalWeight {
+			return ThresholdOfConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sub-authenticator weights overflow")
+		}
+		totalWeight += initData.Weight
+	}
+	if totalWeight < parsed.Threshold {
+		return ThresholdOfConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "threshold %d is greater than the total weight %d", parsed.Threshold, totalWeight)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,208 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+type ThresholdOfAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	ThresholdOfAuth authenticator.ThresholdOf
+	spyAuth         testutils.SpyAuthenticator
+}
+
+func TestThresholdOfAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(ThresholdOfAuthenticatorTest))
+}
+
+func (s *ThresholdOfAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+	am := authenticator.NewAuthenticatorManager()
+
+	s.ThresholdOfAuth = authenticator.NewThresholdOf(am)
+	s.spyAuth = testutils.NewSpyAuthenticator(s.OsmosisApp.GetKVStoreKey()[smartaccounttypes.StoreKey])
+
+	am.RegisterAuthenticator(s.ThresholdOfAuth)
+	am.RegisterAuthenticator(s.spyAuth)
+}
+
+// spyConfig builds a ThresholdOf configuration with one spy per weight.
+func (s *ThresholdOfAuthenticatorTest) spyConfig(threshold uint64, spies []testutils.SpyAuthenticatorData, weights []uint64) []byte {
+	config := authenticator.ThresholdOfConfig{Threshold: threshold}
+	for i, spy := range spies {
+		spyData, err := json.Marshal(spy)
+		s.Require().NoError(err)
+		config.SubAuthenticators = append(config.SubAuthenticators, authenticator.WeightedSubAuthenticatorInitData{
+			Type:   s.spyAuth.Type(),
+			Config: spyData,
+			Weight: weights[i],
+		})
+	}
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *ThresholdOfAuthenticatorTest) signatures(signatures ...string) []byte {
+	sigs := make([][]byte, len(signatures))
+	for i, sig := range signatures {
+		sigs[i] = []byte(sig)
+	}
+	bz, err := json.Marshal(sigs)
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *ThresholdOfAuthenticatorTest) TestAuthenticate() {
+	spies := []testutils.SpyAuthenticatorData{
+		{Name: "a", VerifiesSignature: true},
+		{Name: "b", VerifiesSignature: true},
+		{Name: "c", VerifiesSignature: true, Failure: testutils.AUTHENTICATE_FAIL},
+	}
+
+	tests := []struct {
+		name      string
+		threshold uint64
+		weights   []uint64
+		signature []byte
+		success   bool
+	}{
+		{"2-of-3 with two signatures", 2, []uint64{1, 1, 1}, s.signatures("a", "b", ""), true},
+		{"2-of-3 with one signature", 2, []uint64{1, 1, 1}, s.signatures("a", "", ""), false},
+		{"2-of-3 with an invalid signature", 2, []uint64{1, 1, 1}, s.signatures("a", "b", "c"), false},
+		{"weighted signer reaches the threshold alone", 3, []uint64{3, 1, 1}, s.signatures("a", "", ""), true},
+		{"weighted signers below the threshold", 3, []uint64{3, 1, 1}, s.signatures("", "b", ""), false},
+		{"wrong number of signatures", 2, []uint64{1, 1, 1}, s.signatures("a", "b"), false},
+		{"non partitioned signature", 1, []uint64{1, 1, 1}, []byte("a"), false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			auth, err := s.ThresholdOfAuth.Initialize(s.spyConfig(tc.threshold, spies, tc.weights))
+			s.Require().NoError(err)
+
+			request := authenticator.AuthenticationRequest{
+				AuthenticatorId: "1",
+				Account:         s.TestAccAddress[0],
+				Signature:       tc.signature,
+			}
+			err = auth.Authenticate(s.Ctx, request)
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *ThresholdOfAuthenticatorTest) TestOnlySignersAddWeight() {
+	// c stands for a sub-authenticator that doesn't verify signatures, like a MessageFilter.
+	spies := []testutils.SpyAuthenticatorData{
+		{Name: "a", VerifiesSignature: true},
+		{Name: "b", VerifiesSignature: true},
+		{Name: "c"},
+	}
+	auth, err := s.ThresholdOfAuth.Initialize(s.spyConfig(2, spies, []uint64{1, 1, 5}))
+	s.Require().NoError(err)
+
+	request := authenticator.AuthenticationRequest{
+		AuthenticatorId: "1",
+		Account:         s.TestAccAddress[0],
+		Signature:       s.signatures("a", "", "c"),
+	}
+	s.Require().ErrorContains(auth.Authenticate(s.Ctx, request), "threshold not reached: weight 1 of 2")
+
+	request.Signature = s.signatures("a", "b", "c")
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+
+	// a participating non-signer must still authenticate the request
+	spies[2].Failure = testutils.AUTHENTICATE_FAIL
+	auth, err = s.ThresholdOfAuth.Initialize(s.spyConfig(2, spies, []uint64{1, 1, 5}))
+	s.Require().NoError(err)
+	s.Require().Error(auth.Authenticate(s.Ctx, request))
+	request.Signature = s.signatures("a", "b", "")
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+}
+
+func (s *ThresholdOfAuthenticatorTest) TestOnlyContributorsAreTracked() {
+	spies := []testutils.SpyAuthenticatorData{{Name: "a", VerifiesSignature: true}, {Name: "b", VerifiesSignature: true}, {Name: "c", VerifiesSignature: true}}
+	auth, err := s.ThresholdOfAuth.Initialize(s.spyConfig(2, spies, []uint64{1, 1, 1}))
+	s.Require().NoError(err)
+
+	request := authenticator.AuthenticationRequest{
+		AuthenticatorId: "1",
+		Account:         s.TestAccAddress[0],
+		Signature:       s.signatures("a", "", "c"),
+	}
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+
+	for _, tc := range []struct {
+		name        string
+		contributed bool
+		id          string
+	}{
+		{"a", true, "1.0"},
+		{"b", false, ""},
+		{"c", true, "1.2"},
+	} {
+		spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: tc.name}
+		calls := spy.GetLatestCalls(s.Ctx)
+		s.Require().Equal(tc.id, calls.Track.AuthenticatorId, tc.name)
+		s.Require().Equal(tc.id, calls.ConfirmExecution.AuthenticatorId, tc.name)
+		if tc.contributed {
+			s.Require().Equal([]byte(tc.name), calls.ConfirmExecution.Signature, tc.name)
+		}
+	}
+
+	// a contributor blocking the execution blocks the whole authenticator
+	spies[2].Failure = testutils.CONFIRM_EXECUTION_FAIL
+	auth, err = s.ThresholdOfAuth.Initialize(s.spyConfig(2, spies, []uint64{1, 1, 1}))
+	s.Require().NoError(err)
+	s.Require().Error(auth.ConfirmExecution(s.Ctx, request))
+
+	// unless it didn't contribute
+	request.Signature = s.signatures("a", "b", "")
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+}
+
+func (s *ThresholdOfAuthenticatorTest) TestOnAuthenticatorAdded() {
+	spies := []testutils.SpyAuthenticatorData{{Name: "a", VerifiesSignature: true}, {Name: "b", VerifiesSignature: true}, {Name: "c", VerifiesSignature: true}}
+
+	tests := []struct {
+		name    string
+		config  []byte
+		success bool
+	}{
+		{"valid", s.spyConfig(2, spies, []uint64{1, 1, 1}), true},
+		{"threshold equal to the total weight", s.spyConfig(3, spies, []uint64{1, 1, 1}), true},
+		{"threshold greater than the total weight", s.spyConfig(4, spies, []uint64{1, 1, 1}), false},
+		{"zero threshold", s.spyConfig(0, spies, []uint64{1, 1, 1}), false},
+		{"zero weight", s.spyConfig(2, spies, []uint64{1, 0, 1}), false},
+		{"single sub-authenticator", s.spyConfig(1, spies[:1], []uint64{1}), false},
+		{"threshold greater than the signers weight", s.spyConfig(3, []testutils.SpyAuthenticatorData{spies[0], spies[1], {Name: "c"}}, []uint64{1, 1, 1}), false},
+		{"unregistered sub-authenticator", []byte(`{"threshold":1,"sub_authenticators":[{"type":"Unknown","weight":1},{"type":"Unknown","weight":1}]}`), false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.ThresholdOfAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			if tc.success {
+				s.Require().NoError(err)
+				spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: "c"}
+				s.Require().Equal("1.2", spy.GetLatestCalls(s.Ctx).OnAuthenticatorAdded.AuthenticatorId)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
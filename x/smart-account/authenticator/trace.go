@@ -0,0 +1,63 @@
+package authenticator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+// TraceAuthenticate calls Authenticate on the authenticator and, for composite authenticators, on each of
+// their sub-authenticators with the request they would receive. The calls are made on discarded cache
+// contexts, so no state is modified. It is used to explain why an authenticator passes or fails.
+func TraceAuthenticate(ctx sdk.Context, auth Authenticator, request AuthenticationRequest) types.AuthenticationTrace {
+	trace := types.AuthenticationTrace{
+		AuthenticatorId: request.AuthenticatorId,
+		Type:            auth.Type(),
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	err := auth.Authenticate(cacheCtx, request)
+	trace.Passed = err == nil
+	if err != nil {
+		trace.Error = err.Error()
+	}
+
+	subAuthenticators, partitioned := subAuthenticatorsOf(auth)
+	var signatures [][]byte
+	if partitioned {
+		// If the signatures can't be split, the error is already part of the trace of the composite
+		// authenticator, and the sub-authenticators are traced with the whole signature.
+		signatures, _ = splitSignatures(request.Signature, len(subAuthenticators))
+	}
+
+	baseId := request.AuthenticatorId
+	for i, sub := range subAuthenticators {
+		subRequest := request
+		subRequest.AuthenticatorId = compositeId(baseId, i)
+		if signatures != nil {
+			subRequest.Signature = signatures[i]
+		}
+		trace.SubTraces = append(trace.SubTraces, TraceAuthenticate(ctx, sub, subRequest))
+	}
+	return trace
+}
+
+// subAuthenticatorsOf returns the sub-authenticators of the composite authenticators, and whether the
+// signature is partitioned between them.
+func subAuthenticatorsOf(auth Authenticator) ([]Authenticator, bool) {
+	switch a := auth.(type) {
+	case AnyOf:
+		return a.SubAuthenticators, a.signatureAssignment == Partitioned
+	case AllOf:
+		return a.SubAuthenticators, a.signatureAssignment == Partitioned
+	case ThresholdOf:
+		return a.SubAuthenticators, true
+	case Session:
+		if a.SubAuthenticator == nil {
+			return nil, false
+		}
+		return []Authenticator{a.SubAuthenticator}, false
+	default:
+		return nil, false
+	}
+}
@@ -1,17 +1,31 @@
 package cli
 
 import (
+	"os"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
 )
 
+const (
+	FlagMsgIndex  = "msg-index"
+	FlagSignature = "signature"
+)
+
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticator)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdUnorderedNonces)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticatorUsage)
+	cmd.AddCommand(GetCmdDryRunAuthentication())
 
 	return cmd
 }
@@ -34,6 +48,88 @@ func GetCmdAuthenticator() (*osmocli.QueryDescriptor, *types.GetAuthenticatorReq
 	}, &types.GetAuthenticatorRequest{}
 }
 
+func GetCmdUnorderedNonces() (*osmocli.QueryDescriptor, *types.UnorderedNoncesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "unordered-nonces",
+		Short: "Query the nonces used by an account in unordered transactions and the accepted timeout heights",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &types.UnorderedNoncesRequest{}
+}
+
+func GetCmdAuthenticatorUsage() (*osmocli.QueryDescriptor, *types.GetAuthenticatorUsageRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "authenticator-usage",
+		Short: "Query the use count and last used height of an authenticator by account and id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 17`,
+	}, &types.GetAuthenticatorUsageRequest{}
+}
+
+// GetCmdDryRunAuthentication returns the command to dry-run an authenticator on a message of a transaction.
+func GetCmdDryRunAuthentication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-authentication [account] [authenticator-id] [tx-json-file]",
+		Short: "Run an authenticator on a message of an unsigned transaction and show which sub-authenticators pass or fail",
+		Long: `Run an authenticator on a message of an unsigned transaction, as generated with --generate-only,
+and show which sub-authenticators pass or fail and why. Signatures are not verified.
+
+Example:
+osmosisd query smartaccount dry-run-authentication osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 17 tx.json --msg-index 0`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticatorId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			txJSON, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(txJSON)
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			msgIndex, err := cmd.Flags().GetUint64(FlagMsgIndex)
+			if err != nil {
+				return err
+			}
+			signature, err := cmd.Flags().GetBytesBase64(FlagSignature)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DryRunAuthentication(cmd.Context(), &types.DryRunAuthenticationRequest{
+				TxBytes:         txBytes,
+				MsgIndex:        msgIndex,
+				Account:         args[0],
+				AuthenticatorId: authenticatorId,
+				Signature:       signature,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMsgIndex, 0, "index of the message to authenticate")
+	cmd.Flags().BytesBase64(FlagSignature, nil, "base64 encoded signature passed to the authenticator")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdParams() (*osmocli.QueryDescriptor, *types.QueryParamsRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "params",
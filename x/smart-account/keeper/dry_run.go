@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+// DryRunAuthenticate runs Authenticate on the account authenticator, tracing each of its sub-authenticators,
+// and then Track if the authentication passed, for the message at msgIndex of the encoded transaction.
+//
+// The request is built as in simulation mode, so signatures are not verified and the transaction doesn't need
+// to be signed. The fee payer is assumed to be the account, and the account number and sequence are not set.
+// All calls are made on a discarded cache context.
+func (k Keeper) DryRunAuthenticate(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	authenticatorId uint64,
+	txBytes []byte,
+	msgIndex uint64,
+	signature []byte,
+) (types.DryRunAuthenticationResponse, error) {
+	var tx txtypes.Tx
+	if err := k.cdc.Unmarshal(txBytes, &tx); err != nil {
+		return types.DryRunAuthenticationResponse{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	if tx.Body == nil || msgIndex >= uint64(len(tx.Body.Messages)) {
+		return types.DryRunAuthenticationResponse{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "transaction has no message at index %d", msgIndex)
+	}
+
+	selectedAuthenticator, err := k.GetInitializedAuthenticatorForAccount(ctx, account, int(authenticatorId))
+	if err != nil {
+		return types.DryRunAuthenticationResponse{}, err
+	}
+
+	msgs := make([]authenticator.LocalAny, len(tx.Body.Messages))
+	for i, msg := range tx.Body.Messages {
+		msgs[i] = authenticator.LocalAny{
+			TypeURL: msg.TypeUrl,
+			Value:   msg.Value,
+		}
+	}
+	var fee sdk.Coins
+	if tx.AuthInfo != nil && tx.AuthInfo.Fee != nil {
+		fee = tx.AuthInfo.Fee.Amount
+	}
+
+	request := authenticator.AuthenticationRequest{
+		AuthenticatorId: strconv.FormatUint(authenticatorId, 10),
+		Account:         account,
+		FeePayer:        account,
+		Fee:             fee,
+		Msg:             msgs[msgIndex],
+		MsgIndex:        msgIndex,
+		Signature:       signature,
+		TxData: authenticator.ExplicitTxData{
+			ChainID:       ctx.ChainID(),
+			TimeoutHeight: tx.Body.TimeoutHeight,
+			Msgs:          msgs,
+			Memo:          tx.Body.Memo,
+		},
+		Simulate: true,
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	response := types.DryRunAuthenticationResponse{
+		Authenticate: authenticator.TraceAuthenticate(cacheCtx, selectedAuthenticator.Authenticator, request),
+	}
+	if response.Authenticate.Passed {
+		if err := selectedAuthenticator.Authenticator.Track(cacheCtx, request); err != nil {
+			response.TrackError = err.Error()
+		}
+	}
+	return response, nil
+}
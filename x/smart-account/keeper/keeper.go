@@ -230,6 +230,7 @@ func (k Keeper) RemoveAuthenticator(ctx sdk.Context, account sdk.AccAddress, aut
 	}
 
 	store.Delete(key)
+	store.Delete(types.KeyAuthenticatorUsage(account, authenticatorId))
 	return nil
 }
 
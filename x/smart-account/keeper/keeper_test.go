@@ -2,16 +2,22 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v25/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/keeper"
 	"github.com/osmosis-labs/osmosis/v25/x/smart-account/testutils"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
 )
 
 type KeeperTestSuite struct {
@@ -209,3 +215,138 @@ func (s *KeeperTestSuite) TestKeeper_GetSelectedAuthenticatorForAccount() {
 	s.Require().Equal(selectedAuthenticator.Id, uint64(0), "Incorrect ID returned from store")
 	s.Require().Equal(selectedAuthenticator.Authenticator, nil, "Returned authenticator from store but nothing registered in manager")
 }
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredSessionAuthenticators() {
+	ctx := s.Ctx
+
+	// Set up account
+	key := "6cf5103c60c939a5f38e383b52239c5296c968579eec1c68a47d70fbf1d19159"
+	bz, _ := hex.DecodeString(key)
+	priv := &secp256k1.PrivKey{Key: bz}
+	accAddress := sdk.AccAddress(priv.PubKey().Address())
+
+	notAfter := ctx.BlockTime().Add(time.Hour)
+	config, err := json.Marshal(authenticator.SessionConfig{
+		NotAfter: &notAfter,
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{
+			Type:   authenticator.SignatureVerificationType,
+			Config: priv.PubKey().Bytes(),
+		},
+	})
+	s.Require().NoError(err)
+
+	id, err := s.App.SmartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.SessionType, config)
+	s.Require().NoError(err, "Should successfully add a Session")
+
+	// The session has not expired yet
+	s.App.SmartAccountKeeper.RemoveExpiredSessionAuthenticators(ctx)
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(ctx, accAddress)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+
+	// Once expired, the session is removed from the account
+	ctx = ctx.WithBlockTime(notAfter)
+	s.App.SmartAccountKeeper.RemoveExpiredSessionAuthenticators(ctx)
+	authenticators, err = s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(ctx, accAddress)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 0)
+	s.Require().False(ctx.KVStore(s.App.GetKVStoreKey()[types.StoreKey]).Has(types.KeySessionExpiry(notAfter, accAddress, id)))
+}
+
+func (s *KeeperTestSuite) TestKeeper_PruneExpiredUnorderedNonces() {
+	ctx := s.Ctx.WithBlockHeight(100)
+	k := s.App.SmartAccountKeeper
+	accAddress := s.TestAccs[0]
+
+	k.UseUnorderedNonce(ctx, accAddress, 1, 100)
+	k.UseUnorderedNonce(ctx, accAddress, 2, 150)
+	s.Require().Error(k.ValidateUnorderedNonce(ctx, accAddress, 1, 120), "Used nonces can't be used again")
+	s.Require().NoError(k.ValidateUnorderedNonce(ctx, s.TestAccs[1], 1, 120), "Nonces are per account")
+
+	// Nonces are kept until their timeout height has passed
+	k.PruneExpiredUnorderedNonces(ctx.WithBlockHeight(99))
+	res, err := k.UnorderedNonces(ctx, &types.UnorderedNoncesRequest{Account: accAddress.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UnorderedNonce{{Nonce: 1, TimeoutHeight: 100}, {Nonce: 2, TimeoutHeight: 150}}, res.UsedNonces)
+	s.Require().Equal(uint64(100), res.MinTimeoutHeight)
+	s.Require().Equal(uint64(100+keeper.MaxUnorderedTimeoutHeightDelta), res.MaxTimeoutHeight)
+
+	k.PruneExpiredUnorderedNonces(ctx)
+	res, err = k.UnorderedNonces(ctx, &types.UnorderedNoncesRequest{Account: accAddress.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UnorderedNonce{{Nonce: 2, TimeoutHeight: 150}}, res.UsedNonces)
+	s.Require().NoError(k.ValidateUnorderedNonce(ctx.WithBlockHeight(101), accAddress, 1, 120), "Pruned nonces can be used again")
+	s.Require().False(ctx.KVStore(s.App.GetKVStoreKey()[types.StoreKey]).Has(types.KeyUnorderedNonceExpiry(100, accAddress, 1)))
+}
+
+func (s *KeeperTestSuite) TestKeeper_AuthenticatorUsage() {
+	ctx := s.Ctx.WithBlockHeight(10)
+	k := s.App.SmartAccountKeeper
+	accAddress := s.TestAccs[0]
+
+	id, err := k.AddAuthenticator(ctx, accAddress, authenticator.SignatureVerificationType, secp256k1.GenPrivKey().PubKey().Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuthenticatorUsage{}, k.GetAuthenticatorUsageForAccount(ctx, accAddress, id))
+
+	k.RecordAuthenticatorUsage(ctx, accAddress, id)
+	k.RecordAuthenticatorUsage(ctx.WithBlockHeight(12), accAddress, id)
+	res, err := k.GetAuthenticatorUsage(ctx, &types.GetAuthenticatorUsageRequest{Account: accAddress.String(), AuthenticatorId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuthenticatorUsage{UseCount: 2, LastUsedHeight: 12}, res.Usage)
+
+	// The usage is deleted with the authenticator
+	s.Require().NoError(k.RemoveAuthenticator(ctx, accAddress, id))
+	s.Require().Equal(types.AuthenticatorUsage{}, k.GetAuthenticatorUsageForAccount(ctx, accAddress, id))
+}
+
+func (s *KeeperTestSuite) TestKeeper_DryRunAuthenticate() {
+	ctx := s.Ctx
+	k := s.App.SmartAccountKeeper
+	accAddress := s.TestAccs[0]
+
+	// AllOf(SignatureVerification, MessageFilter(bank send of uosmo))
+	config, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: authenticator.SignatureVerificationType, Config: secp256k1.GenPrivKey().PubKey().Bytes()},
+		{Type: "MessageFilter", Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend","amount":[{"denom":"uosmo"}]}`)},
+	})
+	s.Require().NoError(err)
+	id, err := k.AddAuthenticator(ctx, accAddress, "AllOf", config)
+	s.Require().NoError(err)
+
+	txBytes := func(denom string) []byte {
+		txBuilder := s.App.GetTxConfig().NewTxBuilder()
+		err := txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: accAddress.String(),
+			ToAddress:   s.TestAccs[1].String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+		})
+		s.Require().NoError(err)
+		bz, err := s.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		return bz
+	}
+
+	res, err := k.DryRunAuthenticate(ctx, accAddress, id, txBytes("uosmo"), 0, nil)
+	s.Require().NoError(err)
+	s.Require().True(res.Authenticate.Passed)
+	s.Require().Empty(res.TrackError)
+	s.Require().Len(res.Authenticate.SubTraces, 2)
+
+	// The trace shows which sub-authenticator failed
+	res, err = k.DryRunAuthenticate(ctx, accAddress, id, txBytes("uion"), 0, nil)
+	s.Require().NoError(err)
+	s.Require().False(res.Authenticate.Passed)
+	s.Require().True(res.Authenticate.SubTraces[0].Passed)
+	s.Require().Equal(fmt.Sprintf("%d.0", id), res.Authenticate.SubTraces[0].AuthenticatorId)
+	s.Require().False(res.Authenticate.SubTraces[1].Passed)
+	s.Require().Equal("MessageFilter", res.Authenticate.SubTraces[1].Type)
+	s.Require().Contains(res.Authenticate.SubTraces[1].Error, "message does not match pattern")
+
+	// Nothing is persisted
+	s.Require().Equal(types.AuthenticatorUsage{}, k.GetAuthenticatorUsageForAccount(ctx, accAddress, id))
+
+	_, err = k.DryRunAuthenticate(ctx, accAddress, id, txBytes("uosmo"), 1, nil)
+	s.Require().Error(err, "Message index out of range")
+	_, err = k.DryRunAuthenticate(ctx, accAddress, id+1, txBytes("uosmo"), 0, nil)
+	s.Require().Error(err, "Unknown authenticator")
+}
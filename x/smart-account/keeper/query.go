@@ -55,3 +55,69 @@ func (k Keeper) GetAuthenticator(
 
 	return &types.GetAuthenticatorResponse{AccountAuthenticator: authenticator}, nil
 }
+
+func (k Keeper) UnorderedNonces(
+	ctx context.Context,
+	request *types.UnorderedNoncesRequest,
+) (*types.UnorderedNoncesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nonces, err := k.GetUsedUnorderedNonces(sdkCtx, acc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	minTimeoutHeight, maxTimeoutHeight := k.UnorderedTimeoutHeightWindow(sdkCtx)
+	return &types.UnorderedNoncesResponse{
+		UsedNonces:       nonces,
+		MinTimeoutHeight: minTimeoutHeight,
+		MaxTimeoutHeight: maxTimeoutHeight,
+	}, nil
+}
+
+func (k Keeper) GetAuthenticatorUsage(
+	ctx context.Context,
+	request *types.GetAuthenticatorUsageRequest,
+) (*types.GetAuthenticatorUsageResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	usage := k.GetAuthenticatorUsageForAccount(sdkCtx, acc, request.AuthenticatorId)
+	return &types.GetAuthenticatorUsageResponse{Usage: usage}, nil
+}
+
+func (k Keeper) DryRunAuthentication(
+	ctx context.Context,
+	request *types.DryRunAuthenticationRequest,
+) (*types.DryRunAuthenticationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response, err := k.DryRunAuthenticate(sdkCtx, acc, request.AuthenticatorId, request.TxBytes, request.MsgIndex, request.Signature)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &response, nil
+}
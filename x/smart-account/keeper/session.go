@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+// MaxExpiredSessionsRemovedPerBlock bounds the work done by the EndBlocker. Remaining expired sessions
+// are removed in the following blocks.
+const MaxExpiredSessionsRemovedPerBlock = 100
+
+// RemoveExpiredSessionAuthenticators removes the Session authenticators that have expired, either because
+// their not_after time has passed or because their uses have been consumed.
+func (k Keeper) RemoveExpiredSessionAuthenticators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeySessionExpiryPrefixId())
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid() && len(expiredKeys) < MaxExpiredSessionsRemovedPerBlock; iterator.Next() {
+		expiry, _, _, err := types.ParseSessionExpiryKey(iterator.Key())
+		if err == nil && expiry.After(ctx.BlockTime()) {
+			break
+		}
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		// The index entry is deleted by the authenticator's OnAuthenticatorRemoved, but we also delete it
+		// here so that entries of authenticators that no longer exist don't block the index.
+		store.Delete(key)
+
+		_, account, id, err := types.ParseSessionExpiryKey(key)
+		if err != nil {
+			k.Logger(ctx).Error("invalid session expiry key", "key", string(key), "error", err)
+			continue
+		}
+		if !store.Has(types.KeyAccountId(account, id)) {
+			continue
+		}
+
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.RemoveAuthenticator(cacheCtx, account, id)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to remove expired session authenticator", "account", account, "id", id, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSessionAuthenticatorExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, account.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorId, strconv.FormatUint(id, 10)),
+		))
+	}
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+const (
+	// MaxUnorderedTimeoutHeightDelta is the maximum number of blocks in the future that the timeout height
+	// of an unordered transaction can be set to. It bounds how long used nonces have to be stored.
+	MaxUnorderedTimeoutHeightDelta = 600
+
+	// MaxExpiredUnorderedNoncesPrunedPerBlock bounds the work done by the EndBlocker. Remaining expired
+	// nonces are pruned in the following blocks.
+	MaxExpiredUnorderedNoncesPrunedPerBlock = 1000
+)
+
+// UnorderedNonceReplayProtection returns a replay protection that ignores the account sequence and instead
+// requires the nonce to not have been used by the account in a transaction that hasn't timed out yet.
+// The nonce must be marked as used with UseUnorderedNonce once the transaction is authenticated.
+func (k Keeper) UnorderedNonceReplayProtection(ctx sdk.Context, account sdk.AccAddress, nonce uint64) authenticator.ReplayProtection {
+	return func(txData *authenticator.ExplicitTxData, _ *signing.SignatureV2) error {
+		return k.ValidateUnorderedNonce(ctx, account, nonce, txData.TimeoutHeight)
+	}
+}
+
+// ValidateUnorderedNonce checks that the nonce can be used by the account in a transaction with the given
+// timeout height.
+func (k Keeper) ValidateUnorderedNonce(ctx sdk.Context, account sdk.AccAddress, nonce, timeoutHeight uint64) error {
+	if nonce == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered nonce must be positive")
+	}
+	if timeoutHeight == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transactions must set a timeout height")
+	}
+
+	minTimeoutHeight, maxTimeoutHeight := k.UnorderedTimeoutHeightWindow(ctx)
+	if timeoutHeight < minTimeoutHeight {
+		return errorsmod.Wrapf(sdkerrors.ErrTxTimeoutHeight, "timeout height %d has passed", timeoutHeight)
+	}
+	if timeoutHeight > maxTimeoutHeight {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"unordered transaction timeout height %d is more than %d blocks in the future", timeoutHeight, MaxUnorderedTimeoutHeightDelta)
+	}
+
+	if ctx.KVStore(k.storeKey).Has(types.KeyUnorderedNonce(account, nonce)) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidSequence, "unordered nonce %d has already been used", nonce)
+	}
+	return nil
+}
+
+// UseUnorderedNonce marks the nonce as used by the account until the timeout height has passed.
+func (k Keeper) UseUnorderedNonce(ctx sdk.Context, account sdk.AccAddress, nonce, timeoutHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyUnorderedNonce(account, nonce), &types.UnorderedNonce{
+		Nonce:         nonce,
+		TimeoutHeight: timeoutHeight,
+	})
+	store.Set(types.KeyUnorderedNonceExpiry(timeoutHeight, account, nonce), []byte{})
+}
+
+// GetUsedUnorderedNonces returns the nonces used by the account in unordered transactions that haven't been pruned.
+func (k Keeper) GetUsedUnorderedNonces(ctx sdk.Context, account sdk.AccAddress) ([]types.UnorderedNonce, error) {
+	return osmoutils.GatherValuesFromStorePrefix(
+		ctx.KVStore(k.storeKey),
+		types.KeyAccountUnorderedNonces(account),
+		func(bz []byte) (types.UnorderedNonce, error) {
+			var nonce types.UnorderedNonce
+			err := k.cdc.Unmarshal(bz, &nonce)
+			return nonce, err
+		},
+	)
+}
+
+// UnorderedTimeoutHeightWindow returns the range of timeout heights accepted for unordered transactions
+// in the current block.
+func (k Keeper) UnorderedTimeoutHeightWindow(ctx sdk.Context) (uint64, uint64) {
+	height := uint64(ctx.BlockHeight())
+	return height, height + MaxUnorderedTimeoutHeightDelta
+}
+
+// PruneExpiredUnorderedNonces deletes the nonces of unordered transactions that have timed out. Once the
+// timeout height of a transaction has passed it can't be included in a block anymore, so its nonce
+// doesn't need to be remembered.
+func (k Keeper) PruneExpiredUnorderedNonces(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyUnorderedNonceExpiryPrefixId())
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid() && len(expiredKeys) < MaxExpiredUnorderedNoncesPrunedPerBlock; iterator.Next() {
+		timeoutHeight, _, _, err := types.ParseUnorderedNonceExpiryKey(iterator.Key())
+		if err == nil && timeoutHeight > uint64(ctx.BlockHeight()) {
+			break
+		}
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+
+		_, account, nonce, err := types.ParseUnorderedNonceExpiryKey(key)
+		if err != nil {
+			k.Logger(ctx).Error("invalid unordered nonce expiry key", "key", string(key), "error", err)
+			continue
+		}
+		store.Delete(types.KeyUnorderedNonce(account, nonce))
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v25/x/smart-account/types"
+)
+
+// RecordAuthenticatorUsage increments the use count of the account authenticator and sets its last used
+// height to the current block height.
+func (k Keeper) RecordAuthenticatorUsage(ctx sdk.Context, account sdk.AccAddress, authenticatorId uint64) {
+	usage := k.GetAuthenticatorUsageForAccount(ctx, account, authenticatorId)
+	usage.UseCount++
+	usage.LastUsedHeight = ctx.BlockHeight()
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyAuthenticatorUsage(account, authenticatorId), &usage)
+}
+
+// GetAuthenticatorUsageForAccount returns the usage of the account authenticator. Authenticators that have never
+// been used have an empty usage.
+func (k Keeper) GetAuthenticatorUsageForAccount(ctx sdk.Context, account sdk.AccAddress, authenticatorId uint64) types.AuthenticatorUsage {
+	usage := types.AuthenticatorUsage{}
+	_, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyAuthenticatorUsage(account, authenticatorId), &usage)
+	if err != nil {
+		panic(err)
+	}
+	return usage
+}
@@ -29,6 +29,7 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -139,5 +140,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock removes expired session authenticators and prunes the nonces of timed out unordered transactions.
+func (am AppModule) EndBlock(context context.Context) error {
+	ctx := sdk.UnwrapSDKContext(context)
+	am.keeper.RemoveExpiredSessionAuthenticators(ctx)
+	am.keeper.PruneExpiredUnorderedNonces(ctx)
+	return nil
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return 1 }
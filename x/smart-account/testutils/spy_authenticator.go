@@ -55,6 +55,8 @@ func Has(fixed, toCheck FailureFlag) bool {
 type SpyAuthenticatorData struct {
 	Name    string      `json:"name"`
 	Failure FailureFlag `json:"failure"` // bit flag representing authenticator failure
+	// VerifiesSignature makes the spy count as a signature verifier
+	VerifiesSignature bool `json:"verifies_signature"`
 }
 
 // SpyAuthenticator tracks latest call and can be used to test the authenticator
@@ -62,6 +64,7 @@ type SpyAuthenticator struct {
 	KvStoreKey storetypes.StoreKey
 	Name       string
 	Failure    FailureFlag
+	Verifier   bool
 }
 
 func NewSpyAuthenticator(kvStoreKey storetypes.StoreKey) SpyAuthenticator {
@@ -84,9 +87,14 @@ func (s SpyAuthenticator) Initialize(config []byte) (authenticator.Authenticator
 	}
 	s.Name = spyData.Name
 	s.Failure = spyData.Failure
+	s.Verifier = spyData.VerifiesSignature
 	return s, nil
 }
 
+func (s SpyAuthenticator) VerifiesSignature() bool {
+	return s.Verifier
+}
+
 func (s SpyAuthenticator) Authenticate(ctx sdk.Context, request authenticator.AuthenticationRequest) error {
 	s.UpdateLatestCalls(ctx, func(calls LatestCalls) LatestCalls {
 		calls.Authenticate = request
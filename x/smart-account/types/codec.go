@@ -10,6 +10,7 @@ import (
 // AuthenticatorTxOptions
 type AuthenticatorTxOptions interface {
 	GetSelectedAuthenticators() []uint64
+	GetUnorderedNonce() uint64
 }
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
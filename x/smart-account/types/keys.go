@@ -2,7 +2,9 @@ package types
 
 import (
 	fmt "fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,12 +29,20 @@ const (
 
 	AttributeKeyAccountSequenceAuthenticator = "authenticator_acc_seq"
 	AttributeKeySignatureAuthenticator       = "authenticator_signature"
+
+	TypeEvtSessionAuthenticatorExpired = "session_authenticator_expired"
 )
 
 var (
 	// Store prefix keys
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitPrefix                 = []byte{0x03}
+	KeySessionPrefix                    = []byte{0x04}
+	KeySessionExpiryPrefix              = []byte{0x05}
+	KeyUnorderedNoncePrefix             = []byte{0x06}
+	KeyUnorderedNonceExpiryPrefix       = []byte{0x07}
+	KeyAuthenticatorUsagePrefix         = []byte{0x08}
 
 	// Parameter keys
 	KeyMaximumUnauthenticatedGas = []byte("MaximumUnauthenticatedGas")
@@ -56,6 +66,102 @@ func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
 
+// KeyAuthenticatorUsage returns the key under which the usage of an account authenticator is stored.
+func KeyAuthenticatorUsage(account sdk.AccAddress, id uint64) []byte {
+	return BuildKey(KeyAuthenticatorUsagePrefix, account.String(), id)
+}
+
+// KeySpendLimitState returns the key under which the spending of a SpendLimit authenticator
+// in its rolling period is stored.
+func KeySpendLimitState(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, "state", account.String(), authenticatorId)
+}
+
+// KeySpendLimitSnapshot returns the key under which a SpendLimit authenticator stores the
+// pre-execution balances recorded in Track.
+func KeySpendLimitSnapshot(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, "snapshot", account.String(), authenticatorId)
+}
+
+// KeySessionUses returns the key under which the number of uses of a Session authenticator is stored.
+func KeySessionUses(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySessionPrefix, "uses", account.String(), authenticatorId)
+}
+
+// KeySessionExpiry returns the key of a Session authenticator in the expiry index, which is ordered by
+// expiry time so that expired sessions can be removed in the EndBlocker.
+func KeySessionExpiry(expiry time.Time, account sdk.AccAddress, authenticatorId uint64) []byte {
+	return BuildKey(KeySessionExpiryPrefix, sdk.FormatTimeString(expiry), account.String(), authenticatorId)
+}
+
+// KeySessionExpiryPrefixId returns the prefix of the Session authenticator expiry index.
+func KeySessionExpiryPrefixId() []byte {
+	return BuildKey(KeySessionExpiryPrefix)
+}
+
+// ParseSessionExpiryKey returns the expiry time, account and authenticator id encoded in a session expiry key.
+func ParseSessionExpiryKey(key []byte) (time.Time, sdk.AccAddress, uint64, error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 5 {
+		return time.Time{}, nil, 0, fmt.Errorf("invalid session expiry key %s", key)
+	}
+	expiry, err := sdk.ParseTime(parts[1])
+	if err != nil {
+		return time.Time{}, nil, 0, err
+	}
+	account, err := sdk.AccAddressFromBech32(parts[2])
+	if err != nil {
+		return time.Time{}, nil, 0, err
+	}
+	id, err := strconv.ParseUint(parts[3], 10, 64)
+	if err != nil {
+		return time.Time{}, nil, 0, err
+	}
+	return expiry, account, id, nil
+}
+
+// KeyUnorderedNonce returns the key under which a nonce used by an account in an unordered transaction is stored.
+func KeyUnorderedNonce(account sdk.AccAddress, nonce uint64) []byte {
+	return BuildKey(KeyUnorderedNoncePrefix, account.String(), nonce)
+}
+
+// KeyAccountUnorderedNonces returns the prefix of the nonces used by an account in unordered transactions.
+func KeyAccountUnorderedNonces(account sdk.AccAddress) []byte {
+	return BuildKey(KeyUnorderedNoncePrefix, account.String())
+}
+
+// KeyUnorderedNonceExpiry returns the key of a used nonce in the expiry index, which is ordered by
+// timeout height so that expired nonces can be pruned in the EndBlocker.
+func KeyUnorderedNonceExpiry(timeoutHeight uint64, account sdk.AccAddress, nonce uint64) []byte {
+	return BuildKey(KeyUnorderedNonceExpiryPrefix, fmt.Sprintf("%020d", timeoutHeight), account.String(), nonce)
+}
+
+// KeyUnorderedNonceExpiryPrefixId returns the prefix of the unordered nonce expiry index.
+func KeyUnorderedNonceExpiryPrefixId() []byte {
+	return BuildKey(KeyUnorderedNonceExpiryPrefix)
+}
+
+// ParseUnorderedNonceExpiryKey returns the timeout height, account and nonce encoded in an unordered nonce expiry key.
+func ParseUnorderedNonceExpiryKey(key []byte) (uint64, sdk.AccAddress, uint64, error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 5 {
+		return 0, nil, 0, fmt.Errorf("invalid unordered nonce expiry key %s", key)
+	}
+	timeoutHeight, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	account, err := sdk.AccAddressFromBech32(parts[2])
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	nonce, err := strconv.ParseUint(parts[3], 10, 64)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	return timeoutHeight, account, nonce, nil
+}
+
 // BuildKey creates a key by concatenating the provided elements with the key separator.
 func BuildKey(elements ...interface{}) []byte {
 	strElements := make([]string, len(elements))
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -93,8 +94,202 @@ func (m *AccountAuthenticator) GetConfig() []byte {
 	return nil
 }
 
+// UnorderedNonce is a nonce used by an account in an unordered transaction.
+// It can't be used again by the account until the timeout height of the
+// transaction has passed.
+type UnorderedNonce struct {
+	Nonce         uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TimeoutHeight uint64 `protobuf:"varint,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+}
+
+func (m *UnorderedNonce) Reset()         { *m = UnorderedNonce{} }
+func (m *UnorderedNonce) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNonce) ProtoMessage()    {}
+func (*UnorderedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{1}
+}
+func (m *UnorderedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNonce.Merge(m, src)
+}
+func (m *UnorderedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNonce proto.InternalMessageInfo
+
+func (m *UnorderedNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *UnorderedNonce) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+// AuthenticatorUsage records how an authenticator has been used to
+// authenticate messages.
+type AuthenticatorUsage struct {
+	// use_count is the number of messages the authenticator has authenticated.
+	UseCount uint64 `protobuf:"varint,1,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	// last_used_height is the height of the block in which the authenticator
+	// last authenticated a message.
+	LastUsedHeight int64 `protobuf:"varint,2,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+}
+
+func (m *AuthenticatorUsage) Reset()         { *m = AuthenticatorUsage{} }
+func (m *AuthenticatorUsage) String() string { return proto.CompactTextString(m) }
+func (*AuthenticatorUsage) ProtoMessage()    {}
+func (*AuthenticatorUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{2}
+}
+func (m *AuthenticatorUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticatorUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticatorUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticatorUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticatorUsage.Merge(m, src)
+}
+func (m *AuthenticatorUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticatorUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticatorUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticatorUsage proto.InternalMessageInfo
+
+func (m *AuthenticatorUsage) GetUseCount() uint64 {
+	if m != nil {
+		return m.UseCount
+	}
+	return 0
+}
+
+func (m *AuthenticatorUsage) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+// AuthenticationTrace is the outcome of Authenticate for an authenticator and,
+// for composite authenticators, each of its sub-authenticators.
+type AuthenticationTrace struct {
+	AuthenticatorId string `protobuf:"bytes,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	Type            string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Passed          bool   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// error is the reason the authenticator failed, if it did.
+	Error     string                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	SubTraces []AuthenticationTrace `protobuf:"bytes,5,rep,name=sub_traces,json=subTraces,proto3" json:"sub_traces"`
+}
+
+func (m *AuthenticationTrace) Reset()         { *m = AuthenticationTrace{} }
+func (m *AuthenticationTrace) String() string { return proto.CompactTextString(m) }
+func (*AuthenticationTrace) ProtoMessage()    {}
+func (*AuthenticationTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c4440607a75fe8, []int{3}
+}
+func (m *AuthenticationTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticationTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticationTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationTrace.Merge(m, src)
+}
+func (m *AuthenticationTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationTrace proto.InternalMessageInfo
+
+func (m *AuthenticationTrace) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+func (m *AuthenticationTrace) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuthenticationTrace) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *AuthenticationTrace) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuthenticationTrace) GetSubTraces() []AuthenticationTrace {
+	if m != nil {
+		return m.SubTraces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccountAuthenticator)(nil), "osmosis.smartaccount.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*UnorderedNonce)(nil), "osmosis.smartaccount.v1beta1.UnorderedNonce")
+	proto.RegisterType((*AuthenticatorUsage)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorUsage")
+	proto.RegisterType((*AuthenticationTrace)(nil), "osmosis.smartaccount.v1beta1.AuthenticationTrace")
 }
 
 func init() {
@@ -102,21 +297,34 @@ func init() {
 }
 
 var fileDescriptor_e6c4440607a75fe8 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xce, 0x4d, 0x2c, 0x2a, 0x49, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xcd, 0x4f, 0x49, 0xcd, 0x29, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0xaa,
-	0x14, 0xc4, 0x25, 0xe2, 0x08, 0x11, 0x72, 0x2c, 0x2d, 0xc9, 0x48, 0xcd, 0x2b, 0xc9, 0x4c, 0x4e,
-	0x2c, 0xc9, 0x2f, 0x12, 0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
-	0x62, 0xca, 0x4c, 0x11, 0x12, 0xe2, 0x62, 0x29, 0xa9, 0x2c, 0x48, 0x95, 0x60, 0x52, 0x60, 0xd4,
-	0xe0, 0x0c, 0x02, 0xb3, 0x85, 0xc4, 0xb8, 0xd8, 0x92, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x25, 0x98,
-	0x15, 0x18, 0x35, 0x78, 0x82, 0xa0, 0x3c, 0xa7, 0xe0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xb2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x3a, 0x4b, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0x2f, 0x33, 0x32, 0xd5, 0xaf, 0x80, 0x78,
-	0x4a, 0x17, 0xe6, 0x2b, 0x90, 0x5d, 0xc5, 0x49, 0x6c, 0x60, 0xdf, 0x18, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x3b, 0x03, 0x63, 0xd7, 0xfa, 0x00, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xc4, 0xad, 0x9a, 0x05, 0x42, 0xb5, 0x44, 0xc8, 0x02, 0x64, 0xa2, 0x48, 0x48,
+	0xee, 0xa1, 0xb6, 0x52, 0xc4, 0x81, 0x63, 0xcb, 0x05, 0x0e, 0x70, 0x58, 0x08, 0x07, 0x38, 0x58,
+	0x6b, 0x7b, 0x70, 0x56, 0x8a, 0x3d, 0xd1, 0xce, 0x6e, 0x05, 0x6f, 0xc1, 0x63, 0xf5, 0xd8, 0x23,
+	0xe2, 0x80, 0x50, 0xf2, 0x22, 0xc8, 0xeb, 0xad, 0x94, 0x4a, 0xc0, 0x6d, 0xbe, 0xd1, 0xef, 0x7f,
+	0xc6, 0xff, 0x0e, 0x3b, 0x41, 0x6a, 0x90, 0x14, 0x65, 0xd4, 0x48, 0x6d, 0x64, 0x59, 0xa2, 0x6d,
+	0x4d, 0x76, 0xb9, 0x28, 0xc0, 0xc8, 0x45, 0xd6, 0x60, 0x05, 0x6b, 0x4a, 0x37, 0x1a, 0x0d, 0xf2,
+	0x27, 0x5e, 0x9a, 0xee, 0x4b, 0x53, 0x2f, 0x7d, 0x34, 0xad, 0xb1, 0x46, 0x27, 0xcc, 0xba, 0xaa,
+	0xff, 0x66, 0x2e, 0xd8, 0xf4, 0xbc, 0x17, 0x9e, 0x5b, 0xb3, 0x82, 0xd6, 0xa8, 0x52, 0x1a, 0xd4,
+	0x7c, 0xc2, 0x86, 0xaa, 0x8a, 0x82, 0x59, 0x90, 0x84, 0x62, 0xa8, 0x2a, 0xce, 0x59, 0x68, 0xbe,
+	0x6d, 0x20, 0x1a, 0xce, 0x82, 0x64, 0x2c, 0x5c, 0xcd, 0x1f, 0xb2, 0xc3, 0x12, 0xdb, 0x2f, 0xaa,
+	0x8e, 0x46, 0xb3, 0x20, 0xb9, 0x2b, 0x3c, 0xcd, 0xdf, 0xb2, 0xc9, 0xb2, 0x45, 0x5d, 0x81, 0x86,
+	0xea, 0x1d, 0xb6, 0x25, 0xf0, 0x29, 0x3b, 0x68, 0xbb, 0xc2, 0x1b, 0xf6, 0xc0, 0x9f, 0xb1, 0x89,
+	0x51, 0x0d, 0xa0, 0x35, 0xf9, 0x0a, 0x54, 0xbd, 0x32, 0xce, 0x3d, 0x14, 0xf7, 0x7c, 0xf7, 0xb5,
+	0x6b, 0xce, 0x3f, 0x33, 0x7e, 0x6b, 0xb7, 0x25, 0xc9, 0x1a, 0xf8, 0x63, 0x36, 0xb6, 0x04, 0xb9,
+	0x5b, 0xdd, 0xdb, 0x1e, 0x59, 0x82, 0x57, 0x1d, 0xf3, 0x84, 0x1d, 0xaf, 0x25, 0x99, 0xdc, 0x12,
+	0x54, 0xfb, 0xde, 0x23, 0x31, 0xe9, 0xfa, 0x4b, 0x82, 0xca, 0x9b, 0xff, 0x0c, 0xd8, 0x83, 0x3d,
+	0x77, 0x85, 0xed, 0x07, 0x2d, 0x4b, 0xe0, 0x27, 0xec, 0x58, 0xee, 0x0f, 0xcd, 0x7d, 0x1a, 0x63,
+	0x71, 0xff, 0x56, 0xff, 0xcd, 0x3f, 0xa3, 0xd9, 0x48, 0x22, 0xa8, 0x5c, 0x34, 0x47, 0xc2, 0x53,
+	0x17, 0x04, 0x68, 0x8d, 0x3a, 0x0a, 0x9d, 0xb8, 0x07, 0xfe, 0x91, 0x31, 0xb2, 0x45, 0x6e, 0xba,
+	0xc9, 0x14, 0x1d, 0xcc, 0x46, 0xc9, 0x9d, 0xb3, 0x45, 0xfa, 0xbf, 0xd7, 0x4c, 0xff, 0xb2, 0xf3,
+	0x45, 0x78, 0xf5, 0xeb, 0xe9, 0x40, 0x8c, 0xc9, 0x16, 0x8e, 0xe9, 0xe2, 0xfd, 0xd5, 0x36, 0x0e,
+	0xae, 0xb7, 0x71, 0xf0, 0x7b, 0x1b, 0x07, 0xdf, 0x77, 0xf1, 0xe0, 0x7a, 0x17, 0x0f, 0x7e, 0xec,
+	0xe2, 0xc1, 0xa7, 0x97, 0xb5, 0x32, 0x2b, 0x5b, 0xa4, 0x25, 0x36, 0x99, 0x9f, 0x73, 0xba, 0x96,
+	0x05, 0xdd, 0x40, 0x76, 0x79, 0xf6, 0x22, 0xfb, 0xda, 0xdf, 0xdc, 0xe9, 0xcd, 0xd1, 0x75, 0x7f,
+	0x46, 0xc5, 0xa1, 0x3b, 0x9c, 0xe7, 0x7f, 0x06, 0x00, 0xcd, 0x3b, 0x41, 0x77, 0x99, 0x02, 0x00,
+	0x00,
 }
 
 func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
@@ -161,6 +369,140 @@ func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticatorUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticatorUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticatorUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UseCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.UseCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticationTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticationTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubTraces) > 0 {
+		for iNdEx := len(m.SubTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -192,6 +534,66 @@ func (m *AccountAuthenticator) Size() (n int) {
 	return n
 }
 
+func (m *UnorderedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovModels(uint64(m.Nonce))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovModels(uint64(m.TimeoutHeight))
+	}
+	return n
+}
+
+func (m *AuthenticatorUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UseCount != 0 {
+		n += 1 + sovModels(uint64(m.UseCount))
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovModels(uint64(m.LastUsedHeight))
+	}
+	return n
+}
+
+func (m *AuthenticationTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.SubTraces) > 0 {
+		for _, e := range m.SubTraces {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovModels(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -333,6 +735,382 @@ func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnorderedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticatorUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticatorUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticatorUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCount", wireType)
+			}
+			m.UseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticationTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticationTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticationTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubTraces = append(m.SubTraces, AuthenticationTrace{})
+			if err := m.SubTraces[len(m.SubTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModels(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
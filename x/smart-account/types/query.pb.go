@@ -301,6 +301,358 @@ func (m *GetAuthenticatorResponse) GetAccountAuthenticator() *AccountAuthenticat
 	return nil
 }
 
+// UnorderedNoncesRequest defines the Query/UnorderedNonces request type.
+type UnorderedNoncesRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *UnorderedNoncesRequest) Reset()         { *m = UnorderedNoncesRequest{} }
+func (m *UnorderedNoncesRequest) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNoncesRequest) ProtoMessage()    {}
+func (*UnorderedNoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{6}
+}
+func (m *UnorderedNoncesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNoncesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNoncesRequest.Merge(m, src)
+}
+func (m *UnorderedNoncesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNoncesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNoncesRequest proto.InternalMessageInfo
+
+func (m *UnorderedNoncesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// UnorderedNoncesResponse defines the Query/UnorderedNonces response type.
+type UnorderedNoncesResponse struct {
+	// used_nonces are the nonces that can't be used by the account until their
+	// timeout height has passed.
+	UsedNonces []UnorderedNonce `protobuf:"bytes,1,rep,name=used_nonces,json=usedNonces,proto3" json:"used_nonces"`
+	// min_timeout_height and max_timeout_height bound the timeout height of
+	// unordered transactions included in the next block.
+	MinTimeoutHeight uint64 `protobuf:"varint,2,opt,name=min_timeout_height,json=minTimeoutHeight,proto3" json:"min_timeout_height,omitempty"`
+	MaxTimeoutHeight uint64 `protobuf:"varint,3,opt,name=max_timeout_height,json=maxTimeoutHeight,proto3" json:"max_timeout_height,omitempty"`
+}
+
+func (m *UnorderedNoncesResponse) Reset()         { *m = UnorderedNoncesResponse{} }
+func (m *UnorderedNoncesResponse) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNoncesResponse) ProtoMessage()    {}
+func (*UnorderedNoncesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{7}
+}
+func (m *UnorderedNoncesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNoncesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNoncesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNoncesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNoncesResponse.Merge(m, src)
+}
+func (m *UnorderedNoncesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNoncesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNoncesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNoncesResponse proto.InternalMessageInfo
+
+func (m *UnorderedNoncesResponse) GetUsedNonces() []UnorderedNonce {
+	if m != nil {
+		return m.UsedNonces
+	}
+	return nil
+}
+
+func (m *UnorderedNoncesResponse) GetMinTimeoutHeight() uint64 {
+	if m != nil {
+		return m.MinTimeoutHeight
+	}
+	return 0
+}
+
+func (m *UnorderedNoncesResponse) GetMaxTimeoutHeight() uint64 {
+	if m != nil {
+		return m.MaxTimeoutHeight
+	}
+	return 0
+}
+
+// GetAuthenticatorUsageRequest defines the Query/GetAuthenticatorUsage request
+// type.
+type GetAuthenticatorUsageRequest struct {
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AuthenticatorId uint64 `protobuf:"varint,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+}
+
+func (m *GetAuthenticatorUsageRequest) Reset()         { *m = GetAuthenticatorUsageRequest{} }
+func (m *GetAuthenticatorUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuthenticatorUsageRequest) ProtoMessage()    {}
+func (*GetAuthenticatorUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{8}
+}
+func (m *GetAuthenticatorUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAuthenticatorUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAuthenticatorUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAuthenticatorUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthenticatorUsageRequest.Merge(m, src)
+}
+func (m *GetAuthenticatorUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAuthenticatorUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthenticatorUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuthenticatorUsageRequest proto.InternalMessageInfo
+
+func (m *GetAuthenticatorUsageRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetAuthenticatorUsageRequest) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+// GetAuthenticatorUsageResponse defines the Query/GetAuthenticatorUsage
+// response type.
+type GetAuthenticatorUsageResponse struct {
+	Usage AuthenticatorUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *GetAuthenticatorUsageResponse) Reset()         { *m = GetAuthenticatorUsageResponse{} }
+func (m *GetAuthenticatorUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuthenticatorUsageResponse) ProtoMessage()    {}
+func (*GetAuthenticatorUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{9}
+}
+func (m *GetAuthenticatorUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAuthenticatorUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAuthenticatorUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAuthenticatorUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthenticatorUsageResponse.Merge(m, src)
+}
+func (m *GetAuthenticatorUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAuthenticatorUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthenticatorUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuthenticatorUsageResponse proto.InternalMessageInfo
+
+func (m *GetAuthenticatorUsageResponse) GetUsage() AuthenticatorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return AuthenticatorUsage{}
+}
+
+// DryRunAuthenticationRequest defines the Query/DryRunAuthentication request
+// type.
+type DryRunAuthenticationRequest struct {
+	// tx_bytes is the protobuf encoded transaction. It doesn't need to be signed.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// msg_index is the index of the message to authenticate.
+	MsgIndex uint64 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// account is the signer of the message.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// authenticator_id is the id of the account authenticator to run.
+	AuthenticatorId uint64 `protobuf:"varint,4,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// signature is the optional signature passed to the authenticator, for
+	// example the list of signatures of a partitioned composite authenticator.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DryRunAuthenticationRequest) Reset()         { *m = DryRunAuthenticationRequest{} }
+func (m *DryRunAuthenticationRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunAuthenticationRequest) ProtoMessage()    {}
+func (*DryRunAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{10}
+}
+func (m *DryRunAuthenticationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunAuthenticationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunAuthenticationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunAuthenticationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunAuthenticationRequest.Merge(m, src)
+}
+func (m *DryRunAuthenticationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunAuthenticationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunAuthenticationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunAuthenticationRequest proto.InternalMessageInfo
+
+func (m *DryRunAuthenticationRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *DryRunAuthenticationRequest) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *DryRunAuthenticationRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DryRunAuthenticationRequest) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *DryRunAuthenticationRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// DryRunAuthenticationResponse defines the Query/DryRunAuthentication response
+// type.
+type DryRunAuthenticationResponse struct {
+	// authenticate is the trace of Authenticate for the authenticator and its
+	// sub-authenticators.
+	Authenticate AuthenticationTrace `protobuf:"bytes,1,opt,name=authenticate,proto3" json:"authenticate"`
+	// track_error is the error returned by Track, which is only called if
+	// Authenticate passed.
+	TrackError string `protobuf:"bytes,2,opt,name=track_error,json=trackError,proto3" json:"track_error,omitempty"`
+}
+
+func (m *DryRunAuthenticationResponse) Reset()         { *m = DryRunAuthenticationResponse{} }
+func (m *DryRunAuthenticationResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunAuthenticationResponse) ProtoMessage()    {}
+func (*DryRunAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{11}
+}
+func (m *DryRunAuthenticationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunAuthenticationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunAuthenticationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunAuthenticationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunAuthenticationResponse.Merge(m, src)
+}
+func (m *DryRunAuthenticationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunAuthenticationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunAuthenticationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunAuthenticationResponse proto.InternalMessageInfo
+
+func (m *DryRunAuthenticationResponse) GetAuthenticate() AuthenticationTrace {
+	if m != nil {
+		return m.Authenticate
+	}
+	return AuthenticationTrace{}
+}
+
+func (m *DryRunAuthenticationResponse) GetTrackError() string {
+	if m != nil {
+		return m.TrackError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.smartaccount.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.smartaccount.v1beta1.QueryParamsResponse")
@@ -308,6 +660,12 @@ func init() {
 	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsResponse")
 	proto.RegisterType((*GetAuthenticatorRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorRequest")
 	proto.RegisterType((*GetAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorResponse")
+	proto.RegisterType((*UnorderedNoncesRequest)(nil), "osmosis.smartaccount.v1beta1.UnorderedNoncesRequest")
+	proto.RegisterType((*UnorderedNoncesResponse)(nil), "osmosis.smartaccount.v1beta1.UnorderedNoncesResponse")
+	proto.RegisterType((*GetAuthenticatorUsageRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorUsageRequest")
+	proto.RegisterType((*GetAuthenticatorUsageResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorUsageResponse")
+	proto.RegisterType((*DryRunAuthenticationRequest)(nil), "osmosis.smartaccount.v1beta1.DryRunAuthenticationRequest")
+	proto.RegisterType((*DryRunAuthenticationResponse)(nil), "osmosis.smartaccount.v1beta1.DryRunAuthenticationResponse")
 }
 
 func init() {
@@ -315,40 +673,63 @@ func init() {
 }
 
 var fileDescriptor_aab2e1fc442f3cc3 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x47, 0x29, 0xc2, 0x3b, 0x30, 0x4c, 0x07, 0xa1, 0xaa, 0x42, 0x15, 0xed, 0xd0, 0x21,
-	0x2d, 0x5e, 0x03, 0x1b, 0xe2, 0xc6, 0x7a, 0x01, 0x6e, 0x10, 0x4e, 0x70, 0x60, 0x72, 0x52, 0x2b,
-	0xb3, 0xd4, 0xc4, 0x59, 0xec, 0x4c, 0x4c, 0xd3, 0x2e, 0x20, 0x71, 0x46, 0xda, 0xbf, 0xe0, 0x37,
-	0xf0, 0x03, 0x26, 0x71, 0x99, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0x3f, 0x04, 0xcd, 0x76, 0x0b, 0x69,
-	0x43, 0x58, 0x77, 0xab, 0x5f, 0xbf, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0x53, 0x60, 0x97, 0x8b, 0x98,
-	0x0b, 0x26, 0xb0, 0x88, 0x49, 0x26, 0x49, 0x18, 0xf2, 0x3c, 0x91, 0xf8, 0xa0, 0x17, 0x50, 0x49,
-	0x7a, 0x78, 0x3f, 0xa7, 0xd9, 0xa1, 0x9b, 0x66, 0x5c, 0x72, 0xd4, 0x36, 0x48, 0xf7, 0x6f, 0xa4,
-	0x6b, 0x90, 0xad, 0x66, 0xc4, 0x23, 0xae, 0x80, 0xf8, 0xfc, 0x97, 0xe6, 0xb4, 0xda, 0x11, 0xe7,
-	0xd1, 0x90, 0x62, 0x92, 0x32, 0x4c, 0x92, 0x84, 0x4b, 0x22, 0x19, 0x4f, 0x84, 0xf9, 0xf7, 0x7e,
-	0xa8, 0x5a, 0xe2, 0x80, 0x08, 0xaa, 0xa5, 0xa6, 0xc2, 0x29, 0x89, 0x58, 0xa2, 0xc0, 0x06, 0xbb,
-	0x5e, 0xe9, 0x33, 0x25, 0x19, 0x89, 0xc5, 0x85, 0xa0, 0x31, 0x1f, 0xd0, 0xa1, 0x81, 0x3a, 0x4d,
-	0x88, 0x5e, 0x9e, 0xeb, 0xbe, 0x50, 0x7c, 0x9f, 0xee, 0xe7, 0x54, 0x48, 0xe7, 0x35, 0xbc, 0x55,
-	0xa8, 0x8a, 0x94, 0x27, 0x82, 0xa2, 0x3e, 0x6c, 0x68, 0x1d, 0x0b, 0x74, 0x40, 0x77, 0xd9, 0x5b,
-	0x73, 0xab, 0x26, 0xe2, 0x6a, 0x76, 0xbf, 0x7e, 0xfa, 0xe3, 0x5e, 0xcd, 0x37, 0x4c, 0xe7, 0x21,
-	0xb4, 0x9e, 0x52, 0xb9, 0x93, 0xcb, 0x3d, 0x9a, 0x48, 0x16, 0x12, 0xc9, 0xb3, 0x89, 0x2c, 0xb2,
-	0xe0, 0x35, 0xd3, 0x43, 0x09, 0x5c, 0xf7, 0x27, 0x4f, 0xe7, 0x23, 0x80, 0x77, 0x4b, 0x68, 0xc6,
-	0x17, 0x83, 0xb7, 0x0d, 0x70, 0x97, 0x14, 0x10, 0x16, 0xe8, 0x5c, 0xe9, 0x2e, 0x7b, 0x5e, 0xb5,
-	0xcf, 0x1d, 0xfd, 0x2e, 0x34, 0xf7, 0x57, 0x49, 0x49, 0x55, 0x38, 0x6f, 0xe1, 0x9d, 0x59, 0x1f,
-	0xff, 0x75, 0x8f, 0xd6, 0xe1, 0x4a, 0xc1, 0xd7, 0x2e, 0x1b, 0x58, 0x4b, 0x1d, 0xd0, 0xad, 0xfb,
-	0x37, 0x0a, 0xf5, 0xe7, 0x03, 0xe7, 0x03, 0x98, 0x9f, 0xcf, 0x34, 0x67, 0x04, 0x57, 0x4b, 0x73,
-	0x9a, 0x75, 0x5c, 0x26, 0x66, 0xb3, 0x2c, 0xa6, 0xf7, 0xb9, 0x0e, 0xaf, 0xaa, 0x03, 0x40, 0x27,
-	0x00, 0x36, 0xf4, 0x1e, 0xd1, 0x66, 0x75, 0xfb, 0xf9, 0x33, 0x6a, 0xf5, 0x16, 0x60, 0xe8, 0x88,
-	0xce, 0xda, 0xfb, 0x6f, 0xbf, 0x4e, 0x96, 0x6c, 0xd4, 0xc6, 0xa5, 0x37, 0xac, 0x8f, 0x08, 0x7d,
-	0x05, 0x70, 0x65, 0x76, 0x4a, 0x68, 0xab, 0x5a, 0xed, 0x1f, 0x6b, 0x6b, 0x6d, 0x2f, 0x4a, 0x33,
-	0x4e, 0x9f, 0x29, 0xa7, 0x7d, 0xf4, 0xa4, 0xdc, 0x69, 0x61, 0x41, 0xf8, 0xc8, 0x94, 0x8f, 0xf1,
-	0xd1, 0xec, 0x25, 0x1c, 0xa3, 0x2f, 0x00, 0xde, 0x9c, 0x3b, 0x6e, 0xb4, 0xa0, 0xaf, 0xe9, 0xd0,
-	0x1f, 0x2d, 0xcc, 0x33, 0x81, 0xb6, 0x55, 0xa0, 0x4d, 0xe4, 0x5e, 0x20, 0x90, 0xf8, 0x93, 0xa8,
-	0xff, 0xea, 0x74, 0x64, 0x83, 0xb3, 0x91, 0x0d, 0x7e, 0x8e, 0x6c, 0xf0, 0x69, 0x6c, 0xd7, 0xce,
-	0xc6, 0x76, 0xed, 0xfb, 0xd8, 0xae, 0xbd, 0x79, 0x1c, 0x31, 0xb9, 0x97, 0x07, 0x6e, 0xc8, 0xe3,
-	0x49, 0xcf, 0x8d, 0x21, 0x09, 0xc4, 0x54, 0xe0, 0xc0, 0xdb, 0xc2, 0xef, 0xb4, 0xcc, 0xc6, 0x44,
-	0x47, 0x1e, 0xa6, 0x54, 0x04, 0x0d, 0xf5, 0x79, 0x7a, 0xf0, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x7c, 0x95, 0xf4, 0x9e, 0x05, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0x90, 0x1f, 0xe0, 0x17, 0x24, 0xd2, 0x69, 0x02, 0xc6, 0xb8, 0x26, 0x5a, 0x71, 0x08,
+	0x28, 0x78, 0x63, 0x97, 0x40, 0x49, 0x2f, 0xc5, 0xea, 0x8f, 0x20, 0x55, 0xa8, 0x5d, 0xe0, 0xd0,
+	0x56, 0xea, 0x6a, 0xbc, 0x3b, 0xda, 0x8c, 0x9a, 0x9d, 0x31, 0x33, 0xb3, 0xc8, 0x16, 0xe2, 0xd2,
+	0x4a, 0x3d, 0xb7, 0xe2, 0xda, 0x3f, 0x84, 0x43, 0xcf, 0x15, 0x6a, 0x2f, 0x48, 0xbd, 0xf4, 0x54,
+	0xa1, 0xa4, 0x7f, 0x48, 0xe5, 0xd9, 0xb1, 0xf1, 0xda, 0x9b, 0xb5, 0x1d, 0x71, 0xcb, 0x3c, 0x7f,
+	0xef, 0xbd, 0xef, 0x7b, 0xef, 0xe5, 0xd3, 0xc2, 0x96, 0x50, 0xb1, 0x50, 0x4c, 0xb9, 0x2a, 0x26,
+	0x52, 0x93, 0x20, 0x10, 0x09, 0xd7, 0xee, 0xd3, 0x46, 0x9b, 0x6a, 0xd2, 0x70, 0x9f, 0x24, 0x54,
+	0xf6, 0xea, 0x1d, 0x29, 0xb4, 0xc0, 0x55, 0x8b, 0xac, 0x8f, 0x22, 0xeb, 0x16, 0x59, 0x59, 0x8f,
+	0x44, 0x24, 0x0c, 0xd0, 0xed, 0xff, 0x95, 0xe6, 0x54, 0xaa, 0x91, 0x10, 0xd1, 0x21, 0x75, 0x49,
+	0x87, 0xb9, 0x84, 0x73, 0xa1, 0x89, 0x66, 0x82, 0x2b, 0xfb, 0xeb, 0x8d, 0xc0, 0x94, 0x74, 0xdb,
+	0x44, 0xd1, 0xb4, 0xd5, 0xb0, 0x71, 0x87, 0x44, 0x8c, 0x1b, 0xb0, 0xc5, 0x5e, 0x2f, 0xe4, 0xd9,
+	0x21, 0x92, 0xc4, 0x6a, 0x26, 0x68, 0x2c, 0x42, 0x7a, 0x68, 0xa1, 0xce, 0x3a, 0xe0, 0xaf, 0xfb,
+	0x7d, 0xbf, 0x32, 0xf9, 0x1e, 0x7d, 0x92, 0x50, 0xa5, 0x9d, 0x6f, 0xe0, 0xfd, 0x4c, 0x54, 0x75,
+	0x04, 0x57, 0x14, 0xb7, 0x60, 0x25, 0xed, 0x53, 0x46, 0x9b, 0x68, 0x6b, 0xb5, 0x79, 0xad, 0x5e,
+	0x34, 0x91, 0x7a, 0x9a, 0xdd, 0x5a, 0x7a, 0xf5, 0xef, 0xd5, 0x05, 0xcf, 0x66, 0x3a, 0xb7, 0xa0,
+	0xfc, 0x05, 0xd5, 0xf7, 0x12, 0x7d, 0x40, 0xb9, 0x66, 0x01, 0xd1, 0x42, 0x0e, 0xda, 0xe2, 0x32,
+	0x9c, 0xb5, 0x35, 0x4c, 0x83, 0x92, 0x37, 0x78, 0x3a, 0x3f, 0x23, 0xb8, 0x9c, 0x93, 0x66, 0x79,
+	0x31, 0xb8, 0x68, 0x81, 0x3e, 0xc9, 0x20, 0xca, 0x68, 0x73, 0x71, 0x6b, 0xb5, 0xd9, 0x2c, 0xe6,
+	0x79, 0x2f, 0x7d, 0x67, 0x8a, 0x7b, 0x1b, 0x24, 0x27, 0xaa, 0x9c, 0xef, 0xe1, 0xd2, 0x38, 0x8f,
+	0xa9, 0xec, 0xf1, 0x75, 0x58, 0xcb, 0xf0, 0xf2, 0x59, 0x58, 0x3e, 0xb3, 0x89, 0xb6, 0x96, 0xbc,
+	0x0b, 0x99, 0xf8, 0xfd, 0xd0, 0xf9, 0x09, 0x4d, 0xce, 0x67, 0xa8, 0x33, 0x82, 0x8d, 0x5c, 0x9d,
+	0x76, 0x1d, 0xa7, 0x91, 0xb9, 0x9e, 0x27, 0xd3, 0x69, 0xc2, 0xc5, 0xc7, 0x5c, 0xc8, 0x90, 0x4a,
+	0x1a, 0x3e, 0x10, 0x3c, 0xa0, 0x33, 0xac, 0xe8, 0x4f, 0x04, 0x97, 0x26, 0x92, 0x2c, 0xf1, 0x87,
+	0xb0, 0x9a, 0x28, 0x1a, 0xfa, 0xdc, 0x84, 0xed, 0x56, 0xb6, 0x8b, 0xe9, 0x66, 0x6b, 0xd9, 0x2b,
+	0x82, 0x44, 0xd9, 0x80, 0xc2, 0xdb, 0x80, 0x63, 0xc6, 0x7d, 0xcd, 0x62, 0x2a, 0x12, 0xed, 0x1f,
+	0x50, 0x16, 0x1d, 0x68, 0x3b, 0xd7, 0xb5, 0x98, 0xf1, 0x47, 0xe9, 0x0f, 0xfb, 0x26, 0x6e, 0xd0,
+	0xa4, 0x3b, 0x8e, 0x5e, 0xb4, 0x68, 0xd2, 0xcd, 0xa0, 0x9d, 0x00, 0xaa, 0xe3, 0x5b, 0x78, 0xac,
+	0x48, 0x44, 0xdf, 0xe9, 0xae, 0x63, 0xf8, 0xe0, 0x84, 0x26, 0x76, 0x6c, 0x5f, 0xc2, 0x72, 0xd2,
+	0x0f, 0xd8, 0xfd, 0xee, 0x4c, 0xd9, 0xef, 0x44, 0x21, 0x3b, 0xb4, 0xb4, 0x88, 0xf3, 0x12, 0xc1,
+	0x95, 0x4f, 0x65, 0xcf, 0x4b, 0xf8, 0x08, 0x92, 0x09, 0x3e, 0xd0, 0x74, 0x19, 0xce, 0xe9, 0xae,
+	0xdf, 0xee, 0x69, 0x9a, 0xfe, 0x7f, 0x9f, 0xf7, 0xce, 0xea, 0x6e, 0xab, 0xff, 0xc4, 0x57, 0xa0,
+	0x14, 0xab, 0xc8, 0x67, 0x3c, 0xa4, 0x5d, 0xab, 0xe6, 0x5c, 0xac, 0xa2, 0xfb, 0xfd, 0xf7, 0xe8,
+	0x2c, 0x16, 0xa7, 0xcf, 0x62, 0x29, 0x77, 0x16, 0xb8, 0x0a, 0x25, 0xc5, 0x22, 0x4e, 0x74, 0x22,
+	0x69, 0x79, 0xd9, 0x74, 0x7f, 0x1b, 0x70, 0x7e, 0x43, 0x50, 0xcd, 0xa7, 0x6e, 0x27, 0xf5, 0x1d,
+	0x9c, 0x1f, 0xa9, 0x38, 0x18, 0x58, 0x63, 0xe6, 0x81, 0x31, 0xc1, 0x1f, 0x49, 0x32, 0x3c, 0xb3,
+	0x4c, 0x31, 0x7c, 0x15, 0x56, 0xb5, 0x24, 0xc1, 0x0f, 0x3e, 0x95, 0x52, 0x48, 0xa3, 0xbf, 0xe4,
+	0x81, 0x09, 0x7d, 0xd6, 0x8f, 0x34, 0x7f, 0x2d, 0xc1, 0xb2, 0xf1, 0x4b, 0xfc, 0x02, 0xc1, 0x4a,
+	0x6a, 0x7b, 0x78, 0xca, 0xb6, 0x26, 0x5d, 0xb7, 0xd2, 0x98, 0x23, 0x23, 0xd5, 0xed, 0x5c, 0xfb,
+	0xf1, 0xef, 0xff, 0x5e, 0x9c, 0xa9, 0xe1, 0xaa, 0x9b, 0x6b, 0xf9, 0xa9, 0xe7, 0xe2, 0xbf, 0x10,
+	0xac, 0x8d, 0x5f, 0x1a, 0xde, 0x2d, 0xee, 0x76, 0x82, 0xcb, 0x55, 0x6e, 0xcf, 0x9b, 0x66, 0x99,
+	0xee, 0x1b, 0xa6, 0x2d, 0xfc, 0x49, 0x3e, 0xd3, 0xcc, 0x3d, 0xb8, 0xcf, 0x6c, 0xf8, 0xb9, 0xfb,
+	0x6c, 0xfc, 0x80, 0x9e, 0xe3, 0xdf, 0x11, 0xbc, 0x37, 0xde, 0x46, 0xe1, 0x39, 0x79, 0x0d, 0x87,
+	0x7e, 0x67, 0xee, 0x3c, 0x2b, 0xe8, 0xb6, 0x11, 0xb4, 0x83, 0xeb, 0x33, 0x08, 0x52, 0x6f, 0x15,
+	0xe1, 0x97, 0x08, 0x2e, 0x8c, 0xf9, 0x24, 0xbe, 0x35, 0x8f, 0x15, 0x0e, 0xa9, 0xef, 0xce, 0x99,
+	0x65, 0x89, 0x7f, 0x64, 0x88, 0x37, 0xf1, 0x4e, 0x3e, 0xf1, 0x64, 0x90, 0x66, 0xdd, 0x7a, 0x84,
+	0xfa, 0x1b, 0x04, 0x1b, 0xb9, 0x8e, 0x85, 0xf7, 0xe6, 0x9b, 0xe2, 0xa8, 0x97, 0x56, 0x3e, 0x3e,
+	0x55, 0xae, 0x15, 0xf3, 0xc0, 0x88, 0xd9, 0xc7, 0x9f, 0xcf, 0xb0, 0x05, 0xdf, 0xf8, 0x60, 0xf1,
+	0x71, 0xfd, 0x81, 0x60, 0x3d, 0xcf, 0x69, 0xf0, 0xdd, 0x62, 0x96, 0x05, 0xc6, 0x5a, 0xd9, 0x3b,
+	0x4d, 0xaa, 0xd5, 0x77, 0xc7, 0xe8, 0x6b, 0x38, 0xdb, 0xf9, 0xfa, 0x42, 0xd9, 0xf3, 0x65, 0xc2,
+	0x47, 0x3f, 0x07, 0x98, 0xe0, 0x7b, 0xe8, 0x46, 0xeb, 0xe1, 0xab, 0xa3, 0x1a, 0x7a, 0x7d, 0x54,
+	0x43, 0x6f, 0x8e, 0x6a, 0xe8, 0x97, 0xe3, 0xda, 0xc2, 0xeb, 0xe3, 0xda, 0xc2, 0x3f, 0xc7, 0xb5,
+	0x85, 0x6f, 0xef, 0x46, 0x4c, 0x1f, 0x24, 0xed, 0x7a, 0x20, 0xe2, 0x41, 0xd1, 0x9b, 0x87, 0xa4,
+	0xad, 0x86, 0x1d, 0x9e, 0x36, 0x77, 0xdd, 0x6e, 0xda, 0xe7, 0xe6, 0xa0, 0x91, 0xee, 0x75, 0xa8,
+	0x6a, 0xaf, 0x98, 0x8f, 0xc6, 0x0f, 0xff, 0x1f, 0x00, 0x43, 0xec, 0xa4, 0x77, 0x34, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +748,18 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+	// UnorderedNonces returns the nonces used by an account in unordered
+	// transactions that have not timed out yet, and the window of timeout
+	// heights currently accepted for unordered transactions.
+	UnorderedNonces(ctx context.Context, in *UnorderedNoncesRequest, opts ...grpc.CallOption) (*UnorderedNoncesResponse, error)
+	// GetAuthenticatorUsage returns how many messages an authenticator has
+	// authenticated and when it was last used.
+	GetAuthenticatorUsage(ctx context.Context, in *GetAuthenticatorUsageRequest, opts ...grpc.CallOption) (*GetAuthenticatorUsageResponse, error)
+	// DryRunAuthentication runs Authenticate and Track of an authenticator for a
+	// message of a transaction, without signature verification and without
+	// persisting any state. It returns which sub-authenticators passed or failed
+	// and why.
+	DryRunAuthentication(ctx context.Context, in *DryRunAuthenticationRequest, opts ...grpc.CallOption) (*DryRunAuthenticationResponse, error)
 }
 
 type queryClient struct {
@@ -404,12 +797,51 @@ func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticato
 	return out, nil
 }
 
+func (c *queryClient) UnorderedNonces(ctx context.Context, in *UnorderedNoncesRequest, opts ...grpc.CallOption) (*UnorderedNoncesResponse, error) {
+	out := new(UnorderedNoncesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/UnorderedNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuthenticatorUsage(ctx context.Context, in *GetAuthenticatorUsageRequest, opts ...grpc.CallOption) (*GetAuthenticatorUsageResponse, error) {
+	out := new(GetAuthenticatorUsageResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/GetAuthenticatorUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DryRunAuthentication(ctx context.Context, in *DryRunAuthenticationRequest, opts ...grpc.CallOption) (*DryRunAuthenticationResponse, error) {
+	out := new(DryRunAuthenticationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/DryRunAuthentication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetAuthenticator(context.Context, *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+	// UnorderedNonces returns the nonces used by an account in unordered
+	// transactions that have not timed out yet, and the window of timeout
+	// heights currently accepted for unordered transactions.
+	UnorderedNonces(context.Context, *UnorderedNoncesRequest) (*UnorderedNoncesResponse, error)
+	// GetAuthenticatorUsage returns how many messages an authenticator has
+	// authenticated and when it was last used.
+	GetAuthenticatorUsage(context.Context, *GetAuthenticatorUsageRequest) (*GetAuthenticatorUsageResponse, error)
+	// DryRunAuthentication runs Authenticate and Track of an authenticator for a
+	// message of a transaction, without signature verification and without
+	// persisting any state. It returns which sub-authenticators passed or failed
+	// and why.
+	DryRunAuthentication(context.Context, *DryRunAuthenticationRequest) (*DryRunAuthenticationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -425,6 +857,15 @@ func (*UnimplementedQueryServer) GetAuthenticator(ctx context.Context, req *GetA
 func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
 }
+func (*UnimplementedQueryServer) UnorderedNonces(ctx context.Context, req *UnorderedNoncesRequest) (*UnorderedNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnorderedNonces not implemented")
+}
+func (*UnimplementedQueryServer) GetAuthenticatorUsage(ctx context.Context, req *GetAuthenticatorUsageRequest) (*GetAuthenticatorUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticatorUsage not implemented")
+}
+func (*UnimplementedQueryServer) DryRunAuthentication(ctx context.Context, req *DryRunAuthenticationRequest) (*DryRunAuthenticationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunAuthentication not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -484,6 +925,60 @@ func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnorderedNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnorderedNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnorderedNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/UnorderedNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnorderedNonces(ctx, req.(*UnorderedNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuthenticatorUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthenticatorUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuthenticatorUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/GetAuthenticatorUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuthenticatorUsage(ctx, req.(*GetAuthenticatorUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DryRunAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DryRunAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/DryRunAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DryRunAuthentication(ctx, req.(*DryRunAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.smartaccount.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -500,6 +995,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthenticators",
 			Handler:    _Query_GetAuthenticators_Handler,
 		},
+		{
+			MethodName: "UnorderedNonces",
+			Handler:    _Query_UnorderedNonces_Handler,
+		},
+		{
+			MethodName: "GetAuthenticatorUsage",
+			Handler:    _Query_GetAuthenticatorUsage_Handler,
+		},
+		{
+			MethodName: "DryRunAuthentication",
+			Handler:    _Query_DryRunAuthentication_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/smartaccount/v1beta1/query.proto",
@@ -698,6 +1205,245 @@ func (m *GetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedNoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnorderedNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTimeoutHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTimeoutHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinTimeoutHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinTimeoutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UsedNonces) > 0 {
+		for iNdEx := len(m.UsedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuthenticatorUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuthenticatorUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuthenticatorUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunAuthenticationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunAuthenticationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunAuthenticationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunAuthenticationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunAuthenticationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunAuthenticationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrackError) > 0 {
+		i -= len(m.TrackError)
+		copy(dAtA[i:], m.TrackError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrackError)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Authenticate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -754,45 +1500,634 @@ func (m *GetAuthenticatorsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GetAuthenticatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AuthenticatorId != 0 {
-		n += 1 + sovQuery(uint64(m.AuthenticatorId))
-	}
-	return n
-}
+	return n
+}
+
+func (m *GetAuthenticatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountAuthenticator != nil {
+		l = m.AccountAuthenticator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnorderedNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnorderedNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UsedNonces) > 0 {
+		for _, e := range m.UsedNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MinTimeoutHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinTimeoutHeight))
+	}
+	if m.MaxTimeoutHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTimeoutHeight))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *DryRunAuthenticationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DryRunAuthenticationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Authenticate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TrackError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAuthenticators = append(m.AccountAuthenticators, &AccountAuthenticator{})
+			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountAuthenticator == nil {
+				m.AccountAuthenticator = &AccountAuthenticator{}
+			}
+			if err := m.AccountAuthenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GetAuthenticatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AccountAuthenticator != nil {
-		l = m.AccountAuthenticator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *UnorderedNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,12 +2150,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnorderedNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnorderedNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -842,7 +2209,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *UnorderedNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -865,15 +2232,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnorderedNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnorderedNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -900,10 +2267,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UsedNonces = append(m.UsedNonces, UnorderedNonce{})
+			if err := m.UsedNonces[len(m.UsedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeoutHeight", wireType)
+			}
+			m.MinTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutHeight", wireType)
+			}
+			m.MaxTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -925,7 +2331,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAuthenticatorUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,10 +2354,10 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAuthenticatorUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAuthenticatorUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -986,6 +2392,25 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1007,7 +2432,7 @@ func (m *GetAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAuthenticatorUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1030,15 +2455,15 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAuthenticatorUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAuthenticatorUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1065,8 +2490,7 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAuthenticators = append(m.AccountAuthenticators, &AccountAuthenticator{})
-			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1091,7 +2515,7 @@ func (m *GetAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
+func (m *DryRunAuthenticationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,13 +2538,66 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunAuthenticationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunAuthenticationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -1152,7 +2629,7 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
 			}
@@ -1171,6 +2648,40 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1192,7 +2703,7 @@ func (m *GetAuthenticatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+func (m *DryRunAuthenticationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,15 +2726,15 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAuthenticatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunAuthenticationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunAuthenticationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1250,13 +2761,42 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccountAuthenticator == nil {
-				m.AccountAuthenticator = &AccountAuthenticator{}
-			}
-			if err := m.AccountAuthenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Authenticate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -329,6 +329,12 @@ type TxExtension struct {
 	// selected_authenticators holds the authenticator_id for the chosen
 	// authenticator per message.
 	SelectedAuthenticators []uint64 `protobuf:"varint,1,rep,packed,name=selected_authenticators,json=selectedAuthenticators,proto3" json:"selected_authenticators,omitempty"`
+	// unordered_nonce, when non-zero, replaces the account sequence check of
+	// the transaction signers with a nonce that each signer can only use once.
+	// This allows transactions to be accepted out of order. Unordered
+	// transactions must set a timeout height, and the nonce is remembered until
+	// then.
+	UnorderedNonce uint64 `protobuf:"varint,2,opt,name=unordered_nonce,json=unorderedNonce,proto3" json:"unordered_nonce,omitempty"`
 }
 
 func (m *TxExtension) Reset()         { *m = TxExtension{} }
@@ -371,6 +377,13 @@ func (m *TxExtension) GetSelectedAuthenticators() []uint64 {
 	return nil
 }
 
+func (m *TxExtension) GetUnorderedNonce() uint64 {
+	if m != nil {
+		return m.UnorderedNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgAddAuthenticator)(nil), "osmosis.smartaccount.v1beta1.MsgAddAuthenticator")
 	proto.RegisterType((*MsgAddAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.MsgAddAuthenticatorResponse")
@@ -386,40 +399,41 @@ func init() {
 }
 
 var fileDescriptor_e696d15b139ba7e5 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xe3, 0x24, 0xca, 0xd7, 0x6f, 0x40, 0x15, 0x71, 0xa5, 0x34, 0xa4, 0xc8, 0xaa, 0x2c,
-	0x81, 0x42, 0x25, 0x7b, 0x95, 0x42, 0x89, 0x12, 0x71, 0x09, 0x12, 0xdc, 0x72, 0x71, 0x7a, 0xe2,
-	0x52, 0x6d, 0xec, 0x91, 0x6b, 0xa9, 0xf6, 0x46, 0x9e, 0x8d, 0x95, 0x96, 0x0b, 0xe2, 0x06, 0xa7,
-	0x3e, 0x06, 0xc7, 0x3e, 0x06, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0xd0, 0xd7, 0x40, 0xd9, 0xd8, 0x55,
-	0x9d, 0x1a, 0xda, 0x72, 0x49, 0x3c, 0x9e, 0xf9, 0xcf, 0xfc, 0xc6, 0xfb, 0xd7, 0xc2, 0x73, 0x41,
-	0xa1, 0xa0, 0x80, 0x18, 0x85, 0x3c, 0x96, 0xdc, 0x75, 0xc5, 0x34, 0x92, 0x2c, 0xe9, 0x8c, 0x51,
-	0xf2, 0x0e, 0x93, 0x33, 0x7b, 0x12, 0x0b, 0x29, 0xf4, 0x67, 0x69, 0x99, 0x7d, 0xb3, 0xcc, 0x4e,
-	0xcb, 0x5a, 0xdb, 0xae, 0x4a, 0xb3, 0x90, 0x7c, 0x96, 0x74, 0x96, 0x7f, 0x2b, 0x59, 0xab, 0xce,
-	0xc3, 0x20, 0x12, 0x4c, 0xfd, 0xae, 0x5e, 0x99, 0xdf, 0x35, 0xd8, 0x1a, 0x92, 0x3f, 0xf0, 0xbc,
-	0xc1, 0x54, 0x1e, 0x63, 0x24, 0x03, 0x97, 0x4b, 0x11, 0xeb, 0x0d, 0xa8, 0x11, 0x46, 0x1e, 0xc6,
-	0x4d, 0x6d, 0x57, 0x6b, 0xff, 0xef, 0xa4, 0x91, 0x6e, 0x81, 0xce, 0x6f, 0x16, 0x1e, 0xc9, 0xd3,
-	0x09, 0x36, 0xcb, 0xaa, 0xa6, 0x9e, 0xcb, 0x1c, 0x9e, 0x4e, 0x50, 0xd7, 0xa1, 0xea, 0x71, 0xc9,
-	0x9b, 0x95, 0x5d, 0xad, 0xfd, 0xd8, 0x51, 0xcf, 0xfd, 0x37, 0x5f, 0xae, 0x2e, 0xf6, 0xd2, 0x7e,
-	0xdf, 0xae, 0x2e, 0xf6, 0x5e, 0x14, 0xee, 0xcc, 0x3d, 0xcf, 0xca, 0xf5, 0x33, 0xbb, 0xb0, 0x53,
-	0x40, 0xea, 0x20, 0x4d, 0x44, 0x44, 0xa8, 0x37, 0xe1, 0x3f, 0x9a, 0xba, 0x2e, 0x12, 0x29, 0xe4,
-	0x0d, 0x27, 0x0b, 0xcd, 0x4f, 0xd0, 0x18, 0x92, 0xef, 0x60, 0x28, 0x12, 0xbc, 0xdf, 0x96, 0x9b,
-	0x50, 0x0e, 0x3c, 0xb5, 0x55, 0xd5, 0x29, 0x07, 0x5e, 0xbf, 0xb7, 0x86, 0xfc, 0xb2, 0x10, 0x39,
-	0x56, 0x13, 0xd6, 0xa8, 0xfb, 0x60, 0x14, 0x0f, 0xbf, 0x07, 0xf8, 0x19, 0xd4, 0x87, 0xe4, 0x8f,
-	0x50, 0x0e, 0x5c, 0x19, 0x24, 0x38, 0x92, 0x5c, 0xe2, 0x1f, 0x99, 0x1b, 0x50, 0xe3, 0xaa, 0x4c,
-	0x71, 0x6f, 0x38, 0x69, 0xd4, 0x3f, 0x58, 0x63, 0x2f, 0xb6, 0x18, 0xa1, 0xb4, 0x56, 0x02, 0x8b,
-	0x96, 0x63, 0xcc, 0x1d, 0x78, 0x7a, 0x6b, 0x76, 0x86, 0x6c, 0x7e, 0x80, 0x47, 0x87, 0xb3, 0xf7,
-	0x33, 0x89, 0x11, 0x05, 0x22, 0xd2, 0xbb, 0xb0, 0x4d, 0x78, 0x82, 0xae, 0x44, 0xef, 0x28, 0xb7,
-	0xfd, 0x72, 0xa3, 0x4a, 0xbb, 0xea, 0x34, 0xb2, 0x74, 0xee, 0x0b, 0xd0, 0xfe, 0x79, 0x05, 0x2a,
-	0x43, 0xf2, 0xf5, 0xcf, 0x1a, 0x3c, 0xb9, 0x65, 0xc1, 0x8e, 0xfd, 0x37, 0x97, 0xdb, 0x05, 0x5e,
-	0x68, 0xf5, 0x1e, 0x2c, 0xb9, 0x3e, 0x85, 0xaf, 0x1a, 0x6c, 0x15, 0x59, 0xe4, 0xf5, 0x9d, 0x2d,
-	0x0b, 0x54, 0xad, 0xb7, 0xff, 0xa2, 0xba, 0x66, 0x39, 0x83, 0xcd, 0xb5, 0x43, 0x67, 0x77, 0xf6,
-	0xcb, 0x0b, 0x5a, 0xdd, 0x07, 0x0a, 0xb2, 0xd9, 0xef, 0x46, 0x3f, 0xe6, 0x86, 0x76, 0x39, 0x37,
-	0xb4, 0x5f, 0x73, 0x43, 0x3b, 0x5f, 0x18, 0xa5, 0xcb, 0x85, 0x51, 0xfa, 0xb9, 0x30, 0x4a, 0x1f,
-	0x7b, 0x7e, 0x20, 0x8f, 0xa7, 0x63, 0xdb, 0x15, 0x21, 0x4b, 0x9b, 0x5b, 0x27, 0x7c, 0x4c, 0x59,
-	0xc0, 0x92, 0xfd, 0x03, 0x36, 0x5b, 0xd9, 0xca, 0xca, 0x7c, 0xb5, 0xbc, 0x1e, 0x68, 0x5c, 0x53,
-	0x97, 0xcd, 0xab, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x13, 0x9b, 0xb0, 0xdf, 0x04, 0x00,
-	0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xda, 0x4c,
+	0x10, 0xc5, 0x80, 0xf8, 0xf2, 0x4d, 0x2b, 0x5a, 0x1c, 0x89, 0x50, 0x52, 0x59, 0x11, 0x52, 0x5b,
+	0x1a, 0xc9, 0xb6, 0x48, 0x9b, 0x22, 0x50, 0x2f, 0x54, 0xea, 0x91, 0x1e, 0x4c, 0x4e, 0xbd, 0xa0,
+	0xc5, 0x3b, 0x72, 0x2c, 0xc5, 0x5e, 0xe4, 0x59, 0x10, 0x49, 0x2f, 0x55, 0x6f, 0xed, 0x29, 0x3f,
+	0xa3, 0xc7, 0xfc, 0x8c, 0x1e, 0x73, 0xec, 0xb1, 0x82, 0x43, 0xfe, 0x46, 0xc5, 0x62, 0xa3, 0x98,
+	0xb8, 0x4d, 0xd2, 0x0b, 0x78, 0x66, 0xde, 0x9b, 0x79, 0xb3, 0x7e, 0x5e, 0x78, 0x26, 0x28, 0x10,
+	0xe4, 0x93, 0x4d, 0x01, 0x8b, 0x24, 0x73, 0x5d, 0x31, 0x09, 0xa5, 0x3d, 0x6d, 0x8d, 0x50, 0xb2,
+	0x96, 0x2d, 0x67, 0xd6, 0x38, 0x12, 0x52, 0xe8, 0x4f, 0x63, 0x98, 0x75, 0x1d, 0x66, 0xc5, 0xb0,
+	0xfa, 0x8e, 0xab, 0xca, 0x76, 0x40, 0x9e, 0x3d, 0x6d, 0x2d, 0xff, 0x56, 0xb4, 0x7a, 0x85, 0x05,
+	0x7e, 0x28, 0x6c, 0xf5, 0xbb, 0x4a, 0x35, 0xbe, 0x6b, 0xb0, 0xdd, 0x27, 0xaf, 0xc7, 0x79, 0x6f,
+	0x22, 0x8f, 0x31, 0x94, 0xbe, 0xcb, 0xa4, 0x88, 0xf4, 0x2a, 0x94, 0x08, 0x43, 0x8e, 0x51, 0x4d,
+	0xdb, 0xd3, 0x9a, 0xff, 0x3b, 0x71, 0xa4, 0x9b, 0xa0, 0xb3, 0xeb, 0xc0, 0xa1, 0x3c, 0x1d, 0x63,
+	0x2d, 0xaf, 0x30, 0x95, 0x54, 0xe5, 0xe8, 0x74, 0x8c, 0xba, 0x0e, 0x45, 0xce, 0x24, 0xab, 0x15,
+	0xf6, 0xb4, 0xe6, 0x43, 0x47, 0x3d, 0x77, 0xdf, 0x7c, 0xb9, 0xba, 0xd8, 0x8f, 0xfb, 0x7d, 0xbb,
+	0xba, 0xd8, 0x7f, 0x9e, 0xb9, 0x33, 0xe3, 0xdc, 0x4c, 0xf5, 0x6b, 0xb4, 0x61, 0x37, 0x43, 0xa9,
+	0x83, 0x34, 0x16, 0x21, 0xa1, 0x5e, 0x83, 0xff, 0x68, 0xe2, 0xba, 0x48, 0xa4, 0x24, 0x6f, 0x39,
+	0x49, 0xd8, 0xf8, 0x04, 0xd5, 0x3e, 0x79, 0x0e, 0x06, 0x62, 0x8a, 0x77, 0xdb, 0xb2, 0x0c, 0x79,
+	0x9f, 0xab, 0xad, 0x8a, 0x4e, 0xde, 0xe7, 0xdd, 0xce, 0x86, 0xe4, 0x97, 0x99, 0x92, 0x23, 0x35,
+	0x61, 0x43, 0x75, 0x17, 0x8c, 0xec, 0xe1, 0x77, 0x10, 0x7e, 0x06, 0x95, 0x3e, 0x79, 0x03, 0x94,
+	0x3d, 0x57, 0xfa, 0x53, 0x1c, 0x48, 0x26, 0xf1, 0x8f, 0x9a, 0xab, 0x50, 0x62, 0x0a, 0xa6, 0x74,
+	0x6f, 0x39, 0x71, 0xd4, 0x3d, 0xdc, 0xd0, 0x9e, 0x6d, 0x31, 0x42, 0x69, 0xae, 0x08, 0x26, 0x2d,
+	0xc7, 0x34, 0x76, 0xe1, 0xc9, 0x8d, 0xd9, 0x89, 0xe4, 0x86, 0x80, 0x07, 0x47, 0xb3, 0xf7, 0x33,
+	0x89, 0x21, 0xf9, 0x22, 0xd4, 0xdb, 0xb0, 0x43, 0x78, 0x82, 0xae, 0x44, 0x3e, 0x4c, 0x6d, 0xbf,
+	0xdc, 0xa8, 0xd0, 0x2c, 0x3a, 0xd5, 0xa4, 0x9c, 0x3a, 0x01, 0xd2, 0x5f, 0xc0, 0xa3, 0x49, 0x28,
+	0x22, 0x8e, 0x11, 0xf2, 0x61, 0x28, 0x42, 0x17, 0xe3, 0x43, 0x2f, 0xaf, 0xd3, 0x1f, 0x96, 0xd9,
+	0x83, 0xf3, 0x02, 0x14, 0xfa, 0xe4, 0xe9, 0x9f, 0x35, 0x78, 0x7c, 0xc3, 0xab, 0x2d, 0xeb, 0x6f,
+	0x9f, 0x83, 0x95, 0x61, 0x9a, 0x7a, 0xe7, 0xde, 0x94, 0xf5, 0xeb, 0xfa, 0xaa, 0xc1, 0x76, 0x96,
+	0x97, 0x5e, 0xdf, 0xda, 0x32, 0x83, 0x55, 0x7f, 0xfb, 0x2f, 0xac, 0xb5, 0x96, 0x33, 0x28, 0x6f,
+	0xb8, 0xc3, 0xbe, 0xb5, 0x5f, 0x9a, 0x50, 0x6f, 0xdf, 0x93, 0x90, 0xcc, 0x7e, 0x37, 0xf8, 0x31,
+	0x37, 0xb4, 0xcb, 0xb9, 0xa1, 0xfd, 0x9a, 0x1b, 0xda, 0xf9, 0xc2, 0xc8, 0x5d, 0x2e, 0x8c, 0xdc,
+	0xcf, 0x85, 0x91, 0xfb, 0xd8, 0xf1, 0x7c, 0x79, 0x3c, 0x19, 0x59, 0xae, 0x08, 0xec, 0xb8, 0xb9,
+	0x79, 0xc2, 0x46, 0x94, 0x04, 0xf6, 0xf4, 0xe0, 0xd0, 0x9e, 0xad, 0xfc, 0x67, 0x26, 0x06, 0x5c,
+	0xde, 0x23, 0x34, 0x2a, 0xa9, 0x5b, 0xe9, 0xd5, 0xef, 0x01, 0x00, 0xc5, 0x7d, 0x68, 0xfa, 0x08,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -806,6 +820,11 @@ func (m *TxExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnorderedNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnorderedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.SelectedAuthenticators) > 0 {
 		dAtA2 := make([]byte, len(m.SelectedAuthenticators)*10)
 		var j1 int
@@ -937,6 +956,9 @@ func (m *TxExtension) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if m.UnorderedNonce != 0 {
+		n += 1 + sovTx(uint64(m.UnorderedNonce))
+	}
 	return n
 }
 
@@ -1592,6 +1614,25 @@ func (m *TxExtension) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field SelectedAuthenticators", wireType)
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedNonce", wireType)
+			}
+			m.UnorderedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnorderedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
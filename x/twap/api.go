@@ -79,8 +79,159 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetVolumeWeightedTwap returns a volume weighted average price (VWAP) of the base asset, in units of the
+// quote asset, from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+// It is the amount of the quote asset traded over the amount of the base asset traded, in swaps of the pool
+// between the two assets, so prices that moved without volume behind them have no weight.
+//
+// It has the same constraints on startTime and endTime as GetArithmeticTwap, and additionally errors
+// if there were no swaps between the two assets from startTime to endTime, or if startTime is before the
+// volume of the pair was tracked by its records.
+func (k Keeper) GetVolumeWeightedTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getStartAndEndRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if err := k.validateAccumulatorsTracked(ctx, startRecord); err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	twap, err := computeTwap(startRecord, endRecord, quoteAssetDenom, k.GetVolumeWeightedStrategy())
+	if err == nil && twap.IsZero() {
+		return osmomath.Dec{}, types.NoVolumeInTimeRangeError{PoolId: poolId, StartTime: startTime, EndTime: endTime}
+	}
+	return twap, err
+}
+
+// GetVolumeWeightedTwapToNow returns the volume weighted average price from start time until the current
+// block time for quote and base assets in a given pool.
+func (k Keeper) GetVolumeWeightedTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	return k.GetVolumeWeightedTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime())
+}
+
+// GetArithmeticTwapOverRoute returns the arithmetic twap of the base asset, in units of the quote asset of the
+// last hop of the route, from (startTime, endTime).
+// It is the product of the arithmetic twaps of every hop of the route, all computed over the same time range.
+// Each hop prices the quote asset of the previous hop, or the base asset for the first hop, in its own quote asset.
+//
+// Note that the product of arithmetic means is not the arithmetic mean of the product of the prices,
+// so the result is an approximation when prices move during the time range. Use GetGeometricTwapOverRoute
+// for an exact composition.
+//
+// It has the same constraints on startTime and endTime as GetArithmeticTwap, and errors if the route is empty
+// or if any pool of the route does not contain the denoms of its hop.
+func (k Keeper) GetArithmeticTwapOverRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	routeRecords, err := k.getRouteRecords(ctx, baseAssetDenom, route, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return computeTwapProductOverRoute(routeRecords, k.GetArithmeticStrategy())
+}
+
+// GetGeometricTwapOverRoute returns the geometric twap of the base asset, in units of the quote asset of the
+// last hop of the route, from (startTime, endTime).
+// Since the geometric mean of a product is the product of the geometric means, it composes exactly: the mean
+// log prices of every hop are summed from their accumulators, and exponentiated once.
+//
+// It has the same constraints on startTime and endTime as GetGeometricTwap, and errors if the route is empty
+// or if any pool of the route does not contain the denoms of its hop.
+func (k Keeper) GetGeometricTwapOverRoute(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	routeRecords, err := k.getRouteRecords(ctx, baseAssetDenom, route, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return computeGeometricTwapOverRoute(routeRecords)
+}
+
+// GetVolatility returns the realized volatility of the pool's spot price from (startTime, endTime):
+// the time weighted standard deviation of the natural log of the spot price over the time range.
+// It is not annualized, and is the same for both assets of the pair.
+//
+// It has the same constraints on startTime and endTime as GetGeometricTwap, and additionally errors
+// if startTime is before the log price squared accumulator was tracked by the records of the pool.
+func (k Keeper) GetVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getStartAndEndRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if err := k.validateAccumulatorsTracked(ctx, startRecord); err != nil {
+		return osmomath.Dec{}, err
+	}
+	return computeVolatility(startRecord, endRecord)
+}
+
+// GetSpotPriceRange returns the minimum and maximum spot price of the base asset, in units of the quote asset,
+// observed in pool `poolId` from (startTime, endTime). These are the spot price at the start time, and the spot prices
+// at the end of every block that changed the pool during the time range, so prices within a block are not observed.
+//
+// It has the same constraints on startTime and endTime as GetArithmeticTwap, and similarly returns the prices along
+// with an error if there was a spot price error during the time range.
+func (k Keeper) GetSpotPriceRange(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (minSpotPrice osmomath.Dec, maxSpotPrice osmomath.Dec, err error) {
+	startRecord, endRecord, err := k.getStartAndEndRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	records, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+
+	spotPrice := func(record types.TwapRecord) osmomath.Dec {
+		if quoteAssetDenom == record.Asset0Denom {
+			return record.P0LastSpotPrice
+		}
+		return record.P1LastSpotPrice
+	}
+	minSpotPrice, maxSpotPrice = spotPrice(startRecord), spotPrice(startRecord)
+	for _, record := range records {
+		price := spotPrice(record)
+		minSpotPrice = osmomath.MinDec(minSpotPrice, price)
+		maxSpotPrice = osmomath.MaxDec(maxSpotPrice, price)
+	}
+	return minSpotPrice, maxSpotPrice, spotPriceErrorBetweenRecords(startRecord, endRecord)
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or volume weighted.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -90,28 +241,72 @@ func (k Keeper) getTwap(
 	endTime time.Time,
 	strategy twapStrategy,
 ) (osmomath.Dec, error) {
+	startRecord, endRecord, err := k.getStartAndEndRecords(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+}
+
+// getStartAndEndRecords returns the records of the pool at the start time and at the end time,
+// interpolated from the records in state. If the end time is the current block time, the end record
+// is the most recent record of the pool.
+func (k Keeper) getStartAndEndRecords(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (startRecord types.TwapRecord, endRecord types.TwapRecord, err error) {
 	if startTime.After(endTime) {
-		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+		return types.TwapRecord{}, types.TwapRecord{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
 	}
-	if endTime.Equal(ctx.BlockTime()) {
-		return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, strategy)
-	} else if endTime.After(ctx.BlockTime()) {
-		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	if endTime.After(ctx.BlockTime()) {
+		return types.TwapRecord{}, types.TwapRecord{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
 	}
-	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	startRecord, err = k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
 	if err != nil {
-		return osmomath.Dec{}, err
+		return types.TwapRecord{}, types.TwapRecord{}, err
+	}
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
 	}
-	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
 	if err != nil {
-		return osmomath.Dec{}, err
+		return types.TwapRecord{}, types.TwapRecord{}, err
 	}
+	return startRecord, endRecord, nil
+}
 
-	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+// getRouteRecords returns the start and end records of every hop of the route, over the same time range.
+func (k Keeper) getRouteRecords(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) ([]routeHopRecords, error) {
+	if len(route) == 0 {
+		return nil, types.ErrEmptyTwapRoute
+	}
+	routeRecords := make([]routeHopRecords, len(route))
+	denomIn := baseAssetDenom
+	for i, hop := range route {
+		startRecord, endRecord, err := k.getStartAndEndRecords(ctx, hop.PoolId, denomIn, hop.QuoteAsset, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		routeRecords[i] = routeHopRecords{startRecord: startRecord, endRecord: endRecord, quoteAsset: hop.QuoteAsset}
+		denomIn = hop.QuoteAsset
+	}
+	return routeRecords, nil
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or volume weighted.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
@@ -13,6 +13,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v25/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v25/x/twap"
 	"github.com/osmosis-labs/osmosis/v25/x/twap/types"
+	"github.com/osmosis-labs/osmosis/v25/x/twap/types/twapmock"
 )
 
 var (
@@ -112,7 +113,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec, osmomath.ZeroDec()), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			withLogPriceSquaredAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), logSquaredTenSecAccum),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -909,3 +910,304 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+// TestGetVolumeWeightedTwap tests that the volume weighted twap of a pair is the amount of the quote asset
+// traded over the amount of the base asset traded in swaps between the two assets, and that swaps of
+// the other pairs of the pool are not part of it.
+func (s *TestSuite) TestGetVolumeWeightedTwap() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...)
+	startTime := s.Ctx.BlockTime()
+	s.Commit()
+
+	// commitBlockWithSwaps tracks the given swaps of the pool, as pairs of the coin swapped in and the coin
+	// swapped out, and commits the block, which updates the records of the pool. It returns the block time.
+	commitBlockWithSwaps := func(swaps ...[2]sdk.Coin) time.Time {
+		blockTime := s.Ctx.BlockTime()
+		for _, swap := range swaps {
+			s.twapkeeper.TrackChangedPool(s.Ctx, poolId)
+			s.twapkeeper.TrackSwapVolume(s.Ctx, poolId, sdk.NewCoins(swap[0]), sdk.NewCoins(swap[1]))
+		}
+		s.Commit()
+		return blockTime
+	}
+
+	// the first block swaps 100 denom0 for 200 denom1, and 1000 denom2 for 10 denom0.
+	// the second block has no swaps, and the third swaps 300 denom1 for 100 denom0.
+	accumulatorsStartHeight := s.Ctx.BlockHeight()
+	firstBlockTime := commitBlockWithSwaps(
+		[2]sdk.Coin{sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom1, 200)},
+		[2]sdk.Coin{sdk.NewInt64Coin(denom2, 1000), sdk.NewInt64Coin(denom0, 10)},
+	)
+	secondBlockTime := commitBlockWithSwaps()
+	commitBlockWithSwaps([2]sdk.Coin{sdk.NewInt64Coin(denom1, 300), sdk.NewInt64Coin(denom0, 100)})
+
+	// (100 + 100) denom0 traded for (200 + 300) denom1.
+	expectedTwap := osmomath.MustNewDecFromStr("0.4")
+
+	twap, err := s.twapkeeper.GetVolumeWeightedTwap(s.Ctx, poolId, denom1, denom0, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTwap, twap)
+
+	twap, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom1, denom0, startTime)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTwap, twap)
+
+	// only the third block is after the first one: 300 denom1 traded for 100 denom0.
+	twap, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom0, denom1, firstBlockTime)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(3), twap)
+
+	// the denom0 and denom2 pair only has the volume of its own swap.
+	twap, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom2, denom0, startTime)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.01"), twap)
+
+	_, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom1, denom2, startTime)
+	s.Require().Equal(types.NoVolumeInTimeRangeError{PoolId: poolId, StartTime: startTime, EndTime: s.Ctx.BlockTime()}, err)
+
+	_, err = s.twapkeeper.GetVolumeWeightedTwap(s.Ctx, poolId, denom1, denom0, firstBlockTime, secondBlockTime)
+	s.Require().Equal(types.NoVolumeInTimeRangeError{PoolId: poolId, StartTime: firstBlockTime, EndTime: secondBlockTime}, err)
+
+	// time ranges starting before the volume was tracked error.
+	s.App.TwapKeeper.SetAccumulatorsStartHeight(s.Ctx, accumulatorsStartHeight)
+	_, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom1, denom0, startTime)
+	s.Require().ErrorAs(err, &types.AccumulatorsNotTrackedError{})
+
+	twap, err = s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denom0, denom1, firstBlockTime)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(3), twap)
+}
+
+// TestGetTwapOverRoute tests that the twaps over a route compose the twaps of every hop of the route,
+// over the same time range.
+func (s *TestSuite) TestGetTwapOverRoute() {
+	// 1 denom0 = 2 denom1, and 1 denom1 = 3 denom2.
+	poolOne := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000), sdk.NewInt64Coin(denom1, 2_000_000))
+	poolTwo := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 3_000_000))
+	programmableAmmInterface := twapmock.NewProgrammedAmmInterface(s.App.TwapKeeper.GetAmmInterface())
+	s.App.TwapKeeper.SetAmmInterface(programmableAmmInterface)
+
+	route := []types.TwapRouteHop{{PoolId: poolOne, QuoteAsset: denom1}, {PoolId: poolTwo, QuoteAsset: denom2}}
+	reverseRoute := []types.TwapRouteHop{{PoolId: poolTwo, QuoteAsset: denom1}, {PoolId: poolOne, QuoteAsset: denom0}}
+	// the log and exponent computations of geometric twaps are precise to about 8 decimals.
+	errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 7)}
+
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(30 * time.Second))
+	twap, err := s.twapkeeper.GetArithmeticTwapOverRoute(s.Ctx, denom0, route, baseTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDec(6), twap)
+
+	twap, err = s.twapkeeper.GetGeometricTwapOverRoute(s.Ctx, denom0, route, baseTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.NewBigDec(6), osmomath.BigDecFromDec(twap)))
+
+	twap, err = s.twapkeeper.GetGeometricTwapOverRoute(s.Ctx, denom2, reverseRoute, baseTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.OneBigDec().QuoInt64(6), osmomath.BigDecFromDec(twap)))
+
+	// the price of pool one doubles halfway through the time range.
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	programmableAmmInterface.ProgramPoolSpotPriceOverride(poolOne, denom1, denom0, osmomath.NewDec(4), nil)
+	programmableAmmInterface.ProgramPoolSpotPriceOverride(poolOne, denom0, denom1, osmomath.MustNewDecFromStr("0.25"), nil)
+	s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolOne))
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(60 * time.Second))
+	endTime := baseTime.Add(50 * time.Second)
+
+	// the arithmetic twap over the route is the product of the arithmetic twaps of the hops.
+	twap, err = s.twapkeeper.GetArithmeticTwapOverRoute(s.Ctx, denom0, route, baseTime, endTime)
+	s.Require().NoError(err)
+	poolOneTwap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, poolOne, denom0, denom1, baseTime, endTime)
+	s.Require().NoError(err)
+	poolTwoTwap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, poolTwo, denom1, denom2, baseTime, endTime)
+	s.Require().NoError(err)
+	s.Require().Equal(poolOneTwap.Mul(poolTwoTwap), twap)
+
+	// the geometric twap over the route is the geometric mean of the price over the route:
+	// (2^30 * 4^20)^(1/50) * 3
+	twap, err = s.twapkeeper.GetGeometricTwapOverRoute(s.Ctx, denom0, route, baseTime, endTime)
+	s.Require().NoError(err)
+	expectedTwap := osmomath.Exp2(osmomath.NewBigDecWithPrec(14, 1)).MulInt64(3)
+	s.Require().Equal(0, errTolerance.CompareBigDec(expectedTwap, osmomath.BigDecFromDec(twap)))
+
+	_, err = s.twapkeeper.GetGeometricTwapOverRoute(s.Ctx, denom0, []types.TwapRouteHop{}, baseTime, endTime)
+	s.Require().ErrorIs(err, types.ErrEmptyTwapRoute)
+
+	// the second hop does not price the quote asset of the first one.
+	_, err = s.twapkeeper.GetArithmeticTwapOverRoute(s.Ctx, denom0, []types.TwapRouteHop{{PoolId: poolOne, QuoteAsset: denom1}, {PoolId: poolOne, QuoteAsset: denom2}}, baseTime, endTime)
+	s.Require().Error(err)
+
+	_, err = s.twapkeeper.GetArithmeticTwapOverRoute(s.Ctx, denom0, route, baseTime, baseTime.Add(time.Hour))
+	s.Require().Equal(types.EndTimeInFutureError{EndTime: baseTime.Add(time.Hour), BlockTime: s.Ctx.BlockTime()}, err)
+}
+
+// TestGetVolatility tests that the volatility is the time weighted standard deviation of the
+// natural log of the spot price over the time range.
+func (s *TestSuite) TestGetVolatility() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	programmableAmmInterface := twapmock.NewProgrammedAmmInterface(s.App.TwapKeeper.GetAmmInterface())
+	s.App.TwapKeeper.SetAmmInterface(programmableAmmInterface)
+	// the log computations of geometric twaps are precise to about 8 decimals.
+	errTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 7)}
+
+	updateRecordsAt := func(t time.Time, sp0 osmomath.Dec) {
+		s.Ctx = s.Ctx.WithBlockTime(t).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom0, denom1, sp0, nil)
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom1, denom0, osmomath.OneDec().Quo(sp0), nil)
+		s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolId))
+	}
+
+	// spot price of 2 for 10 seconds, then of 8 for 10 seconds.
+	updateRecordsAt(baseTime.Add(10*time.Second), osmomath.NewDec(2))
+	updateRecordsAt(baseTime.Add(20*time.Second), osmomath.NewDec(8))
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(30 * time.Second))
+
+	// the spot price did not change, so it did not vary.
+	volatility, err := s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime, baseTime.Add(10*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroDec(), volatility)
+
+	volatility, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime.Add(21*time.Second), baseTime.Add(30*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroDec(), volatility)
+
+	// log_2 of the spot price is 1 and 3 for the same duration, so its standard deviation is 1,
+	// which is ln(2) in natural log. The volatility does not depend on the direction of the price.
+	volatility, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime.Add(10*time.Second), baseTime.Add(30*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.BigDecFromDec(types.Ln2), osmomath.BigDecFromDec(volatility)))
+
+	volatility, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom0, denom1, baseTime.Add(10*time.Second), baseTime.Add(30*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.BigDecFromDec(types.Ln2), osmomath.BigDecFromDec(volatility)))
+
+	_, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime, baseTime.Add(time.Hour))
+	s.Require().Equal(types.EndTimeInFutureError{EndTime: baseTime.Add(time.Hour), BlockTime: s.Ctx.BlockTime()}, err)
+
+	// time ranges starting before the log price squared accumulator was tracked error.
+	s.App.TwapKeeper.SetAccumulatorsStartHeight(s.Ctx, s.Ctx.BlockHeight())
+	_, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime.Add(10*time.Second), baseTime.Add(30*time.Second))
+	s.Require().ErrorAs(err, &types.AccumulatorsNotTrackedError{})
+
+	volatility, err = s.twapkeeper.GetVolatility(s.Ctx, poolId, denom1, denom0, baseTime.Add(21*time.Second), baseTime.Add(30*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroDec(), volatility)
+}
+
+// TestComputeVolatilityNegativeVariance tests that a negative variance of the log price is clamped to zero
+// only if it is within rounding errors.
+func (s *TestSuite) TestComputeVolatilityNegativeVariance() {
+	startRecord := types.TwapRecord{
+		Time:                       baseTime,
+		GeometricTwapAccumulator:   osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator: osmomath.ZeroDec(),
+	}
+	// log_2 of the spot price is 1 for one second, so E[log(P)] = 1 and E[log(P)^2] is expected to be 1.
+	endRecord := func(logPriceSquaredAccum osmomath.Dec) types.TwapRecord {
+		return types.TwapRecord{
+			Time:                       baseTime.Add(time.Second),
+			GeometricTwapAccumulator:   OneSec,
+			LogPriceSquaredAccumulator: logPriceSquaredAccum,
+		}
+	}
+
+	// a rounding error is clamped to zero.
+	volatility, err := twap.ComputeVolatility(startRecord, endRecord(OneSec.Sub(osmomath.NewDecWithPrec(1, 15))))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroDec(), volatility)
+
+	// a larger negative variance means the accumulators are inconsistent.
+	_, err = twap.ComputeVolatility(startRecord, endRecord(OneSec.Sub(osmomath.NewDecWithPrec(1, 3))))
+	s.Require().ErrorAs(err, &types.NegativeVarianceError{})
+}
+
+// TestGetSpotPriceRange tests that the spot price range is the minimum and maximum of the spot price
+// at the start time and the spot prices recorded during the time range.
+func (s *TestSuite) TestGetSpotPriceRange() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	programmableAmmInterface := twapmock.NewProgrammedAmmInterface(s.App.TwapKeeper.GetAmmInterface())
+	s.App.TwapKeeper.SetAmmInterface(programmableAmmInterface)
+
+	updateRecordsAt := func(t time.Time, sp0 osmomath.Dec) {
+		s.Ctx = s.Ctx.WithBlockTime(t).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom0, denom1, sp0, nil)
+		programmableAmmInterface.ProgramPoolSpotPriceOverride(poolId, denom1, denom0, osmomath.OneDec().Quo(sp0), nil)
+		s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolId))
+	}
+
+	// spot price of 2, then of 8, then of 4.
+	updateRecordsAt(baseTime.Add(10*time.Second), osmomath.NewDec(2))
+	updateRecordsAt(baseTime.Add(20*time.Second), osmomath.NewDec(8))
+	updateRecordsAt(baseTime.Add(30*time.Second), osmomath.NewDec(4))
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(40 * time.Second))
+
+	tests := map[string]struct {
+		baseDenom   string
+		quoteDenom  string
+		startTime   time.Time
+		endTime     time.Time
+		expectedMin osmomath.Dec
+		expectedMax osmomath.Dec
+		expectedErr error
+	}{
+		"all records": {
+			baseDenom:   denom1,
+			quoteDenom:  denom0,
+			startTime:   baseTime.Add(10 * time.Second),
+			endTime:     s.Ctx.BlockTime(),
+			expectedMin: osmomath.NewDec(2),
+			expectedMax: osmomath.NewDec(8),
+		},
+		"inverse of the spot price": {
+			baseDenom:   denom0,
+			quoteDenom:  denom1,
+			startTime:   baseTime.Add(10 * time.Second),
+			endTime:     s.Ctx.BlockTime(),
+			expectedMin: osmomath.MustNewDecFromStr("0.125"),
+			expectedMax: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"start time between records uses the spot price of the previous record": {
+			baseDenom:   denom1,
+			quoteDenom:  denom0,
+			startTime:   baseTime.Add(25 * time.Second),
+			endTime:     s.Ctx.BlockTime(),
+			expectedMin: osmomath.NewDec(4),
+			expectedMax: osmomath.NewDec(8),
+		},
+		"records after the end time are excluded": {
+			baseDenom:   denom1,
+			quoteDenom:  denom0,
+			startTime:   baseTime.Add(15 * time.Second),
+			endTime:     baseTime.Add(25 * time.Second),
+			expectedMin: osmomath.NewDec(2),
+			expectedMax: osmomath.NewDec(8),
+		},
+		"no records in time range": {
+			baseDenom:   denom1,
+			quoteDenom:  denom0,
+			startTime:   baseTime.Add(31 * time.Second),
+			endTime:     baseTime.Add(35 * time.Second),
+			expectedMin: osmomath.NewDec(4),
+			expectedMax: osmomath.NewDec(4),
+		},
+		"end time in future": {
+			baseDenom:   denom1,
+			quoteDenom:  denom0,
+			startTime:   baseTime,
+			endTime:     baseTime.Add(time.Hour),
+			expectedErr: types.EndTimeInFutureError{EndTime: baseTime.Add(time.Hour), BlockTime: s.Ctx.BlockTime()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			minSpotPrice, maxSpotPrice, err := s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, tc.baseDenom, tc.quoteDenom, tc.startTime, tc.endTime)
+			if tc.expectedErr != nil {
+				s.Require().Equal(tc.expectedErr, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedMin, minSpotPrice)
+			s.Require().Equal(tc.expectedMax, maxSpotPrice)
+		})
+	}
+}
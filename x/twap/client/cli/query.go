@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	poolmanager "github.com/osmosis-labs/osmosis/v25/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v25/x/twap/client/queryproto"
@@ -25,11 +26,26 @@ type twapQueryArgs struct {
 	EndTime   time.Time
 }
 
+// twapRouteQueryArgs represents the outcome
+// of parsing the arguments for twap over route query command.
+type twapRouteQueryArgs struct {
+	BaseDenom string
+	Route     []types.TwapRouteHop
+	StartTime time.Time
+	EndTime   time.Time
+}
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryVolumeWeightedCommand())
+	cmd.AddCommand(GetQueryVolatilityCommand())
+	cmd.AddCommand(GetQuerySpotPriceRangeCommand())
+	cmd.AddCommand(GetQueryArithmeticOverRouteCommand())
+	cmd.AddCommand(GetQueryGeometricOverRouteCommand())
+	cmd.AddCommand(GetQueryHistoricalRecordsCommand())
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -138,6 +154,294 @@ Example:
 	return cmd
 }
 
+// GetQueryVolumeWeightedCommand returns a volume weighted twap query command.
+func GetQueryVolumeWeightedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "volume-weighted [poolid] [base denom] [start time] [end time]",
+		Short:   "Query volume weighted twap",
+		Aliases: []string{"vwap"},
+		Long: osmocli.FormatLongDescDirect(`Query volume weighted twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.VolumeWeightedTwap(cmd.Context(), &queryproto.VolumeWeightedTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryVolatilityCommand returns a volatility query command.
+func GetQueryVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query the volatility of the spot price",
+		Long: osmocli.FormatLongDescDirect(`Query the realized volatility of the spot price of a pool, as the time weighted standard deviation of the natural log of the spot price.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.Volatility(cmd.Context(), &queryproto.VolatilityRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQuerySpotPriceRangeCommand returns a spot price range query command.
+func GetQuerySpotPriceRangeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spot-price-range [poolid] [base denom] [start time] [end time]",
+		Short: "Query the minimum and maximum spot price",
+		Long: osmocli.FormatLongDescDirect(`Query the minimum and maximum spot price of a pool observed over a time range. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} spot-price-range 1 uosmo 1667088000 24h
+{{.CommandPrefix}} spot-price-range 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.SpotPriceRange(cmd.Context(), &queryproto.SpotPriceRangeRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryHistoricalRecordsCommand returns a historical twap records query command.
+func GetQueryHistoricalRecordsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-records [poolid] [denom0] [denom1] [start time] [end time]",
+		Short: "Query the historical twap records of a denom pair of a pool",
+		Long: osmocli.FormatLongDescDirect(`Query the historical twap records of a denom pair of a pool, written from the start time to the end time.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} historical-records 1 uosmo uatom 1667088000 24h
+{{.CommandPrefix}} historical-records 1 uosmo uatom 1667088000 1667174400 --limit 10
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, err := osmocli.ParseUint(args[0], "poolId")
+			if err != nil {
+				return err
+			}
+			startTime, endTime, err := twapQueryParseTimeArgs(args[3], args[4])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.HistoricalRecords(cmd.Context(), &queryproto.HistoricalRecordsRequest{
+				PoolId:      poolId,
+				Asset0Denom: strings.TrimSpace(args[1]),
+				Asset1Denom: strings.TrimSpace(args[2]),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historical-records")
+
+	return cmd
+}
+
+// GetQueryArithmeticOverRouteCommand returns an arithmetic twap over route query command.
+func GetQueryArithmeticOverRouteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arithmetic-route [base denom] [pool ids] [quote denoms] [start time] [end time]",
+		Short: "Query arithmetic twap over a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query arithmetic twap of the base denom over a route of pools, in units of the last quote denom.
+Each pool of the route prices the quote denom of the previous pool in its own quote denom.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} arithmetic-route uatom 1,2 uosmo,uusdc 1667088000 24h
+{{.CommandPrefix}} arithmetic-route uatom 1,2 uosmo,uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			twapArgs, err := twapRouteQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.ArithmeticTwapOverRoute(cmd.Context(), &queryproto.ArithmeticTwapOverRouteRequest{
+				BaseAsset: twapArgs.BaseDenom,
+				Route:     twapArgs.Route,
+				StartTime: twapArgs.StartTime,
+				EndTime:   &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryGeometricOverRouteCommand returns a geometric twap over route query command.
+func GetQueryGeometricOverRouteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometric-route [base denom] [pool ids] [quote denoms] [start time] [end time]",
+		Short: "Query geometric twap over a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap of the base denom over a route of pools, in units of the last quote denom.
+Each pool of the route prices the quote denom of the previous pool in its own quote denom.
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} geometric-route uatom 1,2 uosmo,uusdc 1667088000 24h
+{{.CommandPrefix}} geometric-route uatom 1,2 uosmo,uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			twapArgs, err := twapRouteQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.GeometricTwapOverRoute(cmd.Context(), &queryproto.GeometricTwapOverRouteRequest{
+				BaseAsset: twapArgs.BaseDenom,
+				Route:     twapArgs.Route,
+				StartTime: twapArgs.StartTime,
+				EndTime:   &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -173,28 +477,63 @@ func twapQueryParseArgs(args []string) (twapQueryArgs, error) {
 	// <DENOM PARSE>
 	baseDenom := strings.TrimSpace(args[1])
 
-	// <UNIX TIME PARSE>
-	startTime, err := osmocli.ParseUnixTime(args[2], "start time")
+	startTime, endTime, err := twapQueryParseTimeArgs(args[2], args[3])
 	if err != nil {
 		return twapQueryArgs{}, err
 	}
+	return twapQueryArgs{
+		PoolId:    poolId,
+		BaseDenom: baseDenom,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+func twapQueryParseTimeArgs(startTimeArg, endTimeArg string) (time.Time, time.Time, error) {
+	// <UNIX TIME PARSE>
+	startTime, err := osmocli.ParseUnixTime(startTimeArg, "start time")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
 
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err := osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err := osmocli.ParseUnixTime(endTimeArg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(endTimeArg)
 		if err2 != nil {
-			err = err2
-			return twapQueryArgs{}, err
+			return time.Time{}, time.Time{}, err2
 		}
 		endTime = startTime.Add(duration)
 	}
-	return twapQueryArgs{
-		PoolId:    poolId,
+	return startTime, endTime, nil
+}
+
+func twapRouteQueryParseArgs(args []string) (twapRouteQueryArgs, error) {
+	baseDenom := strings.TrimSpace(args[0])
+
+	poolIds, err := osmoutils.ParseUint64SliceFromString(args[1], ",")
+	if err != nil {
+		return twapRouteQueryArgs{}, err
+	}
+	quoteDenoms := strings.Split(args[2], ",")
+	if len(poolIds) != len(quoteDenoms) {
+		return twapRouteQueryArgs{}, fmt.Errorf("route has %d pool ids but %d quote denoms", len(poolIds), len(quoteDenoms))
+	}
+	route := make([]types.TwapRouteHop, len(poolIds))
+	for i, poolId := range poolIds {
+		route[i] = types.TwapRouteHop{PoolId: poolId, QuoteAsset: quoteDenoms[i]}
+	}
+
+	startTime, endTime, err := twapQueryParseTimeArgs(args[3], args[4])
+	if err != nil {
+		return twapRouteQueryArgs{}, err
+	}
+	return twapRouteQueryArgs{
 		BaseDenom: baseDenom,
+		Route:     route,
 		StartTime: startTime,
 		EndTime:   endTime,
 	}, nil
@@ -21,6 +21,46 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) VolumeWeightedTwapToNow(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwapToNow(ctx, *req)
+}
+
+func (q Querier) VolumeWeightedTwap(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwap(ctx, *req)
+}
+
+func (q Querier) Volatility(grpcCtx context.Context,
+	req *queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Volatility(ctx, *req)
+}
+
+func (q Querier) SpotPriceRange(grpcCtx context.Context,
+	req *queryproto.SpotPriceRangeRequest,
+) (*queryproto.SpotPriceRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SpotPriceRange(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +71,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) HistoricalRecords(grpcCtx context.Context,
+	req *queryproto.HistoricalRecordsRequest,
+) (*queryproto.HistoricalRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HistoricalRecords(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -41,6 +91,16 @@ func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	return q.Q.GeometricTwapToNow(ctx, *req)
 }
 
+func (q Querier) GeometricTwapOverRoute(grpcCtx context.Context,
+	req *queryproto.GeometricTwapOverRouteRequest,
+) (*queryproto.GeometricTwapOverRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapOverRoute(ctx, *req)
+}
+
 func (q Querier) GeometricTwap(grpcCtx context.Context,
 	req *queryproto.GeometricTwapRequest,
 ) (*queryproto.GeometricTwapResponse, error) {
@@ -61,6 +121,16 @@ func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	return q.Q.ArithmeticTwapToNow(ctx, *req)
 }
 
+func (q Querier) ArithmeticTwapOverRoute(grpcCtx context.Context,
+	req *queryproto.ArithmeticTwapOverRouteRequest,
+) (*queryproto.ArithmeticTwapOverRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ArithmeticTwapOverRoute(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwap(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapRequest,
 ) (*queryproto.ArithmeticTwapResponse, error) {
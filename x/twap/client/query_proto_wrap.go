@@ -61,6 +61,107 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) Volatility(ctx sdk.Context,
+	req queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolatilityResponse{Volatility: volatility}, err
+}
+
+func (q Querier) SpotPriceRange(ctx sdk.Context,
+	req queryproto.SpotPriceRangeRequest,
+) (*queryproto.SpotPriceRangeResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	minSpotPrice, maxSpotPrice, err := q.K.GetSpotPriceRange(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.SpotPriceRangeResponse{MinSpotPrice: minSpotPrice, MaxSpotPrice: maxSpotPrice}, err
+}
+
+func (q Querier) HistoricalRecords(ctx sdk.Context,
+	req queryproto.HistoricalRecordsRequest,
+) (*queryproto.HistoricalRecordsResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	records, pageRes, err := q.K.GetHistoricalRecords(ctx, req.PoolId, req.Asset0Denom, req.Asset1Denom, req.StartTime, *req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.HistoricalRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (q Querier) ArithmeticTwapOverRoute(ctx sdk.Context,
+	req queryproto.ArithmeticTwapOverRouteRequest,
+) (*queryproto.ArithmeticTwapOverRouteResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetArithmeticTwapOverRoute(ctx, req.BaseAsset, req.Route, req.StartTime, *req.EndTime)
+
+	return &queryproto.ArithmeticTwapOverRouteResponse{ArithmeticTwap: twap}, err
+}
+
+func (q Querier) GeometricTwapOverRoute(ctx sdk.Context,
+	req queryproto.GeometricTwapOverRouteRequest,
+) (*queryproto.GeometricTwapOverRouteResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetGeometricTwapOverRoute(ctx, req.BaseAsset, req.Route, req.StartTime, *req.EndTime)
+
+	return &queryproto.GeometricTwapOverRouteResponse{GeometricTwap: twap}, err
+}
+
+func (q Querier) VolumeWeightedTwap(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetVolumeWeightedTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolumeWeightedTwapResponse{VolumeWeightedTwap: twap}, err
+}
+
+func (q Querier) VolumeWeightedTwapToNow(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	twap, err := q.K.GetVolumeWeightedTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.VolumeWeightedTwapToNowResponse{VolumeWeightedTwap: twap}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -10,7 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -475,21 +475,26 @@ func (m *GeometricTwapToNowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeometricTwapToNowResponse proto.InternalMessageInfo
 
-type ParamsRequest struct {
+type VolatilityRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
-func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ParamsRequest) ProtoMessage()    {}
-func (*ParamsRequest) Descriptor() ([]byte, []int) {
+func (m *VolatilityRequest) Reset()         { *m = VolatilityRequest{} }
+func (m *VolatilityRequest) String() string { return proto.CompactTextString(m) }
+func (*VolatilityRequest) ProtoMessage()    {}
+func (*VolatilityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_141a22dba58615af, []int{8}
 }
-func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *VolatilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VolatilityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -499,34 +504,71 @@ func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsRequest.Merge(m, src)
+func (m *VolatilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolatilityRequest.Merge(m, src)
 }
-func (m *ParamsRequest) XXX_Size() int {
+func (m *VolatilityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+func (m *VolatilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolatilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_VolatilityRequest proto.InternalMessageInfo
 
-type ParamsResponse struct {
-	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *VolatilityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
-func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ParamsResponse) ProtoMessage()    {}
-func (*ParamsResponse) Descriptor() ([]byte, []int) {
+func (m *VolatilityRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *VolatilityRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *VolatilityRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VolatilityRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type VolatilityResponse struct {
+	// volatility is the time weighted standard deviation of the natural log of
+	// the spot price over the time range.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+}
+
+func (m *VolatilityResponse) Reset()         { *m = VolatilityResponse{} }
+func (m *VolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*VolatilityResponse) ProtoMessage()    {}
+func (*VolatilityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_141a22dba58615af, []int{9}
 }
-func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *VolatilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VolatilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -536,905 +578,4859 @@ func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsResponse.Merge(m, src)
+func (m *VolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolatilityResponse.Merge(m, src)
 }
-func (m *ParamsResponse) XXX_Size() int {
+func (m *VolatilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+func (m *VolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolatilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_VolatilityResponse proto.InternalMessageInfo
 
-func (m *ParamsResponse) GetParams() types.Params {
-	if m != nil {
-		return m.Params
-	}
-	return types.Params{}
+type SpotPriceRangeRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func init() {
-	proto.RegisterType((*ArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapRequest")
-	proto.RegisterType((*ArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapResponse")
-	proto.RegisterType((*ArithmeticTwapToNowRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowRequest")
-	proto.RegisterType((*ArithmeticTwapToNowResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowResponse")
-	proto.RegisterType((*GeometricTwapRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapRequest")
-	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
-	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
-	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
+func (m *SpotPriceRangeRequest) Reset()         { *m = SpotPriceRangeRequest{} }
+func (m *SpotPriceRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceRangeRequest) ProtoMessage()    {}
+func (*SpotPriceRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{10}
+}
+func (m *SpotPriceRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceRangeRequest.Merge(m, src)
+}
+func (m *SpotPriceRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceRangeRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
+var xxx_messageInfo_SpotPriceRangeRequest proto.InternalMessageInfo
 
-var fileDescriptor_141a22dba58615af = []byte{
-	// 801 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x33, 0x29, 0x84, 0x66, 0x10, 0x41, 0x9d, 0x02, 0x05, 0x03, 0x76, 0x64, 0x28, 0x4a,
-	0x09, 0xb5, 0x49, 0xaa, 0x5e, 0x10, 0x3d, 0x10, 0x55, 0xaa, 0x2a, 0xa1, 0xaa, 0xb5, 0x50, 0x55,
-	0xf5, 0x12, 0x4d, 0x9c, 0xc1, 0x58, 0x8d, 0x3d, 0xc6, 0x9e, 0x40, 0x23, 0xf5, 0xd0, 0x56, 0xea,
-	0x1d, 0xb5, 0xea, 0xa1, 0x87, 0xf6, 0xde, 0x43, 0xbf, 0x07, 0xa7, 0x16, 0xa9, 0x97, 0xd5, 0x1e,
-	0xb2, 0x2b, 0xd8, 0x4f, 0xc0, 0x27, 0x58, 0x79, 0x66, 0x9c, 0x8d, 0xb3, 0x16, 0xeb, 0xbd, 0x20,
-	0x21, 0xed, 0x09, 0x66, 0xde, 0xff, 0xbd, 0xff, 0x6f, 0xde, 0x8c, 0x67, 0x02, 0xab, 0x34, 0xf2,
-	0x68, 0xe4, 0x46, 0x26, 0x3b, 0xc7, 0x81, 0x79, 0xd6, 0xe8, 0x10, 0x86, 0x1b, 0xe6, 0x69, 0x9f,
-	0x84, 0x03, 0x23, 0x08, 0x29, 0xa3, 0x68, 0x41, 0x2a, 0x8c, 0x58, 0x61, 0x48, 0x85, 0xb2, 0xe0,
-	0x50, 0x87, 0x72, 0x81, 0x19, 0xff, 0x27, 0xb4, 0xca, 0x56, 0x66, 0xb5, 0x78, 0xd0, 0x0e, 0x89,
-	0x4d, 0xc3, 0xae, 0xd4, 0xe9, 0x99, 0x3a, 0x87, 0xf8, 0x24, 0x36, 0x12, 0x1a, 0xd5, 0xe6, 0x22,
-	0xb3, 0x83, 0x23, 0x32, 0x92, 0xd8, 0xd4, 0xf5, 0x65, 0x7c, 0x7b, 0x3c, 0xce, 0x81, 0x47, 0xaa,
-	0x00, 0x3b, 0xae, 0x8f, 0x99, 0x4b, 0x13, 0xed, 0x9a, 0x43, 0xa9, 0xd3, 0x23, 0x26, 0x0e, 0x5c,
-	0x13, 0xfb, 0x3e, 0x65, 0x3c, 0x98, 0x38, 0xad, 0xc8, 0x28, 0x1f, 0x75, 0xfa, 0xc7, 0x26, 0xf6,
-	0x07, 0x49, 0x48, 0x98, 0xb4, 0xc5, 0x4a, 0xc5, 0x40, 0x86, 0xb4, 0xc9, 0x2c, 0xe6, 0x7a, 0x24,
-	0x62, 0xd8, 0x0b, 0x84, 0x40, 0xff, 0xab, 0x08, 0x17, 0x0f, 0x42, 0x97, 0x9d, 0x78, 0x84, 0xb9,
-	0xf6, 0xd1, 0x39, 0x0e, 0x2c, 0x72, 0xda, 0x27, 0x11, 0x43, 0xef, 0xc1, 0x99, 0x80, 0xd2, 0x5e,
-	0xdb, 0xed, 0x2e, 0x83, 0x2a, 0xa8, 0x4d, 0x59, 0xa5, 0x78, 0xf8, 0x79, 0x17, 0xad, 0x43, 0x18,
-	0x2f, 0xa7, 0x8d, 0xa3, 0x88, 0xb0, 0xe5, 0x62, 0x15, 0xd4, 0xca, 0x56, 0x39, 0x9e, 0x39, 0x88,
-	0x27, 0x90, 0x06, 0x67, 0x4f, 0xfb, 0x94, 0x25, 0xf1, 0xb7, 0x78, 0x1c, 0xf2, 0x29, 0x21, 0xf8,
-	0x06, 0xc2, 0x88, 0xe1, 0x90, 0xb5, 0x63, 0x96, 0xe5, 0xa9, 0x2a, 0xa8, 0xcd, 0x36, 0x15, 0x43,
-	0x80, 0x1a, 0x09, 0xa8, 0x71, 0x94, 0x80, 0xb6, 0xd6, 0x2f, 0x87, 0x5a, 0xe1, 0x76, 0xa8, 0xbd,
-	0x33, 0xc0, 0x5e, 0x6f, 0x4f, 0x7f, 0x91, 0xab, 0x5f, 0x3c, 0xd1, 0x80, 0x55, 0xe6, 0x13, 0xb1,
-	0x1c, 0x59, 0xf0, 0x6d, 0xe2, 0x77, 0x45, 0xdd, 0xe9, 0x57, 0xd6, 0x5d, 0xbd, 0x1c, 0x6a, 0xe0,
-	0x76, 0xa8, 0xcd, 0x8b, 0xba, 0x49, 0xa6, 0xa8, 0x3a, 0x43, 0xfc, 0x6e, 0x2c, 0xd5, 0x7f, 0x04,
-	0x70, 0x69, 0xb2, 0x41, 0x51, 0x40, 0xfd, 0x88, 0xa0, 0x63, 0x38, 0x8f, 0x47, 0x91, 0x76, 0x7c,
-	0x4a, 0x78, 0xa7, 0xca, 0xad, 0x4f, 0x62, 0xe2, 0xc7, 0x43, 0x6d, 0x55, 0xec, 0x45, 0xd4, 0xfd,
-	0xce, 0x70, 0xa9, 0xe9, 0x61, 0x76, 0x62, 0x1c, 0x12, 0x07, 0xdb, 0x83, 0x4f, 0x89, 0x7d, 0x3b,
-	0xd4, 0x96, 0x84, 0xf1, 0x44, 0x0d, 0xdd, 0xaa, 0xe0, 0x94, 0x9f, 0xfe, 0x1f, 0x80, 0x4a, 0x1a,
-	0xe1, 0x88, 0x7e, 0x41, 0xcf, 0x1f, 0xee, 0x46, 0xe9, 0xbf, 0x00, 0xb8, 0x9a, 0xb9, 0xa2, 0x7b,
-	0xee, 0xec, 0x9f, 0x45, 0xb8, 0xf0, 0x19, 0xa1, 0x1e, 0x61, 0xe1, 0x9b, 0xc3, 0x9f, 0x71, 0xf8,
-	0x7f, 0x80, 0x8b, 0x13, 0xed, 0x91, 0x1b, 0x64, 0xc3, 0x8a, 0x93, 0x04, 0xc6, 0xf7, 0x67, 0x3f,
-	0xdf, 0xfe, 0x2c, 0x0a, 0xd7, 0x74, 0x09, 0xdd, 0x9a, 0x73, 0xc6, 0xcd, 0xf4, 0x7f, 0x01, 0x5c,
-	0x49, 0xd9, 0x3f, 0xf4, 0x63, 0xff, 0x13, 0x80, 0x4a, 0xd6, 0x82, 0xee, 0xb3, 0xa9, 0xf3, 0x70,
-	0xee, 0x4b, 0x1c, 0x62, 0x2f, 0x92, 0x7d, 0xd4, 0x0f, 0x61, 0x25, 0x99, 0x90, 0x1c, 0x7b, 0xb0,
-	0x14, 0xf0, 0x19, 0xee, 0x3f, 0xdb, 0x5c, 0x33, 0xb2, 0x5e, 0x57, 0x43, 0x64, 0xb5, 0xa6, 0x62,
-	0x3a, 0x4b, 0x66, 0x34, 0x7f, 0x2d, 0xc1, 0xe9, 0xaf, 0xe2, 0x77, 0x0e, 0x0d, 0x60, 0x49, 0x28,
-	0xd0, 0xc6, 0x5d, 0xf9, 0x12, 0x43, 0xd9, 0xbc, 0x5b, 0x24, 0xd0, 0xf4, 0xcd, 0x9f, 0xff, 0x7f,
-	0xf6, 0x5b, 0x51, 0x45, 0x6b, 0x66, 0xe6, 0xe3, 0x2c, 0x0d, 0xff, 0x00, 0xb0, 0x92, 0xbe, 0x5e,
-	0x50, 0x3d, 0xbb, 0x7c, 0xe6, 0xd3, 0xa7, 0xec, 0xe4, 0x13, 0x4b, 0xa6, 0x1d, 0xce, 0xb4, 0x85,
-	0x36, 0xb3, 0x99, 0x26, 0x40, 0xfe, 0x01, 0xf0, 0xdd, 0x8c, 0xab, 0x0f, 0xed, 0xe6, 0xf1, 0x1c,
-	0xff, 0x00, 0x94, 0xc6, 0x6b, 0x64, 0x48, 0xd4, 0x06, 0x47, 0xad, 0xa3, 0x0f, 0xf2, 0xa0, 0x0a,
-	0xae, 0xdf, 0x01, 0x9c, 0x4b, 0x9d, 0x59, 0xb4, 0x9d, 0xed, 0x9b, 0x75, 0x8f, 0x2a, 0xf5, 0x5c,
-	0x5a, 0x49, 0x57, 0xe7, 0x74, 0xef, 0xa3, 0x8d, 0x6c, 0xba, 0x34, 0xc5, 0xdf, 0x00, 0xa2, 0x97,
-	0xbf, 0x25, 0x64, 0xe6, 0x30, 0x4c, 0x75, 0x71, 0x37, 0x7f, 0x82, 0xc4, 0xdc, 0xe5, 0x98, 0xdb,
-	0xa8, 0x96, 0x03, 0x93, 0x67, 0xb6, 0xbe, 0xbe, 0xbc, 0x56, 0xc1, 0xd5, 0xb5, 0x0a, 0x9e, 0x5e,
-	0xab, 0xe0, 0xe2, 0x46, 0x2d, 0x5c, 0xdd, 0xa8, 0x85, 0x47, 0x37, 0x6a, 0xe1, 0xdb, 0x7d, 0xc7,
-	0x65, 0x27, 0xfd, 0x8e, 0x61, 0x53, 0x2f, 0xa9, 0xf6, 0x61, 0x0f, 0x77, 0xa2, 0x51, 0xe9, 0xb3,
-	0xe6, 0xc7, 0xe6, 0xf7, 0xc2, 0xc0, 0xee, 0xb9, 0xc4, 0x67, 0xe2, 0x57, 0xa4, 0xb8, 0x86, 0x4a,
-	0xfc, 0xcf, 0x47, 0xcf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xb1, 0xc3, 0x4d, 0x20, 0x0b, 0x00,
-	0x00,
+func (m *SpotPriceRangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SpotPriceRangeRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *SpotPriceRangeRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error)
-	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
-	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
-	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+func (m *SpotPriceRangeRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *SpotPriceRangeRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type SpotPriceRangeResponse struct {
+	MinSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_spot_price,json=minSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spot_price" yaml:"min_spot_price"`
+	MaxSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_spot_price,json=maxSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price" yaml:"max_spot_price"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SpotPriceRangeResponse) Reset()         { *m = SpotPriceRangeResponse{} }
+func (m *SpotPriceRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceRangeResponse) ProtoMessage()    {}
+func (*SpotPriceRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{11}
+}
+func (m *SpotPriceRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SpotPriceRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceRangeResponse.Merge(m, src)
+}
+func (m *SpotPriceRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceRangeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error) {
-	out := new(ArithmeticTwapResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpotPriceRangeResponse proto.InternalMessageInfo
+
+type ArithmeticTwapOverRouteRequest struct {
+	BaseAsset string               `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	Route     []types.TwapRouteHop `protobuf:"bytes,2,rep,name=route,proto3" json:"route"`
+	StartTime time.Time            `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   *time.Time           `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *ArithmeticTwapOverRouteRequest) Reset()         { *m = ArithmeticTwapOverRouteRequest{} }
+func (m *ArithmeticTwapOverRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*ArithmeticTwapOverRouteRequest) ProtoMessage()    {}
+func (*ArithmeticTwapOverRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{12}
+}
+func (m *ArithmeticTwapOverRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArithmeticTwapOverRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArithmeticTwapOverRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ArithmeticTwapOverRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithmeticTwapOverRouteRequest.Merge(m, src)
+}
+func (m *ArithmeticTwapOverRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArithmeticTwapOverRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithmeticTwapOverRouteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error) {
-	out := new(ArithmeticTwapToNowResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArithmeticTwapOverRouteRequest proto.InternalMessageInfo
+
+func (m *ArithmeticTwapOverRouteRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error) {
-	out := new(GeometricTwapResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ArithmeticTwapOverRouteRequest) GetRoute() []types.TwapRouteHop {
+	if m != nil {
+		return m.Route
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error) {
-	out := new(GeometricTwapToNowResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwapToNow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ArithmeticTwapOverRouteRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	ArithmeticTwap(context.Context, *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error)
-	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
-	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
-	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+func (m *ArithmeticTwapOverRouteRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type ArithmeticTwapOverRouteResponse struct {
+	ArithmeticTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ArithmeticTwapOverRouteResponse) Reset()         { *m = ArithmeticTwapOverRouteResponse{} }
+func (m *ArithmeticTwapOverRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*ArithmeticTwapOverRouteResponse) ProtoMessage()    {}
+func (*ArithmeticTwapOverRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{13}
 }
-func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+func (m *ArithmeticTwapOverRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ArithmeticTwapToNow(ctx context.Context, req *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwapToNow not implemented")
+func (m *ArithmeticTwapOverRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArithmeticTwapOverRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *GeometricTwapRequest) (*GeometricTwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwap not implemented")
+func (m *ArithmeticTwapOverRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithmeticTwapOverRouteResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
+func (m *ArithmeticTwapOverRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArithmeticTwapOverRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithmeticTwapOverRouteResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_ArithmeticTwapOverRouteResponse proto.InternalMessageInfo
+
+type GeometricTwapOverRouteRequest struct {
+	BaseAsset string               `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	Route     []types.TwapRouteHop `protobuf:"bytes,2,rep,name=route,proto3" json:"route"`
+	StartTime time.Time            `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   *time.Time           `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *GeometricTwapOverRouteRequest) Reset()         { *m = GeometricTwapOverRouteRequest{} }
+func (m *GeometricTwapOverRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*GeometricTwapOverRouteRequest) ProtoMessage()    {}
+func (*GeometricTwapOverRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{14}
+}
+func (m *GeometricTwapOverRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeometricTwapOverRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeometricTwapOverRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GeometricTwapOverRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeometricTwapOverRouteRequest.Merge(m, src)
+}
+func (m *GeometricTwapOverRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeometricTwapOverRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeometricTwapOverRouteRequest.DiscardUnknown(m)
 }
 
-func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArithmeticTwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ArithmeticTwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*ArithmeticTwapRequest))
+var xxx_messageInfo_GeometricTwapOverRouteRequest proto.InternalMessageInfo
+
+func (m *GeometricTwapOverRouteRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ArithmeticTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArithmeticTwapToNowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ArithmeticTwapToNow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ArithmeticTwapToNow(ctx, req.(*ArithmeticTwapToNowRequest))
+func (m *GeometricTwapOverRouteRequest) GetRoute() []types.TwapRouteHop {
+	if m != nil {
+		return m.Route
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeometricTwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeometricTwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeometricTwap(ctx, req.(*GeometricTwapRequest))
+func (m *GeometricTwapOverRouteRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeometricTwapToNowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeometricTwapToNow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwapToNow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeometricTwapToNow(ctx, req.(*GeometricTwapToNowRequest))
+func (m *GeometricTwapOverRouteRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.twap.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ArithmeticTwap",
-			Handler:    _Query_ArithmeticTwap_Handler,
-		},
-		{
-			MethodName: "ArithmeticTwapToNow",
-			Handler:    _Query_ArithmeticTwapToNow_Handler,
-		},
-		{
-			MethodName: "GeometricTwap",
-			Handler:    _Query_GeometricTwap_Handler,
-		},
-		{
-			MethodName: "GeometricTwapToNow",
-			Handler:    _Query_GeometricTwapToNow_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/twap/v1beta1/query.proto",
+type GeometricTwapOverRouteResponse struct {
+	GeometricTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=geometric_twap,json=geometricTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"geometric_twap" yaml:"geometric_twap"`
 }
 
-func (m *ArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GeometricTwapOverRouteResponse) Reset()         { *m = GeometricTwapOverRouteResponse{} }
+func (m *GeometricTwapOverRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*GeometricTwapOverRouteResponse) ProtoMessage()    {}
+func (*GeometricTwapOverRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{15}
+}
+func (m *GeometricTwapOverRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeometricTwapOverRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeometricTwapOverRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GeometricTwapOverRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeometricTwapOverRouteResponse.Merge(m, src)
+}
+func (m *GeometricTwapOverRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeometricTwapOverRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeometricTwapOverRouteResponse.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GeometricTwapOverRouteResponse proto.InternalMessageInfo
+
+type VolumeWeightedTwapRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
-func (m *ArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
+func (m *VolumeWeightedTwapRequest) Reset()         { *m = VolumeWeightedTwapRequest{} }
+func (m *VolumeWeightedTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeWeightedTwapRequest) ProtoMessage()    {}
+func (*VolumeWeightedTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{16}
+}
+func (m *VolumeWeightedTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeWeightedTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeWeightedTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *VolumeWeightedTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeWeightedTwapRequest.Merge(m, src)
+}
+func (m *VolumeWeightedTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeWeightedTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeWeightedTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeWeightedTwapRequest proto.InternalMessageInfo
+
+func (m *VolumeWeightedTwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VolumeWeightedTwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VolumeWeightedTwapRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
 }
 
-func (m *ArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ArithmeticTwap.Size()
-		i -= size
-		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VolumeWeightedTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ArithmeticTwapToNowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VolumeWeightedTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ArithmeticTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VolumeWeightedTwapResponse struct {
+	VolumeWeightedTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volume_weighted_twap,json=volumeWeightedTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volume_weighted_twap" yaml:"volume_weighted_twap"`
 }
 
-func (m *ArithmeticTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+func (m *VolumeWeightedTwapResponse) Reset()         { *m = VolumeWeightedTwapResponse{} }
+func (m *VolumeWeightedTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeWeightedTwapResponse) ProtoMessage()    {}
+func (*VolumeWeightedTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{17}
+}
+func (m *VolumeWeightedTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeWeightedTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeWeightedTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *VolumeWeightedTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeWeightedTwapResponse.Merge(m, src)
+}
+func (m *VolumeWeightedTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeWeightedTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeWeightedTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeWeightedTwapResponse proto.InternalMessageInfo
+
+type VolumeWeightedTwapToNowRequest struct {
+	PoolId     uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string    `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string    `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+}
+
+func (m *VolumeWeightedTwapToNowRequest) Reset()         { *m = VolumeWeightedTwapToNowRequest{} }
+func (m *VolumeWeightedTwapToNowRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeWeightedTwapToNowRequest) ProtoMessage()    {}
+func (*VolumeWeightedTwapToNowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{18}
+}
+func (m *VolumeWeightedTwapToNowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeWeightedTwapToNowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeWeightedTwapToNowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VolumeWeightedTwapToNowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeWeightedTwapToNowRequest.Merge(m, src)
+}
+func (m *VolumeWeightedTwapToNowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeWeightedTwapToNowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeWeightedTwapToNowRequest.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapToNowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VolumeWeightedTwapToNowRequest proto.InternalMessageInfo
+
+func (m *VolumeWeightedTwapToNowRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ArithmeticTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VolumeWeightedTwapToNowRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
 }
 
-func (m *ArithmeticTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ArithmeticTwap.Size()
-		i -= size
-		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VolumeWeightedTwapToNowRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GeometricTwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VolumeWeightedTwapToNowRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *GeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VolumeWeightedTwapToNowResponse struct {
+	VolumeWeightedTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volume_weighted_twap,json=volumeWeightedTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volume_weighted_twap" yaml:"volume_weighted_twap"`
 }
 
-func (m *GeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err4 != nil {
-			return 0, err4
+func (m *VolumeWeightedTwapToNowResponse) Reset()         { *m = VolumeWeightedTwapToNowResponse{} }
+func (m *VolumeWeightedTwapToNowResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeWeightedTwapToNowResponse) ProtoMessage()    {}
+func (*VolumeWeightedTwapToNowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{19}
+}
+func (m *VolumeWeightedTwapToNowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeWeightedTwapToNowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeWeightedTwapToNowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n4
-		i = encodeVarintQuery(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *VolumeWeightedTwapToNowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeWeightedTwapToNowResponse.Merge(m, src)
+}
+func (m *VolumeWeightedTwapToNowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeWeightedTwapToNowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeWeightedTwapToNowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeWeightedTwapToNowResponse proto.InternalMessageInfo
+
+type HistoricalRecordsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// asset0_denom and asset1_denom are the denom pair of the records, in any
+	// order.
+	Asset0Denom string             `protobuf:"bytes,2,opt,name=asset0_denom,json=asset0Denom,proto3" json:"asset0_denom,omitempty"`
+	Asset1Denom string             `protobuf:"bytes,3,opt,name=asset1_denom,json=asset1Denom,proto3" json:"asset1_denom,omitempty"`
+	StartTime   time.Time          `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime     *time.Time         `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	Pagination  *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *HistoricalRecordsRequest) Reset()         { *m = HistoricalRecordsRequest{} }
+func (m *HistoricalRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoricalRecordsRequest) ProtoMessage()    {}
+func (*HistoricalRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{20}
+}
+func (m *HistoricalRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HistoricalRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalRecordsRequest.Merge(m, src)
+}
+func (m *HistoricalRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *GeometricTwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HistoricalRecordsRequest proto.InternalMessageInfo
+
+func (m *HistoricalRecordsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRecordsRequest) GetAsset0Denom() string {
+	if m != nil {
+		return m.Asset0Denom
+	}
+	return ""
 }
 
-func (m *GeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GeometricTwap.Size()
-		i -= size
-		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *HistoricalRecordsRequest) GetAsset1Denom() string {
+	if m != nil {
+		return m.Asset1Denom
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GeometricTwapToNowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoricalRecordsRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *GeometricTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRecordsRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (m *GeometricTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *HistoricalRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GeometricTwapToNowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type HistoricalRecordsResponse struct {
+	// records are the historical records of the denom pair written in the time
+	// range, in order of time.
+	Records    []types.TwapRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GeometricTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRecordsResponse) Reset()         { *m = HistoricalRecordsResponse{} }
+func (m *HistoricalRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoricalRecordsResponse) ProtoMessage()    {}
+func (*HistoricalRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{21}
 }
-
-func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GeometricTwap.Size()
-		i -= size
-		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *HistoricalRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *HistoricalRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalRecordsResponse.Merge(m, src)
 }
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_HistoricalRecordsResponse proto.InternalMessageInfo
+
+func (m *HistoricalRecordsResponse) GetRecords() []types.TwapRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoricalRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ParamsRequest struct {
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{22}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
 }
-func (m *ArithmeticTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *ArithmeticTwapToNowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{23}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() types.Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return types.Params{}
 }
 
-func (m *GeometricTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func init() {
+	proto.RegisterType((*ArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapRequest")
+	proto.RegisterType((*ArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapResponse")
+	proto.RegisterType((*ArithmeticTwapToNowRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowRequest")
+	proto.RegisterType((*ArithmeticTwapToNowResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowResponse")
+	proto.RegisterType((*GeometricTwapRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapRequest")
+	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
+	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
+	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
+	proto.RegisterType((*VolatilityRequest)(nil), "osmosis.twap.v1beta1.VolatilityRequest")
+	proto.RegisterType((*VolatilityResponse)(nil), "osmosis.twap.v1beta1.VolatilityResponse")
+	proto.RegisterType((*SpotPriceRangeRequest)(nil), "osmosis.twap.v1beta1.SpotPriceRangeRequest")
+	proto.RegisterType((*SpotPriceRangeResponse)(nil), "osmosis.twap.v1beta1.SpotPriceRangeResponse")
+	proto.RegisterType((*ArithmeticTwapOverRouteRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapOverRouteRequest")
+	proto.RegisterType((*ArithmeticTwapOverRouteResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapOverRouteResponse")
+	proto.RegisterType((*GeometricTwapOverRouteRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapOverRouteRequest")
+	proto.RegisterType((*GeometricTwapOverRouteResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapOverRouteResponse")
+	proto.RegisterType((*VolumeWeightedTwapRequest)(nil), "osmosis.twap.v1beta1.VolumeWeightedTwapRequest")
+	proto.RegisterType((*VolumeWeightedTwapResponse)(nil), "osmosis.twap.v1beta1.VolumeWeightedTwapResponse")
+	proto.RegisterType((*VolumeWeightedTwapToNowRequest)(nil), "osmosis.twap.v1beta1.VolumeWeightedTwapToNowRequest")
+	proto.RegisterType((*VolumeWeightedTwapToNowResponse)(nil), "osmosis.twap.v1beta1.VolumeWeightedTwapToNowResponse")
+	proto.RegisterType((*HistoricalRecordsRequest)(nil), "osmosis.twap.v1beta1.HistoricalRecordsRequest")
+	proto.RegisterType((*HistoricalRecordsResponse)(nil), "osmosis.twap.v1beta1.HistoricalRecordsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
+
+var fileDescriptor_141a22dba58615af = []byte{
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x4e, 0x9a, 0x92, 0x97, 0x36, 0x51, 0x87, 0x34, 0x75, 0x36, 0xad, 0x6d, 0xb6,
+	0xa5, 0x75, 0x9b, 0x74, 0x37, 0x49, 0x1b, 0x09, 0x55, 0x05, 0xd1, 0xa8, 0xa2, 0x45, 0xaa, 0xa0,
+	0x2c, 0x55, 0xa9, 0xb8, 0x58, 0x13, 0x7b, 0xea, 0xac, 0xf0, 0xee, 0x6c, 0xbd, 0xe3, 0xfc, 0x90,
+	0x38, 0x00, 0x52, 0x0f, 0xbd, 0x55, 0x20, 0x84, 0x40, 0x82, 0x03, 0x12, 0x48, 0x48, 0xf4, 0x0f,
+	0xe0, 0xc0, 0xbd, 0xe2, 0x00, 0x95, 0x7a, 0x41, 0x1c, 0x42, 0xd5, 0x72, 0x47, 0xca, 0x5f, 0x80,
+	0x76, 0x66, 0xd6, 0xf6, 0xda, 0xe3, 0x64, 0x53, 0x41, 0x50, 0xa4, 0x9c, 0x5a, 0xcf, 0x7c, 0xdf,
+	0xbc, 0xcf, 0xbe, 0xf7, 0x66, 0xe7, 0xed, 0x04, 0x0a, 0x2c, 0xf4, 0x58, 0xe8, 0x86, 0x36, 0x5f,
+	0x21, 0x81, 0xbd, 0x3c, 0xbb, 0x48, 0x39, 0x99, 0xb5, 0xef, 0x34, 0x68, 0x7d, 0xcd, 0x0a, 0xea,
+	0x8c, 0x33, 0x3c, 0xa6, 0x14, 0x56, 0xa4, 0xb0, 0x94, 0xc2, 0x18, 0xab, 0xb2, 0x2a, 0x13, 0x02,
+	0x3b, 0xfa, 0x9f, 0xd4, 0x1a, 0x27, 0xb5, 0xab, 0x45, 0x3f, 0x4a, 0x75, 0x5a, 0x66, 0xf5, 0x8a,
+	0xd2, 0x99, 0x5a, 0x5d, 0x95, 0xfa, 0x34, 0x72, 0x24, 0x35, 0x7a, 0xb2, 0x3a, 0x6b, 0x70, 0xaa,
+	0x14, 0xb9, 0xb2, 0x90, 0xd8, 0x8b, 0x24, 0xa4, 0x4d, 0x41, 0x99, 0xb9, 0xbe, 0x9a, 0x3f, 0xd3,
+	0x3e, 0x2f, 0x1e, 0xa9, 0xa9, 0x0a, 0x48, 0xd5, 0xf5, 0x09, 0x77, 0x59, 0xac, 0x3d, 0x5a, 0x65,
+	0xac, 0x5a, 0xa3, 0x36, 0x09, 0x5c, 0x9b, 0xf8, 0x3e, 0xe3, 0x62, 0x32, 0x66, 0x99, 0x50, 0xb3,
+	0xe2, 0xd7, 0x62, 0xe3, 0xb6, 0x4d, 0xfc, 0xb5, 0x78, 0x4a, 0x3a, 0x29, 0xc9, 0x58, 0xc8, 0x1f,
+	0x6a, 0x2a, 0xdf, 0x69, 0xc5, 0x5d, 0x8f, 0x86, 0x9c, 0x78, 0x81, 0x14, 0x98, 0xdf, 0x64, 0xe0,
+	0xf0, 0xa5, 0xba, 0xcb, 0x97, 0x3c, 0xca, 0xdd, 0xf2, 0x8d, 0x15, 0x12, 0x38, 0xf4, 0x4e, 0x83,
+	0x86, 0x1c, 0x1f, 0x81, 0xfd, 0x01, 0x63, 0xb5, 0x92, 0x5b, 0xc9, 0xa2, 0x02, 0x2a, 0x0e, 0x38,
+	0x83, 0xd1, 0xcf, 0x37, 0x2b, 0xf8, 0x18, 0x40, 0xf4, 0x38, 0x25, 0x12, 0x86, 0x94, 0x67, 0x33,
+	0x05, 0x54, 0x1c, 0x72, 0x86, 0xa2, 0x91, 0x4b, 0xd1, 0x00, 0xce, 0xc3, 0xf0, 0x9d, 0x06, 0xe3,
+	0xf1, 0x7c, 0xbf, 0x98, 0x07, 0x31, 0x24, 0x05, 0xb7, 0x00, 0x42, 0x4e, 0xea, 0xbc, 0x14, 0xb1,
+	0x64, 0x07, 0x0a, 0xa8, 0x38, 0x3c, 0x67, 0x58, 0x12, 0xd4, 0x8a, 0x41, 0xad, 0x1b, 0x31, 0xe8,
+	0xc2, 0xb1, 0x87, 0xeb, 0xf9, 0xbe, 0x8d, 0xf5, 0xfc, 0xa1, 0x35, 0xe2, 0xd5, 0x2e, 0x98, 0x2d,
+	0x5b, 0xf3, 0xfe, 0x9f, 0x79, 0xe4, 0x0c, 0x89, 0x81, 0x48, 0x8e, 0x1d, 0x78, 0x81, 0xfa, 0x15,
+	0xb9, 0xee, 0xbe, 0x2d, 0xd7, 0x9d, 0x7c, 0xb8, 0x9e, 0x47, 0x1b, 0xeb, 0xf9, 0x51, 0xb9, 0x6e,
+	0x6c, 0x29, 0x57, 0xdd, 0x4f, 0xfd, 0x4a, 0x24, 0x35, 0x3f, 0x42, 0x30, 0xde, 0x19, 0xa0, 0x30,
+	0x60, 0x7e, 0x48, 0xf1, 0x6d, 0x18, 0x25, 0xcd, 0x99, 0x52, 0x54, 0x23, 0x22, 0x52, 0x43, 0x0b,
+	0xaf, 0x46, 0xc4, 0x7f, 0xac, 0xe7, 0x27, 0x65, 0x2e, 0xc2, 0xca, 0x07, 0x96, 0xcb, 0x6c, 0x8f,
+	0xf0, 0x25, 0xeb, 0x1a, 0xad, 0x92, 0xf2, 0xda, 0x65, 0x5a, 0xde, 0x58, 0xcf, 0x8f, 0x4b, 0xc7,
+	0x1d, 0x6b, 0x98, 0xce, 0x08, 0x49, 0xf8, 0x33, 0x7f, 0x43, 0x60, 0x24, 0x11, 0x6e, 0xb0, 0xb7,
+	0xd8, 0xca, 0xee, 0x4d, 0x94, 0x79, 0x17, 0xc1, 0xa4, 0xf6, 0x89, 0x76, 0x38, 0xb2, 0x5f, 0x67,
+	0x60, 0xec, 0x0a, 0x65, 0x1e, 0xe5, 0xf5, 0xbd, 0xe2, 0xd7, 0x14, 0xff, 0x87, 0x70, 0xb8, 0x23,
+	0x3c, 0x2a, 0x41, 0x65, 0x18, 0xa9, 0xc6, 0x13, 0xed, 0xf9, 0xb9, 0x98, 0x2e, 0x3f, 0x87, 0xa5,
+	0xd7, 0xe4, 0x12, 0xa6, 0x73, 0xb0, 0xda, 0xee, 0xcc, 0xfc, 0x15, 0xc1, 0x44, 0xc2, 0xfd, 0x6e,
+	0x2f, 0xfb, 0x8f, 0x11, 0x18, 0xba, 0x07, 0xda, 0xc9, 0xa0, 0x7e, 0x95, 0x81, 0x43, 0x37, 0x59,
+	0x8d, 0x70, 0xb7, 0xe6, 0xf2, 0xb5, 0xbd, 0x7a, 0x4f, 0xd4, 0xbb, 0x0f, 0xb8, 0x3d, 0x36, 0x2a,
+	0x2f, 0xb7, 0x00, 0x96, 0x9b, 0xa3, 0x2a, 0x27, 0xaf, 0xa4, 0xcb, 0x89, 0x7a, 0x8c, 0x96, 0xb9,
+	0xe9, 0xb4, 0xad, 0x25, 0x4e, 0xdf, 0x77, 0x03, 0xc6, 0xaf, 0xd7, 0xdd, 0x32, 0x75, 0x88, 0x5f,
+	0xa5, 0x7b, 0x09, 0x49, 0x24, 0xe4, 0x09, 0x82, 0xf1, 0xce, 0x00, 0xa9, 0xac, 0x2c, 0xc2, 0x88,
+	0xe7, 0xfa, 0xa5, 0x30, 0x60, 0xbc, 0x14, 0x44, 0xd3, 0xcf, 0xb5, 0x5b, 0x92, 0x4b, 0x98, 0xce,
+	0x01, 0xcf, 0xf5, 0x9b, 0x0e, 0x85, 0x0f, 0xb2, 0xda, 0xee, 0x23, 0xf3, 0x3c, 0x3e, 0xc8, 0x6a,
+	0x87, 0x0f, 0xb2, 0xda, 0xf4, 0x61, 0x3e, 0xc8, 0x40, 0x2e, 0x79, 0x16, 0xbe, 0xbd, 0x4c, 0xeb,
+	0x4e, 0xd4, 0x64, 0xc6, 0xc5, 0x90, 0xcc, 0x39, 0xea, 0xcc, 0xf9, 0x6b, 0xb0, 0x4f, 0xf4, 0xa4,
+	0xd9, 0x4c, 0xa1, 0xbf, 0x38, 0x3c, 0x67, 0x5a, 0xba, 0x76, 0xd9, 0x12, 0xef, 0xef, 0x48, 0x76,
+	0x95, 0x05, 0x0b, 0x03, 0xd1, 0x03, 0x38, 0xd2, 0xac, 0xa3, 0x24, 0xfa, 0xff, 0xa3, 0x92, 0x18,
+	0xf8, 0x97, 0x4a, 0xe2, 0x1e, 0x82, 0x7c, 0xcf, 0x78, 0xed, 0x70, 0xff, 0xf0, 0x63, 0x06, 0x8e,
+	0x25, 0x5e, 0xe8, 0x7b, 0xa9, 0xdb, 0x2c, 0x75, 0x77, 0x11, 0xe4, 0x7a, 0x85, 0x6b, 0x27, 0xcf,
+	0xc0, 0x6f, 0x33, 0x30, 0x71, 0x93, 0xd5, 0x1a, 0x1e, 0x7d, 0x8f, 0xba, 0xd5, 0x25, 0x4e, 0x2b,
+	0x7b, 0xbd, 0x5f, 0x57, 0xb2, 0x3e, 0x45, 0x60, 0xe8, 0x82, 0xa4, 0x12, 0xc5, 0x61, 0x6c, 0x59,
+	0xcc, 0x96, 0x56, 0xd4, 0x74, 0x7b, 0xba, 0x16, 0xd2, 0xa5, 0x6b, 0xb2, 0x79, 0x3c, 0x76, 0x2d,
+	0x64, 0x3a, 0x78, 0xb9, 0xcb, 0xbb, 0xf9, 0x18, 0x41, 0xae, 0x1b, 0x6a, 0xb7, 0xf7, 0x85, 0x5f,
+	0x20, 0xc8, 0xf7, 0x7c, 0xaa, 0xff, 0x35, 0xde, 0x7f, 0x67, 0x20, 0x7b, 0xd5, 0x0d, 0x39, 0xab,
+	0xbb, 0x65, 0x52, 0x73, 0xc4, 0x05, 0x4a, 0xb8, 0x65, 0xa4, 0x5f, 0x82, 0x03, 0x22, 0x88, 0x33,
+	0xa5, 0x0a, 0xf5, 0x99, 0xa7, 0x62, 0x3d, 0x2c, 0xc7, 0x2e, 0x47, 0x43, 0x4d, 0xc9, 0xac, 0x92,
+	0xf4, 0xb7, 0x49, 0x66, 0xa5, 0x64, 0x57, 0x6d, 0x17, 0xfc, 0x06, 0x40, 0xeb, 0x46, 0x27, 0x3b,
+	0x28, 0x56, 0x3d, 0x69, 0xa9, 0xcb, 0x98, 0xa8, 0xca, 0x2c, 0x79, 0xa3, 0x15, 0xbf, 0xd3, 0xaf,
+	0x93, 0x66, 0xb3, 0xe7, 0xb4, 0x59, 0x9a, 0xdf, 0x23, 0x98, 0xd0, 0x44, 0x5c, 0x55, 0xc1, 0xeb,
+	0xb0, 0x5f, 0xde, 0x62, 0x85, 0x59, 0x24, 0x4e, 0x8c, 0xc2, 0x26, 0x27, 0x86, 0x10, 0xaa, 0xf3,
+	0x22, 0x36, 0xc3, 0x57, 0x12, 0x9c, 0x19, 0xc1, 0x79, 0x6a, 0x4b, 0x4e, 0xe9, 0x3e, 0x01, 0x3a,
+	0x0a, 0x07, 0xaf, 0x93, 0x3a, 0xf1, 0xe2, 0x72, 0x30, 0xaf, 0xc1, 0x48, 0x3c, 0xa0, 0x68, 0x2f,
+	0xc0, 0x60, 0x20, 0x46, 0x44, 0x7d, 0x0c, 0xcf, 0x1d, 0xd5, 0xc3, 0x4a, 0x2b, 0x05, 0xaa, 0x2c,
+	0xe6, 0x7e, 0x19, 0x85, 0x7d, 0xef, 0x44, 0x24, 0x78, 0x0d, 0x06, 0xa5, 0x02, 0x1f, 0xdf, 0xcc,
+	0x5e, 0x61, 0x18, 0x27, 0x36, 0x17, 0x49, 0x34, 0xf3, 0xc4, 0x27, 0x8f, 0xff, 0xfa, 0x2c, 0x93,
+	0xc3, 0x47, 0x6d, 0xed, 0x1d, 0x9f, 0x72, 0xf8, 0x25, 0x82, 0x91, 0x64, 0xaf, 0x81, 0xa7, 0xf4,
+	0xcb, 0x6b, 0xef, 0xd0, 0x8c, 0xe9, 0x74, 0x62, 0xc5, 0x34, 0x2d, 0x98, 0x4e, 0xe2, 0x13, 0x7a,
+	0xa6, 0x0e, 0x90, 0x07, 0x08, 0x5e, 0xd4, 0xdc, 0xa1, 0xe0, 0x99, 0x34, 0x3e, 0xdb, 0xdf, 0x98,
+	0xc6, 0xec, 0x36, 0x2c, 0x14, 0xea, 0xac, 0x40, 0x9d, 0xc2, 0xa7, 0xd3, 0xa0, 0x4a, 0xae, 0xcf,
+	0x11, 0x1c, 0x4c, 0x1c, 0xfe, 0xf8, 0x8c, 0xde, 0xaf, 0xee, 0x42, 0xc6, 0x98, 0x4a, 0xa5, 0x55,
+	0x74, 0x53, 0x82, 0xee, 0x65, 0x7c, 0x5c, 0x4f, 0x97, 0xa4, 0xf8, 0x01, 0x01, 0xee, 0xfe, 0x28,
+	0xc7, 0x76, 0x0a, 0x87, 0x89, 0x28, 0xce, 0xa4, 0x37, 0x50, 0x98, 0x33, 0x02, 0xf3, 0x0c, 0x2e,
+	0xa6, 0xc0, 0x94, 0x50, 0xf7, 0x10, 0x40, 0xeb, 0x03, 0x15, 0x9f, 0xd2, 0xbb, 0xec, 0xfa, 0xbc,
+	0x37, 0x8a, 0x5b, 0x0b, 0x15, 0x53, 0x51, 0x30, 0x99, 0xb8, 0xa0, 0x67, 0x6a, 0x73, 0x1e, 0xed,
+	0x8d, 0xe4, 0xa7, 0x59, 0xaf, 0xbd, 0xa1, 0xfd, 0xc2, 0x35, 0xa6, 0xd3, 0x89, 0xd3, 0xed, 0x8d,
+	0x0e, 0x90, 0x9f, 0x11, 0x1c, 0xe9, 0xf1, 0x8d, 0x80, 0xcf, 0xa7, 0xa9, 0xf6, 0xce, 0x3e, 0xde,
+	0x98, 0xdf, 0xa6, 0x95, 0xc2, 0x9e, 0x17, 0xd8, 0x36, 0x3e, 0x9b, 0x66, 0x9f, 0xb4, 0x18, 0x7f,
+	0x42, 0x30, 0xae, 0x6f, 0x94, 0xf1, 0xb9, 0x14, 0x65, 0xd6, 0x45, 0x7f, 0x7e, 0x7b, 0x46, 0x0a,
+	0xfe, 0xbc, 0x80, 0xb7, 0xf0, 0x74, 0x8a, 0xfa, 0x6c, 0x01, 0x46, 0xfb, 0xa9, 0xbb, 0x99, 0xe9,
+	0xb5, 0x9f, 0x7a, 0xb6, 0xe1, 0xc6, 0x4c, 0x7a, 0x83, 0x74, 0xfb, 0x49, 0x03, 0x15, 0xd5, 0x49,
+	0x8f, 0xc6, 0xab, 0x57, 0x9d, 0x6c, 0xde, 0x7d, 0x1a, 0xf3, 0xdb, 0xb4, 0x4a, 0x57, 0x27, 0xbd,
+	0x18, 0xbf, 0x43, 0x70, 0xa8, 0xab, 0x59, 0xc0, 0x96, 0x9e, 0xa1, 0x57, 0x1f, 0x67, 0xd8, 0xa9,
+	0xf5, 0x8a, 0xd6, 0x16, 0xb4, 0xa7, 0xf1, 0x29, 0x3d, 0x6d, 0x97, 0xe1, 0xc2, 0xcd, 0x87, 0x4f,
+	0x73, 0xe8, 0xd1, 0xd3, 0x1c, 0x7a, 0xf2, 0x34, 0x87, 0xee, 0x3f, 0xcb, 0xf5, 0x3d, 0x7a, 0x96,
+	0xeb, 0xfb, 0xfd, 0x59, 0xae, 0xef, 0xfd, 0x8b, 0x55, 0x97, 0x2f, 0x35, 0x16, 0xad, 0x32, 0xf3,
+	0xe2, 0xc5, 0xce, 0xd6, 0xc8, 0x62, 0xd8, 0x5c, 0x79, 0x79, 0x6e, 0xde, 0x5e, 0x95, 0xeb, 0x97,
+	0x6b, 0x2e, 0xf5, 0xb9, 0xfc, 0x33, 0x9a, 0xec, 0xd3, 0x06, 0xc5, 0x3f, 0xe7, 0xfe, 0x19, 0x00,
+	0x86, 0x2f, 0xec, 0xf0, 0x43, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error)
+	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
+	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
+	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+	Volatility(ctx context.Context, in *VolatilityRequest, opts ...grpc.CallOption) (*VolatilityResponse, error)
+	SpotPriceRange(ctx context.Context, in *SpotPriceRangeRequest, opts ...grpc.CallOption) (*SpotPriceRangeResponse, error)
+	ArithmeticTwapOverRoute(ctx context.Context, in *ArithmeticTwapOverRouteRequest, opts ...grpc.CallOption) (*ArithmeticTwapOverRouteResponse, error)
+	GeometricTwapOverRoute(ctx context.Context, in *GeometricTwapOverRouteRequest, opts ...grpc.CallOption) (*GeometricTwapOverRouteResponse, error)
+	VolumeWeightedTwap(ctx context.Context, in *VolumeWeightedTwapRequest, opts ...grpc.CallOption) (*VolumeWeightedTwapResponse, error)
+	VolumeWeightedTwapToNow(ctx context.Context, in *VolumeWeightedTwapToNowRequest, opts ...grpc.CallOption) (*VolumeWeightedTwapToNowResponse, error)
+	HistoricalRecords(ctx context.Context, in *HistoricalRecordsRequest, opts ...grpc.CallOption) (*HistoricalRecordsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error) {
+	out := new(ArithmeticTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error) {
+	out := new(ArithmeticTwapToNowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error) {
+	out := new(GeometricTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GeometricTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error) {
+	out := new(GeometricTwapToNowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwapToNow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GeometricTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *GeometricTwapToNowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Volatility(ctx context.Context, in *VolatilityRequest, opts ...grpc.CallOption) (*VolatilityResponse, error) {
+	out := new(VolatilityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Volatility", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	return out, nil
+}
+
+func (c *queryClient) SpotPriceRange(ctx context.Context, in *SpotPriceRangeRequest, opts ...grpc.CallOption) (*SpotPriceRangeResponse, error) {
+	out := new(SpotPriceRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/SpotPriceRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ArithmeticTwapOverRoute(ctx context.Context, in *ArithmeticTwapOverRouteRequest, opts ...grpc.CallOption) (*ArithmeticTwapOverRouteResponse, error) {
+	out := new(ArithmeticTwapOverRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwapOverRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GeometricTwapOverRoute(ctx context.Context, in *GeometricTwapOverRouteRequest, opts ...grpc.CallOption) (*GeometricTwapOverRouteResponse, error) {
+	out := new(GeometricTwapOverRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwapOverRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *GeometricTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VolumeWeightedTwap(ctx context.Context, in *VolumeWeightedTwapRequest, opts ...grpc.CallOption) (*VolumeWeightedTwapResponse, error) {
+	out := new(VolumeWeightedTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/VolumeWeightedTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GeometricTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VolumeWeightedTwapToNow(ctx context.Context, in *VolumeWeightedTwapToNowRequest, opts ...grpc.CallOption) (*VolumeWeightedTwapToNowResponse, error) {
+	out := new(VolumeWeightedTwapToNowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/VolumeWeightedTwapToNow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) HistoricalRecords(ctx context.Context, in *HistoricalRecordsRequest, opts ...grpc.CallOption) (*HistoricalRecordsResponse, error) {
+	out := new(HistoricalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/HistoricalRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	ArithmeticTwap(context.Context, *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error)
+	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
+	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
+	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+	Volatility(context.Context, *VolatilityRequest) (*VolatilityResponse, error)
+	SpotPriceRange(context.Context, *SpotPriceRangeRequest) (*SpotPriceRangeResponse, error)
+	ArithmeticTwapOverRoute(context.Context, *ArithmeticTwapOverRouteRequest) (*ArithmeticTwapOverRouteResponse, error)
+	GeometricTwapOverRoute(context.Context, *GeometricTwapOverRouteRequest) (*GeometricTwapOverRouteResponse, error)
+	VolumeWeightedTwap(context.Context, *VolumeWeightedTwapRequest) (*VolumeWeightedTwapResponse, error)
+	VolumeWeightedTwapToNow(context.Context, *VolumeWeightedTwapToNowRequest) (*VolumeWeightedTwapToNowResponse, error)
+	HistoricalRecords(context.Context, *HistoricalRecordsRequest) (*HistoricalRecordsResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwapToNow(ctx context.Context, req *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwapToNow not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *GeometricTwapRequest) (*GeometricTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwap not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
+}
+func (*UnimplementedQueryServer) Volatility(ctx context.Context, req *VolatilityRequest) (*VolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Volatility not implemented")
+}
+func (*UnimplementedQueryServer) SpotPriceRange(ctx context.Context, req *SpotPriceRangeRequest) (*SpotPriceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPriceRange not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwapOverRoute(ctx context.Context, req *ArithmeticTwapOverRouteRequest) (*ArithmeticTwapOverRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwapOverRoute not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwapOverRoute(ctx context.Context, req *GeometricTwapOverRouteRequest) (*GeometricTwapOverRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapOverRoute not implemented")
+}
+func (*UnimplementedQueryServer) VolumeWeightedTwap(ctx context.Context, req *VolumeWeightedTwapRequest) (*VolumeWeightedTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeWeightedTwap not implemented")
+}
+func (*UnimplementedQueryServer) VolumeWeightedTwapToNow(ctx context.Context, req *VolumeWeightedTwapToNowRequest) (*VolumeWeightedTwapToNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeWeightedTwapToNow not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalRecords(ctx context.Context, req *HistoricalRecordsRequest) (*HistoricalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRecords not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArithmeticTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*ArithmeticTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArithmeticTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArithmeticTwapToNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwapToNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwapToNow(ctx, req.(*ArithmeticTwapToNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometricTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwap(ctx, req.(*GeometricTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometricTwapToNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwapToNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwapToNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwapToNow(ctx, req.(*GeometricTwapToNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Volatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolatilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Volatility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/Volatility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Volatility(ctx, req.(*VolatilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpotPriceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpotPriceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPriceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/SpotPriceRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPriceRange(ctx, req.(*SpotPriceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArithmeticTwapOverRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArithmeticTwapOverRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwapOverRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwapOverRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwapOverRoute(ctx, req.(*ArithmeticTwapOverRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeometricTwapOverRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometricTwapOverRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwapOverRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwapOverRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwapOverRoute(ctx, req.(*GeometricTwapOverRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolumeWeightedTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeWeightedTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeWeightedTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/VolumeWeightedTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeWeightedTwap(ctx, req.(*VolumeWeightedTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolumeWeightedTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeWeightedTwapToNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeWeightedTwapToNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/VolumeWeightedTwapToNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeWeightedTwapToNow(ctx, req.(*VolumeWeightedTwapToNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoricalRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/HistoricalRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalRecords(ctx, req.(*HistoricalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.twap.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwap",
+			Handler:    _Query_ArithmeticTwap_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwapToNow",
+			Handler:    _Query_ArithmeticTwapToNow_Handler,
+		},
+		{
+			MethodName: "GeometricTwap",
+			Handler:    _Query_GeometricTwap_Handler,
+		},
+		{
+			MethodName: "GeometricTwapToNow",
+			Handler:    _Query_GeometricTwapToNow_Handler,
+		},
+		{
+			MethodName: "Volatility",
+			Handler:    _Query_Volatility_Handler,
+		},
+		{
+			MethodName: "SpotPriceRange",
+			Handler:    _Query_SpotPriceRange_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwapOverRoute",
+			Handler:    _Query_ArithmeticTwapOverRoute_Handler,
+		},
+		{
+			MethodName: "GeometricTwapOverRoute",
+			Handler:    _Query_GeometricTwapOverRoute_Handler,
+		},
+		{
+			MethodName: "VolumeWeightedTwap",
+			Handler:    _Query_VolumeWeightedTwap_Handler,
+		},
+		{
+			MethodName: "VolumeWeightedTwapToNow",
+			Handler:    _Query_VolumeWeightedTwapToNow_Handler,
+		},
+		{
+			MethodName: "HistoricalRecords",
+			Handler:    _Query_HistoricalRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/twap/v1beta1/query.proto",
+}
+
+func (m *ArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapToNowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapToNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapToNowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapToNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolatilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolatilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPriceRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPriceRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSpotPrice.Size()
+		i -= size
+		if _, err := m.MaxSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSpotPrice.Size()
+		i -= size
+		if _, err := m.MinSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapOverRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapOverRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapOverRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapOverRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapOverRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapOverRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapOverRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapOverRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapOverRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x22
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapOverRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapOverRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapOverRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeWeightedTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeWeightedTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeWeightedTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeWeightedTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeWeightedTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeWeightedTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VolumeWeightedTwap.Size()
+		i -= size
+		if _, err := m.VolumeWeightedTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeWeightedTwapToNowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeWeightedTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeWeightedTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeWeightedTwapToNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeWeightedTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeWeightedTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VolumeWeightedTwap.Size()
+		i -= size
+		if _, err := m.VolumeWeightedTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintQuery(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Asset1Denom) > 0 {
+		i -= len(m.Asset1Denom)
+		copy(dAtA[i:], m.Asset1Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset1Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset0Denom) > 0 {
+		i -= len(m.Asset0Denom)
+		copy(dAtA[i:], m.Asset0Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset0Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GeometricTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SpotPriceRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotPriceRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxSpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapOverRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ArithmeticTwapOverRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapOverRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GeometricTwapOverRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VolumeWeightedTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VolumeWeightedTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VolumeWeightedTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VolumeWeightedTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VolumeWeightedTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VolumeWeightedTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HistoricalRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Asset0Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Asset1Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoricalRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolatilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolatilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolatilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolatilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotPriceRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPriceRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPriceRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotPriceRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPriceRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPriceRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapOverRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapOverRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapOverRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, types.TwapRouteHop{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapOverRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapOverRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapOverRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapOverRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,32 +5453,13 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapOverRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapOverRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
@@ -1514,11 +5491,11 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1528,25 +5505,27 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			m.Route = append(m.Route, types.TwapRouteHop{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -1579,7 +5558,7 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
@@ -1636,7 +5615,7 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapOverRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1659,15 +5638,15 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapOverRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapOverRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1695,7 +5674,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1720,7 +5699,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *VolumeWeightedTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,10 +5722,10 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeWeightedTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeWeightedTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1865,6 +5844,42 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1886,7 +5901,7 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *VolumeWeightedTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1909,15 +5924,15 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeWeightedTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeWeightedTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightedTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1945,7 +5960,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeWeightedTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1970,7 +5985,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *VolumeWeightedTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1993,10 +6008,10 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeWeightedTwapToNowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeWeightedTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2115,42 +6130,6 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2172,7 +6151,7 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *VolumeWeightedTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,15 +6174,15 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeWeightedTwapToNowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeWeightedTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightedTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2231,7 +6210,7 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeWeightedTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2256,7 +6235,7 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *HistoricalRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2279,10 +6258,10 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2306,7 +6285,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2334,11 +6313,11 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.Asset0Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2366,7 +6345,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			m.Asset1Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2401,6 +6380,78 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2422,7 +6473,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *HistoricalRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,17 +6496,17 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2465,23 +6516,59 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, types.TwapRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,6 +14,7 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	VolumeWeightedStrategy = volumeWeighted
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -32,6 +33,14 @@ func (k Keeper) GetChangedPools(ctx sdk.Context) []uint64 {
 	return k.getChangedPools(ctx)
 }
 
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+func (k Keeper) GetBlockVolume(ctx sdk.Context, poolId uint64, denom0, denom1, volumeDenom string) osmomath.Dec {
+	return k.getBlockVolume(ctx, poolId, denom0, denom1, volumeDenom)
+}
+
 func (k Keeper) UpdateRecord(ctx sdk.Context, record types.TwapRecord) (types.TwapRecord, error) {
 	return k.updateRecord(ctx, record)
 }
@@ -48,6 +57,10 @@ func (k Keeper) GetInterpolatedRecord(ctx sdk.Context, poolId uint64, asset0Deno
 	return k.getInterpolatedRecord(ctx, poolId, t, asset0Denom, asset1Denom)
 }
 
+func ComputeVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	return computeVolatility(startRecord, endRecord)
+}
+
 func ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	return computeTwap(startRecord, endRecord, quoteAsset, strategy)
 }
@@ -60,6 +73,10 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (s volumeWeighted) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
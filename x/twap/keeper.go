@@ -75,6 +75,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
 	}
+
+	k.SetAccumulatorsStartHeight(ctx, genState.AccumulatorsStartHeight)
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -85,8 +87,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:                  k.GetParams(ctx),
+		Twaps:                   twapRecords,
+		AccumulatorsStartHeight: k.GetAccumulatorsStartHeight(ctx),
 	}
 }
 
@@ -100,6 +103,11 @@ func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
 
+// GetVolumeWeightedStrategy gets volume weighted TWAP keeper.
+func (k Keeper) GetVolumeWeightedStrategy() *volumeWeighted {
+	return &volumeWeighted{k}
+}
+
 // GetPruningState gets the current pruning state, which is used to determine
 // whether to prune historical records in the EndBlock. This allows us to spread
 // out the computational cost of pruning over time rather than all at once at epoch.
@@ -127,3 +135,32 @@ func (k Keeper) SetPruningState(ctx sdk.Context, state types.PruningState) {
 	}
 	store.Set(types.PruningStateKey, bz)
 }
+
+// GetAccumulatorsStartHeight returns the height from which the volume and log price squared accumulators
+// of the records are tracked. Records from before it have zero accumulators, so time ranges starting
+// before it can't use them. It is zero if the accumulators were tracked from genesis.
+func (k Keeper) GetAccumulatorsStartHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccumulatorsStartHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (k Keeper) SetAccumulatorsStartHeight(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.AccumulatorsStartHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// validateAccumulatorsTracked returns an error if the start record of a time range was recorded before
+// the volume and log price squared accumulators were tracked.
+func (k Keeper) validateAccumulatorsTracked(ctx sdk.Context, startRecord types.TwapRecord) error {
+	accumulatorsStartHeight := k.GetAccumulatorsStartHeight(ctx)
+	if startRecord.Height < accumulatorsStartHeight {
+		return types.AccumulatorsNotTrackedError{
+			StartTime:               startRecord.Time,
+			StartRecordHeight:       startRecord.Height,
+			AccumulatorsStartHeight: accumulatorsStartHeight,
+		}
+	}
+	return nil
+}
@@ -63,6 +63,9 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -85,6 +88,9 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+				Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+				LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -97,6 +103,9 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+				Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+				LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -112,6 +121,9 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -129,6 +141,9 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+				Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+				LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -141,6 +156,9 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+				Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+				LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 			},
 		})
 
@@ -160,6 +178,18 @@ func withLastErrTime(twap types.TwapRecord, lastErrorTime time.Time) types.TwapR
 	return twap
 }
 
+func withLogPriceSquaredAccum(twap types.TwapRecord, accum osmomath.Dec) types.TwapRecord {
+	twap.LogPriceSquaredAccumulator = accum
+	return twap
+}
+
+func withThreeAssetLogPriceSquaredAccums(twaps []types.TwapRecord, accumAB, accumAC, accumBC osmomath.Dec) []types.TwapRecord {
+	twaps[0].LogPriceSquaredAccumulator = accumAB
+	twaps[1].LogPriceSquaredAccumulator = accumAC
+	twaps[2].LogPriceSquaredAccumulator = accumBC
+	return twaps
+}
+
 func withSp0(twap types.TwapRecord, sp osmomath.Dec) types.TwapRecord {
 	twap.P0LastSpotPrice = sp
 	return twap
@@ -227,6 +257,9 @@ func (s *TestSuite) TestTwapInitGenesis() {
 						P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 						P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 						GeometricTwapAccumulator:    osmomath.OneDec(),
+						Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+						Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+						LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 					},
 				}),
 
@@ -427,6 +460,9 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 }
 
@@ -448,6 +484,9 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -475,6 +514,9 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 }
 
@@ -505,6 +547,9 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum osmoma
 		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 }
 
@@ -517,6 +562,9 @@ func newExpRecord(accum0, accum1, geomAccum osmomath.Dec) types.TwapRecord {
 		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 }
 
@@ -535,6 +583,9 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -559,6 +610,9 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -69,12 +69,15 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 					if poolType == poolmanagertypes.Concentrated {
 						expectedRecord.LastErrorTime = s.Ctx.BlockTime()
 					}
+					// a swap on the pool creation block is part of the volume of its pair.
+					expectedRecord.Asset0VolumeAccumulator = s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1, denomPair.Denom0)
+					expectedRecord.Asset1VolumeAccumulator = s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1, denomPair.Denom1)
 					expectedRecords = append(expectedRecords, expectedRecord)
 				}
 
 				// check internal property, that the pool will go through EndBlock flow.
 				s.Require().Equal([]uint64{poolId}, s.twapkeeper.GetChangedPools(s.Ctx))
-				s.twapkeeper.EndBlock(s.Ctx)
+				// N.B. EndBlock is run by Commit, it must not be run twice as the block volume would be counted again.
 				s.Commit()
 
 				// check on the correctness of all individual twap records
@@ -370,6 +373,52 @@ func (s *TestSuite) TestPoolStateChange() {
 	}
 }
 
+// TestSwapTracksPairVolume tests that swaps track the amounts swapped in and out of the pool as the volume of
+// their pair of denoms, and that the volume accumulators of the pair's record are updated with it at the end of the block.
+func (s *TestSuite) TestSwapTracksPairVolume() {
+	tests := map[string]struct {
+		createPool func() uint64
+	}{
+		"balancer pool": {
+			createPool: func() uint64 { return s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...) },
+		},
+		"three asset balancer pool": {
+			createPool: func() uint64 { return s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...) },
+		},
+		"concentrated pool": {
+			createPool: func() uint64 { return s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(denom0, denom1).GetId() },
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := tc.createPool()
+			s.Commit()
+
+			tokenIn := sdk.NewInt64Coin(denom0, 1000)
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+			tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0],
+				[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: denom1}}, tokenIn, osmomath.OneInt(), poolmanagertypes.SwapLimits{})
+			s.Require().NoError(err)
+
+			denom0Volume := s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denom0, denom1, denom0)
+			denom1Volume := s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denom0, denom1, denom1)
+			s.Require().True(denom0Volume.IsPositive())
+			s.Require().True(denom0Volume.LTE(tokenIn.Amount.ToLegacyDec()))
+			s.Require().Equal(tokenOutAmount.ToLegacyDec(), denom1Volume)
+			s.Require().True(s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denom0, denom2, denom0).IsZero())
+
+			s.Commit()
+
+			record, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+			s.Require().NoError(err)
+			s.Require().Equal(denom0Volume, record.Asset0VolumeAccumulator)
+			s.Require().Equal(denom1Volume, record.Asset1VolumeAccumulator)
+		})
+	}
+}
+
 // This test validates that all twap record mutators (listeners) run as expected
 // and update twap + last spot price error at the desired points in the execution flow.
 // It assumed that every state change message occurs in a separate block.
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v25/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v25/x/twap/types"
 )
 
@@ -30,6 +31,9 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}, nil
 }
 
@@ -200,6 +204,12 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newRecord.P1LastSpotPrice = newSp1
 	newRecord.LastErrorTime = lastErrorTime
 
+	// add the amounts of the pair swapped in this block to the volume accumulators.
+	newRecord.Asset0VolumeAccumulator = record.Asset0VolumeAccumulator.Add(
+		k.getBlockVolume(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Asset0Denom))
+	newRecord.Asset1VolumeAccumulator = record.Asset1VolumeAccumulator.Add(
+		k.getBlockVolume(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Asset1Denom))
+
 	return newRecord, nil
 }
 
@@ -247,6 +257,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
 
+	// p0NewLogSquaredAccum = (log_{2}{P_0})^2 * timeDelta
+	p0NewLogSquaredAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+	newRecord.LogPriceSquaredAccumulator = p0NewLogSquaredAccum.AddMut(newRecord.LogPriceSquaredAccumulator)
+
 	return newRecord
 }
 
@@ -277,7 +291,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 }
 
 // computeTwap computes and returns a TWAP of a given
-// type - arithmetic or geometric.
+// type - arithmetic, geometric or volume weighted.
 // Between two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
@@ -287,12 +301,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := spotPriceErrorBetweenRecords(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
@@ -305,6 +314,127 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// volatilityVarianceEpsilon is the largest negative variance of the log price that is attributed to rounding errors,
+// and clamped to zero. The log prices and their squares are accurate to about 16 decimals, as log_2 of the spot price
+// is at most 128 in absolute value.
+var volatilityVarianceEpsilon = osmomath.NewDecWithPrec(1, 12)
+
+// computeVolatility returns the time weighted standard deviation of the natural log of the spot price
+// between two records, from the variance of the log price: E[log(P)^2] - E[log(P)]^2.
+// The standard deviation is the same for both spot prices of the records, as log(P_1) = -log(P_0).
+// Like computeTwap, it returns the result along with an error if there was a spot price error between the records.
+// If the time range is empty, the volatility is zero.
+// Returns an error if the variance is more negative than rounding errors can explain, which means that the
+// accumulators of the records are inconsistent.
+func computeVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	err := spotPriceErrorBetweenRecords(startRecord, endRecord)
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	if timeDelta == 0 {
+		return osmomath.ZeroDec(), err
+	}
+
+	meanLogPrice := types.AccumDiffDivDuration(endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator), timeDelta)
+	meanLogPriceSquared := types.AccumDiffDivDuration(endRecord.LogPriceSquaredAccumulator.Sub(startRecord.LogPriceSquaredAccumulator), timeDelta)
+
+	// the variance cannot be negative, but may be by a rounding error when the price did not move.
+	variance := meanLogPriceSquared.Sub(meanLogPrice.Mul(meanLogPrice))
+	if variance.LT(volatilityVarianceEpsilon.Neg()) {
+		return osmomath.Dec{}, types.NegativeVarianceError{Variance: variance}
+	}
+	if !variance.IsPositive() {
+		return osmomath.ZeroDec(), err
+	}
+	stdDev, sqrtErr := osmomath.MonotonicSqrt(variance)
+	if sqrtErr != nil {
+		return osmomath.Dec{}, sqrtErr
+	}
+	// convert the standard deviation of log base 2 prices to the one of natural log prices.
+	return stdDev.Mul(types.Ln2), err
+}
+
+// spotPriceErrorBetweenRecords returns an error if there was an error in the pool spot price
+// between the start and end record, in which case the twap between them may be faulty.
+func spotPriceErrorBetweenRecords(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
+// routeHopRecords are the start and end records of a hop of a twap route, and the quote asset of the hop.
+type routeHopRecords struct {
+	startRecord types.TwapRecord
+	endRecord   types.TwapRecord
+	quoteAsset  string
+}
+
+// computeTwapProductOverRoute returns the product of the twaps of every hop of the route, computed with the given strategy.
+// Like computeTwap, it returns the result along with an error if there was a spot price error in any hop.
+func computeTwapProductOverRoute(routeRecords []routeHopRecords, strategy twapStrategy) (osmomath.Dec, error) {
+	var twapErr error
+	twap := osmomath.OneDec()
+	for _, hop := range routeRecords {
+		hopTwap, err := computeTwap(hop.startRecord, hop.endRecord, hop.quoteAsset, strategy)
+		if err != nil {
+			twapErr = err
+		}
+		twap = twap.Mul(hopTwap)
+	}
+	return twap, twapErr
+}
+
+// computeGeometricTwapOverRoute returns the geometric twap over the route, by summing the arithmetic
+// means of the log prices of every hop and exponentiating the sum. The log price of a hop is taken
+// with the sign of its quote asset, as log_{2}{P_1} = -log_{2}{P_0}.
+// Like computeTwap, it returns the result along with an error if there was a spot price error in any hop.
+// If the time range is empty, it returns the product of the last spot prices.
+func computeGeometricTwapOverRoute(routeRecords []routeHopRecords) (osmomath.Dec, error) {
+	var twapErr error
+	for _, hop := range routeRecords {
+		if err := spotPriceErrorBetweenRecords(hop.startRecord, hop.endRecord); err != nil {
+			twapErr = err
+		}
+	}
+
+	// all hops share the same time range.
+	startTime, endTime := routeRecords[0].startRecord.Time, routeRecords[0].endRecord.Time
+	if endTime.Equal(startTime) {
+		twap := osmomath.OneDec()
+		for _, hop := range routeRecords {
+			if hop.quoteAsset == hop.endRecord.Asset0Denom {
+				twap = twap.Mul(hop.endRecord.P0LastSpotPrice)
+			} else {
+				twap = twap.Mul(hop.endRecord.P1LastSpotPrice)
+			}
+		}
+		return twap, twapErr
+	}
+
+	timeDelta := types.CanonicalTimeMs(endTime) - types.CanonicalTimeMs(startTime)
+	exponent := osmomath.ZeroDec()
+	for _, hop := range routeRecords {
+		accumDiff := hop.endRecord.GeometricTwapAccumulator.Sub(hop.startRecord.GeometricTwapAccumulator)
+		if hop.quoteAsset == hop.endRecord.Asset0Denom {
+			exponent = exponent.Add(accumDiff)
+		} else {
+			exponent = exponent.Sub(accumDiff)
+		}
+	}
+	exponent = types.AccumDiffDivDuration(exponent, timeDelta)
+
+	// result = 2^exponent
+	result := osmomath.Exp2(osmomath.BigDecFromDec(exponent.Abs()))
+	if exponent.IsNegative() {
+		result = osmomath.OneBigDec().Quo(result)
+	}
+
+	// N.B. we round because this is the max number of significant figures supported
+	// by the underlying spot price function.
+	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs), twapErr
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
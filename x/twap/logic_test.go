@@ -19,15 +19,17 @@ import (
 )
 
 var (
-	zeroDec              = osmomath.ZeroDec()
-	oneDec               = osmomath.OneDec()
-	twoDec               = oneDec.Add(oneDec)
-	pointFiveDec         = osmomath.OneDec().Quo(twoDec)
-	OneSec               = osmomath.MustNewDecFromStr("1000.000000000000000000")
-	logTen               = twap.TwapLog(osmomath.NewDec(10))
-	logOneOverTen        = twap.TwapLog(osmomath.OneDec().QuoInt64(10))
-	tenSecAccum          = OneSec.MulInt64(10)
-	geometricTenSecAccum = OneSec.Mul(logTen)
+	zeroDec                      = osmomath.ZeroDec()
+	oneDec                       = osmomath.OneDec()
+	twoDec                       = oneDec.Add(oneDec)
+	pointFiveDec                 = osmomath.OneDec().Quo(twoDec)
+	OneSec                       = osmomath.MustNewDecFromStr("1000.000000000000000000")
+	logTen                       = twap.TwapLog(osmomath.NewDec(10))
+	logOneOverTen                = twap.TwapLog(osmomath.OneDec().QuoInt64(10))
+	tenSecAccum                  = OneSec.MulInt64(10)
+	geometricTenSecAccum         = OneSec.Mul(logTen)
+	logSquaredTenSecAccum        = OneSec.Mul(logTen.Mul(logTen))
+	logSquaredOneOverTenSecAccum = OneSec.Mul(logOneOverTen.Mul(logOneOverTen))
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -177,7 +179,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	sp10OneTimeUnitAccumRecord := withLogPriceSquaredAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), logSquaredTenSecAccum)
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -263,17 +265,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), zeroDec, zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), logSquaredTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), logSquaredTenSecAccum),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), osmomath.NewDec(10), oneDec, twoDec, pointFiveDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))), logSquaredTenSecAccum.MulInt64(44)),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -288,7 +290,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom accum updated correctly": {
 			record:    withPrice1Set(defaultRecord, osmomath.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)), logSquaredTenSecAccum),
 		},
 		"both sp - zero spot price - accum0 unchange, accum1 unchanged, geom accum unchanged": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, osmomath.ZeroDec()), osmomath.ZeroDec()),
@@ -304,7 +306,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"nanoseconds in time of the original record do not affect final result": {
 			record:    withTime(defaultRecord, defaultRecord.Time.Add(oneHundredNanoseconds)),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withLogPriceSquaredAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), logSquaredTenSecAccum),
 		},
 	}
 
@@ -334,17 +336,17 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		"accum with zero value": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+			expRecord:       withThreeAssetLogPriceSquaredAccums(newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)), logSquaredTenSecAccum, logSquaredTenSecAccum, logSquaredOneOverTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+			expRecord:       withThreeAssetLogPriceSquaredAccums(newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))), logSquaredTenSecAccum, logSquaredTenSecAccum, logSquaredOneOverTenSecAccum),
 		},
 		"larger time interval": {
 			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), osmomath.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+			expRecord:       withThreeAssetLogPriceSquaredAccums(newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))), logSquaredTenSecAccum.MulInt64(44), logSquaredTenSecAccum.MulInt64(44), logSquaredOneOverTenSecAccum.MulInt64(44)),
 		},
 	}
 
@@ -1,14 +1,17 @@
 package twap
 
 import (
-	"encoding/binary"
+	"bytes"
 	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v25/x/twap/types"
 )
@@ -45,29 +48,55 @@ var sentinelExistsValue = []byte{1}
 // This tracking is for use in EndBlock, to create new TWAP records.
 func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 	store := ctx.TransientStore(k.transientKey)
-	poolIdBz := make([]byte, 8)
-	binary.LittleEndian.PutUint64(poolIdBz, poolId)
-
-	store.Set(poolIdBz, sentinelExistsValue)
+	store.Set(types.FormatChangedPoolKey(poolId), sentinelExistsValue)
 }
 
 // getChangedPools returns all poolIDs that changed this block.
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
 func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolsPrefix)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
 	alteredPoolIds := []uint64{}
 	for ; iter.Valid(); iter.Next() {
-		k := iter.Key()
-		poolId := binary.LittleEndian.Uint64(k)
+		poolId := sdk.BigEndianToUint64(iter.Key())
 		alteredPoolIds = append(alteredPoolIds, poolId)
 	}
 	return alteredPoolIds
 }
 
+// trackSwapVolume adds the amounts swapped in and out of the pool to the volume of their pair of denoms
+// in this block, in a transient store. This tracking is for use in EndBlock, to update the volume
+// accumulators of the records. Swaps that don't swap a single denom for another are not tracked.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	if len(input) != 1 || len(output) != 1 {
+		return
+	}
+	denom0, denom1, err := types.LexicographicalOrderDenoms(input[0].Denom, output[0].Denom)
+	if err != nil {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	for _, coin := range []sdk.Coin{input[0], output[0]} {
+		volume := k.getBlockVolume(ctx, poolId, denom0, denom1, coin.Denom).Add(coin.Amount.ToLegacyDec())
+		osmoutils.MustSetDec(store, types.FormatBlockVolumeKey(poolId, denom0, denom1, coin.Denom), volume)
+	}
+}
+
+// getBlockVolume returns the amount of volumeDenom swapped in this block, in swaps of the pool
+// between denom0 and denom1.
+func (k Keeper) getBlockVolume(ctx sdk.Context, poolId uint64, denom0, denom1, volumeDenom string) osmomath.Dec {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.FormatBlockVolumeKey(poolId, denom0, denom1, volumeDenom)
+	if !store.Has(key) {
+		return osmomath.ZeroDec()
+	}
+	return osmoutils.MustGetDec(store, key)
+}
+
 // storeHistoricalTWAP writes a twap to the store, indexed by pool id.
 func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
@@ -269,6 +298,81 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 	return twap, nil
 }
 
+// getRecordsInTimeRange returns the historical records of (id, asset0, asset1) in state
+// with a time after startTime, and at or before endTime, in ascending order of time.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	// the suffixes of the time keys exclude a record at startTime, and include a record at endTime.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, endTime)
+	return osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+}
+
+// GetHistoricalRecords returns a page of the historical records of (pool id, asset0, asset1) written in the time range
+// [startTime, endTime], in order of time. The denoms may be given in any order.
+// It returns an error if the start time is after the end time, or if the denoms are the same.
+func (k Keeper) GetHistoricalRecords(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string, startTime time.Time, endTime time.Time, pagination *query.PageRequest) ([]types.TwapRecord, *query.PageResponse, error) {
+	if startTime.After(endTime) {
+		return nil, nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the keys of the records of the denom pair are their sortable time strings, so that the iterators over the
+	// time range only walk the records written in it. The "." suffix includes a record at endTime.
+	recordStore := timeRangeStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatHistoricalPoolIndexTimePrefix(poolId, asset0Denom, asset1Denom)),
+		start:   []byte(osmoutils.FormatTimeString(startTime)),
+		end:     []byte(osmoutils.FormatTimeString(endTime) + "."),
+	}
+
+	records := []types.TwapRecord{}
+	pageRes, err := query.Paginate(recordStore, pagination, func(key, value []byte) error {
+		record, err := types.ParseTwapFromBz(value)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pageRes, nil
+}
+
+// timeRangeStore is a store whose iterators are bounded to the keys in [start, end).
+type timeRangeStore struct {
+	storetypes.KVStore
+	start, end []byte
+}
+
+func (s timeRangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s timeRangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// bound returns the intersection of [start, end) with the range of the store, where nil is unbounded.
+func (s timeRangeStore) bound(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		end = s.end
+	}
+	return start, end
+}
+
 // DeleteHistoricalTimeIndexedTWAPs deletes every historical twap record indexed by time (now deprecated) up till the limit.
 // This is to be used in the upgrade handler, to clear out the now-obsolete historical twap records
 // that were indexed by time.
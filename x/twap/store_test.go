@@ -6,8 +6,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v25/x/twap"
 
 	storetypes "cosmossdk.io/store/types"
@@ -916,6 +918,139 @@ func (s *TestSuite) TestGetAllHistoricalPoolIndexedTWAPsForPooId() {
 	}
 }
 
+func (s *TestSuite) TestGetHistoricalRecords() {
+	baseRecord := newEmptyPriceRecord(1, baseTime, denom0, denom1)
+	tPlusOneRecord := newEmptyPriceRecord(1, tPlusOne, denom0, denom1)
+	tPlusTwoRecord := newEmptyPriceRecord(1, tPlusOne.Add(time.Second), denom0, denom1)
+	otherPairRecord := newEmptyPriceRecord(1, tPlusOne, denom0, denom2)
+	otherPoolRecord := newEmptyPriceRecord(2, tPlusOne, denom0, denom1)
+
+	tests := map[string]struct {
+		asset0Denom     string
+		asset1Denom     string
+		startTime       time.Time
+		endTime         time.Time
+		pagination      *query.PageRequest
+		expectedRecords []types.TwapRecord
+		expectedNextKey bool
+		expectedTotal   uint64
+		expectedErr     error
+	}{
+		"all records of the pair": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       baseTime,
+			endTime:         tPlusTwoRecord.Time,
+			expectedRecords: []types.TwapRecord{baseRecord, tPlusOneRecord, tPlusTwoRecord},
+		},
+		"denoms in reverse order": {
+			asset0Denom:     denom1,
+			asset1Denom:     denom0,
+			startTime:       baseTime,
+			endTime:         tPlusTwoRecord.Time,
+			expectedRecords: []types.TwapRecord{baseRecord, tPlusOneRecord, tPlusTwoRecord},
+		},
+		"time range includes records at the start and end time": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       tPlusOne,
+			endTime:         tPlusOne,
+			expectedRecords: []types.TwapRecord{tPlusOneRecord},
+		},
+		"time range between records": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       baseTime.Add(time.Millisecond),
+			endTime:         tPlusOne.Add(-time.Millisecond),
+			expectedRecords: []types.TwapRecord{},
+		},
+		"first page": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       baseTime,
+			endTime:         tPlusTwoRecord.Time,
+			pagination:      &query.PageRequest{Limit: 2},
+			expectedRecords: []types.TwapRecord{baseRecord, tPlusOneRecord},
+			expectedNextKey: true,
+		},
+		"page with offset": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       tPlusOne,
+			endTime:         tPlusTwoRecord.Time,
+			pagination:      &query.PageRequest{Offset: 1, Limit: 2},
+			expectedRecords: []types.TwapRecord{tPlusTwoRecord},
+		},
+		"next page from key": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       baseTime,
+			endTime:         tPlusTwoRecord.Time,
+			pagination:      &query.PageRequest{Key: []byte(osmoutils.FormatTimeString(tPlusTwoRecord.Time)), Limit: 2},
+			expectedRecords: []types.TwapRecord{tPlusTwoRecord},
+		},
+		"key before the time range": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       tPlusOne,
+			endTime:         tPlusOne,
+			pagination:      &query.PageRequest{Key: []byte(osmoutils.FormatTimeString(baseTime))},
+			expectedRecords: []types.TwapRecord{tPlusOneRecord},
+		},
+		"reverse page": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       baseTime,
+			endTime:         tPlusOne,
+			pagination:      &query.PageRequest{Reverse: true},
+			expectedRecords: []types.TwapRecord{tPlusOneRecord, baseRecord},
+		},
+		"total counts only the records in the time range": {
+			asset0Denom:     denom0,
+			asset1Denom:     denom1,
+			startTime:       tPlusOne,
+			endTime:         tPlusTwoRecord.Time,
+			pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+			expectedRecords: []types.TwapRecord{tPlusOneRecord},
+			expectedNextKey: true,
+			expectedTotal:   2,
+		},
+		"start time after end time": {
+			asset0Denom: denom0,
+			asset1Denom: denom1,
+			startTime:   tPlusOne,
+			endTime:     baseTime,
+			expectedErr: types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"same denoms": {
+			asset0Denom: denom0,
+			asset1Denom: denom0,
+			startTime:   baseTime,
+			endTime:     tPlusOne,
+			expectedErr: fmt.Errorf("both assets cannot be of the same denom: assetA: %s, assetB: %s", denom0, denom0),
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{baseRecord, tPlusOneRecord, tPlusTwoRecord, otherPairRecord, otherPoolRecord})
+
+			records, pageRes, err := s.twapkeeper.GetHistoricalRecords(s.Ctx, 1, test.asset0Denom, test.asset1Denom, test.startTime, test.endTime, test.pagination)
+			if test.expectedErr != nil {
+				s.Require().EqualError(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedRecords, records)
+			s.Require().Equal(test.expectedNextKey, len(pageRes.NextKey) > 0)
+			if test.expectedTotal > 0 {
+				s.Require().Equal(test.expectedTotal, pageRes.Total)
+			}
+		})
+	}
+}
+
 // prepPoolsAndRemoveRecords creates pool and then removes the records that get created
 // at time of pool creation. This method is used to simplify tests. Pruning logic
 // now requires we pull the underlying denoms from pools as well as the last pool ID.
@@ -8,7 +8,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and volume weighted.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -23,6 +23,10 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type volumeWeighted struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -70,3 +74,20 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a volume weighted average price between
+// two records given the quote asset: the amount of the quote asset traded between the
+// records over the amount of the base asset traded.
+// Returns zero if the pair had no volume between the records.
+func (s *volumeWeighted) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	asset0VolumeDiff := endRecord.Asset0VolumeAccumulator.Sub(startRecord.Asset0VolumeAccumulator)
+	asset1VolumeDiff := endRecord.Asset1VolumeAccumulator.Sub(startRecord.Asset1VolumeAccumulator)
+	if !asset0VolumeDiff.IsPositive() || !asset1VolumeDiff.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+
+	if quoteAsset == startRecord.Asset0Denom {
+		return asset0VolumeDiff.Quo(asset1VolumeDiff)
+	}
+	return asset1VolumeDiff.Quo(asset0VolumeDiff)
+}
@@ -375,3 +375,51 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeVolumeWeightedStrategyTwap tests volume weighted strategy's computeTwap.
+func (s *TestSuite) TestComputeVolumeWeightedStrategyTwap() {
+	newVolumeRecord := func(t time.Time, asset0Volume, asset1Volume osmomath.Dec) types.TwapRecord {
+		return types.TwapRecord{
+			Time:                    t,
+			Asset0Denom:             denom0,
+			Asset1Denom:             denom1,
+			Asset0VolumeAccumulator: asset0Volume,
+			Asset1VolumeAccumulator: asset1Volume,
+		}
+	}
+
+	tests := map[string]computeTwapTestCase{
+		"basic: 200 asset0 traded for 100 asset1, 0 init accumulator": {
+			startRecord: newVolumeRecord(baseTime, zeroDec, zeroDec),
+			endRecord:   newVolumeRecord(tPlusOne, osmomath.NewDec(200), osmomath.NewDec(100)),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDec(2),
+		},
+		"basic, asset 1": {
+			startRecord: newVolumeRecord(baseTime, zeroDec, zeroDec),
+			endRecord:   newVolumeRecord(tPlusOne, osmomath.NewDec(200), osmomath.NewDec(100)),
+			quoteAsset:  denom1,
+			expTwap:     pointFiveDec,
+		},
+		// test that base accum has no impact
+		"200 asset0 for 100 asset1, then 1200 asset0 for 300 asset1. 10 base accum": {
+			startRecord: newVolumeRecord(baseTime, osmomath.NewDec(10), osmomath.NewDec(10)),
+			endRecord:   newVolumeRecord(tPlusOneMin, osmomath.NewDec(1410), osmomath.NewDec(410)),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.MustNewDecFromStr("3.5"),
+		},
+		"time elapsed without volume: zero": {
+			startRecord: newVolumeRecord(baseTime, osmomath.NewDec(200), osmomath.NewDec(100)),
+			endRecord:   newVolumeRecord(tPlusOneMin, osmomath.NewDec(200), osmomath.NewDec(100)),
+			quoteAsset:  denom0,
+			expTwap:     zeroDec,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			volumeWeightedStrategy := &twap.VolumeWeightedStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := volumeWeightedStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			s.Require().Equal(test.expTwap, actualTwap)
+		})
+	}
+}
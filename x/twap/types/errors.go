@@ -1,10 +1,15 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	time "time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
+var ErrEmptyTwapRoute = errors.New("twap route must have at least one hop")
+
 type EndTimeInFutureError struct {
 	EndTime   time.Time
 	BlockTime time.Time
@@ -73,3 +78,34 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type AccumulatorsNotTrackedError struct {
+	StartTime               time.Time
+	StartRecordHeight       int64
+	AccumulatorsStartHeight int64
+}
+
+func (e AccumulatorsNotTrackedError) Error() string {
+	return fmt.Sprintf("the volume and log price squared accumulators are tracked from height %d, "+
+		"cannot compute over a time range starting at %s, recorded at height %d",
+		e.AccumulatorsStartHeight, e.StartTime, e.StartRecordHeight)
+}
+
+type NegativeVarianceError struct {
+	Variance osmomath.Dec
+}
+
+func (e NegativeVarianceError) Error() string {
+	return fmt.Sprintf("the variance of the log spot price is negative (%s), the accumulators of the records are inconsistent", e.Variance)
+}
+
+type NoVolumeInTimeRangeError struct {
+	PoolId    uint64
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e NoVolumeInTimeRangeError) Error() string {
+	return fmt.Sprintf("pool %d has no volume between start time %s and end time %s, cannot compute volume weighted twap",
+		e.PoolId, e.StartTime, e.EndTime)
+}
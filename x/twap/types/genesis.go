@@ -24,6 +24,10 @@ func (g *GenesisState) Validate() error {
 		return err
 	}
 
+	if g.AccumulatorsStartHeight < 0 {
+		return fmt.Errorf("accumulators start height cannot be negative, was (%d)", g.AccumulatorsStartHeight)
+	}
+
 	for _, twap := range g.Twaps {
 		if err := twap.validate(); err != nil {
 			return err
@@ -84,5 +88,18 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	// the volume and squared log price accumulators may be nil in records exported before they were introduced.
+	if !t.Asset0VolumeAccumulator.IsNil() && t.Asset0VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset0 volume accumulator cannot be negative, was (%s)", t.Asset0VolumeAccumulator)
+	}
+
+	if !t.Asset1VolumeAccumulator.IsNil() && t.Asset1VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset1 volume accumulator cannot be negative, was (%s)", t.Asset1VolumeAccumulator)
+	}
+
+	if !t.LogPriceSquaredAccumulator.IsNil() && t.LogPriceSquaredAccumulator.IsNegative() {
+		return fmt.Errorf("twap record log price squared accumulator cannot be negative, was (%s)", t.LogPriceSquaredAccumulator)
+	}
 	return nil
 }
@@ -88,6 +88,10 @@ type GenesisState struct {
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// accumulators_start_height is the height from which the volume and log
+	// price squared accumulators of the records are tracked. Records from
+	// before it don't have them.
+	AccumulatorsStartHeight int64 `protobuf:"varint,3,opt,name=accumulators_start_height,json=accumulatorsStartHeight,proto3" json:"accumulators_start_height,omitempty" yaml:"accumulators_start_height"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -137,6 +141,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAccumulatorsStartHeight() int64 {
+	if m != nil {
+		return m.AccumulatorsStartHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
@@ -147,32 +158,35 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0x5b, 0xa1, 0x34, 0x64, 0x40, 0x5d,
-	0x6a, 0xd3, 0x02, 0x4b, 0xc5, 0x54, 0x81, 0xf8, 0x5a, 0xaa, 0xc0, 0xc4, 0x12, 0x39, 0xc9, 0x6d,
-	0x6a, 0xd1, 0xc4, 0x96, 0xed, 0xb4, 0xe4, 0x01, 0x90, 0x18, 0x19, 0x79, 0x22, 0xd4, 0xb1, 0x23,
-	0x53, 0x41, 0xed, 0x1b, 0xf0, 0x04, 0x28, 0xb1, 0xcb, 0x80, 0xfa, 0xdf, 0x72, 0xf5, 0x3b, 0xe7,
-	0xe8, 0xe4, 0x5e, 0xbb, 0x21, 0x57, 0x05, 0x57, 0x4c, 0x11, 0xbd, 0xa5, 0x82, 0x6c, 0x26, 0x09,
-	0x68, 0x3a, 0x21, 0x39, 0x94, 0xa0, 0x98, 0xc2, 0x42, 0x72, 0xcd, 0xbd, 0xae, 0xd5, 0xe0, 0x46,
-	0x83, 0xad, 0x66, 0xd0, 0xcd, 0x79, 0xce, 0x5b, 0x01, 0x69, 0xbe, 0x8c, 0x76, 0xf0, 0xe8, 0x62,
-	0x5e, 0x33, 0xc4, 0x12, 0x52, 0x2e, 0x33, 0xab, 0xeb, 0xe7, 0x9c, 0xe7, 0x6b, 0x20, 0xed, 0x94,
-	0x54, 0x4b, 0x42, 0xcb, 0xfa, 0x8c, 0xd2, 0x36, 0x23, 0x36, 0xd9, 0x66, 0xb0, 0xc8, 0xff, 0xdf,
-	0x95, 0x55, 0x92, 0x6a, 0xc6, 0x4b, 0xc3, 0xc3, 0x1f, 0xc8, 0xed, 0x2c, 0xa8, 0xa4, 0x85, 0xf2,
-	0x9e, 0xba, 0xf7, 0x84, 0xac, 0x4a, 0x88, 0x41, 0xf0, 0x74, 0x15, 0xb3, 0x0c, 0x4a, 0xcd, 0x96,
-	0x0c, 0x64, 0x0f, 0x05, 0x68, 0x74, 0x27, 0xea, 0xb6, 0xf4, 0x65, 0x03, 0xdf, 0xfc, 0x63, 0xde,
-	0x17, 0xe4, 0x0e, 0x4c, 0xcf, 0x78, 0xc5, 0x94, 0xe6, 0xb2, 0x8e, 0x3f, 0x01, 0x88, 0x58, 0x80,
-	0x64, 0x3c, 0xeb, 0xdd, 0x08, 0xd0, 0xe8, 0x6a, 0xda, 0xc7, 0xa6, 0x06, 0x3e, 0xd7, 0xc0, 0x2f,
-	0x6c, 0x8d, 0xf9, 0x78, 0x77, 0x18, 0x3a, 0x7f, 0x0e, 0xc3, 0x87, 0x35, 0x2d, 0xd6, 0xb3, 0xf0,
-	0xfa, 0xa8, 0xf0, 0xfb, 0xaf, 0x21, 0x8a, 0xee, 0x1b, 0xc1, 0x6b, 0xc3, 0xdf, 0x01, 0x88, 0x85,
-	0xa1, 0x5f, 0x91, 0x7b, 0xf7, 0x95, 0x39, 0xc2, 0x7b, 0x4d, 0x35, 0x78, 0xcf, 0xdd, 0xdb, 0xcd,
-	0x12, 0x55, 0x0f, 0x05, 0x37, 0x47, 0x57, 0xd3, 0x00, 0x5f, 0xba, 0x09, 0xfe, 0xb0, 0xa5, 0x22,
-	0x6a, 0x23, 0xe7, 0xb7, 0x9a, 0x26, 0x91, 0x31, 0x79, 0x33, 0xb7, 0x23, 0xda, 0xb5, 0xd8, 0x3f,
-	0x78, 0x70, 0xd9, 0x6e, 0x56, 0x67, 0xad, 0xd6, 0x31, 0x7f, 0xbb, 0x3b, 0xfa, 0x68, 0x7f, 0xf4,
-	0xd1, 0xef, 0xa3, 0x8f, 0xbe, 0x9d, 0x7c, 0x67, 0x7f, 0xf2, 0x9d, 0x9f, 0x27, 0xdf, 0xf9, 0xf8,
-	0x38, 0x67, 0x7a, 0x55, 0x25, 0x38, 0xe5, 0x05, 0xb1, 0x79, 0xe3, 0x35, 0x4d, 0xd4, 0x79, 0x20,
-	0x9b, 0xe9, 0x33, 0xf2, 0xd9, 0xbc, 0x04, 0x5d, 0x0b, 0x50, 0x49, 0xa7, 0xdd, 0xd8, 0x93, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0xd9, 0xcd, 0x26, 0x76, 0x02, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x73, 0x04, 0x22, 0xe1, 0x32, 0x59, 0x11, 0x4d, 0x22, 0xe4, 0x18, 0x0b, 0xa1, 0x2c,
+	0xbd, 0xa3, 0x01, 0x96, 0x8a, 0x29, 0x02, 0x51, 0x60, 0xa9, 0x5c, 0x26, 0x96, 0xe3, 0xec, 0xbc,
+	0xda, 0x27, 0x62, 0xdf, 0xe9, 0xee, 0xdc, 0x92, 0x3f, 0x80, 0x9d, 0x91, 0xbf, 0x08, 0x75, 0xec,
+	0xc8, 0x14, 0x50, 0xb2, 0x31, 0xf6, 0x2f, 0x40, 0xbe, 0xbb, 0x20, 0x84, 0xd2, 0xcd, 0x4f, 0xbf,
+	0xef, 0xfb, 0xf4, 0xdd, 0x7b, 0x0e, 0x12, 0xa1, 0x2b, 0xa1, 0xb9, 0x26, 0xe6, 0x82, 0x49, 0x72,
+	0x7e, 0x98, 0x81, 0x61, 0x87, 0xa4, 0x80, 0x1a, 0x34, 0xd7, 0x58, 0x2a, 0x61, 0x44, 0xd8, 0xf7,
+	0x1a, 0xdc, 0x6a, 0xb0, 0xd7, 0x8c, 0xfa, 0x85, 0x28, 0x84, 0x15, 0x90, 0xf6, 0xcb, 0x69, 0x47,
+	0x8f, 0x77, 0xe6, 0xb5, 0x03, 0x55, 0x90, 0x0b, 0x35, 0xf7, 0xba, 0x61, 0x21, 0x44, 0xb1, 0x00,
+	0x62, 0xa7, 0xac, 0x39, 0x23, 0xac, 0x5e, 0x6e, 0x51, 0x6e, 0x33, 0xa8, 0xcb, 0x76, 0x83, 0x47,
+	0xd1, 0xff, 0xae, 0x79, 0xa3, 0x98, 0xe1, 0xa2, 0x76, 0x3c, 0xf9, 0x8e, 0x82, 0xde, 0x09, 0x53,
+	0xac, 0xd2, 0xe1, 0xb3, 0xe0, 0xbe, 0x54, 0x4d, 0x0d, 0x14, 0xa4, 0xc8, 0x4b, 0xca, 0xe7, 0x50,
+	0x1b, 0x7e, 0xc6, 0x41, 0x0d, 0x50, 0x8c, 0x26, 0x77, 0xd3, 0xbe, 0xa5, 0xaf, 0x5a, 0xf8, 0xe6,
+	0x2f, 0x0b, 0xbf, 0xa0, 0x60, 0xe4, 0x7a, 0xd2, 0x92, 0x6b, 0x23, 0xd4, 0x92, 0x7e, 0x02, 0x90,
+	0x54, 0x82, 0xe2, 0x62, 0x3e, 0xb8, 0x15, 0xa3, 0xc9, 0xde, 0x74, 0x88, 0x5d, 0x0d, 0xbc, 0xad,
+	0x81, 0x5f, 0xfa, 0x1a, 0xb3, 0x83, 0xcb, 0xd5, 0xb8, 0x73, 0xbd, 0x1a, 0x3f, 0x5c, 0xb2, 0x6a,
+	0x71, 0x94, 0xdc, 0x1c, 0x95, 0x7c, 0xfb, 0x39, 0x46, 0xe9, 0xbe, 0x13, 0x1c, 0x3b, 0xfe, 0x0e,
+	0x40, 0x9e, 0x38, 0xfa, 0x1b, 0x05, 0xf7, 0x5e, 0xbb, 0x23, 0x9c, 0x1a, 0x66, 0x20, 0x7c, 0x11,
+	0xdc, 0x69, 0x97, 0xa8, 0x07, 0x28, 0xee, 0x4e, 0xf6, 0xa6, 0x31, 0xde, 0x75, 0x13, 0xfc, 0xfe,
+	0x82, 0xc9, 0xd4, 0x46, 0xce, 0x6e, 0xb7, 0x4d, 0x52, 0x67, 0x0a, 0x8f, 0x82, 0x9e, 0xb4, 0x6b,
+	0xf1, 0x2f, 0x78, 0xb0, 0xdb, 0xee, 0x56, 0xe7, 0xad, 0xde, 0x11, 0x7e, 0x0c, 0x86, 0x2c, 0xcf,
+	0x9b, 0xaa, 0x59, 0x30, 0x23, 0x94, 0xa6, 0xda, 0x30, 0x65, 0x68, 0x09, 0xbc, 0x28, 0xcd, 0xa0,
+	0x1b, 0xa3, 0x49, 0x77, 0xf6, 0xe8, 0x7a, 0x35, 0x8e, 0xdd, 0x8b, 0x6f, 0x94, 0x26, 0xe9, 0xfe,
+	0xbf, 0xec, 0xb4, 0x45, 0xc7, 0x96, 0xcc, 0xde, 0x5e, 0xae, 0x23, 0x74, 0xb5, 0x8e, 0xd0, 0xaf,
+	0x75, 0x84, 0xbe, 0x6e, 0xa2, 0xce, 0xd5, 0x26, 0xea, 0xfc, 0xd8, 0x44, 0x9d, 0x0f, 0x4f, 0x0a,
+	0x6e, 0xca, 0x26, 0xc3, 0xb9, 0xa8, 0x88, 0x6f, 0x7c, 0xb0, 0x60, 0x99, 0xde, 0x0e, 0xe4, 0x7c,
+	0xfa, 0x9c, 0x7c, 0x76, 0xff, 0x9a, 0x59, 0x4a, 0xd0, 0x59, 0xcf, 0xde, 0xe4, 0xe9, 0x9f, 0x01,
+	0x00, 0x24, 0x2b, 0xfe, 0xb4, 0xd8, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -233,6 +247,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccumulatorsStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AccumulatorsStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -300,6 +319,9 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.AccumulatorsStartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.AccumulatorsStartHeight))
+	}
 	return n
 }
 
@@ -520,6 +542,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorsStartHeight", wireType)
+			}
+			m.AccumulatorsStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccumulatorsStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,6 +30,9 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 )
 
@@ -58,6 +61,9 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 					P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 					GeometricTwapAccumulator:    osmomath.OneDec(),
+					Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+					Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+					LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 				},
 				{
 					PoolId:                      basePoolId,
@@ -70,6 +76,9 @@ func TestGenesisState_Validate(t *testing.T) {
 					P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 					P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 					GeometricTwapAccumulator:    osmomath.OneDec(),
+					Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+					Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+					LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 				},
 			})
 	)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	time "time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	storetypes "cosmossdk.io/store/types"
@@ -30,8 +31,13 @@ var (
 	PruningStateKey = []byte{0x01}
 	// TODO: Delete in v26
 	DeprecatedHistoricalTWAPsIsPruningKey = []byte{0x02}
-	mostRecentTWAPsNoSeparator            = "recent_twap"
-	historicalTWAPPoolIndexNoSeparator    = "historical_pool_index"
+	AccumulatorsStartHeightKey            = []byte{0x03}
+
+	// Prefixes of the transient store, which is cleared at the end of every block.
+	ChangedPoolsPrefix                 = []byte{0x01}
+	BlockVolumePrefix                  = []byte{0x02}
+	mostRecentTWAPsNoSeparator         = "recent_twap"
+	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -52,6 +58,18 @@ func FormatKeyPoolTwapRecords(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", HistoricalTWAPPoolIndexPrefix, poolId))
 }
 
+// FormatChangedPoolKey returns the transient store key tracking that the pool changed in the current block.
+func FormatChangedPoolKey(poolId uint64) []byte {
+	return append(ChangedPoolsPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// FormatBlockVolumeKey returns the transient store key of the amount of volumeDenom swapped in the current block,
+// in swaps of the pool between denom0 and denom1.
+func FormatBlockVolumeKey(poolId uint64, denom0, denom1, volumeDenom string) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s%s%s", BlockVolumePrefix, poolIdS, KeySeparator, denom0, KeySeparator, denom1, KeySeparator, volumeDenom))
+}
+
 func FormatMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
 	return []byte(fmt.Sprintf("%s%s%s%s%s%s", mostRecentTWAPsPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
@@ -108,5 +126,15 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = osmomath.ZeroDec()
 	}
+	// records stored before volume weighted twaps were introduced have no volume accumulators.
+	if twap.Asset0VolumeAccumulator.IsNil() {
+		twap.Asset0VolumeAccumulator = osmomath.ZeroDec()
+	}
+	if twap.Asset1VolumeAccumulator.IsNil() {
+		twap.Asset1VolumeAccumulator = osmomath.ZeroDec()
+	}
+	if twap.LogPriceSquaredAccumulator.IsNil() {
+		twap.LogPriceSquaredAccumulator = osmomath.ZeroDec()
+	}
 	return twap, err
 }
@@ -71,6 +71,9 @@ func TestParseTwapFromBz(t *testing.T) {
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroDec(),
+		Asset1VolumeAccumulator:     osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  osmomath.ZeroDec(),
 	}
 
 	withGeomAcc := func(r TwapRecord, acc osmomath.Dec) TwapRecord {
@@ -78,21 +81,30 @@ func TestParseTwapFromBz(t *testing.T) {
 		return r
 	}
 
+	withoutVolumeAccs := func(r TwapRecord) TwapRecord {
+		r.Asset0VolumeAccumulator = osmomath.Dec{}
+		r.Asset1VolumeAccumulator = osmomath.Dec{}
+		return r
+	}
+
 	tests := map[string]struct {
 		record              TwapRecord
 		isGeometricAccumNil bool
+		isVolumeAccumNil    bool
 	}{
 		"standard": {
-			baseParseRecord,
-			false,
+			record: baseParseRecord,
 		},
 		"with nil geometric twap accumulator -> set to zero": {
-			withGeomAcc(baseParseRecord, osmomath.Dec{}),
-			true,
+			record:              withGeomAcc(baseParseRecord, osmomath.Dec{}),
+			isGeometricAccumNil: true,
 		},
 		"with non-nil geometric twap accumulator -> not overwritten": {
-			withGeomAcc(baseParseRecord, osmomath.OneDec()),
-			false,
+			record: withGeomAcc(baseParseRecord, osmomath.OneDec()),
+		},
+		"with nil volume accumulators -> set to zero": {
+			record:           withoutVolumeAccs(baseParseRecord),
+			isVolumeAccumNil: true,
 		},
 	}
 	for name, tt := range tests {
@@ -106,6 +118,10 @@ func TestParseTwapFromBz(t *testing.T) {
 			if tt.isGeometricAccumNil {
 				tt.record.GeometricTwapAccumulator = osmomath.ZeroDec()
 			}
+			if tt.isVolumeAccumNil {
+				tt.record.Asset0VolumeAccumulator = osmomath.ZeroDec()
+				tt.record.Asset1VolumeAccumulator = osmomath.ZeroDec()
+			}
 
 			require.Equal(t, tt.record, record)
 		})
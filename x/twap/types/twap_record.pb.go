@@ -57,6 +57,17 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// The volume accumulators are the total amounts of asset0 and asset1
+	// swapped in or out of the pool in swaps between the two assets, up to the
+	// time of the record. Together they give the volume weighted average price
+	// between two records, which is the amount of the quote asset traded over
+	// the amount of the base asset traded.
+	Asset0VolumeAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=asset0_volume_accumulator,json=asset0VolumeAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"asset0_volume_accumulator"`
+	Asset1VolumeAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=asset1_volume_accumulator,json=asset1VolumeAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"asset1_volume_accumulator"`
+	// Time weighted accumulator of the squared log base 2 of the p0 spot price.
+	// Together with the geometric twap accumulator it gives the variance of the
+	// log price between two records.
+	LogPriceSquaredAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=log_price_squared_accumulator,json=logPriceSquaredAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"log_price_squared_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -226,47 +237,51 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x21, 0xbf, 0x00, 0x9b, 0xf0, 0x43, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55,
-	0xe1, 0x50, 0x3b, 0xa6, 0xea, 0x85, 0x9e, 0x88, 0xe8, 0xa1, 0x2d, 0xaa, 0x22, 0xc3, 0xa9, 0x17,
-	0x6b, 0x63, 0x0f, 0x8e, 0x45, 0x9c, 0x5d, 0xed, 0x6e, 0xa0, 0xf9, 0x16, 0x7c, 0xa5, 0xde, 0x38,
-	0x72, 0xac, 0x7a, 0x48, 0x2b, 0xb8, 0xf5, 0xc8, 0xb1, 0xa7, 0x6a, 0x77, 0x9d, 0x94, 0xd0, 0x3f,
-	0xc0, 0xcd, 0x33, 0xfb, 0xe6, 0xbd, 0x37, 0xde, 0xd9, 0x41, 0xcf, 0x08, 0xcf, 0x09, 0xcf, 0xb8,
-	0x2f, 0x4e, 0x31, 0xf5, 0x4f, 0x82, 0x2e, 0x08, 0x1c, 0xa8, 0x20, 0x62, 0x10, 0x13, 0x96, 0x78,
-	0x94, 0x11, 0x41, 0xcc, 0xb5, 0x02, 0xe7, 0xc9, 0x23, 0xaf, 0xc0, 0xd5, 0xd7, 0x52, 0x92, 0x12,
-	0x05, 0xf0, 0xe5, 0x97, 0xc6, 0xd6, 0x9d, 0x94, 0x90, 0xb4, 0x0f, 0xbe, 0x8a, 0xba, 0xc3, 0x23,
-	0x5f, 0x64, 0x39, 0x70, 0x81, 0x73, 0xaa, 0x01, 0xee, 0xa7, 0x0a, 0x42, 0x87, 0xa7, 0x98, 0x86,
-	0x4a, 0xc1, 0x5c, 0x47, 0x0b, 0x94, 0x90, 0x7e, 0x94, 0x25, 0x96, 0xd1, 0x30, 0x9a, 0xe5, 0xb0,
-	0x22, 0xc3, 0x37, 0x89, 0xb9, 0x89, 0x6a, 0x98, 0x73, 0x10, 0xad, 0x28, 0x81, 0x01, 0xc9, 0xad,
-	0xb9, 0x86, 0xd1, 0x5c, 0x0a, 0xab, 0x3a, 0xb7, 0x27, 0x53, 0x53, 0x48, 0x50, 0x40, 0xe6, 0x6f,
-	0x40, 0x02, 0x0d, 0xd9, 0x45, 0x95, 0x1e, 0x64, 0x69, 0x4f, 0x58, 0xe5, 0x86, 0xd1, 0x9c, 0x6f,
-	0x6f, 0x7d, 0x1f, 0x3b, 0xcb, 0xba, 0xb9, 0x48, 0x1f, 0x5c, 0x8f, 0x9d, 0xb5, 0x11, 0xce, 0xfb,
-	0x3b, 0xee, 0x4c, 0xda, 0x0d, 0x8b, 0x42, 0xf3, 0x3d, 0x2a, 0xcb, 0x1e, 0xac, 0xff, 0x1a, 0x46,
-	0xb3, 0xba, 0x5d, 0xf7, 0x74, 0x83, 0xde, 0xa4, 0x41, 0xef, 0x70, 0xd2, 0x60, 0xdb, 0x3e, 0x1f,
-	0x3b, 0xa5, 0xeb, 0xb1, 0x63, 0xce, 0xf0, 0xc9, 0x62, 0xf7, 0xec, 0xab, 0x63, 0x84, 0x8a, 0xc7,
-	0xec, 0x20, 0x93, 0xb6, 0xa2, 0x3e, 0xe6, 0x22, 0xe2, 0x94, 0x88, 0x88, 0xb2, 0x2c, 0x06, 0xab,
-	0x22, 0xbd, 0xb7, 0x9f, 0x4a, 0x86, 0x2f, 0x63, 0x67, 0x23, 0x56, 0xbf, 0x9c, 0x27, 0xc7, 0x5e,
-	0x46, 0xfc, 0x1c, 0x8b, 0x9e, 0xb7, 0x0f, 0x29, 0x8e, 0x47, 0x7b, 0x10, 0x87, 0x2b, 0xb4, 0xb5,
-	0x8f, 0xb9, 0x38, 0xa0, 0x44, 0x74, 0x64, 0xad, 0x62, 0x0c, 0x7e, 0x63, 0x5c, 0x78, 0x08, 0x63,
-	0x30, 0xcb, 0xd8, 0x43, 0x36, 0x6d, 0x45, 0x98, 0x65, 0xa2, 0x97, 0x83, 0xc8, 0xe2, 0x48, 0x0d,
-	0x05, 0x8e, 0xe3, 0x61, 0x3e, 0xec, 0x63, 0x41, 0x98, 0xb5, 0x78, 0x7f, 0xf6, 0x0d, 0xda, 0xda,
-	0x9d, 0x32, 0xc9, 0xab, 0xdf, 0xfd, 0xc5, 0xa3, 0x94, 0x82, 0x7f, 0x2a, 0x2d, 0x3d, 0x44, 0x29,
-	0xf8, 0xbb, 0x12, 0x46, 0xf5, 0x14, 0x48, 0x0e, 0x82, 0xfd, 0x49, 0x05, 0xdd, 0x5f, 0xc5, 0x9a,
-	0xd2, 0xdc, 0x96, 0x38, 0x42, 0x2b, 0xea, 0x16, 0x80, 0x31, 0xc2, 0xd4, 0xc5, 0x5b, 0xd5, 0x3b,
-	0xa7, 0xc6, 0x2d, 0xa6, 0xe6, 0xb1, 0x9e, 0x9a, 0x5b, 0x04, 0x7a, 0x72, 0x96, 0x65, 0xf6, 0xb5,
-	0x4c, 0xca, 0x3a, 0xf7, 0x87, 0x81, 0x6a, 0x1d, 0x36, 0x1c, 0x64, 0x83, 0xf4, 0x40, 0x60, 0x01,
-	0xe6, 0x13, 0x84, 0x32, 0x1e, 0x51, 0x9d, 0x52, 0x0f, 0x69, 0x31, 0x5c, 0xca, 0x78, 0x81, 0x31,
-	0x63, 0xf4, 0xbf, 0xa2, 0x3d, 0x06, 0x2a, 0xb4, 0xad, 0xb9, 0x3b, 0x6d, 0x6d, 0x16, 0xb6, 0x1e,
-	0xdd, 0xb0, 0x35, 0xad, 0xd7, 0xae, 0x6a, 0x32, 0xf9, 0x0e, 0xa8, 0x90, 0x55, 0xe6, 0x2b, 0xb4,
-	0x5c, 0x80, 0x46, 0x11, 0x07, 0x18, 0xa8, 0xe7, 0x58, 0x6b, 0xaf, 0x5f, 0x8f, 0x9d, 0xd5, 0x04,
-	0x28, 0x83, 0x18, 0x0b, 0x48, 0x76, 0x5c, 0xc1, 0x86, 0xe0, 0x5a, 0x46, 0x58, 0xd5, 0xd5, 0xa3,
-	0x03, 0x80, 0x81, 0xb9, 0x85, 0x56, 0xf5, 0xfc, 0x02, 0x0c, 0xa2, 0xc9, 0x42, 0x28, 0xab, 0x85,
-	0xa0, 0xac, 0x4b, 0x50, 0x47, 0x2d, 0x86, 0xf6, 0xdb, 0xf3, 0x4b, 0xdb, 0xb8, 0xb8, 0xb4, 0x8d,
-	0x6f, 0x97, 0xb6, 0x71, 0x76, 0x65, 0x97, 0x2e, 0xae, 0xec, 0xd2, 0xe7, 0x2b, 0xbb, 0xf4, 0xa1,
-	0x95, 0x66, 0xa2, 0x37, 0xec, 0x7a, 0x31, 0xc9, 0xfd, 0x62, 0x65, 0x3d, 0xef, 0xe3, 0x2e, 0x9f,
-	0x04, 0xfe, 0xc9, 0xf6, 0x4b, 0xff, 0xa3, 0xde, 0x76, 0x62, 0x44, 0x81, 0x77, 0x2b, 0xaa, 0xf1,
-	0x17, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x30, 0x20, 0x56, 0x0a, 0x05, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x31, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x21, 0x0d, 0x70, 0x49, 0x40, 0x58, 0xb4, 0xb8, 0x41, 0xd8, 0xc1, 0x95, 0xaa, 0x30,
+	0xd4, 0x8e, 0xa9, 0xba, 0xd0, 0x89, 0x88, 0x0e, 0x6d, 0x51, 0x15, 0x39, 0xa8, 0x43, 0x17, 0xeb,
+	0x62, 0x1f, 0x8e, 0x85, 0x9d, 0xbb, 0xfa, 0xce, 0xd0, 0xfc, 0x0b, 0x7e, 0x16, 0x23, 0x63, 0xd5,
+	0x21, 0xad, 0x60, 0x6b, 0x37, 0xc6, 0x4e, 0xd5, 0xdd, 0x39, 0x69, 0x02, 0xb4, 0x84, 0x2d, 0xef,
+	0xf9, 0x7b, 0xdf, 0xe7, 0x2f, 0xdf, 0xcb, 0x0b, 0x78, 0x8e, 0x69, 0x82, 0x69, 0x44, 0x6d, 0x76,
+	0x0a, 0x89, 0x7d, 0xe2, 0x74, 0x11, 0x83, 0x8e, 0x28, 0xbc, 0x14, 0xf9, 0x38, 0x0d, 0x2c, 0x92,
+	0x62, 0x86, 0xd5, 0xb5, 0x1c, 0x67, 0xf1, 0x47, 0x56, 0x8e, 0xab, 0xad, 0x85, 0x38, 0xc4, 0x02,
+	0x60, 0xf3, 0x4f, 0x12, 0x5b, 0x33, 0x42, 0x8c, 0xc3, 0x18, 0xd9, 0xa2, 0xea, 0x66, 0x47, 0x36,
+	0x8b, 0x12, 0x44, 0x19, 0x4c, 0x88, 0x04, 0x98, 0xbf, 0x16, 0x01, 0x38, 0x3c, 0x85, 0xc4, 0x15,
+	0x0a, 0xea, 0x3a, 0x58, 0x20, 0x18, 0xc7, 0x5e, 0x14, 0x68, 0x4a, 0x5d, 0x69, 0x14, 0xdd, 0x12,
+	0x2f, 0xdf, 0x06, 0xea, 0x16, 0xa8, 0x40, 0x4a, 0x11, 0x6b, 0x7a, 0x01, 0xea, 0xe3, 0x44, 0x9b,
+	0xab, 0x2b, 0x8d, 0x25, 0xb7, 0x2c, 0x7b, 0xfb, 0xbc, 0x35, 0x86, 0x38, 0x39, 0x64, 0x7e, 0x02,
+	0xe2, 0x48, 0xc8, 0x1e, 0x28, 0xf5, 0x50, 0x14, 0xf6, 0x98, 0x56, 0xac, 0x2b, 0x8d, 0xf9, 0xd6,
+	0xf6, 0xcf, 0xa1, 0x51, 0x95, 0xe6, 0x3c, 0xf9, 0xe0, 0x7a, 0x68, 0xac, 0x0d, 0x60, 0x12, 0xef,
+	0x9a, 0x53, 0x6d, 0xd3, 0xcd, 0x07, 0xd5, 0x0f, 0xa0, 0xc8, 0x3d, 0x68, 0x8f, 0xea, 0x4a, 0xa3,
+	0xbc, 0x53, 0xb3, 0xa4, 0x41, 0x6b, 0x64, 0xd0, 0x3a, 0x1c, 0x19, 0x6c, 0xe9, 0xe7, 0x43, 0xa3,
+	0x70, 0x3d, 0x34, 0xd4, 0x29, 0x3e, 0x3e, 0x6c, 0x9e, 0x7d, 0x37, 0x14, 0x57, 0xf0, 0xa8, 0x6d,
+	0xa0, 0x92, 0xa6, 0x17, 0x43, 0xca, 0x3c, 0x4a, 0x30, 0xf3, 0x48, 0x1a, 0xf9, 0x48, 0x2b, 0xf1,
+	0x77, 0x6f, 0x3d, 0xe3, 0x0c, 0xdf, 0x86, 0xc6, 0x86, 0x2f, 0xbe, 0x72, 0x1a, 0x1c, 0x5b, 0x11,
+	0xb6, 0x13, 0xc8, 0x7a, 0xd6, 0x01, 0x0a, 0xa1, 0x3f, 0xd8, 0x47, 0xbe, 0xbb, 0x42, 0x9a, 0x07,
+	0x90, 0xb2, 0x0e, 0xc1, 0xac, 0xcd, 0x67, 0x05, 0xa3, 0x73, 0x8b, 0x71, 0xe1, 0x21, 0x8c, 0xce,
+	0x34, 0x63, 0x0f, 0xe8, 0xa4, 0xe9, 0xc1, 0x34, 0x62, 0xbd, 0x04, 0xb1, 0xc8, 0xf7, 0xc4, 0x52,
+	0x40, 0xdf, 0xcf, 0x92, 0x2c, 0x86, 0x0c, 0xa7, 0xda, 0xe2, 0xec, 0xec, 0x1b, 0xa4, 0xb9, 0x37,
+	0x66, 0xe2, 0xd1, 0xef, 0xfd, 0xe5, 0x11, 0x4a, 0xce, 0x7f, 0x95, 0x96, 0x1e, 0xa2, 0xe4, 0xfc,
+	0x5b, 0x09, 0x82, 0x5a, 0x88, 0x70, 0x82, 0x58, 0x7a, 0x97, 0x0a, 0x98, 0x5d, 0x45, 0x1b, 0xd3,
+	0xdc, 0x94, 0x38, 0x02, 0x2b, 0x22, 0x05, 0x94, 0xa6, 0x38, 0x15, 0xc1, 0x6b, 0xe5, 0x7b, 0xb7,
+	0xc6, 0xcc, 0xb7, 0xe6, 0x89, 0xdc, 0x9a, 0x1b, 0x04, 0x72, 0x73, 0xaa, 0xbc, 0xfb, 0x86, 0x37,
+	0xf9, 0x9c, 0xea, 0x81, 0xa7, 0xf9, 0x6f, 0xe3, 0x04, 0xc7, 0x59, 0x82, 0xa6, 0x9c, 0x54, 0x66,
+	0x77, 0xb2, 0x2e, 0x59, 0x3e, 0x0a, 0x92, 0x49, 0x23, 0x23, 0x01, 0xe7, 0x2e, 0x81, 0xea, 0x43,
+	0x05, 0x9c, 0xdb, 0x02, 0x47, 0x60, 0x33, 0xc6, 0xa1, 0xdc, 0x54, 0x8f, 0x7e, 0xce, 0x60, 0x8a,
+	0x82, 0x29, 0x91, 0xe5, 0xd9, 0x45, 0x6a, 0x31, 0x0e, 0xc5, 0xda, 0x76, 0x24, 0xcf, 0x84, 0x8e,
+	0xf9, 0x5b, 0x01, 0x95, 0x76, 0x9a, 0xf5, 0xa3, 0x7e, 0xd8, 0x61, 0x90, 0x21, 0x75, 0x13, 0x80,
+	0x88, 0x7a, 0x44, 0xb6, 0xc4, 0xc9, 0x59, 0x74, 0x97, 0x22, 0x9a, 0x63, 0x54, 0x1f, 0x2c, 0x8b,
+	0x00, 0x8e, 0x11, 0x61, 0x32, 0xc0, 0xb9, 0x7b, 0x03, 0xdc, 0xca, 0x03, 0x7c, 0x3c, 0x11, 0xe0,
+	0x78, 0x5e, 0xe6, 0x57, 0xe1, 0xcd, 0xf7, 0x88, 0x30, 0x11, 0xdf, 0x6b, 0x50, 0xcd, 0x41, 0x03,
+	0x8f, 0x22, 0xd4, 0x17, 0x87, 0xab, 0xd2, 0x5a, 0xbf, 0x1e, 0x1a, 0xab, 0x01, 0x22, 0x29, 0xf2,
+	0x21, 0x43, 0xc1, 0xae, 0xc9, 0xd2, 0x0c, 0x99, 0x9a, 0xe2, 0x96, 0xe5, 0xf4, 0xa0, 0x83, 0x50,
+	0x5f, 0xdd, 0x06, 0xab, 0x62, 0x98, 0x0f, 0x7a, 0xa3, 0xd3, 0x59, 0x14, 0xa7, 0x53, 0xbc, 0x3a,
+	0x07, 0xb5, 0xc5, 0x09, 0x6d, 0xbd, 0x3b, 0xbf, 0xd4, 0x95, 0x8b, 0x4b, 0x5d, 0xf9, 0x71, 0xa9,
+	0x2b, 0x67, 0x57, 0x7a, 0xe1, 0xe2, 0x4a, 0x2f, 0x7c, 0xbd, 0xd2, 0x0b, 0x9f, 0x9a, 0x61, 0xc4,
+	0x7a, 0x59, 0xd7, 0xf2, 0x71, 0x62, 0xe7, 0xc7, 0xfd, 0x45, 0x0c, 0xbb, 0x74, 0x54, 0xd8, 0x27,
+	0x3b, 0xaf, 0xec, 0x2f, 0xf2, 0x7f, 0x81, 0x0d, 0x08, 0xa2, 0xdd, 0x92, 0x30, 0xfe, 0xf2, 0xcf,
+	0x00, 0xfd, 0x37, 0x22, 0x51, 0x34, 0x06, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -289,6 +304,36 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LogPriceSquaredAccumulator.Size()
+		i -= size
+		if _, err := m.LogPriceSquaredAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.Asset1VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset1VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.Asset0VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset0VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -480,6 +525,12 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Asset0VolumeAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Asset1VolumeAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.LogPriceSquaredAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -877,6 +928,108 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset0VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPriceSquaredAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogPriceSquaredAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
@@ -11,6 +11,8 @@ import (
 var (
 	MaxSpotPrice       = osmomath.NewDec(2).Power(128).Sub(osmomath.OneDec())
 	MaxSpotPriceBigDec = osmomath.BigDecFromDec(MaxSpotPrice)
+	// Ln2 is the natural logarithm of 2, used to convert log base 2 prices to natural log prices.
+	Ln2 = osmomath.MustNewDecFromStr("0.693147180559945309")
 )
 
 // GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
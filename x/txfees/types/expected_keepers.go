@@ -24,7 +24,8 @@ type PoolManager interface {
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
-		tokenOutMinAmount osmomath.Int) (tokenOutAmount osmomath.Int, err error)
+		tokenOutMinAmount osmomath.Int,
+		limits poolmanagertypes.SwapLimits) (tokenOutAmount osmomath.Int, err error)
 
 	SwapExactAmountIn(
 		ctx sdk.Context,